@@ -8,16 +8,21 @@ import (
 
 	sifchainAnte "github.com/Sifchain/sifnode/app/ante"
 	"github.com/Sifchain/sifnode/x/clp"
+	clpclient "github.com/Sifchain/sifnode/x/clp/client"
 	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
 	clptypes "github.com/Sifchain/sifnode/x/clp/types"
 	"github.com/Sifchain/sifnode/x/dispensation"
 	dispkeeper "github.com/Sifchain/sifnode/x/dispensation/keeper"
 	disptypes "github.com/Sifchain/sifnode/x/dispensation/types"
 	"github.com/Sifchain/sifnode/x/ethbridge"
+	ethbridgeclient "github.com/Sifchain/sifnode/x/ethbridge/client"
 	ethbridgekeeper "github.com/Sifchain/sifnode/x/ethbridge/keeper"
 	ethbridgetypes "github.com/Sifchain/sifnode/x/ethbridge/types"
 	ibctransferoverride "github.com/Sifchain/sifnode/x/ibctransfer"
 	sctransfertypes "github.com/Sifchain/sifnode/x/ibctransfer/types"
+	"github.com/Sifchain/sifnode/x/margin"
+	marginkeeper "github.com/Sifchain/sifnode/x/margin/keeper"
+	margintypes "github.com/Sifchain/sifnode/x/margin/types"
 	"github.com/Sifchain/sifnode/x/oracle"
 	oraclekeeper "github.com/Sifchain/sifnode/x/oracle/keeper"
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
@@ -37,6 +42,7 @@ import (
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -88,6 +94,7 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfer "github.com/cosmos/ibc-go/v2/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v2/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v2/modules/core"
@@ -129,6 +136,12 @@ var (
 			upgradeclient.ProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
+			clpclient.UpdatePoolFeeTierProposalHandler,
+			clpclient.TreasuryAddLiquidityProposalHandler,
+			clpclient.TreasuryRemoveLiquidityProposalHandler,
+			clpclient.TreasuryRebalanceProposalHandler,
+			ethbridgeclient.SetPauseProposalHandler,
+			ethbridgeclient.SetAdminRoleProposalHandler,
 		),
 		params.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
@@ -142,6 +155,7 @@ var (
 		ethbridge.AppModuleBasic{},
 		dispensation.AppModuleBasic{},
 		tokenregistry.AppModuleBasic{},
+		margin.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 	)
 
@@ -156,7 +170,14 @@ var (
 		sctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 		ethbridgetypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
 		clptypes.ModuleName:            {authtypes.Burner, authtypes.Minter},
+		clptypes.TreasuryName:          nil,
 		dispensation.ModuleName:        {authtypes.Burner, authtypes.Minter},
+		margintypes.ModuleName:         nil,
+	}
+	// allowedReceivingModAcc are the module accounts that can receive funds, so governance can fund the clp treasury
+	// with community pool spend proposals
+	allowedReceivingModAcc = map[string]bool{
+		clptypes.TreasuryName: true,
 	}
 )
 
@@ -214,6 +235,7 @@ type SifchainApp struct {
 	EthbridgeKeeper     ethbridgekeeper.Keeper
 	DispensationKeeper  dispkeeper.Keeper
 	TokenRegistryKeeper tokenregistrytypes.Keeper
+	MarginKeeper        marginkeeper.Keeper
 
 	mm           *module.Manager
 	sm           *module.SimulationManager
@@ -252,6 +274,7 @@ func NewSifApp(
 		clptypes.StoreKey,
 		oracletypes.StoreKey,
 		tokenregistrytypes.StoreKey,
+		margintypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -293,7 +316,7 @@ func NewSifApp(
 		appCodec, keys[banktypes.StoreKey],
 		app.AccountKeeper,
 		app.GetSubspace(banktypes.ModuleName),
-		app.ModuleAccountAddrs(),
+		app.BlockedAddrs(),
 	)
 
 	app.FeegrantKeeper = feegrantkeeper.NewKeeper(
@@ -346,6 +369,15 @@ func NewSifApp(
 		app.TokenRegistryKeeper,
 		app.GetSubspace(clptypes.ModuleName),
 	)
+	app.MarginKeeper = marginkeeper.NewKeeper(
+		appCodec,
+		keys[margintypes.StoreKey],
+		app.BankKeeper,
+		app.ClpKeeper,
+		app.TokenRegistryKeeper,
+		app.GetSubspace(margintypes.ModuleName),
+	)
+	app.ClpKeeper.SetMarginKeeper(app.MarginKeeper)
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
@@ -356,12 +388,30 @@ func NewSifApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec,
+		keys[oracletypes.StoreKey],
+		app.StakingKeeper,
+		app.GetSubspace(oracletypes.ModuleName),
+	)
+
+	app.EthbridgeKeeper = ethbridgekeeper.NewKeeper(
+		appCodec,
+		app.BankKeeper,
+		app.OracleKeeper,
+		app.AccountKeeper,
+		app.TokenRegistryKeeper,
+		keys[ethbridgetypes.StoreKey],
+	)
+
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(clptypes.RouterKey, clp.NewProposalHandler(app.ClpKeeper)).
+		AddRoute(ethbridgetypes.RouterKey, ethbridge.NewProposalHandler(app.EthbridgeKeeper))
 	govKeeper := govkeeper.NewKeeper(
 		appCodec,
 		keys[govtypes.StoreKey],
@@ -389,21 +439,6 @@ func NewSifApp(
 		scopedTransferKeeper,
 	)
 	transferModule := ibctransferoverride.NewAppModule(app.TransferKeeper, app.TokenRegistryKeeper, app.BankKeeper, appCodec)
-	app.OracleKeeper = oraclekeeper.NewKeeper(
-		appCodec,
-		keys[oracletypes.StoreKey],
-		app.StakingKeeper,
-		oracletypes.DefaultConsensusNeeded,
-	)
-
-	app.EthbridgeKeeper = ethbridgekeeper.NewKeeper(
-		appCodec,
-		app.BankKeeper,
-		app.OracleKeeper,
-		app.AccountKeeper,
-		keys[ethbridgetypes.StoreKey],
-	)
-
 	app.DispensationKeeper = dispkeeper.NewKeeper(
 		appCodec,
 		keys[disptypes.StoreKey],
@@ -459,6 +494,7 @@ func NewSifApp(
 		ethbridge.NewAppModule(app.OracleKeeper, app.BankKeeper, app.AccountKeeper, app.EthbridgeKeeper, &appCodec),
 		dispensation.NewAppModule(app.DispensationKeeper, app.BankKeeper, app.AccountKeeper),
 		tokenregistry.NewAppModule(app.TokenRegistryKeeper, &appCodec),
+		margin.NewAppModule(app.MarginKeeper, app.AccountKeeper),
 	)
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
@@ -478,6 +514,8 @@ func NewSifApp(
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
+		margintypes.ModuleName,
+		ethbridgetypes.ModuleName,
 	)
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -501,10 +539,37 @@ func NewSifApp(
 		ethbridge.ModuleName,
 		dispensation.ModuleName,
 		tokenregistry.ModuleName,
+		margintypes.ModuleName,
 	)
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(app.configurator)
+	// create the simulation manager and define the order of the modules for deterministic simulations
+	// NOTE: bank must come before clp, which funds the simulation accounts in
+	// the bank genesis, and clp before tokenregistry and ethbridge, which
+	// register the denoms found there. margin enables the clp simulation assets.
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		simBankModule{bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper)},
+		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
+		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeegrantKeeper, app.interfaceRegistry),
+		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		params.NewAppModule(app.ParamsKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper),
+		ibc.NewAppModule(app.IBCKeeper),
+		ibctransfer.NewAppModule(app.TransferKeeper),
+		clp.NewAppModule(app.ClpKeeper, app.BankKeeper),
+		tokenregistry.NewAppModule(app.TokenRegistryKeeper, &appCodec),
+		oracle.NewAppModule(app.OracleKeeper),
+		ethbridge.NewAppModule(app.OracleKeeper, app.BankKeeper, app.AccountKeeper, app.EthbridgeKeeper, &appCodec),
+		dispensation.NewAppModule(app.DispensationKeeper, app.BankKeeper, app.AccountKeeper),
+		margin.NewAppModule(app.MarginKeeper, app.AccountKeeper),
+	)
+	app.sm.RegisterStoreDecoders()
 	// initialize stores
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
@@ -619,10 +684,30 @@ func (app *SifchainApp) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
+// BlockedAddrs returns the module account addresses that cannot receive funds.
+func (app *SifchainApp) BlockedAddrs() map[string]bool {
+	blockedAddrs := make(map[string]bool)
+	for acc := range maccPerms {
+		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = !allowedReceivingModAcc[acc]
+	}
+	return blockedAddrs
+}
+
 func (app *SifchainApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// simBankModule is the bank module without its simulation operations, random
+// sends pay random fees and are rejected by the rowan fee requirement of the
+// ante handler.
+type simBankModule struct {
+	bank.AppModule
+}
+
+func (simBankModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
+
 func GetMaccPerms() map[string][]string {
 	modAccPerms := make(map[string][]string)
 	for k, v := range maccPerms {
@@ -682,6 +767,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(distrtypes.ModuleName)
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(clptypes.ModuleName)
+	paramsKeeper.Subspace(margintypes.ModuleName)
+	paramsKeeper.Subspace(oracletypes.ModuleName)
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
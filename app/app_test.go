@@ -7,8 +7,12 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/pkg/errors"
 
@@ -18,6 +22,11 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	ethbridgetypes "github.com/Sifchain/sifnode/x/ethbridge/types"
+	margintypes "github.com/Sifchain/sifnode/x/margin/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 )
 
 func TestAppUpgrade_CannotDeleteLatestVersion(t *testing.T) {
@@ -212,6 +221,70 @@ func TestAppUpgrade_CannotLoadCorruptStoreUsingLatestHeight(t *testing.T) {
 	require.Contains(t, err.Error(), errors.Errorf("initial version set to %v, but found earlier version %v", 110, 1).Error())
 }
 
+func TestMarginUpgradeHandler(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, types.Header{Height: 10})
+	vm := app.mm.GetVersionMap()
+	vm[clptypes.ModuleName] = 1
+	vm[ethbridgetypes.ModuleName] = 1
+	vm[oracletypes.ModuleName] = 1
+	delete(vm, margintypes.ModuleName)
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: marginUpgradeName, Height: ctx.BlockHeight()})
+	// every module, margin included, is at its consensus version after the upgrade
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+}
+
+func TestAdminRolesOnlyGrantedByGovernance(t *testing.T) {
+	app := Setup(false)
+	// the admin account has no message to grant or revoke bridge admin roles, they are set by proposals
+	for _, typeURL := range []string{"/sifnode.ethbridge.v1.MsgGrantAdminRole", "/sifnode.ethbridge.v1.MsgRevokeAdminRole"} {
+		_, err := app.interfaceRegistry.Resolve(typeURL)
+		require.Error(t, err)
+		require.Nil(t, app.MsgServiceRouter().HandlerByTypeURL(typeURL))
+	}
+	_, err := app.interfaceRegistry.Resolve("/sifnode.ethbridge.v1.SetAdminRoleProposal")
+	require.NoError(t, err)
+}
+
+func TestOracleUpgradeProcessesClaims(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, types.Header{Height: 10})
+	// before version 2 of the oracle the consensus needed was hard-wired, with no param in the store
+	paramStore := prefix.NewStore(ctx.KVStore(app.keys[paramstypes.StoreKey]), append([]byte(oracletypes.ModuleName), '/'))
+	paramStore.Delete(oracletypes.KeyConsensusNeeded)
+	require.Equal(t, oracletypes.DefaultConsensusNeeded, app.OracleKeeper.GetConsensusNeeded(ctx))
+	vm := app.mm.GetVersionMap()
+	vm[oracletypes.ModuleName] = 1
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+	// a single whitelisted validator holding all the power
+	pubKey := CreateTestPubKeys(1)[0]
+	valAddress := sdk.ValAddress(pubKey.Address())
+	tokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokens))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.NotBondedPoolName, coins))
+	validator, err := stakingtypes.NewValidator(valAddress, pubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	validator, _ = validator.AddTokensFromDel(tokens)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	app.OracleKeeper.SetOracleWhiteList(ctx, []sdk.ValAddress{valAddress})
+
+	// the upgrade handler migrates the oracle from version 1, which sets the param
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: marginUpgradeName, Height: ctx.BlockHeight()})
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+	require.Equal(t, oracletypes.DefaultParams(), app.OracleKeeper.GetParams(ctx))
+
+	status, err := app.OracleKeeper.ProcessClaim(ctx, oracletypes.NewClaim("oracleID", valAddress.String(), "{value: 5}"))
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -223,8 +296,9 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	app := NewSifApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
 
 	for acc := range maccPerms {
-		require.True(
+		require.Equal(
 			t,
+			!allowedReceivingModAcc[acc],
 			app.BankKeeper.BlockedAddr(app.AccountKeeper.GetModuleAddress(acc)),
 			"ensure that blocked addresses are properly set in bank keeper",
 		)
@@ -9,10 +9,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibcconnectiontypes "github.com/cosmos/ibc-go/v2/modules/core/03-connection/types"
+
+	margintypes "github.com/Sifchain/sifnode/x/margin/types"
 )
 
 const upgradeName = "0.10.0"
 
+// marginUpgradeName adds the margin module and migrates clp, ethbridge and oracle to their current consensus versions
+const marginUpgradeName = "0.11.0"
+
 func SetupHandlers(app *SifchainApp) {
 	app.UpgradeKeeper.SetUpgradeHandler(upgradeName, func(ctx sdk.Context, plan types.Plan, vm m.VersionMap) (m.VersionMap, error) {
 		app.Logger().Info("Running upgrade handler for " + upgradeName)
@@ -43,6 +48,12 @@ func SetupHandlers(app *SifchainApp) {
 		delete(vm, crisistypes.ModuleName)
 		return app.mm.RunMigrations(ctx, app.configurator, vm)
 	})
+	app.UpgradeKeeper.SetUpgradeHandler(marginUpgradeName, func(ctx sdk.Context, plan types.Plan, vm m.VersionMap) (m.VersionMap, error) {
+		app.Logger().Info("Running upgrade handler for " + marginUpgradeName)
+		// Margin is missing from the version map and is initialised with its default genesis, the other modules
+		// run the migrations registered from their version on chain up to their ConsensusVersion
+		return app.mm.RunMigrations(ctx, app.configurator, vm)
+	})
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
@@ -57,4 +68,10 @@ func SetupHandlers(app *SifchainApp) {
 		// instead the default which is the latest version that store last committed i.e 0 for new stores.
 		app.SetStoreLoader(types.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
+	if upgradeInfo.Name == marginUpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{margintypes.StoreKey},
+		}
+		app.SetStoreLoader(types.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
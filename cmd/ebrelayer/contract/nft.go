@@ -0,0 +1,27 @@
+package contract
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// NftEventsABI is the ABI of the event BridgeBank emits when an ERC-721 token is locked to be bridged to sifchain
+const NftEventsABI = `[{"anonymous":false,"inputs":[` +
+	`{"indexed":false,"internalType":"address","name":"_from","type":"address"},` +
+	`{"indexed":false,"internalType":"bytes","name":"_to","type":"bytes"},` +
+	`{"indexed":false,"internalType":"address","name":"_token","type":"address"},` +
+	`{"indexed":false,"internalType":"string","name":"_symbol","type":"string"},` +
+	`{"indexed":false,"internalType":"uint256","name":"_tokenId","type":"uint256"},` +
+	`{"indexed":false,"internalType":"string","name":"_tokenURI","type":"string"},` +
+	`{"indexed":false,"internalType":"uint256","name":"_nonce","type":"uint256"}],` +
+	`"name":"LogNftLock","type":"event"}]`
+
+// LoadNftEventsABI loads the ABI of the NFT events of BridgeBank
+func LoadNftEventsABI() abi.ABI {
+	nftABI, err := abi.JSON(strings.NewReader(NftEventsABI))
+	if err != nil {
+		panic(err)
+	}
+	return nftABI
+}
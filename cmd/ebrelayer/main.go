@@ -217,11 +217,12 @@ func RunInitRelayerCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	// Initialize new Cosmos event listener
-	cosmosSub := relayer.NewCosmosSub(tendermintNode, web3Provider, contractAddress, key, db, sugaredLogger)
+	txFactory := tx.NewFactoryCLI(cliContext, cmd.Flags())
+	cosmosSub := relayer.NewCosmosSub(tendermintNode, web3Provider, contractAddress, key, db, sugaredLogger).
+		WithOutboundReports(cliContext, validatorMoniker, txFactory)
 
 	waitForAll := sync.WaitGroup{}
 	waitForAll.Add(2)
-	txFactory := tx.NewFactoryCLI(cliContext, cmd.Flags())
 	go ethSub.Start(txFactory, &waitForAll, symbolTranslator)
 	go cosmosSub.Start(&waitForAll, symbolTranslator)
 	waitForAll.Wait()
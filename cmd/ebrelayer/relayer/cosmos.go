@@ -24,11 +24,16 @@ import (
 	cosmosbridge "github.com/Sifchain/sifnode/cmd/ebrelayer/contract/generated/bindings/cosmosbridge"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
+	ethbridge "github.com/Sifchain/sifnode/x/ethbridge/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gogo/protobuf/proto"
 	"github.com/syndtr/goleveldb/leveldb"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmClient "github.com/tendermint/tendermint/rpc/client/http"
 	tmTypes "github.com/tendermint/tendermint/types"
 	"go.uber.org/zap"
@@ -49,6 +54,11 @@ type CosmosSub struct {
 	PrivateKey              *ecdsa.PrivateKey
 	DB                      *leveldb.DB
 	SugaredLogger           *zap.SugaredLogger
+	// CliCtx, ValidatorName and TxFactory report relayed locks and burns back to sifchain, reports are skipped
+	// when ValidatorName is empty
+	CliCtx        client.Context
+	ValidatorName string
+	TxFactory     tx.Factory
 }
 
 // NewCosmosSub initializes a new CosmosSub
@@ -66,6 +76,15 @@ func NewCosmosSub(tmProvider, ethProvider string, registryContractAddress common
 	}
 }
 
+// WithOutboundReports returns a copy of the CosmosSub that reports the ethereum transactions of relayed locks and
+// burns to sifchain, signed by the validator
+func (sub CosmosSub) WithOutboundReports(cliCtx client.Context, validatorName string, txFactory tx.Factory) CosmosSub {
+	sub.CliCtx = cliCtx
+	sub.ValidatorName = validatorName
+	sub.TxFactory = txFactory
+	return sub
+}
+
 // Start a Cosmos chain subscription
 func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *symbol_translator.SymbolTranslator) {
 	defer completionEvent.Done()
@@ -159,7 +178,7 @@ func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *sy
 				}
 
 				for _, txLog := range block.TxsResults {
-					for _, event := range txLog.Events {
+					for _, event := range burnLockEvents(txLog.Events) {
 
 						claimType := getOracleClaimType(event.GetType())
 
@@ -167,7 +186,7 @@ func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *sy
 
 						switch claimType {
 						case types.MsgBurn, types.MsgLock:
-							cosmosMsg, err := txs.BurnLockEventToCosmosMsg(claimType, event.GetAttributes(), symbolTranslator, sub.SugaredLogger)
+							cosmosMsg, err := burnLockEventToCosmosMsg(claimType, event, symbolTranslator, sub.SugaredLogger)
 							if err != nil {
 								sub.SugaredLogger.Errorw("sifchain client failed in get message from event.",
 									errorMessageKey, err.Error())
@@ -346,7 +365,7 @@ func (sub CosmosSub) Replay(symbolTranslator *symbol_translator.SymbolTranslator
 		}
 
 		for _, ethLog := range block.TxsResults {
-			for _, event := range ethLog.Events {
+			for _, event := range burnLockEvents(ethLog.Events) {
 
 				claimType := getOracleClaimType(event.GetType())
 
@@ -354,7 +373,7 @@ func (sub CosmosSub) Replay(symbolTranslator *symbol_translator.SymbolTranslator
 				case types.MsgBurn, types.MsgLock:
 					log.Println("found out a lock burn message")
 
-					cosmosMsg, err := txs.BurnLockEventToCosmosMsg(claimType, event.GetAttributes(), symbolTranslator, sub.SugaredLogger)
+					cosmosMsg, err := burnLockEventToCosmosMsg(claimType, event, symbolTranslator, sub.SugaredLogger)
 					if err != nil {
 						log.Println(err)
 						continue
@@ -372,13 +391,40 @@ func (sub CosmosSub) Replay(symbolTranslator *symbol_translator.SymbolTranslator
 	}
 }
 
+// burnLockEvents returns the lock and burn events of a tx, its typed events or its legacy events when it was
+// executed before typed events were emitted
+func burnLockEvents(events []abci.Event) []abci.Event {
+	var typed, legacy []abci.Event
+	for _, event := range events {
+		switch event.GetType() {
+		case proto.MessageName(&ethbridge.EventBurn{}), proto.MessageName(&ethbridge.EventLock{}):
+			typed = append(typed, event)
+		case types.MsgBurn.String(), types.MsgLock.String():
+			legacy = append(legacy, event)
+		}
+	}
+	if len(typed) > 0 {
+		return typed
+	}
+	return legacy
+}
+
+// burnLockEventToCosmosMsg parses a typed or legacy lock or burn event into a CosmosMsg struct
+func burnLockEventToCosmosMsg(claimType types.Event, event abci.Event, symbolTranslator *symbol_translator.SymbolTranslator,
+	sugaredLogger *zap.SugaredLogger) (types.CosmosMsg, error) {
+	if event.GetType() == claimType.String() {
+		return txs.BurnLockEventToCosmosMsg(claimType, event.GetAttributes(), symbolTranslator, sugaredLogger)
+	}
+	return txs.TypedBurnLockEventToCosmosMsg(event, symbolTranslator, sugaredLogger)
+}
+
 // getOracleClaimType sets the OracleClaim's claim type based upon the witnessed event type
 func getOracleClaimType(eventType string) types.Event {
 	var claimType types.Event
 	switch eventType {
-	case types.MsgBurn.String():
+	case types.MsgBurn.String(), proto.MessageName(&ethbridge.EventBurn{}):
 		claimType = types.MsgBurn
-	case types.MsgLock.String():
+	case types.MsgLock.String(), proto.MessageName(&ethbridge.EventLock{}):
 		claimType = types.MsgLock
 	default:
 		claimType = types.Unsupported
@@ -439,8 +485,9 @@ func (sub CosmosSub) handleBurnLockMsg(
 	maxRetries := 5
 	i := 0
 
+	var txHash common.Hash
 	for i < maxRetries {
-		err = txs.RelayProphecyClaimToEthereum(
+		txHash, err = txs.RelayProphecyClaimToEthereum(
 			cosmosMsg,
 			sub.SugaredLogger,
 			client,
@@ -464,5 +511,29 @@ func (sub CosmosSub) handleBurnLockMsg(
 		if err != nil {
 			sub.SugaredLogger.Errorw("error for failed broadcast is", errorMessageKey, err)
 		}
+		return
+	}
+
+	sub.reportOutboundTransfer(cosmosMsg, txHash)
+}
+
+// reportOutboundTransfer reports the ethereum transaction relaying a lock or burn to sifchain
+func (sub CosmosSub) reportOutboundTransfer(cosmosMsg types.CosmosMsg, txHash common.Hash) {
+	if sub.ValidatorName == "" {
+		return
+	}
+
+	valAddr, err := GetValAddressFromKeyring(sub.TxFactory.Keybase(), sub.ValidatorName)
+	if err != nil {
+		sub.SugaredLogger.Errorw("failed to get the validator address from keyring.",
+			errorMessageKey, err.Error())
+		return
+	}
+
+	err = txs.ReportOutboundTransferToCosmos(sub.TxFactory, valAddr, string(cosmosMsg.CosmosSender),
+		cosmosMsg.CosmosSenderSequence.Uint64(), txHash, sub.CliCtx, sub.SugaredLogger)
+	if err != nil {
+		sub.SugaredLogger.Errorw("failed to report outbound transfer.",
+			errorMessageKey, err.Error())
 	}
 }
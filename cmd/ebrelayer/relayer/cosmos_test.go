@@ -6,11 +6,13 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/internal/symbol_translator"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 	"github.com/syndtr/goleveldb/leveldb"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"go.uber.org/zap"
 )
 
@@ -65,3 +67,22 @@ func TestMyDecode(t *testing.T) {
 	_, err := MyDecode(wrongData)
 	require.Error(t, err)
 }
+
+func TestBurnLockEvents(t *testing.T) {
+	legacy := abci.Event{Type: types.MsgLock.String(), Attributes: txs.CreateCosmosMsgAttributes(t, types.MsgLock)}
+	typed := txs.CreateTestTypedBurnLockEvent(t, types.MsgLock)
+	other := abci.Event{Type: "message"}
+
+	// Txs executed before typed events were emitted only have legacy events
+	require.Equal(t, []abci.Event{legacy}, burnLockEvents([]abci.Event{other, legacy}))
+	// The legacy events of txs with typed events are ignored so that locks and burns are relayed once
+	require.Equal(t, []abci.Event{typed}, burnLockEvents([]abci.Event{other, legacy, typed}))
+
+	for _, event := range []abci.Event{legacy, typed} {
+		claimType := getOracleClaimType(event.GetType())
+		require.Equal(t, types.MsgLock, claimType)
+		msg, err := burnLockEventToCosmosMsg(claimType, event, symbol_translator.NewSymbolTranslator(), zap.NewNop().Sugar())
+		require.NoError(t, err)
+		require.Equal(t, txs.CreateTestCosmosMsg(t, types.MsgLock), msg)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ctypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/syndtr/goleveldb/leveldb"
 	tmclient "github.com/tendermint/tendermint/rpc/client/http"
 	"go.uber.org/zap"
@@ -40,6 +41,8 @@ const (
 	transactionInterval = 10 * time.Second
 	trailingBlocks      = 50
 	ethLevelDBKey       = "ethereumLastProcessedBlock"
+	// gasPriceReportInterval is the number of ethereum blocks between gas price reports
+	gasPriceReportInterval = 100
 )
 
 // EthereumSub is an Ethereum listener that can relay txs to Cosmos and Ethereum
@@ -128,6 +131,7 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 	}
 
 	bridgeBankContractABI := contract.LoadABI(txs.BridgeBank)
+	nftEventsABI := contract.LoadNftEventsABI()
 
 	// Listen the new header
 	heads := make(chan *ctypes.Header)
@@ -167,6 +171,10 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 				"ethereum block number", newHead.Number,
 				"ethereum block hash", newHead.Hash())
 
+			if new(big.Int).Mod(newHead.Number, big.NewInt(gasPriceReportInterval)).Sign() == 0 {
+				sub.reportGasPrice(ethClient, clientChainID, txFactory)
+			}
+
 			startingBigInt := newHead.Number
 			endingBlock := startingBigInt.Sub(startingBigInt, big.NewInt(trailingBlocks))
 
@@ -208,23 +216,41 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 				"endingBlock", endingBlock)
 
 			var events []types.EthereumEvent
+			var nftEvents []types.EthereumNftEvent
 
 			// loop over ethlogs, and build an array of burn/lock events
 			for _, ethLog := range ethLogs {
 				log.Printf("Processed events from block %v", ethLog.BlockNumber)
-				event, isBurnLock, err := sub.logToEvent(clientChainID, bridgeBankAddress, bridgeBankContractABI, ethLog)
+				event, isBurnLock, err := sub.logToEvent(ethClient, clientChainID, bridgeBankAddress, bridgeBankContractABI, ethLog)
 				if err != nil {
 					sub.SugaredLogger.Errorw("failed to transform from log to event.",
 						errorMessageKey, err.Error())
 					continue
 				}
 				if !isBurnLock {
+					nftEvent, isNftLock, err := sub.logToNftEvent(clientChainID, bridgeBankAddress, nftEventsABI, ethLog)
+					if err != nil {
+						sub.SugaredLogger.Errorw("failed to transform from log to nft event.",
+							errorMessageKey, err.Error())
+						continue
+					}
+					if isNftLock {
+						nftEvents = append(nftEvents, nftEvent)
+						continue
+					}
 					sub.SugaredLogger.Infow("not burn or lock event, continue events.")
 					continue
 				}
 				events = append(events, event)
 			}
 
+			if len(nftEvents) > 0 {
+				if err := sub.handleEthereumNftEvents(txFactory, nftEvents); err != nil {
+					sub.SugaredLogger.Errorw("failed to handle ethereum nft events.",
+						errorMessageKey, err.Error())
+				}
+			}
+
 			if len(events) > 0 {
 				if err := sub.handleEthereumEvent(txFactory, events, symbolTranslator); err != nil {
 					sub.SugaredLogger.Errorw("failed to handle ethereum event.",
@@ -350,7 +376,7 @@ func (sub EthereumSub) Replay(txFactory tx.Factory, fromBlock int64, toBlock int
 
 	for _, ethLog := range logs {
 		// Before deal with it, we need check in cosmos if it is already handled by myself bofore.
-		event, isBurnLock, err := sub.logToEvent(clientChainID, subContractAddress, bridgeBankContractABI, ethLog)
+		event, isBurnLock, err := sub.logToEvent(c, clientChainID, subContractAddress, bridgeBankContractABI, ethLog)
 		if err != nil {
 			log.Println("Failed to get event from ethereum log")
 		} else if isBurnLock {
@@ -369,7 +395,7 @@ func (sub EthereumSub) Replay(txFactory tx.Factory, fromBlock int64, toBlock int
 }
 
 // logToEvent unpacks an Ethereum event
-func (sub EthereumSub) logToEvent(clientChainID *big.Int, contractAddress common.Address,
+func (sub EthereumSub) logToEvent(client *ethclient.Client, clientChainID *big.Int, contractAddress common.Address,
 	contractABI abi.ABI, cLog ctypes.Log) (types.EthereumEvent, bool, error) {
 	// Parse the event's attributes via contract ABI
 	event := types.EthereumEvent{}
@@ -401,6 +427,14 @@ func (sub EthereumSub) logToEvent(clientChainID *big.Int, contractAddress common
 		event.ClaimType = ethbridge.ClaimType_CLAIM_TYPE_BURN
 	} else {
 		event.ClaimType = ethbridge.ClaimType_CLAIM_TYPE_LOCK
+		// A token whose decimals cannot be read is claimed without them, the admin sets them when registering it
+		decimals, err := txs.GetTokenDecimals(client, event.Token)
+		if err != nil {
+			sub.SugaredLogger.Errorw("failed to get token decimals.",
+				errorMessageKey, err.Error(),
+				"token", event.Token.Hex())
+		}
+		event.Decimals = decimals
 	}
 	sub.SugaredLogger.Infow("receive an event.",
 		"event", event)
@@ -410,6 +444,44 @@ func (sub EthereumSub) logToEvent(clientChainID *big.Int, contractAddress common
 	return event, true, nil
 }
 
+// logToNftEvent unpacks an Ethereum NFT lock event
+func (sub EthereumSub) logToNftEvent(clientChainID *big.Int, contractAddress common.Address,
+	nftABI abi.ABI, cLog ctypes.Log) (types.EthereumNftEvent, bool, error) {
+	event := types.EthereumNftEvent{}
+	if len(cLog.Topics) == 0 || cLog.Topics[0] != nftABI.Events[types.LogNftLock.String()].ID {
+		return event, false, nil
+	}
+
+	if err := nftABI.UnpackIntoInterface(&event, types.LogNftLock.String(), cLog.Data); err != nil {
+		return event, false, err
+	}
+	event.BridgeContractAddress = contractAddress
+	event.EthereumChainID = clientChainID
+	sub.SugaredLogger.Infow("receive an nft event.",
+		"event", event)
+
+	return event, true, nil
+}
+
+// reportGasPrice reports the suggested gas price of the ethereum node to sifchain
+func (sub EthereumSub) reportGasPrice(client *ethclient.Client, clientChainID *big.Int, txFactory tx.Factory) {
+	gasPrice, err := client.SuggestGasPrice(context.Background())
+	if err != nil {
+		sub.SugaredLogger.Errorw("failed to get gas price.",
+			errorMessageKey, err.Error())
+		return
+	}
+	valAddr, err := GetValAddressFromKeyring(txFactory.Keybase(), sub.ValidatorName)
+	if err != nil {
+		sub.SugaredLogger.Errorw("failed to get validator address.",
+			errorMessageKey, err.Error())
+		return
+	}
+	// Failures are logged by the report, the next interval reports again
+	_ = txs.ReportGasPriceToCosmos(txFactory, valAddr, clientChainID.Int64(), sdk.NewIntFromBigInt(gasPrice),
+		sub.CliCtx, sub.SugaredLogger)
+}
+
 func GetValAddressFromKeyring(k keyring.Keyring, keyname string) (sdk.ValAddress, error) {
 	keyInfo, err := k.Key(keyname)
 	if err != nil {
@@ -443,3 +515,25 @@ func (sub EthereumSub) handleEthereumEvent(txFactory tx.Factory, events []types.
 
 	return txs.RelayToCosmos(txFactory, prophecyClaims, sub.CliCtx, sub.SugaredLogger)
 }
+
+// handleEthereumNftEvents converts Ethereum NFT lock events to NFT claims and relays them to Cosmos
+func (sub EthereumSub) handleEthereumNftEvents(txFactory tx.Factory, events []types.EthereumNftEvent) error {
+	var claims []*ethbridge.EthBridgeNftClaim
+	valAddr, err := GetValAddressFromKeyring(txFactory.Keybase(), sub.ValidatorName)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		claim, err := txs.EthereumNftEventToEthBridgeNftClaim(valAddr, event)
+		if err != nil {
+			sub.SugaredLogger.Errorw("failed to create nft claim from event.",
+				errorMessageKey, err.Error())
+			continue
+		}
+		claims = append(claims, &claim)
+	}
+	sub.SugaredLogger.Infow("relay nft claims to cosmos.",
+		"nft claims length", len(claims))
+
+	return txs.RelayNftClaimsToCosmos(txFactory, claims, sub.CliCtx, sub.SugaredLogger)
+}
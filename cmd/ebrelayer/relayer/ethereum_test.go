@@ -6,8 +6,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ctypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/contract"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
 	ethbridge "github.com/Sifchain/sifnode/x/ethbridge/types"
 )
@@ -64,3 +67,40 @@ func TestNewKeybase(t *testing.T) {
 	require.NotEqual(t, info, nil)
 	require.Equal(t, err, nil)
 }
+
+// TestLogToNftEvent test if the relayer unpacks NFT lock events
+func TestLogToNftEvent(t *testing.T) {
+	sub := EthereumSub{SugaredLogger: zap.NewNop().Sugar()}
+	nftABI := contract.LoadNftEventsABI()
+	bridgeBank := common.HexToAddress("0xd88159878c50e4B2b03BB701DD436e4A98D6fBe2")
+	from := common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207359")
+	token := common.HexToAddress("0xc230f38FF05860753840e0d7cbC66128ad308B67")
+	tokenID, ok := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+	require.True(t, ok)
+	data, err := nftABI.Events[types.LogNftLock.String()].Inputs.Pack(from,
+		[]byte("sif1l7hypmqk2yc334vc6vmdwzp5sdefygj2ad93p5"), token, "PUNK", tokenID, "ipfs://punk/1", big.NewInt(4))
+	require.NoError(t, err)
+
+	event, isNftLock, err := sub.logToNftEvent(big.NewInt(3), bridgeBank, nftABI, ctypes.Log{
+		Topics: []common.Hash{nftABI.Events[types.LogNftLock.String()].ID},
+		Data:   data,
+	})
+	require.NoError(t, err)
+	require.True(t, isNftLock)
+	require.Equal(t, types.EthereumNftEvent{
+		To:                    []byte("sif1l7hypmqk2yc334vc6vmdwzp5sdefygj2ad93p5"),
+		Symbol:                "PUNK",
+		EthereumChainID:       big.NewInt(3),
+		TokenID:               tokenID,
+		TokenURI:              "ipfs://punk/1",
+		Nonce:                 big.NewInt(4),
+		BridgeContractAddress: bridgeBank,
+		From:                  from,
+		Token:                 token,
+	}, event)
+
+	// Other events are skipped
+	_, isNftLock, err = sub.logToNftEvent(big.NewInt(3), bridgeBank, nftABI, ctypes.Log{Topics: []common.Hash{{}}})
+	require.NoError(t, err)
+	require.False(t, isNftLock)
+}
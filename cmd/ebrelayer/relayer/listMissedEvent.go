@@ -7,7 +7,6 @@ import (
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/internal/symbol_translator"
 	"log"
 
-	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -105,14 +104,14 @@ func (list ListMissedCosmosEvent) ListMissedCosmosEvent(symbolTranslator *symbol
 		}
 
 		for _, result := range block.TxsResults {
-			for _, event := range result.Events {
+			for _, event := range burnLockEvents(result.Events) {
 
 				claimType := getOracleClaimType(event.GetType())
 
 				switch claimType {
 				case types.MsgBurn, types.MsgLock:
 
-					cosmosMsg, err := txs.BurnLockEventToCosmosMsg(claimType, event.GetAttributes(), symbolTranslator, list.SugaredLogger)
+					cosmosMsg, err := burnLockEventToCosmosMsg(claimType, event, symbolTranslator, list.SugaredLogger)
 					if err != nil {
 						log.Println(err.Error())
 						continue
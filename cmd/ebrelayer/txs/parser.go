@@ -74,6 +74,9 @@ func EthereumEventToEthBridgeClaim(valAddr sdk.ValAddress, event types.EthereumE
 	witnessClaim.CosmosReceiver = recipient.String()
 	witnessClaim.Amount = amount
 	witnessClaim.ClaimType = event.ClaimType
+	if event.ClaimType == ethbridge.ClaimType_CLAIM_TYPE_LOCK {
+		witnessClaim.Decimals = event.Decimals
+	}
 
 	sugaredLogger.Debug("witnessClaim", witnessClaim)
 
@@ -120,18 +123,11 @@ func BurnLockEventToCosmosMsg(claimType types.Event, attributes []abci.EventAttr
 			ethereumReceiver = common.HexToAddress(val)
 		case types.Symbol.String():
 			attributeNumber++
-			switch claimType {
-			case types.MsgLock:
-				symbol = symbolTranslator.SifchainToEthereum(val)
-			case types.MsgBurn:
-				if !strings.Contains(val, defaultSifchainPrefix) {
-					// log.Printf("Can only relay burns of '%v' prefixed coins", defaultSifchainPrefix)
-					sugaredLogger.Errorw("only relay burns prefixed coins", "coin symbol", val)
-					return types.CosmosMsg{}, errors.New("can only relay burns of '%v' prefixed coins" + defaultSifchainPrefix)
-				}
-				res := strings.SplitAfter(val, defaultSifchainPrefix)
-				symbol = strings.Join(res[1:], "")
+			tempSymbol, err := burnLockSymbol(claimType, val, symbolTranslator, sugaredLogger)
+			if err != nil {
+				return types.CosmosMsg{}, err
 			}
+			symbol = tempSymbol
 		case types.Amount.String():
 			attributeNumber++
 			tempAmount, ok := sdk.NewIntFromString(val)
@@ -161,6 +157,57 @@ func BurnLockEventToCosmosMsg(claimType types.Event, attributes []abci.EventAttr
 	return types.NewCosmosMsg(claimType, cosmosSender, cosmosSenderSequence, ethereumReceiver, symbol, amount), nil
 }
 
+// TypedBurnLockEventToCosmosMsg parses a typed lock or burn event witnessed on Cosmos into a CosmosMsg struct
+func TypedBurnLockEventToCosmosMsg(event abci.Event, symbolTranslator *symbol_translator.SymbolTranslator, sugaredLogger *zap.SugaredLogger) (types.CosmosMsg, error) {
+	typedEvent, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		sugaredLogger.Errorw("Invalid typed event", "event type", event.Type, errorMessageKey, err.Error())
+		return types.CosmosMsg{}, err
+	}
+
+	var claimType types.Event
+	var cosmosSender, ethereumReceiver, denom string
+	var cosmosSenderSequence uint64
+	var amount sdk.Int
+	switch e := typedEvent.(type) {
+	case *ethbridge.EventLock:
+		claimType = types.MsgLock
+		cosmosSender, cosmosSenderSequence, ethereumReceiver, denom, amount =
+			e.CosmosSender, e.CosmosSenderSequence, e.EthereumReceiver, e.Symbol, e.Amount
+	case *ethbridge.EventBurn:
+		claimType = types.MsgBurn
+		cosmosSender, cosmosSenderSequence, ethereumReceiver, denom, amount =
+			e.CosmosSender, e.CosmosSenderSequence, e.EthereumReceiver, e.Symbol, e.Amount
+	default:
+		return types.CosmosMsg{}, errors.New("not a lock or burn event: " + event.Type)
+	}
+
+	if !common.IsHexAddress(ethereumReceiver) {
+		sugaredLogger.Errorw("Invalid recipient address", "recipient address", ethereumReceiver)
+		return types.CosmosMsg{}, errors.New("invalid recipient address: " + ethereumReceiver)
+	}
+	symbol, err := burnLockSymbol(claimType, denom, symbolTranslator, sugaredLogger)
+	if err != nil {
+		return types.CosmosMsg{}, err
+	}
+	return types.NewCosmosMsg(claimType, []byte(cosmosSender), new(big.Int).SetUint64(cosmosSenderSequence),
+		common.HexToAddress(ethereumReceiver), symbol, amount), nil
+}
+
+// burnLockSymbol returns the symbol on Ethereum of the denom of a lock or burn witnessed on Cosmos
+func burnLockSymbol(claimType types.Event, denom string, symbolTranslator *symbol_translator.SymbolTranslator, sugaredLogger *zap.SugaredLogger) (string, error) {
+	if claimType == types.MsgLock {
+		return symbolTranslator.SifchainToEthereum(denom), nil
+	}
+	if !strings.Contains(denom, defaultSifchainPrefix) {
+		// log.Printf("Can only relay burns of '%v' prefixed coins", defaultSifchainPrefix)
+		sugaredLogger.Errorw("only relay burns prefixed coins", "coin symbol", denom)
+		return "", errors.New("can only relay burns of '%v' prefixed coins" + defaultSifchainPrefix)
+	}
+	res := strings.SplitAfter(denom, defaultSifchainPrefix)
+	return strings.Join(res[1:], ""), nil
+}
+
 // AttributesToEthereumBridgeClaim parses data from event to EthereumBridgeClaim
 func AttributesToEthereumBridgeClaim(attributes []abci.EventAttribute) (types.EthereumBridgeClaim, error) {
 	var cosmosSender sdk.ValAddress
@@ -208,3 +255,31 @@ func AttributesToEthereumBridgeClaim(attributes []abci.EventAttribute) (types.Et
 func isZeroAddress(address common.Address) bool {
 	return address == common.HexToAddress(nullAddress)
 }
+
+// EthereumNftEventToEthBridgeNftClaim parses and packages an Ethereum NFT lock event with a validator address in an
+// EthBridgeNftClaim msg
+func EthereumNftEventToEthBridgeNftClaim(valAddr sdk.ValAddress, event types.EthereumNftEvent) (ethbridge.EthBridgeNftClaim, error) {
+	recipient, err := sdk.AccAddressFromBech32(string(event.To))
+	if err != nil {
+		return ethbridge.EthBridgeNftClaim{}, err
+	}
+	if recipient.Empty() {
+		return ethbridge.EthBridgeNftClaim{}, errors.New("empty recipient address")
+	}
+	if event.TokenID == nil || event.Nonce == nil {
+		return ethbridge.EthBridgeNftClaim{}, errors.New("missing token id or nonce")
+	}
+
+	return *ethbridge.NewEthBridgeNftClaim(
+		event.EthereumChainID.Int64(),
+		ethbridge.NewEthereumAddress(event.BridgeContractAddress.Hex()),
+		event.Nonce.Int64(),
+		event.Symbol,
+		ethbridge.NewEthereumAddress(event.Token.Hex()),
+		ethbridge.NewEthereumAddress(event.From.Hex()),
+		recipient,
+		valAddr,
+		event.TokenID.String(),
+		event.TokenURI,
+	), nil
+}
@@ -2,12 +2,14 @@ package txs
 
 import (
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/internal/symbol_translator"
+	"math/big"
 	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"go.uber.org/zap"
 
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
@@ -51,6 +53,38 @@ func TestLogLockToEthBridgeClaim(t *testing.T) {
 	require.Equal(t, expectedEthBridgeClaim, &ethBridgeClaim)
 }
 
+func TestLogNftLockToEthBridgeNftClaim(t *testing.T) {
+	testCosmosAddress, err := sdk.AccAddressFromBech32(TestCosmosAddress1)
+	require.NoError(t, err)
+	testRawCosmosValidatorAddress, err := sdk.AccAddressFromBech32(TestCosmosAddress2)
+	require.NoError(t, err)
+	testCosmosValidatorBech32Address := sdk.ValAddress(testRawCosmosValidatorAddress)
+
+	expectedClaim := ethbridge.NewEthBridgeNftClaim(TestEthereumChainID,
+		ethbridge.NewEthereumAddress(TestBridgeContractAddress), TestNonce, "PUNK",
+		ethbridge.NewEthereumAddress(TestEthereumAddress2), ethbridge.NewEthereumAddress(TestEthereumAddress1),
+		testCosmosAddress, testCosmosValidatorBech32Address, "7", "ipfs://punk/7")
+
+	event := types.EthereumNftEvent{
+		To:                    []byte(TestCosmosAddress1),
+		Symbol:                "PUNK",
+		EthereumChainID:       big.NewInt(TestEthereumChainID),
+		TokenID:               big.NewInt(7),
+		TokenURI:              "ipfs://punk/7",
+		Nonce:                 big.NewInt(TestNonce),
+		BridgeContractAddress: common.HexToAddress(TestBridgeContractAddress),
+		From:                  common.HexToAddress(TestEthereumAddress1),
+		Token:                 common.HexToAddress(TestEthereumAddress2),
+	}
+	claim, err := EthereumNftEventToEthBridgeNftClaim(testCosmosValidatorBech32Address, event)
+	require.NoError(t, err)
+	require.Equal(t, expectedClaim, &claim)
+
+	event.To = []byte("")
+	_, err = EthereumNftEventToEthBridgeNftClaim(testCosmosValidatorBech32Address, event)
+	require.Error(t, err)
+}
+
 func TestBurnEventToCosmosMsg(t *testing.T) {
 	// Set up expected MsgBurn
 	expectedMsgBurn := CreateTestCosmosMsg(t, types.MsgBurn)
@@ -75,6 +109,20 @@ func TestLockEventToCosmosMsg(t *testing.T) {
 	require.Equal(t, expectedMsgLock, msgLock)
 }
 
+func TestTypedBurnLockEventToCosmosMsg(t *testing.T) {
+	for _, claimType := range []types.Event{types.MsgBurn, types.MsgLock} {
+		event := CreateTestTypedBurnLockEvent(t, claimType)
+		msg, err := TypedBurnLockEventToCosmosMsg(event, symbol_translator.NewSymbolTranslator(), sugaredLogger)
+
+		require.NoError(t, err)
+		require.Equal(t, CreateTestCosmosMsg(t, claimType), msg)
+	}
+
+	_, err := TypedBurnLockEventToCosmosMsg(abci.Event{Type: types.MsgBurn.String()},
+		symbol_translator.NewSymbolTranslator(), sugaredLogger)
+	require.Error(t, err)
+}
+
 func TestFailedBurnEventToCosmosMsg(t *testing.T) {
 	// Create MsgBurn attributes as input parameter
 	cosmosMsgAttributes := CreateCosmosMsgIncompleteAttributes(t, types.MsgBurn)
@@ -71,3 +71,44 @@ func RelayToCosmos(factory tx.Factory, claims []*types.EthBridgeClaim, cliCtx cl
 
 	return nil
 }
+
+// RelayNftClaimsToCosmos applies validator's signature to EthBridgeNftClaim messages of ERC-721 tokens locked on
+// Ethereum before relaying them to the Bridge
+func RelayNftClaimsToCosmos(factory tx.Factory, claims []*types.EthBridgeNftClaim, cliCtx client.Context, sugaredLogger *zap.SugaredLogger) error {
+	var messages []sdk.Msg
+
+	for _, claim := range claims {
+		msg := types.NewMsgCreateEthBridgeNftClaim(claim)
+		if err := msg.ValidateBasic(); err != nil {
+			sugaredLogger.Errorw(
+				"failed to get message from nft claim.",
+				"message", msg,
+				errorMessageKey, err.Error(),
+			)
+			continue
+		}
+		messages = append(messages, &msg)
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	sugaredLogger.Infow("RelayNftClaimsToCosmos building, signing, and broadcasting", "messages", messages)
+	err := tx.BroadcastTx(
+		cliCtx,
+		factory.
+			WithGas(1000000000000000000).
+			WithFees("500000000000000000rowan"),
+		messages...,
+	)
+	if err != nil {
+		sugaredLogger.Errorw(
+			"failed to broadcast nft claims to sifchain.",
+			errorMessageKey, err.Error(),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -28,13 +28,14 @@ func sleepThread(seconds time.Duration) {
 }
 
 // RelayProphecyClaimToEthereum relays the provided ProphecyClaim to CosmosBridge contract on the Ethereum network
+// and returns the hash of the ethereum transaction
 func RelayProphecyClaimToEthereum(
 	claim types.CosmosMsg,
 	sugaredLogger *zap.SugaredLogger,
 	client *ethclient.Client,
 	auth *bind.TransactOpts,
 	cosmosBridgeInstance *cosmosbridge.CosmosBridge,
-) error {
+) (common.Hash, error) {
 
 	// Send transaction
 	sugaredLogger.Infow(
@@ -59,7 +60,7 @@ func RelayProphecyClaimToEthereum(
 	sleepThread(2)
 
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
 	sugaredLogger.Infow("get NewProphecyClaim tx hash:", "ProphecyClaimHash", tx.Hash().Hex())
@@ -85,7 +86,7 @@ func RelayProphecyClaimToEthereum(
 	}
 
 	if i == maxRetries {
-		return errors.New("hit max tx receipt query retries")
+		return common.Hash{}, errors.New("hit max tx receipt query retries")
 	}
 
 	sugaredLogger.Infow(
@@ -93,7 +94,7 @@ func RelayProphecyClaimToEthereum(
 		"txReceipt", receipt,
 	)
 
-	return nil
+	return tx.Hash(), nil
 }
 
 // InitRelayConfig set up Ethereum client, validator's transaction auth, and the target contract's address
@@ -0,0 +1,43 @@
+package txs
+
+// DONTCOVER
+
+import (
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/zap"
+)
+
+// ReportGasPriceToCosmos reports the gas price of an ethereum network to sifchain, where the median of the reports of
+// the whitelisted relayers prices the cross-chain fee of locks and burns
+func ReportGasPriceToCosmos(factory tx.Factory, validatorAddress sdk.ValAddress, ethereumChainID int64,
+	gasPrice sdk.Int, cliCtx client.Context, sugaredLogger *zap.SugaredLogger) error {
+	msg := types.NewMsgReportGasPrice(validatorAddress, ethereumChainID, gasPrice)
+	if err := msg.ValidateBasic(); err != nil {
+		sugaredLogger.Errorw("invalid gas price report.",
+			"message", msg,
+			errorMessageKey, err.Error())
+		return err
+	}
+
+	sugaredLogger.Infow("report gas price to cosmos.",
+		"EthereumChainID", ethereumChainID,
+		"GasPrice", gasPrice.String())
+
+	err := tx.BroadcastTx(
+		cliCtx,
+		factory.
+			WithGas(1000000000000000000).
+			WithFees("500000000000000000rowan"),
+		&msg,
+	)
+	if err != nil {
+		sugaredLogger.Errorw("failed to broadcast gas price report to sifchain.",
+			errorMessageKey, err.Error())
+		return err
+	}
+
+	return nil
+}
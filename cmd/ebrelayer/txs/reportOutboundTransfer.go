@@ -0,0 +1,45 @@
+package txs
+
+// DONTCOVER
+
+import (
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// ReportOutboundTransferToCosmos reports the ethereum transaction relaying a lock or burn back to sifchain, so the
+// outbound transfer record is completed once enough validators have reported the same transaction
+func ReportOutboundTransferToCosmos(factory tx.Factory, validatorAddress sdk.ValAddress, cosmosSender string,
+	sequence uint64, ethereumTxHash common.Hash, cliCtx client.Context, sugaredLogger *zap.SugaredLogger) error {
+	msg := types.NewMsgReportOutboundTransfer(validatorAddress, cosmosSender, sequence, ethereumTxHash.Hex())
+	if err := msg.ValidateBasic(); err != nil {
+		sugaredLogger.Errorw("invalid outbound transfer report.",
+			"message", msg,
+			errorMessageKey, err.Error())
+		return err
+	}
+
+	sugaredLogger.Infow("report outbound transfer to cosmos.",
+		"CosmosSender", cosmosSender,
+		"CosmosSenderSequence", sequence,
+		"EthereumTxHash", ethereumTxHash.Hex())
+
+	err := tx.BroadcastTx(
+		cliCtx,
+		factory.
+			WithGas(1000000000000000000).
+			WithFees("500000000000000000rowan"),
+		&msg,
+	)
+	if err != nil {
+		sugaredLogger.Errorw("failed to broadcast outbound transfer report to sifchain.",
+			errorMessageKey, err.Error())
+		return err
+	}
+
+	return nil
+}
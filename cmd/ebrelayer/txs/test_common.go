@@ -9,6 +9,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
@@ -158,6 +160,33 @@ func CreateCosmosMsgAttributes(t *testing.T, claimType types.Event) []abci.Event
 	return attributes[:]
 }
 
+// CreateTestTypedBurnLockEvent creates a typed EventBurn/EventLock for testing purposes
+func CreateTestTypedBurnLockEvent(t *testing.T, claimType types.Event) abci.Event {
+	var typedEvent proto.Message
+	if claimType == types.MsgBurn {
+		typedEvent = &ethbridge.EventBurn{
+			CosmosSender:         TestCosmosAddress1,
+			CosmosSenderSequence: TestCosmosAddressSequence,
+			EthereumReceiver:     common.HexToAddress(TestEthereumAddress1).Hex(),
+			Symbol:               strings.ToLower(TestSymbol),
+			Amount:               testSDKAmount,
+			CethAmount:           sdk.ZeroInt(),
+		}
+	} else {
+		typedEvent = &ethbridge.EventLock{
+			CosmosSender:         TestCosmosAddress1,
+			CosmosSenderSequence: TestCosmosAddressSequence,
+			EthereumReceiver:     common.HexToAddress(TestEthereumAddress1).Hex(),
+			Symbol:               TestSymbol,
+			Amount:               testSDKAmount,
+			CethAmount:           sdk.ZeroInt(),
+		}
+	}
+	event, err := sdk.TypedEventToEvent(typedEvent)
+	require.NoError(t, err)
+	return abci.Event(event)
+}
+
 // CreateCosmosMsgIncompleteAttributes creates a MsgBurn/MsgLock for testing purposes missing some attributes
 func CreateCosmosMsgIncompleteAttributes(t *testing.T, claimType types.Event) []abci.EventAttribute {
 	attributes := [3]abci.EventAttribute{}
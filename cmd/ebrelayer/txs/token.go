@@ -0,0 +1,36 @@
+package txs
+
+// DONTCOVER
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ethDecimals are the decimals of ether, which is locked with the null token address
+const ethDecimals = 18
+
+// erc20DecimalsABI is the part of the ERC20 ABI needed to read the decimals of a token
+const erc20DecimalsABI = `[{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"type":"function"}]`
+
+// GetTokenDecimals queries the decimals of a token contract
+func GetTokenDecimals(client *ethclient.Client, token common.Address) (int64, error) {
+	if isZeroAddress(token) {
+		return ethDecimals, nil
+	}
+	parsed, err := abi.JSON(strings.NewReader(erc20DecimalsABI))
+	if err != nil {
+		return 0, err
+	}
+	contract := bind.NewBoundContract(token, parsed, client, client, client)
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{Context: context.Background()}, &out, "decimals"); err != nil {
+		return 0, err
+	}
+	return int64(*abi.ConvertType(out[0], new(uint8)).(*uint8)), nil
+}
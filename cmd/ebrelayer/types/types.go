@@ -31,6 +31,8 @@ const (
 	NewProphecyClaim
 	// CreateEthBridgeClaim is a Cosmos msg of type MsgCreateEthBridgeClaim
 	CreateEthBridgeClaim
+	// LogNftLock is for Ethereum event LogNftLock
+	LogNftLock
 )
 
 const (
@@ -40,7 +42,7 @@ const (
 
 // String returns the event type as a string
 func (d Event) String() string {
-	return [...]string{"unsupported", "burn", "lock", "LogLock", "LogBurn", "LogNewProphecyClaim", "newProphecyClaim", "create_claim"}[d]
+	return [...]string{"unsupported", "burn", "lock", "LogLock", "LogBurn", "LogNewProphecyClaim", "newProphecyClaim", "create_claim", "LogNftLock"}[d]
 }
 
 // EthereumEvent struct is used by LogLock and LogBurn
@@ -55,6 +57,8 @@ type EthereumEvent struct {
 	BridgeContractAddress common.Address
 	From                  common.Address
 	Token                 common.Address
+	// Decimals of the token, read from its contract for lock events
+	Decimals int64
 }
 
 // Equal two events
@@ -78,6 +82,27 @@ func (e EthereumEvent) String() string {
 		string(e.To), e.Value, e.Nonce, e.ClaimType.String())
 }
 
+// EthereumNftEvent struct is used by LogNftLock
+type EthereumNftEvent struct {
+	To                    []byte
+	Symbol                string
+	EthereumChainID       *big.Int
+	TokenID               *big.Int `abi:"_tokenId"`
+	TokenURI              string
+	Nonce                 *big.Int
+	BridgeContractAddress common.Address
+	From                  common.Address
+	Token                 common.Address
+}
+
+// String implements fmt.Stringer
+func (e EthereumNftEvent) String() string {
+	return fmt.Sprintf("\nChain ID: %v\nBridge contract address: %v\nToken symbol: %v\nToken "+
+		"contract address: %v\nSender: %v\nRecipient: %v\nToken ID: %v\nToken URI: %v\nNonce: %v",
+		e.EthereumChainID, e.BridgeContractAddress.Hex(), e.Symbol, e.Token.Hex(), e.From.Hex(),
+		string(e.To), e.TokenID, e.TokenURI, e.Nonce)
+}
+
 // ProphecyClaimEvent struct which represents a LogNewProphecyClaim event
 type ProphecyClaimEvent struct {
 	CosmosSender     []byte
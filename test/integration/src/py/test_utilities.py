@@ -718,7 +718,8 @@ def rescue_ceth(
         credentials: SifchaincliCredentials
 ):
     cmd = build_sifchain_command(
-        f"{sifnoded_binary} tx ethbridge rescue_ceth -y {admin_account} {receiver_account} {amount:d}",
+        f"{sifnoded_binary} tx ethbridge rescue_ceth -y {admin_account} {receiver_account} {amount:d} "
+        f"--ethereum-chain-id={transfer_request.ethereum_chain_id}",
         transfer_request=transfer_request,
         credentials=credentials
     )
@@ -14,6 +14,10 @@ const (
 	FlagAsymmetry              = "asymmetry"
 	FlagAmount                 = "sentAmount"
 	FlagMinimumReceivingAmount = "minReceivingAmount"
+	FlagFeeTier                = "feeTier"
+	FlagMaxSlippage            = "max-slippage"
+	FlagReferrer               = "referrer"
+	FlagReferralFee            = "referralFee"
 )
 
 // common flagsets to add to various functions
@@ -27,6 +31,9 @@ var (
 	FsReceivedAssetSymbol = flag.NewFlagSet("", flag.ContinueOnError)
 	FsAmount              = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMinReceivingAmount  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsFeeTier             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMaxSlippage         = flag.NewFlagSet("", flag.ContinueOnError)
+	FsReferral            = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -40,5 +47,9 @@ func init() {
 	FsReceivedAssetSymbol.String(FlagReceivedAssetSymbol, "", "Symbol for Received Asset")
 	FsAmount.String(FlagAmount, "", "Sent amount")
 	FsMinReceivingAmount.String(FlagMinimumReceivingAmount, "", "Min threshold for receiving amount")
+	FsFeeTier.Uint64(FlagFeeTier, 0, "Swap fee tier of the pool in basis points")
+	FsMaxSlippage.String(FlagMaxSlippage, "", "Max slippage in percent below the quoted receiving amount, sets the min receiving amount from the pools")
+	FsReferral.String(FlagReferrer, "", "Address receiving the referral fee of the swap")
+	FsReferral.Uint64(FlagReferralFee, 0, "Referral fee in basis points of the received amount")
 
 }
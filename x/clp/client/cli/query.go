@@ -28,6 +28,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdPools(queryRoute),
 		GetCmdAssets(queryRoute),
 		GetCmdLiquidityProvider(queryRoute),
+		GetCmdLiquidityProviderPnL(queryRoute),
+		GetCmdReferrerStats(queryRoute),
+		GetCmdTreasury(queryRoute),
+		GetCmdPoolUSDValue(queryRoute),
+		GetCmdLiquidityProviderUSDValue(queryRoute),
+		GetCmdTVL(queryRoute),
 		GetCmdLpList(queryRoute),
 		GetCmdAllLps(queryRoute),
 	)
@@ -183,6 +189,194 @@ $ %s pool ETH sif1h2zjknvr3xlpk22q4dnv396ahftzqhyeth7egd`,
 	return cmd
 }
 
+func GetCmdLiquidityProviderPnL(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-pnl [External Asset symbol] [lpAddress]",
+		Short: "Get profit and loss of a Liquidity Provider position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current value of a liquidity provider position in rowan, its value had the
+deposited assets been held instead, and the fees it earned since deposit.
+Example:
+$ %s query clp lp-pnl ceth sif1h2zjknvr3xlpk22q4dnv396ahftzqhyeth7egd`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetLiquidityProviderPnL(context.Background(), &types.LiquidityProviderPnLReq{
+				Symbol:    args[0],
+				LpAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdReferrerStats(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referrer-stats [referrerAddress]",
+		Short: "Get the referral fees earned by a referrer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the referral fees a referrer earned on swaps, per received asset, and the number of
+swaps it referred.
+Example:
+$ %s query clp referrer-stats sif1h2zjknvr3xlpk22q4dnv396ahftzqhyeth7egd`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetReferrerStats(context.Background(), &types.ReferrerStatsReq{
+				Referrer: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdTreasury(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury",
+		Short: "Get the balances and liquidity positions of the clp treasury",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address and balances of the clp treasury, and the current value and profit or loss
+of each of its liquidity positions.
+Example:
+$ %s query clp treasury`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetTreasury(context.Background(), &types.TreasuryReq{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPoolUSDValue(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-usd-value [symbol]",
+		Short: "Get the USD value of the liquidity of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the USD value of the liquidity of a pool, with rowan priced by the reference pools param.
+Example:
+$ %s query clp pool-usd-value ceth`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetPoolUSDValue(context.Background(), &types.PoolUSDValueReq{
+				Symbol: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdLiquidityProviderUSDValue(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-usd-value [symbol] [lpAddress]",
+		Short: "Get the USD value of a liquidity provider position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the USD value of the share of a pool owned by a liquidity provider, with rowan priced by
+the reference pools param.
+Example:
+$ %s query clp lp-usd-value ceth sif1h2zjknvr3xlpk22q4dnv396ahftzqhyeth7egd`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetLiquidityProviderUSDValue(context.Background(), &types.LiquidityProviderUSDValueReq{
+				Symbol:    args[0],
+				LpAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdTVL(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tvl",
+		Short: "Get the USD value of the liquidity of all pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total value locked in all pools in USD, with rowan priced by the reference pools param.
+Example:
+$ %s query clp tvl`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetTVL(context.Background(), &types.TVLReq{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdLpList(queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "lplist [symbol]",
@@ -3,12 +3,16 @@ package cli
 import (
 	"fmt"
 	"log"
+	"strconv"
 
+	"github.com/Sifchain/sifnode/x/clp/client/utils"
 	"github.com/Sifchain/sifnode/x/clp/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -29,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 		GetCmdRemoveLiquidity(),
 		GetCmdSwap(),
 		GetCmdDecommissionPool(),
+		GetCmdUpdatePoolFeeTier(),
 	)
 
 	return clpTxCmd
@@ -36,7 +41,7 @@ func GetTxCmd() *cobra.Command {
 
 func GetCmdCreatePool() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-pool --from [key] --symbol [asset-symbol] --nativeAmount [amount] --externalAmount [amount]",
+		Use:   "create-pool --from [key] --symbol [asset-symbol] --nativeAmount [amount] --externalAmount [amount] --feeTier [basis-points]",
 		Short: "Create new liquidity pool",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -61,10 +66,16 @@ func GetCmdCreatePool() *cobra.Command {
 				return err
 			}
 
+			feeTier, err := flags.GetUint64(FlagFeeTier)
+			if err != nil {
+				return err
+			}
+
 			signer := clientCtx.GetFromAddress()
 
 			asset := types.NewAsset(assetSymbol)
 			msg := types.NewMsgCreatePool(signer, asset, sdk.NewUintFromString(nativeAmount), sdk.NewUintFromString(externalAmount))
+			msg.FeeTier = feeTier
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -75,6 +86,7 @@ func GetCmdCreatePool() *cobra.Command {
 	cmd.Flags().AddFlagSet(FsAssetSymbol)
 	cmd.Flags().AddFlagSet(FsExternalAssetAmount)
 	cmd.Flags().AddFlagSet(FsNativeAssetAmount)
+	cmd.Flags().AddFlagSet(FsFeeTier)
 	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
 		log.Println("MarkFlagRequired failed: ", err.Error())
 	}
@@ -120,6 +132,180 @@ func GetCmdDecommissionPool() *cobra.Command {
 	return cmd
 }
 
+func GetCmdUpdatePoolFeeTier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pool-fee-tier --from [key] --symbol [asset-symbol] --feeTier [basis-points]",
+		Short: "Change the fee tier of a liquidity pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			symbol, err := cmd.Flags().GetString(FlagAssetSymbol)
+			if err != nil {
+				return err
+			}
+			feeTier, err := cmd.Flags().GetUint64(FlagFeeTier)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUpdatePoolFeeTier(clientCtx.GetFromAddress(), symbol, feeTier)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsFeeTier)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagFeeTier); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitUpdatePoolFeeTierProposal implements the command to submit a pool fee tier change proposal
+func GetCmdSubmitUpdatePoolFeeTierProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pool-fee-tier [asset-symbol] [basis-points]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to change the fee tier of a liquidity pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			feeTier, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewUpdatePoolFeeTierProposal(title, description, args[0], feeTier)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitTreasuryAddLiquidityProposal implements the command to submit a treasury add liquidity proposal
+func GetCmdSubmitTreasuryAddLiquidityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-add-liquidity [asset-symbol] [native-amount] [external-amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to add liquidity to a pool from the clp treasury",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			nativeAmount, err := sdk.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+			externalAmount, err := sdk.ParseUint(args[2])
+			if err != nil {
+				return err
+			}
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewTreasuryAddLiquidityProposal(title, description, args[0], nativeAmount, externalAmount)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitTreasuryRemoveLiquidityProposal implements the command to submit a treasury remove liquidity proposal
+func GetCmdSubmitTreasuryRemoveLiquidityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-remove-liquidity [asset-symbol] [w-basis-points] [asymmetry]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to remove liquidity of the clp treasury from a pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			wBasisPoints, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return types.ErrInvalidWBasis
+			}
+			asymmetry, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return types.ErrInvalidAsymmetry
+			}
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewTreasuryRemoveLiquidityProposal(title, description, args[0], wBasisPoints, asymmetry)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitTreasuryRebalanceProposal implements the command to submit a treasury rebalance proposal
+func GetCmdSubmitTreasuryRebalanceProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-rebalance [from-asset-symbol] [to-asset-symbol] [w-basis-points]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to move liquidity of the clp treasury from one pool to another",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			wBasisPoints, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return types.ErrInvalidWBasis
+			}
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewTreasuryRebalanceProposal(title, description, args[0], args[1], wBasisPoints)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// submitProposal reads the proposal flags and broadcasts a MsgSubmitProposal with the content built from them
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, newContent func(title, description string) govtypes.Content) error {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
 func GetCmdAddLiquidity() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-liquidity",
@@ -214,21 +400,88 @@ func GetCmdSwap() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap",
 		Short: "Swap tokens using liquidity pools",
+		Long: fmt.Sprintf(`Swap tokens using liquidity pools.
+
+One of --%s and --%s is required, --%s sets the min receiving amount from
+the quote of the pools. --%s prints the quote of the swap, with its fees and price impact, without
+broadcasting it. On this command it replaces the gas simulation the flag runs on other transactions.`,
+			FlagMinimumReceivingAmount, FlagMaxSlippage, FlagMaxSlippage, flags.FlagDryRun),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			sentAsset := types.NewAsset(viper.GetString(FlagSentAssetSymbol))
-			receivedAsset := types.NewAsset(viper.GetString(FlagReceivedAssetSymbol))
+			f := cmd.Flags()
+			sentSymbol, err := f.GetString(FlagSentAssetSymbol)
+			if err != nil {
+				return err
+			}
+			receivedSymbol, err := f.GetString(FlagReceivedAssetSymbol)
+			if err != nil {
+				return err
+			}
+			sentAsset := types.NewAsset(sentSymbol)
+			receivedAsset := types.NewAsset(receivedSymbol)
 
-			sentAmount := viper.GetString(FlagAmount)
-			minReceivingAmount := viper.GetString(FlagMinimumReceivingAmount)
+			sentAmount, err := f.GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+			minReceivingAmount, err := f.GetString(FlagMinimumReceivingAmount)
+			if err != nil {
+				return err
+			}
+			maxSlippage, err := f.GetString(FlagMaxSlippage)
+			if err != nil {
+				return err
+			}
+			// --dry-run is the tx flag of the sdk, it is read into the client context
+			quoteOnly := clientCtx.Simulate
+			referrer, err := f.GetString(FlagReferrer)
+			if err != nil {
+				return err
+			}
+			referralFee, err := f.GetUint64(FlagReferralFee)
+			if err != nil {
+				return err
+			}
+			if minReceivingAmount != "" && maxSlippage != "" {
+				return fmt.Errorf("only one of --%s and --%s can be set", FlagMinimumReceivingAmount, FlagMaxSlippage)
+			}
+			if minReceivingAmount == "" && maxSlippage == "" && !quoteOnly {
+				return fmt.Errorf("one of --%s and --%s is required", FlagMinimumReceivingAmount, FlagMaxSlippage)
+			}
 
 			signer := clientCtx.GetFromAddress()
 
-			msg := types.NewMsgSwap(signer, sentAsset, receivedAsset, sdk.NewUintFromString(sentAmount), sdk.NewUintFromString(minReceivingAmount))
+			msg := types.NewMsgSwap(signer, sentAsset, receivedAsset, sdk.NewUintFromString(sentAmount), sdk.ZeroUint())
+			msg.Referrer = referrer
+			msg.ReferralFee = referralFee
+			if minReceivingAmount != "" {
+				msg.MinReceivingAmount = sdk.NewUintFromString(minReceivingAmount)
+			}
+			if maxSlippage != "" || quoteOnly {
+				slippage := sdk.ZeroDec()
+				if maxSlippage != "" {
+					slippage, err = sdk.NewDecFromStr(maxSlippage)
+					if err != nil {
+						return types.ErrInvalidSlippage
+					}
+				}
+				quote, err := utils.QuoteSwap(clientCtx, sentAsset, receivedAsset, msg.SentAmount, msg.ReferralFee, slippage)
+				if err != nil {
+					return err
+				}
+				if maxSlippage != "" {
+					msg.MinReceivingAmount = quote.MinReceivingAmount
+				} else {
+					quote.MinReceivingAmount = msg.MinReceivingAmount
+				}
+				if quoteOnly {
+					return clientCtx.PrintObjectLegacy(quote)
+				}
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -241,6 +494,8 @@ func GetCmdSwap() *cobra.Command {
 	cmd.Flags().AddFlagSet(FsReceivedAssetSymbol)
 	cmd.Flags().AddFlagSet(FsAmount)
 	cmd.Flags().AddFlagSet(FsMinReceivingAmount)
+	cmd.Flags().AddFlagSet(FsMaxSlippage)
+	cmd.Flags().AddFlagSet(FsReferral)
 
 	if err := cmd.MarkFlagRequired(FlagSentAssetSymbol); err != nil {
 		log.Println("MarkFlagRequired failed: ", err.Error())
@@ -251,11 +506,9 @@ func GetCmdSwap() *cobra.Command {
 	if err := cmd.MarkFlagRequired(FlagAmount); err != nil {
 		log.Println("MarkFlagRequired failed: ", err.Error())
 	}
-	if err := cmd.MarkFlagRequired(FlagMinimumReceivingAmount); err != nil {
-		log.Println("MarkFlagRequired failed: ", err.Error())
-	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Lookup(flags.FlagDryRun).Usage = "Print the quote of the swap without simulating or broadcasting it"
 
 	return cmd
 }
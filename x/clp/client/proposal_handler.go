@@ -0,0 +1,20 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/Sifchain/sifnode/x/clp/client/cli"
+	"github.com/Sifchain/sifnode/x/clp/client/rest"
+)
+
+// UpdatePoolFeeTierProposalHandler is the governance client handler for pool fee tier proposals
+var UpdatePoolFeeTierProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdatePoolFeeTierProposal, rest.UpdatePoolFeeTierProposalRESTHandler)
+
+// TreasuryAddLiquidityProposalHandler is the governance client handler for treasury add liquidity proposals
+var TreasuryAddLiquidityProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTreasuryAddLiquidityProposal, rest.TreasuryAddLiquidityProposalRESTHandler)
+
+// TreasuryRemoveLiquidityProposalHandler is the governance client handler for treasury remove liquidity proposals
+var TreasuryRemoveLiquidityProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTreasuryRemoveLiquidityProposal, rest.TreasuryRemoveLiquidityProposalRESTHandler)
+
+// TreasuryRebalanceProposalHandler is the governance client handler for treasury rebalance proposals
+var TreasuryRebalanceProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTreasuryRebalanceProposal, rest.TreasuryRebalanceProposalRESTHandler)
@@ -27,6 +27,30 @@ func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 		"/clp/getLiquidityProvider",
 		getLiquidityProviderHandler(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getLiquidityProviderPnL",
+		getLiquidityProviderPnLHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getReferrerStats",
+		getReferrerStatsHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getTreasury",
+		getTreasuryHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getPoolUSDValue",
+		getPoolUSDValueHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getLiquidityProviderUSDValue",
+		getLiquidityProviderUSDValueHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getTVL",
+		getTVLHandler(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/clp/getAssets",
 		getAssetsHandler(cliCtx),
@@ -97,6 +121,146 @@ func getLiquidityProviderHandler(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func getLiquidityProviderPnLHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLiquidityProviderPnL)
+		var params types.LiquidityProviderPnLReq
+		params.Symbol = r.URL.Query().Get("symbol")
+		lpAddress, err := sdk.AccAddressFromBech32(r.URL.Query().Get("lpAddress"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params.LpAddress = lpAddress.String()
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getReferrerStatsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryReferrerStats)
+		referrer, err := sdk.AccAddressFromBech32(r.URL.Query().Get("referrer"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(types.NewQueryReqReferrerStats(referrer))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getTreasuryHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTreasury)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getPoolUSDValueHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPoolUSDValue)
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(types.NewQueryReqPoolUSDValue(r.URL.Query().Get("symbol")))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getLiquidityProviderUSDValueHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLPUSDValue)
+		lpAddress, err := sdk.AccAddressFromBech32(r.URL.Query().Get("lpAddress"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(types.NewQueryReqLiquidityProviderUSDValue(r.URL.Query().Get("symbol"), lpAddress))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getTVLHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTVL)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func getPoolsHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
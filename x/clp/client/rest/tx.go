@@ -7,8 +7,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gorilla/mux"
 
+	"github.com/Sifchain/sifnode/x/clp/client/utils"
 	"github.com/Sifchain/sifnode/x/clp/types"
 )
 
@@ -33,6 +36,10 @@ func registerTxRoutes(cliCtx client.Context, r *mux.Router) {
 		"/clp/decommissionPool",
 		decommissionPoolHandler(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/clp/updatePoolFeeTier",
+		updatePoolFeeTierHandler(cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -58,12 +65,54 @@ type (
 		ExternalAsset       types.Asset  `json:"external_asset"`        // ExternalAsset in the pool pair (ex rwn:ceth)
 		NativeAssetAmount   sdk.Uint     `json:"native_asset_amount"`   // NativeAssetAmount is the amount of native asset being added
 		ExternalAssetAmount sdk.Uint     `json:"external_asset_amount"` // ExternalAssetAmount is the amount of external asset being added
+		FeeTier             uint64       `json:"fee_tier"`              // FeeTier is the swap fee of the pool in basis points
 	}
 	DecommissionPoolReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 		Signer  string       `json:"signer"` // User who is trying to Decommission the pool
 		Ticker  string       `json:"ticker"` // ExternalAsset Ticker in the pool pair (ex rwn:ceth ,would be ceth)
 	}
+	UpdatePoolFeeTierReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Signer  string       `json:"signer"`   // Admin who is changing the fee tier
+		Ticker  string       `json:"ticker"`   // ExternalAsset Ticker in the pool pair (ex rwn:ceth ,would be ceth)
+		FeeTier uint64       `json:"fee_tier"` // FeeTier is the new swap fee of the pool in basis points
+	}
+	UpdatePoolFeeTierProposalReq struct {
+		BaseReq     rest.BaseReq `json:"base_req"`
+		Title       string       `json:"title"`
+		Description string       `json:"description"`
+		Deposit     sdk.Coins    `json:"deposit"`
+		Ticker      string       `json:"ticker"`   // ExternalAsset Ticker in the pool pair (ex rwn:ceth ,would be ceth)
+		FeeTier     uint64       `json:"fee_tier"` // FeeTier is the new swap fee of the pool in basis points
+	}
+	TreasuryAddLiquidityProposalReq struct {
+		BaseReq             rest.BaseReq `json:"base_req"`
+		Title               string       `json:"title"`
+		Description         string       `json:"description"`
+		Deposit             sdk.Coins    `json:"deposit"`
+		Ticker              string       `json:"ticker"` // ExternalAsset Ticker of the pool the treasury adds liquidity to
+		NativeAssetAmount   sdk.Uint     `json:"native_asset_amount"`
+		ExternalAssetAmount sdk.Uint     `json:"external_asset_amount"`
+	}
+	TreasuryRemoveLiquidityProposalReq struct {
+		BaseReq      rest.BaseReq `json:"base_req"`
+		Title        string       `json:"title"`
+		Description  string       `json:"description"`
+		Deposit      sdk.Coins    `json:"deposit"`
+		Ticker       string       `json:"ticker"` // ExternalAsset Ticker of the pool the treasury removes liquidity from
+		WBasisPoints sdk.Int      `json:"w_basis_points"`
+		Asymmetry    sdk.Int      `json:"asymmetry"`
+	}
+	TreasuryRebalanceProposalReq struct {
+		BaseReq      rest.BaseReq `json:"base_req"`
+		Title        string       `json:"title"`
+		Description  string       `json:"description"`
+		Deposit      sdk.Coins    `json:"deposit"`
+		FromTicker   string       `json:"from_ticker"` // ExternalAsset Ticker of the pool the liquidity is taken from
+		ToTicker     string       `json:"to_ticker"`   // ExternalAsset Ticker of the pool the liquidity is added to
+		WBasisPoints sdk.Int      `json:"w_basis_points"`
+	}
 	SwapReq struct {
 		BaseReq            rest.BaseReq `json:"base_req"`
 		Signer             string       `json:"signer"`               // User who is trying to swap
@@ -71,6 +120,10 @@ type (
 		ReceivedAsset      types.Asset  `json:"received_asset"`       // Asset which the user wants to receive ,can be an external asset or RWN
 		SentAmount         sdk.Uint     `json:"sent_amount"`          // Amount of SentAsset being sent
 		MinReceivingAmount sdk.Uint     `json:"min_receiving_amount"` // Min amount specified by the user m the swap will not go through if the receiving amount drops below this value
+		MaxSlippage        string       `json:"max_slippage"`         // Max slippage in percent below the quote of the pools ,replaces MinReceivingAmount when set
+		DryRun             bool         `json:"dry_run"`              // Returns the quote of the swap instead of the generated tx
+		Referrer           string       `json:"referrer"`             // Optional address receiving ReferralFee basis points of the received amount
+		ReferralFee        uint64       `json:"referral_fee"`
 	}
 )
 
@@ -97,6 +150,7 @@ func createPoolHandler(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgCreatePool(signer, req.ExternalAsset, req.NativeAssetAmount, req.ExternalAssetAmount)
+		msg.FeeTier = req.FeeTier
 
 		err = msg.ValidateBasic()
 		if err != nil {
@@ -218,6 +272,32 @@ func swapHandler(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgSwap(signer, req.SentAsset, req.ReceivedAsset, req.SentAmount, req.MinReceivingAmount)
+		msg.Referrer = req.Referrer
+		msg.ReferralFee = req.ReferralFee
+		if req.MaxSlippage != "" || req.DryRun {
+			slippage := sdk.ZeroDec()
+			if req.MaxSlippage != "" {
+				slippage, err = sdk.NewDecFromStr(req.MaxSlippage)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, types.ErrInvalidSlippage.Error())
+					return
+				}
+			}
+			quote, err := utils.QuoteSwap(cliCtx, req.SentAsset, req.ReceivedAsset, req.SentAmount, req.ReferralFee, slippage)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if req.MaxSlippage != "" {
+				msg.MinReceivingAmount = quote.MinReceivingAmount
+			} else {
+				quote.MinReceivingAmount = msg.MinReceivingAmount
+			}
+			if req.DryRun {
+				rest.PostProcessResponseBare(w, cliCtx, quote)
+				return
+			}
+		}
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -227,3 +307,113 @@ func swapHandler(cliCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, &msg)
 	}
 }
+
+func updatePoolFeeTierHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdatePoolFeeTierReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		signer, err := sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgUpdatePoolFeeTier(signer, req.Ticker, req.FeeTier)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, &msg)
+	}
+}
+
+// UpdatePoolFeeTierProposalRESTHandler returns the governance REST handler for pool fee tier proposals
+func UpdatePoolFeeTierProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_pool_fee_tier",
+		Handler:  postUpdatePoolFeeTierProposalHandler(cliCtx),
+	}
+}
+
+func postUpdatePoolFeeTierProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdatePoolFeeTierProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+		content := types.NewUpdatePoolFeeTierProposal(req.Title, req.Description, req.Ticker, req.FeeTier)
+		writeProposalTxResponse(w, cliCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+// TreasuryAddLiquidityProposalRESTHandler returns the governance REST handler for treasury add liquidity proposals
+func TreasuryAddLiquidityProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "treasury_add_liquidity",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req TreasuryAddLiquidityProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewTreasuryAddLiquidityProposal(req.Title, req.Description, req.Ticker, req.NativeAssetAmount, req.ExternalAssetAmount)
+			writeProposalTxResponse(w, cliCtx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+// TreasuryRemoveLiquidityProposalRESTHandler returns the governance REST handler for treasury remove liquidity proposals
+func TreasuryRemoveLiquidityProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "treasury_remove_liquidity",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req TreasuryRemoveLiquidityProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewTreasuryRemoveLiquidityProposal(req.Title, req.Description, req.Ticker, req.WBasisPoints, req.Asymmetry)
+			writeProposalTxResponse(w, cliCtx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+// TreasuryRebalanceProposalRESTHandler returns the governance REST handler for treasury rebalance proposals
+func TreasuryRebalanceProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "treasury_rebalance",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req TreasuryRebalanceProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewTreasuryRebalanceProposal(req.Title, req.Description, req.FromTicker, req.ToTicker, req.WBasisPoints)
+			writeProposalTxResponse(w, cliCtx, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+// writeProposalTxResponse writes the generated MsgSubmitProposal tx for the proposal content
+func writeProposalTxResponse(w http.ResponseWriter, cliCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+	from, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+	tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+// QuoteSwap queries the pools and the token registry and computes the expected outcome of a swap with the same legs
+// as the clp msg server, net of a referral fee in basis points. The min receiving amount of the quote allows for
+// maxSlippage percent below the quote.
+func QuoteSwap(clientCtx client.Context, sentAsset, receivedAsset types.Asset, sentAmount sdk.Uint, referralFee uint64, maxSlippage sdk.Dec) (types.SwapQuote, error) {
+	ctx := context.Background()
+	registryRes, err := tokenregistrytypes.NewQueryClient(clientCtx).Entries(ctx, &tokenregistrytypes.QueryEntriesRequest{})
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+	sentDecimals, err := getDecimals(registryRes.Registry, sentAsset)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+	receivedDecimals, err := getDecimals(registryRes.Registry, receivedAsset)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	inPool := types.Pool{}
+	if !sentAsset.Equals(types.GetSettlementAsset()) {
+		poolRes, err := queryClient.GetPool(ctx, &types.PoolReq{Symbol: sentAsset.Symbol})
+		if err != nil {
+			return types.SwapQuote{}, sdkerrors.Wrap(types.ErrPoolDoesNotExist, err.Error())
+		}
+		inPool = *poolRes.Pool
+	}
+	outPool, outDecimals := inPool, sentDecimals
+	if !receivedAsset.Equals(types.GetSettlementAsset()) {
+		poolRes, err := queryClient.GetPool(ctx, &types.PoolReq{Symbol: receivedAsset.Symbol})
+		if err != nil {
+			return types.SwapQuote{}, sdkerrors.Wrap(types.ErrPoolDoesNotExist, err.Error())
+		}
+		outPool, outDecimals = *poolRes.Pool, receivedDecimals
+	}
+	legs, liquidityFee, priceImpact, err := keeper.CalculateSwap(sentAsset, sentAmount, receivedAsset, inPool, outPool, sentDecimals, outDecimals)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+	receivedAmount := legs[len(legs)-1].ReceivedAmount
+	referralFeeAmount := keeper.CalcReferralFee(receivedAmount, referralFee)
+	receivedAmount = receivedAmount.Sub(referralFeeAmount)
+	minReceivingAmount, err := types.GetMinReceivingAmount(receivedAmount, maxSlippage)
+	if err != nil {
+		return types.SwapQuote{}, err
+	}
+	return types.SwapQuote{
+		SentAsset:          sentAsset,
+		SentAmount:         sentAmount,
+		ReceivedAsset:      receivedAsset,
+		ReceivedAmount:     receivedAmount,
+		MinReceivingAmount: minReceivingAmount,
+		LiquidityFee:       liquidityFee,
+		PriceImpact:        priceImpact,
+		ReferralFee:        referralFeeAmount,
+	}, nil
+}
+
+func getDecimals(registry *tokenregistrytypes.Registry, asset types.Asset) (int64, error) {
+	if registry != nil {
+		for _, entry := range registry.Entries {
+			if entry != nil && strings.EqualFold(entry.Denom, asset.Symbol) {
+				return entry.Decimals, nil
+			}
+		}
+	}
+	return 0, sdkerrors.Wrap(types.ErrTokenNotSupported, asset.Symbol)
+}
@@ -15,6 +15,8 @@ import (
 
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) (res []abci.ValidatorUpdate) {
 	k.SetParams(ctx, data.Params)
+	// create the treasury module account, before anyone can send funds to its address
+	k.GetAuthKeeper().GetModuleAccount(ctx, types.TreasuryName)
 	if data.AddressWhitelist == nil || len(data.AddressWhitelist) == 0 {
 		panic("AddressWhiteList must be set.")
 	}
@@ -39,6 +41,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) (res
 	for _, lp := range data.LiquidityProviders {
 		k.SetLiquidityProvider(ctx, lp)
 	}
+	for _, stats := range data.ReferrerStats {
+		k.SetReferrerStats(ctx, stats)
+	}
+	// Liquidity providers exported before cost bases were recorded start from their current position
+	k.SetMissingCostBases(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -61,6 +68,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		AddressWhitelist:   wl,
 		PoolList:           poolList,
 		LiquidityProviders: liquidityProviders,
+		ReferrerStats:      keeper.GetAllReferrerStats(ctx),
 	}
 }
 
@@ -79,5 +87,10 @@ func ValidateGenesis(data types.GenesisState) error {
 			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: liquidityProvider is invalid : %s", lp.String()))
 		}
 	}
+	for _, stats := range data.ReferrerStats {
+		if _, err := sdk.AccAddressFromBech32(stats.Referrer); err != nil || !stats.FeesEarned.IsValid() {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: referrer stats are invalid : %s", stats.String()))
+		}
+	}
 	return nil
 }
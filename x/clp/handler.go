@@ -4,6 +4,7 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/Sifchain/sifnode/x/clp/keeper"
 	"github.com/Sifchain/sifnode/x/clp/types"
@@ -30,9 +31,31 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSwap:
 			res, err := msgServer.Swap(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdatePoolFeeTier:
+			res, err := msgServer.UpdatePoolFeeTier(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 		}
 	}
 }
+
+// NewProposalHandler creates a govtypes.Handler for the clp governance proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdatePoolFeeTierProposal:
+			return k.SetPoolFeeTier(ctx, "", c.Symbol, c.FeeTier)
+		case *types.TreasuryAddLiquidityProposal:
+			return k.TreasuryAddLiquidity(ctx, c)
+		case *types.TreasuryRemoveLiquidityProposal:
+			return k.TreasuryRemoveLiquidity(ctx, c)
+		case *types.TreasuryRebalanceProposal:
+			return k.TreasuryRebalance(ctx, c)
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s proposal content type: %T", types.ModuleName, c)
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
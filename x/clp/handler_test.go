@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -315,6 +316,58 @@ func TestSwap(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSwap_TypedEvent(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	signer := test.GenerateAddress("")
+	clpKeeper := app.ClpKeeper
+	handler := clp.NewHandler(clpKeeper)
+	assetEth := clptypes.NewAsset("eth")
+	assetDash := clptypes.NewAsset("dash")
+	initialBalance := sdk.NewUintFromString("1000000000000000000000")
+	poolBalance := sdk.NewUintFromString("1000000000000000000")
+	swapSentAssetETH := sdk.NewUintFromString("1000000000000000")
+	externalCoin1 := sdk.NewCoin(assetEth.Symbol, sdk.Int(initialBalance))
+	externalCoin2 := sdk.NewCoin(assetDash.Symbol, sdk.Int(initialBalance))
+	nativeCoin := sdk.NewCoin(clptypes.NativeSymbol, sdk.Int(initialBalance))
+	err := sifapp.AddCoinsToAccount(clptypes.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(externalCoin1, externalCoin2, nativeCoin))
+	require.NoError(t, err)
+	msgCreatePool := clptypes.NewMsgCreatePool(signer, assetEth, poolBalance, poolBalance)
+	res, err := handler(ctx, &msgCreatePool)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	msgCreatePool = clptypes.NewMsgCreatePool(signer, assetDash, poolBalance, poolBalance)
+	res, err = handler(ctx, &msgCreatePool)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	ethPoolBefore, err := clpKeeper.GetPool(ctx, assetEth.Symbol)
+	require.NoError(t, err)
+	receivedAmount := CalculateSwapReceived(t, clpKeeper, app.TokenRegistryKeeper, ctx, assetEth, assetDash, swapSentAssetETH)
+	msg := clptypes.NewMsgSwap(signer, assetEth, assetDash, swapSentAssetETH, receivedAmount)
+	res, err = handler(ctx, &msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	var swapEvent *clptypes.EventSwap
+	for _, event := range res.Events {
+		if event.Type != proto.MessageName(&clptypes.EventSwap{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		swapEvent = typedEvent.(*clptypes.EventSwap)
+	}
+	require.NotNil(t, swapEvent)
+	assert.Equal(t, signer.String(), swapEvent.Signer)
+	assert.Equal(t, receivedAmount.String(), swapEvent.ReceivedAmount.String())
+	require.Len(t, swapEvent.Legs, 2)
+	assert.Equal(t, ethPoolBefore.String(), swapEvent.Legs[0].PoolBefore.String())
+	assert.Equal(t, clptypes.GetSettlementAsset(), swapEvent.Legs[0].ReceivedAsset)
+	assert.Equal(t, swapEvent.Legs[0].ReceivedAmount.String(), swapEvent.Legs[1].SentAmount.String())
+	assert.Equal(t, receivedAmount.String(), swapEvent.Legs[1].ReceivedAmount.String())
+	dashPoolAfter, err := clpKeeper.GetPool(ctx, assetDash.Symbol)
+	require.NoError(t, err)
+	assert.Equal(t, dashPoolAfter.String(), swapEvent.Legs[1].PoolAfter.String())
+}
+
 func TestDecommisionPool(t *testing.T) {
 	ctx, app := test.CreateTestAppClp(false)
 	signer := test.GenerateAddress("")
@@ -421,3 +474,110 @@ func CalculateSwapReceived(t *testing.T, keeper clpkeeper.Keeper, tokenRegistryK
 	assert.NoError(t, err)
 	return emitAmount2
 }
+
+func TestUpdatePoolFeeTier(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	signer := test.GenerateAddress("")
+	clpKeeper := app.ClpKeeper
+	handler := clp.NewHandler(clpKeeper)
+	initialBalance := sdk.NewUintFromString("100000000000000000000")
+	poolBalance := sdk.NewUintFromString("1000000000000000000")
+	asset := clptypes.NewAsset("eth")
+	externalCoin := sdk.NewCoin(asset.Symbol, sdk.Int(initialBalance))
+	nativeCoin := sdk.NewCoin(clptypes.NativeSymbol, sdk.Int(initialBalance))
+	err := sifapp.AddCoinsToAccount(clptypes.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(externalCoin, nativeCoin))
+	require.NoError(t, err)
+	// Fee tier must be allowed by the params
+	msgCreatePool := clptypes.NewMsgCreatePool(signer, asset, poolBalance, poolBalance)
+	msgCreatePool.FeeTier = 7
+	_, err = handler(ctx, &msgCreatePool)
+	require.ErrorIs(t, err, clptypes.ErrFeeTierNotAllowed)
+	msgCreatePool.FeeTier = 30
+	_, err = handler(ctx, &msgCreatePool)
+	require.NoError(t, err)
+	pool, err := clpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(30), pool.FeeTier)
+	// Only whitelisted admins can update the fee tier
+	msg := clptypes.NewMsgUpdatePoolFeeTier(signer, asset.Symbol, 100)
+	_, err = handler(ctx, &msg)
+	require.Error(t, err)
+	clpKeeper.SetClpWhiteList(ctx, []sdk.AccAddress{test.GenerateWhitelistAddress("")})
+	msg = clptypes.NewMsgUpdatePoolFeeTier(signer, asset.Symbol, 7)
+	_, err = handler(ctx, &msg)
+	require.ErrorIs(t, err, clptypes.ErrFeeTierNotAllowed)
+	msg = clptypes.NewMsgUpdatePoolFeeTier(signer, asset.Symbol, 100)
+	_, err = handler(ctx, &msg)
+	require.NoError(t, err)
+	pool, err = clpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), pool.FeeTier)
+	// Governance can update the fee tier too
+	proposalHandler := clp.NewProposalHandler(clpKeeper)
+	err = proposalHandler(ctx, clptypes.NewUpdatePoolFeeTierProposal("title", "description", asset.Symbol, 0))
+	require.NoError(t, err)
+	pool, err = clpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), pool.FeeTier)
+	err = proposalHandler(ctx, clptypes.NewUpdatePoolFeeTierProposal("title", "description", "dash", 0))
+	require.ErrorIs(t, err, clptypes.ErrPoolDoesNotExist)
+}
+
+func TestTreasuryProposals(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	signer := test.GenerateAddress("")
+	clpKeeper := app.ClpKeeper
+	handler := clp.NewHandler(clpKeeper)
+	proposalHandler := clp.NewProposalHandler(clpKeeper)
+	initialBalance := sdk.NewUintFromString("100000000000000000000")
+	poolBalance := sdk.NewUintFromString("1000000000000000000")
+	eth, dash := clptypes.NewAsset("eth"), clptypes.NewAsset("dash")
+	coins := sdk.NewCoins(
+		sdk.NewCoin(eth.Symbol, sdk.Int(initialBalance)),
+		sdk.NewCoin(dash.Symbol, sdk.Int(initialBalance)),
+		sdk.NewCoin(clptypes.NativeSymbol, sdk.Int(initialBalance)),
+	)
+	err := sifapp.AddCoinsToAccount(clptypes.ModuleName, app.BankKeeper, ctx, signer, coins)
+	require.NoError(t, err)
+	for _, asset := range []clptypes.Asset{eth, dash} {
+		msgCreatePool := clptypes.NewMsgCreatePool(signer, asset, poolBalance, poolBalance)
+		_, err = handler(ctx, &msgCreatePool)
+		require.NoError(t, err)
+	}
+	// An unfunded treasury cannot add liquidity
+	addProposal := clptypes.NewTreasuryAddLiquidityProposal("title", "description", eth.Symbol, poolBalance, poolBalance)
+	err = proposalHandler(ctx, addProposal)
+	require.Error(t, err)
+	// The treasury account can receive funds
+	treasury := clptypes.GetTreasuryAddress()
+	err = app.BankKeeper.SendCoins(ctx, signer, treasury, sdk.NewCoins(
+		sdk.NewCoin(eth.Symbol, sdk.Int(poolBalance)),
+		sdk.NewCoin(clptypes.NativeSymbol, sdk.Int(poolBalance)),
+	))
+	require.NoError(t, err)
+	err = proposalHandler(ctx, addProposal)
+	require.NoError(t, err)
+	lp, err := clpKeeper.GetLiquidityProvider(ctx, eth.Symbol, treasury.String())
+	require.NoError(t, err)
+	assert.Equal(t, poolBalance.String(), lp.LiquidityProviderUnits.String())
+	assert.True(t, app.BankKeeper.GetAllBalances(ctx, treasury).IsZero())
+	// Half of the position is withdrawn symmetrically
+	err = proposalHandler(ctx, clptypes.NewTreasuryRemoveLiquidityProposal("title", "description", eth.Symbol, sdk.NewInt(5000), sdk.ZeroInt()))
+	require.NoError(t, err)
+	balances := app.BankKeeper.GetAllBalances(ctx, treasury)
+	assert.True(t, balances.AmountOf(eth.Symbol).IsPositive())
+	assert.True(t, balances.AmountOf(clptypes.NativeSymbol).IsPositive())
+	// The rest moves to the dash pool in rowan
+	err = proposalHandler(ctx, clptypes.NewTreasuryRebalanceProposal("title", "description", eth.Symbol, dash.Symbol, sdk.NewInt(10000)))
+	require.NoError(t, err)
+	_, err = clpKeeper.GetLiquidityProvider(ctx, eth.Symbol, treasury.String())
+	require.Error(t, err)
+	_, err = clpKeeper.GetLiquidityProvider(ctx, dash.Symbol, treasury.String())
+	require.NoError(t, err)
+	res, err := clpkeeper.Querier{Keeper: clpKeeper}.GetTreasury(sdk.WrapSDKContext(ctx), &clptypes.TreasuryReq{})
+	require.NoError(t, err)
+	assert.Equal(t, treasury.String(), res.Address)
+	assert.Equal(t, balances, res.Balances)
+	require.Len(t, res.Positions, 1)
+	assert.Equal(t, dash.Symbol, res.Positions[0].LiquidityProvider.Asset.Symbol)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -29,6 +30,10 @@ func SwapOne(from types.Asset, sentAmount sdk.Uint, to types.Asset, pool types.P
 	if swapResult.GTE(Y) {
 		return sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), types.Pool{}, types.ErrNotEnoughAssetTokens
 	}
+	// The fee tier of the pool is charged on the output on top of the slip based fee and stays in the pool
+	tierFee := CalcFeeTierFee(swapResult, pool.FeeTier)
+	swapResult = swapResult.Sub(tierFee)
+	liquidityFee = liquidityFee.Add(tierFee)
 	if from == types.GetSettlementAsset() {
 		pool.NativeAssetBalance = X.Add(x)
 		pool.ExternalAssetBalance = Y.Sub(swapResult)
@@ -40,6 +45,94 @@ func SwapOne(from types.Asset, sentAmount sdk.Uint, to types.Asset, pool types.P
 	return swapResult, liquidityFee, priceImpact, pool, nil
 }
 
+// CalculateSwap runs the legs of a swap of sentAmount of sentAsset for receivedAsset, routed through rowan when
+// neither asset is rowan, without writing the pools. inPool is the pool of the sent asset and is only swapped against
+// on two leg swaps, outPool is the pool paying out the received asset. sentDecimals normalizes the first leg and
+// outDecimals the last one. It returns the legs along with the total liquidity fee, in the received asset, and the
+// summed price impact.
+func CalculateSwap(sentAsset types.Asset, sentAmount sdk.Uint, receivedAsset types.Asset, inPool, outPool types.Pool,
+	sentDecimals, outDecimals int64) ([]types.SwapLeg, sdk.Uint, sdk.Uint, error) {
+	var legs []types.SwapLeg
+	nativeAsset := types.GetSettlementAsset()
+	liquidityFeeNative := sdk.ZeroUint()
+	priceImpact := sdk.ZeroUint()
+	if !sentAsset.Equals(nativeAsset) && !receivedAsset.Equals(nativeAsset) {
+		normalizationFactor, adjustExternalToken := NormalizationFactor(sentDecimals)
+		emitAmount, lp, ts, finalPool, err := SwapOne(sentAsset, sentAmount, nativeAsset, inPool, normalizationFactor, adjustExternalToken)
+		if err != nil {
+			return nil, sdk.Uint{}, sdk.Uint{}, err
+		}
+		legs = append(legs, types.SwapLeg{
+			SentAsset:      sentAsset,
+			SentAmount:     sentAmount,
+			ReceivedAsset:  nativeAsset,
+			ReceivedAmount: emitAmount,
+			LiquidityFee:   lp,
+			PriceImpact:    ts,
+			PoolBefore:     inPool,
+			PoolAfter:      finalPool,
+		})
+		sentAmount = emitAmount
+		sentAsset = nativeAsset
+		priceImpact = priceImpact.Add(ts)
+		liquidityFeeNative = liquidityFeeNative.Add(lp)
+	}
+	normalizationFactor, adjustExternalToken := NormalizationFactor(outDecimals)
+	emitAmount, lp, ts, finalPool, err := SwapOne(sentAsset, sentAmount, receivedAsset, outPool, normalizationFactor, adjustExternalToken)
+	if err != nil {
+		return nil, sdk.Uint{}, sdk.Uint{}, err
+	}
+	var totalLiquidityFee sdk.Uint
+	if liquidityFeeNative.GT(sdk.ZeroUint()) {
+		// the fee of the first leg is in rowan, value it in the received asset
+		firstSwapFeeInOutputAsset := GetSwapFee(liquidityFeeNative, receivedAsset, outPool, normalizationFactor, adjustExternalToken)
+		totalLiquidityFee = lp.Add(firstSwapFeeInOutputAsset)
+	} else {
+		totalLiquidityFee = liquidityFeeNative.Add(lp)
+	}
+	priceImpact = priceImpact.Add(ts)
+	legs = append(legs, types.SwapLeg{
+		SentAsset:      sentAsset,
+		SentAmount:     sentAmount,
+		ReceivedAsset:  receivedAsset,
+		ReceivedAmount: emitAmount,
+		LiquidityFee:   lp,
+		PriceImpact:    ts,
+		PoolBefore:     outPool,
+		PoolAfter:      finalPool,
+	})
+	return legs, totalLiquidityFee, priceImpact, nil
+}
+
+// NormalizationFactor returns the factor scaling amounts with the given decimals to the 18 decimals of rowan, and
+// whether it applies to the external token.
+func NormalizationFactor(decimals int64) (sdk.Dec, bool) {
+	normalizationFactor := sdk.NewDec(1)
+	adjustExternalToken := false
+	nf := decimals
+	if nf != 18 {
+		var diffFactor int64
+		if nf < 18 {
+			diffFactor = 18 - nf
+			adjustExternalToken = true
+		} else {
+			diffFactor = nf - 18
+		}
+		normalizationFactor = sdk.NewDec(10).Power(uint64(diffFactor))
+	}
+	return normalizationFactor, adjustExternalToken
+}
+
+// CalcReferralFee returns the share of a swap output paid to the referrer, referralFee is in basis points
+func CalcReferralFee(receivedAmount sdk.Uint, referralFee uint64) sdk.Uint {
+	return receivedAmount.MulUint64(referralFee).QuoUint64(types.MaxWbasis)
+}
+
+// CalcFeeTierFee returns the share of amount charged by a fee tier in basis points
+func CalcFeeTierFee(amount sdk.Uint, feeTier uint64) sdk.Uint {
+	return amount.MulUint64(feeTier).QuoUint64(types.MaxWbasis)
+}
+
 func SetInputs(sentAmount sdk.Uint, to types.Asset, pool types.Pool) (sdk.Uint, sdk.Uint, sdk.Uint, bool) {
 	var X sdk.Uint
 	var Y sdk.Uint
@@ -314,3 +407,110 @@ func CalculateAllAssetsForLP(pool types.Pool, lp types.LiquidityProvider) (sdk.U
 		sdk.ZeroInt(),
 	)
 }
+
+// CalculateNativeValue values nativeAmount and externalAmount in the native asset at the pool ratio
+func CalculateNativeValue(pool types.Pool, nativeAmount, externalAmount sdk.Uint) sdk.Uint {
+	if pool.ExternalAssetBalance.IsZero() {
+		return nativeAmount
+	}
+	return nativeAmount.Add(externalAmount.Mul(pool.NativeAssetBalance).Quo(pool.ExternalAssetBalance))
+}
+
+// CalculatePoolDepthPerUnit returns sqrt(native * external) / pool units. Adding or removing liquidity keeps it
+// constant, swap fees left in the pool make it grow.
+func CalculatePoolDepthPerUnit(pool types.Pool) sdk.Dec {
+	if pool.PoolUnits.IsZero() {
+		return sdk.ZeroDec()
+	}
+	// Scale the product by 10^36 so its integer square root carries the 18 decimals of a Dec
+	product := new(big.Int).Mul(pool.NativeAssetBalance.BigInt(), pool.ExternalAssetBalance.BigInt())
+	product.Mul(product, new(big.Int).Exp(big.NewInt(10), big.NewInt(2*sdk.Precision), nil))
+	depth := sdk.NewDecFromBigIntWithPrec(new(big.Int).Sqrt(product), sdk.Precision)
+	return depth.Quo(sdk.NewDecFromBigInt(pool.PoolUnits.BigInt()))
+}
+
+// NewCostBasisFromPosition returns a cost basis as if the current position of the lp had just been deposited
+func NewCostBasisFromPosition(pool types.Pool, lp types.LiquidityProvider) *types.LiquidityProviderCostBasis {
+	native, external, _, _ := CalculateAllAssetsForLP(pool, lp)
+	return &types.LiquidityProviderCostBasis{
+		NativeAssetDeposited:   native,
+		ExternalAssetDeposited: external,
+		NativeValueAtDeposit:   CalculateNativeValue(pool, native, external),
+		PoolDepthPerUnit:       CalculatePoolDepthPerUnit(pool),
+	}
+}
+
+// AddToCostBasis adds a deposit of nativeAmount and externalAmount, priced at the ratio of poolBefore, to the basis.
+// The pool depth is averaged over the lp units held before and after the deposit.
+func AddToCostBasis(basis *types.LiquidityProviderCostBasis, unitsBefore, unitsAfter sdk.Uint, poolBefore,
+	poolAfter types.Pool, nativeAmount, externalAmount sdk.Uint) *types.LiquidityProviderCostBasis {
+	if basis == nil {
+		basis = &types.LiquidityProviderCostBasis{
+			NativeAssetDeposited:   sdk.ZeroUint(),
+			ExternalAssetDeposited: sdk.ZeroUint(),
+			NativeValueAtDeposit:   sdk.ZeroUint(),
+			PoolDepthPerUnit:       sdk.ZeroDec(),
+		}
+	}
+	pricingPool := poolBefore
+	if pricingPool.ExternalAssetBalance.IsZero() {
+		pricingPool = poolAfter
+	}
+	depth := basis.PoolDepthPerUnit
+	if !unitsAfter.IsZero() {
+		unitsAdded := unitsAfter.Sub(unitsBefore)
+		depth = basis.PoolDepthPerUnit.MulInt(sdk.NewIntFromBigInt(unitsBefore.BigInt())).
+			Add(CalculatePoolDepthPerUnit(poolAfter).MulInt(sdk.NewIntFromBigInt(unitsAdded.BigInt()))).
+			QuoInt(sdk.NewIntFromBigInt(unitsAfter.BigInt()))
+	}
+	return &types.LiquidityProviderCostBasis{
+		NativeAssetDeposited:   basis.NativeAssetDeposited.Add(nativeAmount),
+		ExternalAssetDeposited: basis.ExternalAssetDeposited.Add(externalAmount),
+		NativeValueAtDeposit:   basis.NativeValueAtDeposit.Add(CalculateNativeValue(pricingPool, nativeAmount, externalAmount)),
+		PoolDepthPerUnit:       depth,
+	}
+}
+
+// ReduceCostBasis scales the basis down to the lp units left after a withdrawal
+func ReduceCostBasis(basis *types.LiquidityProviderCostBasis, unitsBefore, unitsAfter sdk.Uint) *types.LiquidityProviderCostBasis {
+	if basis == nil || unitsBefore.IsZero() {
+		return basis
+	}
+	return &types.LiquidityProviderCostBasis{
+		NativeAssetDeposited:   basis.NativeAssetDeposited.Mul(unitsAfter).Quo(unitsBefore),
+		ExternalAssetDeposited: basis.ExternalAssetDeposited.Mul(unitsAfter).Quo(unitsBefore),
+		NativeValueAtDeposit:   basis.NativeValueAtDeposit.Mul(unitsAfter).Quo(unitsBefore),
+		PoolDepthPerUnit:       basis.PoolDepthPerUnit,
+	}
+}
+
+// CalculatePositionPnL values the position of lp in the native asset at the current pool ratio and compares it to
+// its cost basis
+func CalculatePositionPnL(pool types.Pool, lp types.LiquidityProvider) (types.LiquidityProviderPnLRes, error) {
+	if lp.CostBasis == nil {
+		return types.LiquidityProviderPnLRes{}, types.ErrCostBasisNotRecorded
+	}
+	basis := lp.CostBasis
+	native, external, _, _ := CalculateAllAssetsForLP(pool, lp)
+	currentValue := CalculateNativeValue(pool, native, external)
+	holdValue := CalculateNativeValue(pool, basis.NativeAssetDeposited, basis.ExternalAssetDeposited)
+	feeEarnings := sdk.ZeroUint()
+	depth := CalculatePoolDepthPerUnit(pool)
+	if depth.GT(basis.PoolDepthPerUnit) {
+		feeShare := depth.Sub(basis.PoolDepthPerUnit).Quo(depth)
+		feeEarnings = sdk.NewUintFromBigInt(feeShare.MulInt(sdk.NewIntFromBigInt(currentValue.BigInt())).TruncateInt().BigInt())
+	}
+	currentValueInt := sdk.NewIntFromBigInt(currentValue.BigInt())
+	return types.LiquidityProviderPnLRes{
+		LiquidityProvider:    &lp,
+		NativeAssetBalance:   native,
+		ExternalAssetBalance: external,
+		CurrentValue:         currentValue,
+		HoldValue:            holdValue,
+		DepositValue:         basis.NativeValueAtDeposit,
+		FeeEarnings:          feeEarnings,
+		ImpermanentLoss: sdk.NewIntFromBigInt(holdValue.BigInt()).
+			Sub(currentValueInt.Sub(sdk.NewIntFromBigInt(feeEarnings.BigInt()))),
+		ProfitAndLoss: currentValueInt.Sub(sdk.NewIntFromBigInt(basis.NativeValueAtDeposit.BigInt())),
+	}, nil
+}
@@ -36,6 +36,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, poolUints sdk.Uint, msg *types.MsgCr
 		return nil, types.ErrBalanceNotAvailable
 	}
 	pool := types.NewPool(msg.ExternalAsset, msg.NativeAssetAmount, msg.ExternalAssetAmount, poolUints)
+	pool.FeeTier = msg.FeeTier
 	// Send coins from user to pool
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(externalAssetCoin, nativeAssetCoin))
 	if err != nil {
@@ -94,13 +95,20 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity, pool t
 		return nil, err
 	}
 
+	poolBefore := pool
 	pool.PoolUnits = newPoolUnits
 	pool.NativeAssetBalance = pool.NativeAssetBalance.Add(msg.NativeAssetAmount)
 	pool.ExternalAssetBalance = pool.ExternalAssetBalance.Add(msg.ExternalAssetAmount)
 
 	// Create new Liquidity provider or add liquidity units
+	unitsBefore := sdk.ZeroUint()
 	lp, err := k.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
-	if err != nil {
+	if err == nil {
+		unitsBefore = lp.LiquidityProviderUnits
+		if lp.CostBasis == nil {
+			lp.CostBasis = NewCostBasisFromPosition(poolBefore, lp)
+		}
+	} else {
 		lp = k.CreateLiquidityProvider(ctx, msg.ExternalAsset, lpUnits, addr)
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -109,9 +117,18 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity, pool t
 				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
 			),
 		})
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCreateLiquidityProvider{
+			LiquidityProvider: lp,
+			Height:            ctx.BlockHeight(),
+		})
+		if err != nil {
+			return nil, err
+		}
 		lpUnits = sdk.ZeroUint()
 	}
 	lp.LiquidityProviderUnits = lp.LiquidityProviderUnits.Add(lpUnits)
+	lp.CostBasis = AddToCostBasis(lp.CostBasis, unitsBefore, lp.LiquidityProviderUnits, poolBefore, pool,
+		msg.NativeAssetAmount, msg.ExternalAssetAmount)
 	// Save new pool balances
 	err = k.SetPool(ctx, &pool)
 	if err != nil {
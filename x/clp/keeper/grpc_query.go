@@ -85,6 +85,86 @@ func (k Querier) GetLiquidityProvider(c context.Context, req *types.LiquidityPro
 	return &lpResponse, nil
 }
 
+func (k Querier) GetLiquidityProviderPnL(c context.Context, req *types.LiquidityProviderPnLReq) (*types.LiquidityProviderPnLRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	lp, err := k.Keeper.GetLiquidityProvider(ctx, req.Symbol, req.LpAddress)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := k.Keeper.GetPool(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	res, err := CalculatePositionPnL(pool, lp)
+	if err != nil {
+		return nil, err
+	}
+	res.Height = ctx.BlockHeight()
+	return &res, nil
+}
+
+func (k Querier) GetPoolUSDValue(c context.Context, req *types.PoolUSDValueReq) (*types.PoolUSDValueRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	value, rowanPrice, err := k.Keeper.GetPoolUSDValue(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PoolUSDValueRes{
+		Symbol:     req.Symbol,
+		RowanPrice: rowanPrice,
+		Value:      value,
+		Height:     ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetLiquidityProviderUSDValue(c context.Context, req *types.LiquidityProviderUSDValueReq) (*types.LiquidityProviderUSDValueRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	lp, err := k.Keeper.GetLiquidityProvider(ctx, req.Symbol, req.LpAddress)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := k.Keeper.GetPool(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	rowanPrice, err := k.Keeper.GetRowanUSDPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LiquidityProviderUSDValueRes{
+		Symbol:     req.Symbol,
+		LpAddress:  req.LpAddress,
+		RowanPrice: rowanPrice,
+		Value:      CalcLiquidityProviderUSDValue(pool, lp, rowanPrice),
+		Height:     ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetTVL(c context.Context, req *types.TVLReq) (*types.TVLRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	tvl, rowanPrice, err := k.Keeper.GetTVL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.TVLRes{
+		RowanPrice: rowanPrice,
+		Tvl:        tvl,
+		Height:     ctx.BlockHeight(),
+	}, nil
+}
+
 func (k Querier) GetLiquidityProviderData(c context.Context, req *types.LiquidityProviderDataReq) (*types.LiquidityProviderDataRes, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -220,3 +300,33 @@ func (k Querier) GetLiquidityProviders(c context.Context, req *types.LiquidityPr
 		Pagination:         pageRes,
 	}, nil
 }
+
+func (k Querier) GetReferrerStats(c context.Context, req *types.ReferrerStatsReq) (*types.ReferrerStatsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Referrer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	stats := k.Keeper.GetReferrerStats(ctx, req.Referrer)
+	return &types.ReferrerStatsRes{Stats: &stats, Height: ctx.BlockHeight()}, nil
+}
+
+func (k Querier) GetTreasury(c context.Context, req *types.TreasuryReq) (*types.TreasuryRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	positions, err := k.Keeper.GetTreasuryPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	treasury := types.GetTreasuryAddress()
+	return &types.TreasuryRes{
+		Address:   treasury.String(),
+		Balances:  k.Keeper.GetBankKeeper().GetAllBalances(ctx, treasury),
+		Positions: positions,
+		Height:    ctx.BlockHeight(),
+	}, nil
+}
@@ -20,6 +20,7 @@ type Keeper struct {
 	bankKeeper          types.BankKeeper
 	authKeeper          types.AuthKeeper
 	tokenRegistryKeeper types.TokenRegistryKeeper
+	marginKeeper        types.MarginKeeper
 	paramstore          paramtypes.Subspace
 }
 
@@ -49,6 +50,17 @@ func (k Keeper) Codec() codec.BinaryCodec {
 	return k.cdc
 }
 
+// SetMarginKeeper sets the keeper of the margin positions borrowing from the pools, which is created after the clp
+// keeper as it depends on it
+func (k *Keeper) SetMarginKeeper(marginKeeper types.MarginKeeper) {
+	k.marginKeeper = marginKeeper
+}
+
+// HasMarginPositions returns true if margin positions borrowed from the pool of the external asset are still open
+func (k Keeper) HasMarginPositions(ctx sdk.Context, externalAsset string) bool {
+	return k.marginKeeper != nil && k.marginKeeper.HasPositions(ctx, externalAsset)
+}
+
 func (k Keeper) GetBankKeeper() types.BankKeeper {
 	return k.bankKeeper
 }
@@ -71,18 +83,5 @@ func (k Keeper) HasBalance(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin)
 }
 
 func (k Keeper) GetNormalizationFactor(decimals int64) (sdk.Dec, bool) {
-	normalizationFactor := sdk.NewDec(1)
-	adjustExternalToken := false
-	nf := decimals
-	if nf != 18 {
-		var diffFactor int64
-		if nf < 18 {
-			diffFactor = 18 - nf
-			adjustExternalToken = true
-		} else {
-			diffFactor = nf - 18
-		}
-		normalizationFactor = sdk.NewDec(10).Power(uint64(diffFactor))
-	}
-	return normalizationFactor, adjustExternalToken
+	return NormalizationFactor(decimals)
 }
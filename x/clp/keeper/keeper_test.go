@@ -67,6 +67,22 @@ func TestKeeper_SetPool_ValidatePool(t *testing.T) {
 	assert.True(t, boolean)
 }
 
+func TestKeeper_SetPool_Liabilities(t *testing.T) {
+	pool := test.GenerateRandomPool(1)[0]
+	ctx, app := test.CreateTestAppClp(false)
+	clpKeeper := app.ClpKeeper
+	pool.ExternalLiabilities = pool.ExternalAssetBalance
+	err := clpKeeper.SetPool(ctx, &pool)
+	assert.NoError(t, err)
+	pool.NativeLiabilities = pool.NativeAssetBalance.Add(sdk.OneUint())
+	err = clpKeeper.SetPool(ctx, &pool)
+	assert.ErrorIs(t, err, types.ErrPoolTooShallow)
+	getpool, err := clpKeeper.GetPool(ctx, pool.ExternalAsset.Symbol)
+	assert.NoError(t, err)
+	assert.Equal(t, pool.ExternalAssetBalance.String(), getpool.GetExternalLiabilities().String())
+	assert.True(t, getpool.GetNativeLiabilities().IsZero())
+}
+
 func TestKeeper_GetPools(t *testing.T) {
 	pools := test.GenerateRandomPool(10)
 	ctx, app := test.CreateTestAppClp(false)
@@ -253,6 +269,26 @@ func TestKeeper_SwapOne(t *testing.T) {
 	assert.Equal(t, priceImpact.String(), "0")
 }
 
+func TestKeeper_SwapOne_FeeTier(t *testing.T) {
+	asset := types.NewAsset("eth")
+	balance := sdk.NewUintFromString("1000000000000000000000")
+	pool := types.NewPool(&asset, balance, balance, balance)
+	sentAmount := sdk.NewUintFromString("1000000000000000000")
+	normalizationFactor := sdk.NewDec(1)
+	swapResult, liquidityFee, _, swappedPool, err := clpkeeper.SwapOne(types.GetSettlementAsset(), sentAmount, asset, pool, normalizationFactor, false)
+	require.NoError(t, err)
+	pool.FeeTier = 30
+	tierSwapResult, tierLiquidityFee, _, tierSwappedPool, err := clpkeeper.SwapOne(types.GetSettlementAsset(), sentAmount, asset, pool, normalizationFactor, false)
+	require.NoError(t, err)
+	tierFee := swapResult.MulUint64(30).QuoUint64(10000)
+	assert.False(t, tierFee.IsZero())
+	assert.Equal(t, swapResult.Sub(tierFee).String(), tierSwapResult.String())
+	assert.Equal(t, liquidityFee.Add(tierFee).String(), tierLiquidityFee.String())
+	// the tier fee stays in the pool
+	assert.Equal(t, swappedPool.ExternalAssetBalance.Add(tierFee).String(), tierSwappedPool.ExternalAssetBalance.String())
+	assert.Equal(t, swappedPool.NativeAssetBalance.String(), tierSwappedPool.NativeAssetBalance.String())
+}
+
 func TestKeeper_SetInputs(t *testing.T) {
 	ctx, app := test.CreateTestAppClp(false)
 	signer := test.GenerateAddress(test.AddressKey1)
@@ -126,3 +126,26 @@ func (k Keeper) GetAllLiquidityProvidersPaginated(ctx sdk.Context,
 	}
 	return lpList, pageRes, nil
 }
+
+// SetMissingCostBases records the current position of every liquidity provider without a cost basis as its cost basis
+func (k Keeper) SetMissingCostBases(ctx sdk.Context) {
+	var lps []types.LiquidityProvider
+	iterator := k.GetLiquidityProviderIterator(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		var lp types.LiquidityProvider
+		k.cdc.MustUnmarshal(iterator.Value(), &lp)
+		if lp.CostBasis == nil {
+			lps = append(lps, lp)
+		}
+	}
+	iterator.Close()
+	for i := range lps {
+		lp := lps[i]
+		pool, err := k.GetPool(ctx, lp.Asset.Symbol)
+		if err != nil {
+			continue
+		}
+		lp.CostBasis = NewCostBasisFromPosition(pool, lp)
+		k.SetLiquidityProvider(ctx, &lp)
+	}
+}
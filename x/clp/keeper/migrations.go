@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateToVer2 records the current position of existing liquidity providers as their cost basis
+func (m Migrator) MigrateToVer2(ctx sdk.Context) error {
+	m.keeper.SetMissingCostBases(ctx)
+	return nil
+}
+
+// MigrateToVer3 sets the default fee tiers param, existing pools keep the zero fee tier
+func (m Migrator) MigrateToVer3(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyFeeTiers, types.DefaultFeeTiers)
+	return nil
+}
+
+// MigrateToVer4 sets the default max referral fee param
+func (m Migrator) MigrateToVer4(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyMaxReferralFee, types.DefaultMaxReferralFee)
+	return nil
+}
+
+// MigrateToVer5 creates the treasury module account
+func (m Migrator) MigrateToVer5(ctx sdk.Context) error {
+	m.keeper.authKeeper.GetModuleAccount(ctx, types.TreasuryName)
+	return nil
+}
+
+// MigrateToVer6 sets the default reference pools param
+func (m Migrator) MigrateToVer6(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyReferencePools, types.DefaultReferencePools)
+	return nil
+}
@@ -39,6 +39,11 @@ func (k msgServer) DecommissionPool(goCtx context.Context, msg *types.MsgDecommi
 	if !k.Keeper.ValidateAddress(ctx, addAddr) {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "user does not have permission to decommission pool")
 	}
+	// The pool can not be paid out while margin positions still owe it the amounts they borrowed
+	if !pool.GetNativeLiabilities().IsZero() || !pool.GetExternalLiabilities().IsZero() ||
+		k.Keeper.HasMarginPositions(ctx, msg.Symbol) {
+		return nil, types.ErrPoolHasLiabilities
+	}
 	if pool.NativeAssetBalance.GTE(sdk.NewUintFromString(types.PoolThrehold)) {
 		return nil, types.ErrBalanceTooHigh
 	}
@@ -97,14 +102,42 @@ func (k msgServer) DecommissionPool(goCtx context.Context, msg *types.MsgDecommi
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 		),
 	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDecommissionPool{
+		Signer: msg.Signer,
+		Pool:   pool,
+		Height: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgDecommissionPoolResponse{}, nil
 }
 
-func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
+func (k msgServer) UpdatePoolFeeTier(goCtx context.Context, msg *types.MsgUpdatePoolFeeTier) (*types.MsgUpdatePoolFeeTierResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var (
-		priceImpact sdk.Uint
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.Keeper.ValidateAddress(ctx, signer) {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "user does not have permission to update pool fee tier")
+	}
+	err = k.Keeper.SetPoolFeeTier(ctx, msg.Signer, msg.Symbol, msg.FeeTier)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
 	)
+	return &types.MsgUpdatePoolFeeTierResponse{}, nil
+}
+
+func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
 	sAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.SentAsset.Symbol)
 	if err != nil {
@@ -120,16 +153,9 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	if !k.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
 		return nil, tokenregistrytypes.ErrPermissionDenied
 	}
-	decimals := sAsset.Decimals
-	liquidityFeeNative := sdk.ZeroUint()
-	liquidityFeeExternal := sdk.ZeroUint()
-	totalLiquidityFee := sdk.ZeroUint()
-	priceImpact = sdk.ZeroUint()
-	sentAmount := msg.SentAmount
-	sentAsset := msg.SentAsset
-	receivedAsset := msg.ReceivedAsset
-	// Get native asset
-	nativeAsset := types.GetSettlementAsset()
+	if msg.ReferralFee > k.GetParams(ctx).MaxReferralFee {
+		return nil, types.ErrReferralFeeTooHigh
+	}
 	inPool, outPool := types.Pool{}, types.Pool{}
 	// If sending rowan ,deduct directly from the Native balance  instead of fetching from rowan pool
 	if !msg.SentAsset.Equals(types.GetSettlementAsset()) {
@@ -138,7 +164,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 			return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, msg.SentAsset.String())
 		}
 	}
-	sentAmountInt, ok := k.Keeper.ParseToInt(sentAmount.String())
+	sentAmountInt, ok := k.Keeper.ParseToInt(msg.SentAmount.String())
 	if !ok {
 		return nil, types.ErrUnableToParseInt
 	}
@@ -151,24 +177,8 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
 	}
-	// Check if its a two way swap, swapping non native fro non native .
-	// If its one way we can skip this if condition and add balance to users account from outpool
-	if !msg.SentAsset.Equals(nativeAsset) && !msg.ReceivedAsset.Equals(nativeAsset) {
-		normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(decimals)
-		emitAmount, lp, ts, finalPool, err := SwapOne(*sentAsset, sentAmount, nativeAsset, inPool, normalizationFactor, adjustExternalToken)
-		if err != nil {
-			return nil, err
-		}
-		err = k.Keeper.SetPool(ctx, &finalPool)
-		if err != nil {
-			return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
-		}
-		sentAmount = emitAmount
-		sentAsset = &nativeAsset
-		priceImpact = priceImpact.Add(ts)
-		liquidityFeeNative = liquidityFeeNative.Add(lp)
-	}
 	// If receiving  rowan , add directly to  Native balance  instead of fetching from rowan pool
+	var decimals int64
 	if msg.ReceivedAsset.Equals(types.GetSettlementAsset()) {
 		outPool, err = k.Keeper.GetPool(ctx, msg.SentAsset.Symbol)
 		if err != nil {
@@ -182,12 +192,21 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		}
 		decimals = rAsset.Decimals
 	}
-	// Calculating amount user receives
-	normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(decimals)
-	emitAmount, lp, ts, finalPool, err := SwapOne(*sentAsset, sentAmount, *receivedAsset, outPool, normalizationFactor, adjustExternalToken)
+	// Two way swaps of non native for non native go through rowan and leave the pool of the sent asset changed
+	legs, totalLiquidityFee, priceImpact, err := CalculateSwap(*msg.SentAsset, msg.SentAmount, *msg.ReceivedAsset, inPool, outPool, sAsset.Decimals, decimals)
 	if err != nil {
 		return nil, err
 	}
+	if len(legs) > 1 {
+		err = k.Keeper.SetPool(ctx, &legs[0].PoolAfter)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+		}
+	}
+	emitAmount, finalPool := legs[len(legs)-1].ReceivedAmount, legs[len(legs)-1].PoolAfter
+	// The referral fee is taken out of the output, the min receiving amount applies to what the signer gets
+	referralFee := CalcReferralFee(emitAmount, msg.ReferralFee)
+	emitAmount = emitAmount.Sub(referralFee)
 	if emitAmount.LT(msg.MinReceivingAmount) {
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -204,22 +223,35 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 			),
 		})
-		return &types.MsgSwapResponse{}, types.ErrReceivedAmountBelowExpected
+		err = ctx.EventManager().EmitTypedEvent(&types.EventSwapFailed{
+			Signer:             msg.Signer,
+			SentAsset:          *msg.SentAsset,
+			SentAmount:         msg.SentAmount,
+			ReceivedAsset:      *msg.ReceivedAsset,
+			ReceivedAmount:     emitAmount,
+			MinReceivingAmount: msg.MinReceivingAmount,
+			Height:             ctx.BlockHeight(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return nil, types.ErrReceivedAmountBelowExpected
 	}
 	// todo nil pointer deref test
 	err = k.Keeper.FinalizeSwap(ctx, emitAmount.String(), finalPool, *msg)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
 	}
-	if liquidityFeeNative.GT(sdk.ZeroUint()) {
-		liquidityFeeExternal = liquidityFeeExternal.Add(lp)
-		normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(decimals)
-		firstSwapFeeInOutputAsset := GetSwapFee(liquidityFeeNative, *msg.ReceivedAsset, outPool, normalizationFactor, adjustExternalToken)
-		totalLiquidityFee = liquidityFeeExternal.Add(firstSwapFeeInOutputAsset)
-	} else {
-		totalLiquidityFee = liquidityFeeNative.Add(lp)
+	if msg.Referrer != "" {
+		referrer, err := sdk.AccAddressFromBech32(msg.Referrer)
+		if err != nil {
+			return nil, err
+		}
+		err = k.Keeper.PayReferralFee(ctx, referrer, sdk.NewCoin(msg.ReceivedAsset.Symbol, sdk.NewIntFromBigInt(referralFee.BigInt())))
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+		}
 	}
-	priceImpact = priceImpact.Add(ts)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSwap,
@@ -228,6 +260,8 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 			sdk.NewAttribute(types.AttributeKeyPriceImpact, priceImpact.String()),
 			sdk.NewAttribute(types.AttributeKeyInPool, inPool.String()),
 			sdk.NewAttribute(types.AttributeKeyOutPool, outPool.String()),
+			sdk.NewAttribute(types.AttributeKeyReferrer, msg.Referrer),
+			sdk.NewAttribute(types.AttributeKeyReferralFee, referralFee.String()),
 			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
 		),
 		sdk.NewEvent(
@@ -236,7 +270,29 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 		),
 	})
-	return &types.MsgSwapResponse{}, nil
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSwap{
+		Signer:             msg.Signer,
+		SentAsset:          *msg.SentAsset,
+		SentAmount:         msg.SentAmount,
+		ReceivedAsset:      *msg.ReceivedAsset,
+		ReceivedAmount:     emitAmount,
+		MinReceivingAmount: msg.MinReceivingAmount,
+		LiquidityFee:       totalLiquidityFee,
+		PriceImpact:        priceImpact,
+		Legs:               legs,
+		Height:             ctx.BlockHeight(),
+		Referrer:           msg.Referrer,
+		ReferralFee:        referralFee,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSwapResponse{
+		ReceivedAmount: emitAmount,
+		LiquidityFee:   totalLiquidityFee,
+		PriceImpact:    priceImpact,
+		ReferralFee:    referralFee,
+	}, nil
 }
 
 func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
@@ -258,6 +314,7 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 	if err != nil {
 		return nil, types.ErrLiquidityProviderDoesNotExist
 	}
+	poolBefore := pool
 	poolOriginalEB := pool.ExternalAssetBalance
 	poolOriginalNB := pool.NativeAssetBalance
 	//Calculate amount to withdraw
@@ -327,6 +384,10 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 		}
 		pool = swappedPool
 	}
+	if lp.CostBasis == nil {
+		lp.CostBasis = NewCostBasisFromPosition(poolBefore, lp)
+	}
+	lp.CostBasis = ReduceCostBasis(lp.CostBasis, lp.LiquidityProviderUnits, lpUnitsLeft)
 	// Check and  remove Liquidity
 	err = k.Keeper.RemoveLiquidity(ctx, pool, externalAssetCoin, nativeAssetCoin, lp, lpUnitsLeft, poolOriginalEB, poolOriginalNB)
 	if err != nil {
@@ -344,7 +405,33 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 		),
 	})
-	return &types.MsgRemoveLiquidityResponse{}, nil
+	withdrawnNativeAssetAmount := sdk.NewUintFromBigInt(nativeAssetCoin.Amount.BigInt())
+	withdrawnExternalAssetAmount := sdk.NewUintFromBigInt(externalAssetCoin.Amount.BigInt())
+	lpUnitsRemoved := lp.LiquidityProviderUnits.Sub(lpUnitsLeft)
+	lpAfter := lp
+	lpAfter.LiquidityProviderUnits = lpUnitsLeft
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRemoveLiquidity{
+		Signer:              msg.Signer,
+		ExternalAsset:       *msg.ExternalAsset,
+		WBasisPoints:        msg.WBasisPoints,
+		Asymmetry:           msg.Asymmetry,
+		NativeAssetAmount:   withdrawnNativeAssetAmount,
+		ExternalAssetAmount: withdrawnExternalAssetAmount,
+		LpUnitsRemoved:      lpUnitsRemoved,
+		PoolBefore:          poolBefore,
+		PoolAfter:           pool,
+		LiquidityProvider:   lpAfter,
+		Height:              ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveLiquidityResponse{
+		NativeAssetAmount:   withdrawnNativeAssetAmount,
+		ExternalAssetAmount: withdrawnExternalAssetAmount,
+		LpUnitsRemoved:      lpUnitsRemoved,
+		LpUnitsLeft:         lpUnitsLeft,
+	}, nil
 }
 
 func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
@@ -362,6 +449,9 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 	if !k.tokenRegistryKeeper.CheckEntryPermissions(eAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
 		return nil, tokenregistrytypes.ErrPermissionDenied
 	}
+	if !k.GetParams(ctx).IsFeeTierAllowed(msg.FeeTier) {
+		return nil, sdkerrors.Wrapf(types.ErrFeeTierNotAllowed, "%d", msg.FeeTier)
+	}
 	// Check if pool already exists
 	if k.Keeper.ExistsPool(ctx, msg.ExternalAsset.Symbol) {
 		return nil, types.ErrUnableToCreatePool
@@ -382,7 +472,9 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 	if err != nil {
 		return nil, err
 	}
-	lp := k.Keeper.CreateLiquidityProvider(ctx, msg.ExternalAsset, lpunits, accAddr)
+	lp := types.NewLiquidityProvider(msg.ExternalAsset, lpunits, accAddr)
+	lp.CostBasis = AddToCostBasis(nil, sdk.ZeroUint(), lpunits, *pool, *pool, msg.NativeAssetAmount, msg.ExternalAssetAmount)
+	k.Keeper.SetLiquidityProvider(ctx, &lp)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreatePool,
@@ -400,7 +492,27 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 		),
 	})
-	return &types.MsgCreatePoolResponse{}, nil
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventCreatePool{
+			Signer:              msg.Signer,
+			Pool:                *pool,
+			LiquidityProvider:   lp,
+			NativeAssetAmount:   msg.NativeAssetAmount,
+			ExternalAssetAmount: msg.ExternalAssetAmount,
+			Height:              ctx.BlockHeight(),
+		},
+		&types.EventCreateLiquidityProvider{
+			LiquidityProvider: lp,
+			Height:            ctx.BlockHeight(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreatePoolResponse{
+		Pool:    *pool,
+		LpUnits: lpunits,
+	}, nil
 }
 
 func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
@@ -435,6 +547,10 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrUnableToAddLiquidity, err.Error())
 	}
+	poolAfter, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeAddLiquidity,
@@ -447,5 +563,23 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 		),
 	})
-	return &types.MsgAddLiquidityResponse{}, nil
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAddLiquidity{
+		Signer:              msg.Signer,
+		ExternalAsset:       *msg.ExternalAsset,
+		NativeAssetAmount:   msg.NativeAssetAmount,
+		ExternalAssetAmount: msg.ExternalAssetAmount,
+		LpUnitsAdded:        lpUnits,
+		PoolBefore:          pool,
+		PoolAfter:           poolAfter,
+		LiquidityProvider:   *lp,
+		Height:              ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAddLiquidityResponse{
+		LpUnitsAdded:           lpUnits,
+		LiquidityProviderUnits: lp.LiquidityProviderUnits,
+		PoolUnits:              poolAfter.PoolUnits,
+	}, nil
 }
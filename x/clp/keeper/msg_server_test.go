@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+func TestMsgServer_Responses(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: types.NativeSymbol, Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	signer := test.GenerateAddress(test.AddressKey1)
+	asset := types.NewAsset("eth")
+	initialBalance := sdk.NewUintFromString("1000000000000000000000")
+	poolBalance := sdk.NewUintFromString("1000000000000000000")
+	externalCoin := sdk.NewCoin(asset.Symbol, sdk.Int(initialBalance))
+	nativeCoin := sdk.NewCoin(types.NativeSymbol, sdk.Int(initialBalance))
+	err := sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(externalCoin, nativeCoin))
+	require.NoError(t, err)
+
+	msgCreatePool := types.NewMsgCreatePool(signer, asset, poolBalance, poolBalance)
+	createPoolRes, err := msgServer.CreatePool(goCtx, &msgCreatePool)
+	require.NoError(t, err)
+	assert.Equal(t, poolBalance.String(), createPoolRes.Pool.NativeAssetBalance.String())
+	assert.Equal(t, createPoolRes.Pool.PoolUnits.String(), createPoolRes.LpUnits.String())
+
+	msgAddLiquidity := types.NewMsgAddLiquidity(signer, asset, poolBalance, poolBalance)
+	addLiquidityRes, err := msgServer.AddLiquidity(goCtx, &msgAddLiquidity)
+	require.NoError(t, err)
+	assert.Equal(t, createPoolRes.LpUnits.String(), addLiquidityRes.LpUnitsAdded.String())
+	assert.Equal(t, createPoolRes.LpUnits.Add(addLiquidityRes.LpUnitsAdded).String(), addLiquidityRes.LiquidityProviderUnits.String())
+	assert.Equal(t, addLiquidityRes.LiquidityProviderUnits.String(), addLiquidityRes.PoolUnits.String())
+
+	sentAmount := sdk.NewUintFromString("1000000000000000")
+	msgSwap := types.NewMsgSwap(signer, types.GetSettlementAsset(), asset, sentAmount, sdk.NewUint(1))
+	swapRes, err := msgServer.Swap(goCtx, &msgSwap)
+	require.NoError(t, err)
+	assert.True(t, swapRes.ReceivedAmount.GT(sdk.ZeroUint()))
+	assert.True(t, swapRes.ReceivedAmount.LT(sentAmount))
+	assert.True(t, swapRes.LiquidityFee.GT(sdk.ZeroUint()))
+
+	msgRemoveLiquidity := types.NewMsgRemoveLiquidity(signer, asset, sdk.NewInt(5000), sdk.ZeroInt())
+	removeLiquidityRes, err := msgServer.RemoveLiquidity(goCtx, &msgRemoveLiquidity)
+	require.NoError(t, err)
+	assert.Equal(t, addLiquidityRes.LiquidityProviderUnits.String(), removeLiquidityRes.LpUnitsRemoved.Add(removeLiquidityRes.LpUnitsLeft).String())
+	assert.True(t, removeLiquidityRes.NativeAssetAmount.GT(poolBalance.QuoUint64(2)))
+	assert.True(t, removeLiquidityRes.ExternalAssetAmount.LT(poolBalance))
+	lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, signer.String())
+	require.NoError(t, err)
+	assert.Equal(t, removeLiquidityRes.LpUnitsLeft.String(), lp.LiquidityProviderUnits.String())
+}
+
+func TestMsgServer_SwapMatchesCalculateSwap(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: types.NativeSymbol, Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: "cusdc", Decimals: 6, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	signer := test.GenerateAddress(test.AddressKey1)
+	eth, usdc := types.NewAsset("eth"), types.NewAsset("cusdc")
+	initialBalance := sdk.NewUintFromString("1000000000000000000000000")
+	coins := sdk.NewCoins(sdk.NewCoin(eth.Symbol, sdk.Int(initialBalance)), sdk.NewCoin(usdc.Symbol, sdk.Int(initialBalance)),
+		sdk.NewCoin(types.NativeSymbol, sdk.Int(initialBalance)))
+	err := sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, coins)
+	require.NoError(t, err)
+	msgCreatePool := types.NewMsgCreatePool(signer, eth, sdk.NewUintFromString("3000000000000000000000"), sdk.NewUintFromString("1000000000000000000"))
+	_, err = msgServer.CreatePool(goCtx, &msgCreatePool)
+	require.NoError(t, err)
+	msgCreatePool = types.NewMsgCreatePool(signer, usdc, sdk.NewUintFromString("1000000000000000000000"), sdk.NewUintFromString("1000000000"))
+	_, err = msgServer.CreatePool(goCtx, &msgCreatePool)
+	require.NoError(t, err)
+	usdcPool, err := app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	usdcPool.FeeTier = 30
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &usdcPool))
+
+	for _, tc := range []struct {
+		name                      string
+		sent, received            types.Asset
+		sentAmount                sdk.Uint
+		inSymbol, outSymbol       string
+		sentDecimals, outDecimals int64
+	}{
+		{"rowan to eth", types.GetSettlementAsset(), eth, sdk.NewUintFromString("10000000000000000000"), "", "eth", 18, 18},
+		{"usdc to rowan", usdc, types.GetSettlementAsset(), sdk.NewUint(5000000), "", "cusdc", 6, 6},
+		{"eth to usdc", eth, usdc, sdk.NewUintFromString("10000000000000000"), "eth", "cusdc", 18, 6},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			inPool := types.Pool{}
+			if tc.inSymbol != "" {
+				inPool, err = app.ClpKeeper.GetPool(ctx, tc.inSymbol)
+				require.NoError(t, err)
+			}
+			outPool, err := app.ClpKeeper.GetPool(ctx, tc.outSymbol)
+			require.NoError(t, err)
+			legs, liquidityFee, priceImpact, err := clpkeeper.CalculateSwap(tc.sent, tc.sentAmount, tc.received, inPool, outPool, tc.sentDecimals, tc.outDecimals)
+			require.NoError(t, err)
+			receivedAmount := legs[len(legs)-1].ReceivedAmount
+			msgSwap := types.NewMsgSwap(signer, tc.sent, tc.received, tc.sentAmount, receivedAmount)
+			swapRes, err := msgServer.Swap(goCtx, &msgSwap)
+			require.NoError(t, err)
+			assert.Equal(t, receivedAmount.String(), swapRes.ReceivedAmount.String())
+			assert.Equal(t, liquidityFee.String(), swapRes.LiquidityFee.String())
+			assert.Equal(t, priceImpact.String(), swapRes.PriceImpact.String())
+			// the pools are left untouched by the calculation and only move once swapped against
+			pool, err := app.ClpKeeper.GetPool(ctx, tc.outSymbol)
+			require.NoError(t, err)
+			assert.Equal(t, legs[len(legs)-1].PoolAfter.ExternalAssetBalance.String(), pool.ExternalAssetBalance.String())
+			assert.Equal(t, legs[len(legs)-1].PoolAfter.NativeAssetBalance.String(), pool.NativeAssetBalance.String())
+		})
+	}
+
+	// a min receiving amount above the quote is rejected
+	msgSwap := types.NewMsgSwap(signer, types.GetSettlementAsset(), eth, sdk.NewUintFromString("10000000000000000000"), sdk.NewUintFromString("10000000000000000000"))
+	_, err = msgServer.Swap(goCtx, &msgSwap)
+	assert.ErrorIs(t, err, types.ErrReceivedAmountBelowExpected)
+}
+
+func TestMsgServer_SwapReferral(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: types.NativeSymbol, Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	signer := test.GenerateAddress(test.AddressKey1)
+	referrer := test.GenerateAddress(test.AddressKey2)
+	asset := types.NewAsset("eth")
+	initialBalance := sdk.NewUintFromString("1000000000000000000000")
+	coins := sdk.NewCoins(sdk.NewCoin(asset.Symbol, sdk.Int(initialBalance)), sdk.NewCoin(types.NativeSymbol, sdk.Int(initialBalance)))
+	err := sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, coins)
+	require.NoError(t, err)
+	poolBalance := sdk.NewUintFromString("100000000000000000000")
+	msgCreatePool := types.NewMsgCreatePool(signer, asset, poolBalance, poolBalance)
+	_, err = msgServer.CreatePool(goCtx, &msgCreatePool)
+	require.NoError(t, err)
+	sentAmount := sdk.NewUintFromString("1000000000000000000")
+
+	// fees above the param are rejected
+	msgSwap := types.NewMsgSwapWithReferral(signer, types.GetSettlementAsset(), asset, sentAmount, sdk.NewUint(1), referrer, types.DefaultMaxReferralFee+1)
+	_, err = msgServer.Swap(goCtx, &msgSwap)
+	assert.ErrorIs(t, err, types.ErrReferralFeeTooHigh)
+
+	pool, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	legs, _, _, err := clpkeeper.CalculateSwap(types.GetSettlementAsset(), sentAmount, asset, types.Pool{}, pool, 18, 18)
+	require.NoError(t, err)
+	output := legs[0].ReceivedAmount
+	referralFee := output.MulUint64(types.DefaultMaxReferralFee).QuoUint64(types.MaxWbasis)
+	require.False(t, referralFee.IsZero())
+
+	// the min receiving amount applies to the output net of the referral fee
+	msgSwap = types.NewMsgSwapWithReferral(signer, types.GetSettlementAsset(), asset, sentAmount, output, referrer, types.DefaultMaxReferralFee)
+	_, err = msgServer.Swap(goCtx, &msgSwap)
+	assert.ErrorIs(t, err, types.ErrReceivedAmountBelowExpected)
+
+	balanceBefore := app.BankKeeper.GetBalance(ctx, signer, asset.Symbol).Amount
+	msgSwap = types.NewMsgSwapWithReferral(signer, types.GetSettlementAsset(), asset, sentAmount, output.Sub(referralFee), referrer, types.DefaultMaxReferralFee)
+	swapRes, err := msgServer.Swap(goCtx, &msgSwap)
+	require.NoError(t, err)
+	assert.Equal(t, referralFee.String(), swapRes.ReferralFee.String())
+	assert.Equal(t, output.Sub(referralFee).String(), swapRes.ReceivedAmount.String())
+	balanceAfter := app.BankKeeper.GetBalance(ctx, signer, asset.Symbol).Amount
+	assert.Equal(t, balanceBefore.Add(sdk.Int(swapRes.ReceivedAmount)).String(), balanceAfter.String())
+	assert.Equal(t, referralFee.String(), app.BankKeeper.GetBalance(ctx, referrer, asset.Symbol).Amount.String())
+	stats := app.ClpKeeper.GetReferrerStats(ctx, referrer.String())
+	assert.Equal(t, uint64(1), stats.SwapCount)
+	assert.Equal(t, referralFee.String(), stats.FeesEarned.AmountOf(asset.Symbol).String())
+	// the pool pays out the whole output
+	pool, err = app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	assert.Equal(t, legs[0].PoolAfter.ExternalAssetBalance.String(), pool.ExternalAssetBalance.String())
+
+	res, err := clpkeeper.Querier{Keeper: app.ClpKeeper}.GetReferrerStats(goCtx, &types.ReferrerStatsReq{Referrer: referrer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, stats, *res.Stats)
+}
+
+func TestMsgServer_CostBasisAndPnL(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: types.NativeSymbol, Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	goCtx := sdk.WrapSDKContext(ctx)
+	signer := test.GenerateAddress(test.AddressKey1)
+	asset := types.NewAsset("eth")
+	initialBalance := sdk.NewUintFromString("1000000000000000000000")
+	poolBalance := sdk.NewUintFromString("1000000000000000000")
+	externalCoin := sdk.NewCoin(asset.Symbol, sdk.Int(initialBalance))
+	nativeCoin := sdk.NewCoin(types.NativeSymbol, sdk.Int(initialBalance))
+	err := sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(externalCoin, nativeCoin))
+	require.NoError(t, err)
+
+	msgCreatePool := types.NewMsgCreatePool(signer, asset, poolBalance, poolBalance)
+	_, err = msgServer.CreatePool(goCtx, &msgCreatePool)
+	require.NoError(t, err)
+	msgAddLiquidity := types.NewMsgAddLiquidity(signer, asset, poolBalance, poolBalance)
+	_, err = msgServer.AddLiquidity(goCtx, &msgAddLiquidity)
+	require.NoError(t, err)
+	lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, signer.String())
+	require.NoError(t, err)
+	require.NotNil(t, lp.CostBasis)
+	assert.Equal(t, poolBalance.MulUint64(2).String(), lp.CostBasis.NativeAssetDeposited.String())
+	assert.Equal(t, poolBalance.MulUint64(2).String(), lp.CostBasis.ExternalAssetDeposited.String())
+	assert.Equal(t, poolBalance.MulUint64(4).String(), lp.CostBasis.NativeValueAtDeposit.String())
+
+	pnlReq := types.LiquidityProviderPnLReq{Symbol: asset.Symbol, LpAddress: signer.String()}
+	pnl, err := querier.GetLiquidityProviderPnL(goCtx, &pnlReq)
+	require.NoError(t, err)
+	assert.Equal(t, pnl.HoldValue.String(), pnl.CurrentValue.String())
+	assert.Equal(t, pnl.DepositValue.String(), pnl.CurrentValue.String())
+	assert.True(t, pnl.FeeEarnings.IsZero())
+	assert.True(t, pnl.ImpermanentLoss.IsZero())
+
+	msgSwap := types.NewMsgSwap(signer, types.GetSettlementAsset(), asset, poolBalance.QuoUint64(2), sdk.NewUint(1))
+	_, err = msgServer.Swap(goCtx, &msgSwap)
+	require.NoError(t, err)
+	pnl, err = querier.GetLiquidityProviderPnL(goCtx, &pnlReq)
+	require.NoError(t, err)
+	assert.True(t, pnl.FeeEarnings.GT(sdk.ZeroUint()))
+	assert.True(t, pnl.ImpermanentLoss.IsPositive())
+	assert.True(t, pnl.HoldValue.GT(pnl.CurrentValue.Sub(pnl.FeeEarnings)))
+	assert.True(t, pnl.ProfitAndLoss.IsPositive())
+
+	msgRemoveLiquidity := types.NewMsgRemoveLiquidity(signer, asset, sdk.NewInt(5000), sdk.ZeroInt())
+	_, err = msgServer.RemoveLiquidity(goCtx, &msgRemoveLiquidity)
+	require.NoError(t, err)
+	lp, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, signer.String())
+	require.NoError(t, err)
+	assert.Equal(t, poolBalance.String(), lp.CostBasis.NativeAssetDeposited.String())
+	assert.Equal(t, poolBalance.String(), lp.CostBasis.ExternalAssetDeposited.String())
+	assert.Equal(t, poolBalance.MulUint64(2).String(), lp.CostBasis.NativeValueAtDeposit.String())
+}
+
+func TestMigrator_MigrateToVer2(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	pool := test.GenerateRandomPool(1)[0]
+	err := app.ClpKeeper.SetPool(ctx, &pool)
+	require.NoError(t, err)
+	lp := types.NewLiquidityProvider(pool.ExternalAsset, pool.PoolUnits, test.GenerateAddress(test.AddressKey1))
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+	_, err = clpkeeper.Querier{Keeper: app.ClpKeeper}.GetLiquidityProviderPnL(sdk.WrapSDKContext(ctx),
+		&types.LiquidityProviderPnLReq{Symbol: pool.ExternalAsset.Symbol, LpAddress: lp.LiquidityProviderAddress})
+	assert.ErrorIs(t, err, types.ErrCostBasisNotRecorded)
+
+	err = clpkeeper.NewMigrator(app.ClpKeeper).MigrateToVer2(ctx)
+	require.NoError(t, err)
+	lp, err = app.ClpKeeper.GetLiquidityProvider(ctx, pool.ExternalAsset.Symbol, lp.LiquidityProviderAddress)
+	require.NoError(t, err)
+	require.NotNil(t, lp.CostBasis)
+	assert.Equal(t, pool.NativeAssetBalance.String(), lp.CostBasis.NativeAssetDeposited.String())
+	assert.Equal(t, pool.ExternalAssetBalance.String(), lp.CostBasis.ExternalAssetDeposited.String())
+	assert.Equal(t, clpkeeper.CalculatePoolDepthPerUnit(pool).String(), lp.CostBasis.PoolDepthPerUnit.String())
+}
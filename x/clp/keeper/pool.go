@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/Sifchain/sifnode/x/clp/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,6 +16,10 @@ func (k Keeper) SetPool(ctx sdk.Context, pool *types.Pool) error {
 	if !pool.Validate() {
 		return types.ErrUnableToSetPool
 	}
+	// What is lent to margin positions is not held by the module account and can not be swapped out or withdrawn
+	if !pool.CoversLiabilities() {
+		return sdkerrors.Wrap(types.ErrPoolTooShallow, "pool balance below the amount lent to margin positions")
+	}
 	store := ctx.KVStore(k.storeKey)
 	key, err := types.GetPoolKey(pool.ExternalAsset.Symbol, types.GetSettlementAsset().Symbol)
 	if err != nil {
@@ -102,3 +109,35 @@ func (k Keeper) GetPoolsIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, types.PoolPrefix)
 }
+
+// SetPoolFeeTier changes the fee tier of a pool to one allowed by the params
+func (k Keeper) SetPoolFeeTier(ctx sdk.Context, signer string, symbol string, feeTier uint64) error {
+	pool, err := k.GetPool(ctx, symbol)
+	if err != nil {
+		return types.ErrPoolDoesNotExist
+	}
+	if !k.GetParams(ctx).IsFeeTierAllowed(feeTier) {
+		return sdkerrors.Wrapf(types.ErrFeeTierNotAllowed, "%d", feeTier)
+	}
+	oldFeeTier := pool.FeeTier
+	pool.FeeTier = feeTier
+	err = k.SetPool(ctx, &pool)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdatePoolFeeTier,
+			sdk.NewAttribute(types.AttributeKeyPool, pool.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeTier, strconv.FormatUint(feeTier, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventUpdatePoolFeeTier{
+		Signer:     signer,
+		Symbol:     symbol,
+		OldFeeTier: oldFeeTier,
+		NewFeeTier: feeTier,
+		Height:     ctx.BlockHeight(),
+	})
+}
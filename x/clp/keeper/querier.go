@@ -19,6 +19,18 @@ func NewQuerier(keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier
 			return queryPools(ctx, path[1:], req, legacyQuerierCdc, querier)
 		case types.QueryLiquidityProvider:
 			return queryLiquidityProvider(ctx, path[1:], req, legacyQuerierCdc, querier)
+		case types.QueryLiquidityProviderPnL:
+			return queryLiquidityProviderPnL(ctx, path[1:], req, legacyQuerierCdc, querier)
+		case types.QueryReferrerStats:
+			return queryReferrerStats(ctx, path[1:], req, legacyQuerierCdc, querier)
+		case types.QueryTreasury:
+			return queryTreasury(ctx, querier, legacyQuerierCdc)
+		case types.QueryPoolUSDValue:
+			return queryPoolUSDValue(ctx, req, legacyQuerierCdc, querier)
+		case types.QueryLPUSDValue:
+			return queryLiquidityProviderUSDValue(ctx, req, legacyQuerierCdc, querier)
+		case types.QueryTVL:
+			return queryTVL(ctx, legacyQuerierCdc, querier)
 		case types.QueryLiquidityProviderData:
 			return queryLiquidityProviderData(ctx, path[1:], req, legacyQuerierCdc, querier)
 		case types.QueryAssetList:
@@ -79,6 +91,98 @@ func queryLiquidityProvider(ctx sdk.Context, path []string, req abci.RequestQuer
 	return bz, nil
 }
 
+func queryLiquidityProviderPnL(ctx sdk.Context, path []string, req abci.RequestQuery, legacyQuerierCdc *codec.LegacyAmino, querier Querier) ([]byte, error) { //nolint
+	var params types.LiquidityProviderPnLReq
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	res, err := querier.GetLiquidityProviderPnL(sdk.WrapSDKContext(ctx), &params)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryReferrerStats(ctx sdk.Context, path []string, req abci.RequestQuery, legacyQuerierCdc *codec.LegacyAmino, querier Querier) ([]byte, error) { //nolint
+	var params types.ReferrerStatsReq
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	res, err := querier.GetReferrerStats(sdk.WrapSDKContext(ctx), &params)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryTreasury(ctx sdk.Context, querier Querier, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	res, err := querier.GetTreasury(sdk.WrapSDKContext(ctx), &types.TreasuryReq{})
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPoolUSDValue(ctx sdk.Context, req abci.RequestQuery, legacyQuerierCdc *codec.LegacyAmino, querier Querier) ([]byte, error) {
+	var params types.PoolUSDValueReq
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	res, err := querier.GetPoolUSDValue(sdk.WrapSDKContext(ctx), &params)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryLiquidityProviderUSDValue(ctx sdk.Context, req abci.RequestQuery, legacyQuerierCdc *codec.LegacyAmino, querier Querier) ([]byte, error) {
+	var params types.LiquidityProviderUSDValueReq
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	res, err := querier.GetLiquidityProviderUSDValue(sdk.WrapSDKContext(ctx), &params)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryTVL(ctx sdk.Context, legacyQuerierCdc *codec.LegacyAmino, querier Querier) ([]byte, error) {
+	res, err := querier.GetTVL(sdk.WrapSDKContext(ctx), &types.TVLReq{})
+	if err != nil {
+		return nil, err
+	}
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryLiquidityProviderData(ctx sdk.Context, path []string, req abci.RequestQuery, legacyQuerierCdc *codec.LegacyAmino, querier Querier) ([]byte, error) { //nolint
 	var params types.LiquidityProviderDataReq
 	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+func (k Keeper) SetReferrerStats(ctx sdk.Context, stats types.ReferrerStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetReferrerStatsKey(stats.Referrer), k.cdc.MustMarshal(&stats))
+}
+
+// GetReferrerStats returns the stats of a referrer, empty ones if it never earned a referral fee
+func (k Keeper) GetReferrerStats(ctx sdk.Context, referrer string) types.ReferrerStats {
+	stats := types.ReferrerStats{Referrer: referrer, FeesEarned: sdk.NewCoins()}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetReferrerStatsKey(referrer))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+	return stats
+}
+
+func (k Keeper) GetAllReferrerStats(ctx sdk.Context) []types.ReferrerStats {
+	var allStats []types.ReferrerStats
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReferrerStatsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ReferrerStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		allStats = append(allStats, stats)
+	}
+	return allStats
+}
+
+// PayReferralFee sends the referral fee of a swap from the clp module account to the referrer and adds it to
+// the stats of the referrer
+func (k Keeper) PayReferralFee(ctx sdk.Context, referrer sdk.AccAddress, fee sdk.Coin) error {
+	stats := k.GetReferrerStats(ctx, referrer.String())
+	if fee.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, referrer, sdk.NewCoins(fee))
+		if err != nil {
+			return err
+		}
+		stats.FeesEarned = stats.FeesEarned.Add(fee)
+	}
+	stats.SwapCount++
+	k.SetReferrerStats(ctx, stats)
+	return nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// The treasury provides liquidity through the msg server like any other liquidity provider, signing as the treasury
+// module account, so its positions are ordinary liquidity providers with a cost basis.
+
+// TreasuryAddLiquidity adds liquidity to a pool from the treasury
+func (k Keeper) TreasuryAddLiquidity(ctx sdk.Context, p *types.TreasuryAddLiquidityProposal) error {
+	msg := p.Msg()
+	_, err := NewMsgServerImpl(k).AddLiquidity(sdk.WrapSDKContext(ctx), &msg)
+	return err
+}
+
+// TreasuryRemoveLiquidity removes liquidity of the treasury from a pool
+func (k Keeper) TreasuryRemoveLiquidity(ctx sdk.Context, p *types.TreasuryRemoveLiquidityProposal) error {
+	msg := p.Msg()
+	_, err := NewMsgServerImpl(k).RemoveLiquidity(sdk.WrapSDKContext(ctx), &msg)
+	return err
+}
+
+// TreasuryRebalance withdraws part of the treasury position in a pool in rowan and adds it to another pool
+func (k Keeper) TreasuryRebalance(ctx sdk.Context, p *types.TreasuryRebalanceProposal) error {
+	msgServer := NewMsgServerImpl(k)
+	removeMsg := p.RemoveMsg()
+	res, err := msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &removeMsg)
+	if err != nil {
+		return err
+	}
+	addMsg := types.NewMsgAddLiquidity(types.GetTreasuryAddress(), types.NewAsset(p.ToSymbol), res.NativeAssetAmount, sdk.ZeroUint())
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	return err
+}
+
+// GetTreasuryPositions returns the value of the liquidity positions of the treasury
+func (k Keeper) GetTreasuryPositions(ctx sdk.Context) ([]types.LiquidityProviderPnLRes, error) {
+	var positions []types.LiquidityProviderPnLRes
+	iterator := k.GetLiquidityProviderIterator(ctx)
+	defer iterator.Close()
+	treasury := types.GetTreasuryAddress().String()
+	for ; iterator.Valid(); iterator.Next() {
+		var lp types.LiquidityProvider
+		k.cdc.MustUnmarshal(iterator.Value(), &lp)
+		if lp.LiquidityProviderAddress != treasury {
+			continue
+		}
+		pool, err := k.GetPool(ctx, lp.Asset.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		position, err := CalculatePositionPnL(pool, lp)
+		if err != nil {
+			return nil, err
+		}
+		position.Height = ctx.BlockHeight()
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
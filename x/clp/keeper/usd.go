@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// nativeDecimals are the decimals of rowan
+const nativeDecimals = 18
+
+// GetRowanUSDPrice returns the USD price of one rowan. It is the spot price of rowan in the reference pools, weighted
+// by their depth, and assumes the external asset of a reference pool is worth one USD. Reference pools that do not
+// exist or are not in the token registry are skipped.
+func (k Keeper) GetRowanUSDPrice(ctx sdk.Context) (sdk.Dec, error) {
+	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
+	var pools []types.Pool
+	var decimals []int64
+	for _, symbol := range k.GetParams(ctx).ReferencePools {
+		pool, err := k.GetPool(ctx, symbol)
+		if err != nil {
+			continue
+		}
+		entry, err := k.tokenRegistryKeeper.GetEntry(registry, symbol)
+		if err != nil {
+			continue
+		}
+		pools = append(pools, pool)
+		decimals = append(decimals, entry.Decimals)
+	}
+	return CalcRowanUSDPrice(pools, decimals)
+}
+
+// GetPoolUSDValue returns the USD value of the liquidity of a pool and the USD price of rowan it is valued at
+func (k Keeper) GetPoolUSDValue(ctx sdk.Context, symbol string) (value, rowanPrice sdk.Dec, err error) {
+	pool, err := k.GetPool(ctx, symbol)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	rowanPrice, err = k.GetRowanUSDPrice(ctx)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	return CalcPoolUSDValue(pool, rowanPrice), rowanPrice, nil
+}
+
+// GetTVL returns the USD value of the liquidity of all pools and the USD price of rowan it is valued at
+func (k Keeper) GetTVL(ctx sdk.Context) (tvl, rowanPrice sdk.Dec, err error) {
+	rowanPrice, err = k.GetRowanUSDPrice(ctx)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	tvl = sdk.ZeroDec()
+	for _, pool := range k.GetPools(ctx) {
+		tvl = tvl.Add(CalcPoolUSDValue(*pool, rowanPrice))
+	}
+	return tvl, rowanPrice, nil
+}
+
+// CalcRowanUSDPrice returns the USD price of one rowan from reference pools of USD stable coins with the given
+// decimals, the total of their external balances over the total of their native balances
+func CalcRowanUSDPrice(referencePools []types.Pool, decimals []int64) (sdk.Dec, error) {
+	usd, rowan := sdk.ZeroDec(), sdk.ZeroDec()
+	for i, pool := range referencePools {
+		usd = usd.Add(TokenAmount(pool.ExternalAssetBalance, decimals[i]))
+		rowan = rowan.Add(TokenAmount(pool.NativeAssetBalance, nativeDecimals))
+	}
+	if rowan.IsZero() {
+		return sdk.Dec{}, types.ErrNoReferencePool
+	}
+	return usd.Quo(rowan), nil
+}
+
+// CalcPoolUSDValue returns the USD value of the liquidity of a pool. Both sides of a pool are worth the same at its
+// spot price, so it is twice the value of its native balance.
+func CalcPoolUSDValue(pool types.Pool, rowanPrice sdk.Dec) sdk.Dec {
+	return TokenAmount(pool.NativeAssetBalance, nativeDecimals).Mul(rowanPrice).MulInt64(2)
+}
+
+// CalcLiquidityProviderUSDValue returns the USD value of the share of a pool owned by a liquidity provider
+func CalcLiquidityProviderUSDValue(pool types.Pool, lp types.LiquidityProvider, rowanPrice sdk.Dec) sdk.Dec {
+	if pool.PoolUnits.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return CalcPoolUSDValue(pool, rowanPrice).
+		MulInt(sdk.Int(lp.LiquidityProviderUnits)).
+		QuoInt(sdk.Int(pool.PoolUnits))
+}
+
+// TokenAmount converts an amount in the smallest unit of a token to whole tokens
+func TokenAmount(amount sdk.Uint, decimals int64) sdk.Dec {
+	return sdk.NewDecFromBigInt(amount.BigInt()).Quo(sdk.NewDec(10).Power(uint64(decimals)))
+}
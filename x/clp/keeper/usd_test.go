@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+func TestKeeper_USDValues(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	clpKeeper := app.ClpKeeper
+	querier := clpkeeper.Querier{Keeper: clpKeeper}
+	goCtx := sdk.WrapSDKContext(ctx)
+	for _, symbol := range []string{"cusdc", "cusdt"} {
+		app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: symbol, Decimals: 6})
+	}
+	rowan := func(amount int64) sdk.Uint { return sdk.NewUintFromBigInt(sdk.NewIntWithDecimal(amount, 18).BigInt()) }
+	usd := func(amount int64) sdk.Uint { return sdk.NewUintFromBigInt(sdk.NewIntWithDecimal(amount, 6).BigInt()) }
+	// The reference pools price rowan at (500 + 700) / (1000 + 1000) USD
+	for _, pool := range []struct {
+		symbol                  string
+		native, external, units sdk.Uint
+	}{
+		{"cusdc", rowan(1000), usd(500), rowan(1000)},
+		{"cusdt", rowan(1000), usd(700), rowan(1000)},
+		{"eth", rowan(10000), rowan(5), rowan(10000)},
+	} {
+		asset := types.NewAsset(pool.symbol)
+		p := types.NewPool(&asset, pool.native, pool.external, pool.units)
+		require.NoError(t, clpKeeper.SetPool(ctx, &p))
+	}
+	lpAddress := test.GenerateAddress(test.AddressKey1)
+	eth := types.NewAsset("eth")
+	lp := types.NewLiquidityProvider(&eth, rowan(2500), lpAddress)
+	clpKeeper.SetLiquidityProvider(ctx, &lp)
+
+	rowanPrice := sdk.MustNewDecFromStr("0.6")
+	price, err := clpKeeper.GetRowanUSDPrice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, rowanPrice, price)
+
+	poolRes, err := querier.GetPoolUSDValue(goCtx, &types.PoolUSDValueReq{Symbol: "eth"})
+	require.NoError(t, err)
+	assert.Equal(t, rowanPrice, poolRes.RowanPrice)
+	assert.Equal(t, sdk.NewDec(12000), poolRes.Value)
+	_, err = querier.GetPoolUSDValue(goCtx, &types.PoolUSDValueReq{Symbol: "dash"})
+	require.ErrorIs(t, err, types.ErrPoolDoesNotExist)
+
+	lpRes, err := querier.GetLiquidityProviderUSDValue(goCtx, &types.LiquidityProviderUSDValueReq{Symbol: "eth", LpAddress: lpAddress.String()})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(3000), lpRes.Value)
+
+	tvlRes, err := querier.GetTVL(goCtx, &types.TVLReq{})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(12000+1200+1200), tvlRes.Tvl)
+
+	// Reference pools that do not exist are skipped
+	params := clpKeeper.GetParams(ctx)
+	params.ReferencePools = []string{"cdai", "cusdc"}
+	clpKeeper.SetParams(ctx, params)
+	price, err = clpKeeper.GetRowanUSDPrice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), price)
+	params.ReferencePools = []string{"cdai"}
+	clpKeeper.SetParams(ctx, params)
+	_, err = querier.GetTVL(goCtx, &types.TVLReq{})
+	require.ErrorIs(t, err, types.ErrNoReferencePool)
+}
@@ -31,7 +31,7 @@ func Migrate(genesis v039clp.GenesisState) clptypes.GenesisState {
 	}
 
 	return clptypes.GenesisState{
-		Params:             clptypes.Params{MinCreatePoolThreshold: uint64(genesis.Params.MinCreatePoolThreshold)},
+		Params:             clptypes.Params{MinCreatePoolThreshold: uint64(genesis.Params.MinCreatePoolThreshold), FeeTiers: clptypes.DefaultFeeTiers},
 		AddressWhitelist:   whitelist,
 		PoolList:           poolList,
 		LiquidityProviders: liquidityProviders,
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding clp type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.PoolPrefix):
+			var poolA, poolB types.Pool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+		case bytes.Equal(kvA.Key[:1], types.LiquidityProviderPrefix):
+			var lpA, lpB types.LiquidityProvider
+			cdc.MustUnmarshal(kvA.Value, &lpA)
+			cdc.MustUnmarshal(kvB.Value, &lpB)
+			return fmt.Sprintf("%v\n%v", lpA, lpB)
+		case bytes.Equal(kvA.Key[:1], types.WhiteListValidatorPrefix):
+			var whitelistA, whitelistB stakingtypes.ValAddresses
+			cdc.MustUnmarshal(kvA.Value, &whitelistA)
+			cdc.MustUnmarshal(kvB.Value, &whitelistB)
+			return fmt.Sprintf("%v\n%v", whitelistA, whitelistB)
+		case bytes.Equal(kvA.Key[:1], types.ReferrerStatsPrefix):
+			var statsA, statsB types.ReferrerStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+		default:
+			panic(fmt.Sprintf("invalid clp key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
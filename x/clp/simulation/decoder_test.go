@@ -0,0 +1,55 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	"github.com/Sifchain/sifnode/x/clp/simulation"
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := sifapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+	asset := types.NewAsset("ceth")
+	pool := types.NewPool(&asset, sdk.NewUint(1000), sdk.NewUint(2000), sdk.NewUint(1000))
+	lpAddress := sdk.AccAddress("lp_address__________")
+	lp := types.NewLiquidityProvider(&asset, sdk.NewUint(1000), lpAddress)
+	poolKey, err := types.GetPoolKey(asset.Symbol, types.NativeSymbol)
+	require.NoError(t, err)
+	stats := types.ReferrerStats{Referrer: lpAddress.String(), FeesEarned: sdk.NewCoins(sdk.NewInt64Coin(asset.Symbol, 10)), SwapCount: 1}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: poolKey, Value: cdc.MustMarshal(&pool)},
+			{Key: types.GetLiquidityProviderKey(asset.Symbol, lpAddress.String()), Value: cdc.MustMarshal(&lp)},
+			{Key: types.GetReferrerStatsKey(stats.Referrer), Value: cdc.MustMarshal(&stats)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Pool", fmt.Sprintf("%v\n%v", pool, pool)},
+		{"LiquidityProvider", fmt.Sprintf("%v\n%v", lp, lp)},
+		{"ReferrerStats", fmt.Sprintf("%v\n%v", stats, stats)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
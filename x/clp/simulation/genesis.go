@@ -0,0 +1,196 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// Simulation parameter constants
+const (
+	MinCreatePoolThreshold = "min_create_pool_threshold"
+	FeeTiers               = "fee_tiers"
+	MaxReferralFee         = "max_referral_fee"
+	ReferencePools         = "reference_pools"
+	AddressWhitelist       = "address_whitelist"
+)
+
+// ExternalAssets are the pegged tokens handed out to the simulation accounts.
+// Most of them are paired with rowan in a genesis pool, the rest are left for
+// MsgCreatePool.
+var ExternalAssets = []string{"ceth", "cusdc", "cusdt", "cdai", "clink", "cwbtc"}
+
+var oneRowan = sdk.NewIntWithDecimal(1, 18)
+
+// GenMinCreatePoolThreshold randomized MinCreatePoolThreshold
+func GenMinCreatePoolThreshold(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 1000))
+}
+
+// GenFeeTiers randomized FeeTiers, a non empty subset of a few common tiers
+func GenFeeTiers(r *rand.Rand) []uint64 {
+	tiers := []uint64{0, 5, 30, 100}
+	n := simtypes.RandIntBetween(r, 1, len(tiers)+1)
+	feeTiers := make([]uint64, n)
+	for i, idx := range r.Perm(len(tiers))[:n] {
+		feeTiers[i] = tiers[idx]
+	}
+	return feeTiers
+}
+
+// GenMaxReferralFee randomized MaxReferralFee, up to 5%
+func GenMaxReferralFee(r *rand.Rand) uint64 {
+	return uint64(r.Intn(501))
+}
+
+// GenReferencePools randomized ReferencePools, a possibly empty subset of the stable coins
+func GenReferencePools(r *rand.Rand) []string {
+	stableCoins := []string{"cusdc", "cusdt", "cdai"}
+	n := r.Intn(len(stableCoins) + 1)
+	referencePools := make([]string, n)
+	for i, idx := range r.Perm(len(stableCoins))[:n] {
+		referencePools[i] = stableCoins[idx]
+	}
+	return referencePools
+}
+
+// GenAddressWhitelist picks between one and three accounts allowed to
+// decommission pools.
+func GenAddressWhitelist(r *rand.Rand, accs []simtypes.Account) []string {
+	n := simtypes.RandIntBetween(r, 1, 4)
+	if n > len(accs) {
+		n = len(accs)
+	}
+	whitelist := make([]string, n)
+	for i, idx := range r.Perm(len(accs))[:n] {
+		whitelist[i] = accs[idx].Address.String()
+	}
+	return whitelist
+}
+
+// RandomizedGenState generates a random GenesisState for clp. It also funds
+// every simulation account with rowan and the external assets, and funds the
+// clp module account with the balances of the genesis pools, so it must run
+// after the bank module.
+func RandomizedGenState(simState *module.SimulationState) {
+	var minCreatePoolThreshold uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinCreatePoolThreshold, &minCreatePoolThreshold, simState.Rand,
+		func(r *rand.Rand) { minCreatePoolThreshold = GenMinCreatePoolThreshold(r) },
+	)
+	var feeTiers []uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeTiers, &feeTiers, simState.Rand,
+		func(r *rand.Rand) { feeTiers = GenFeeTiers(r) },
+	)
+	var maxReferralFee uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxReferralFee, &maxReferralFee, simState.Rand,
+		func(r *rand.Rand) { maxReferralFee = GenMaxReferralFee(r) },
+	)
+	var referencePools []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReferencePools, &referencePools, simState.Rand,
+		func(r *rand.Rand) { referencePools = GenReferencePools(r) },
+	)
+	var addressWhitelist []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AddressWhitelist, &addressWhitelist, simState.Rand,
+		func(r *rand.Rand) { addressWhitelist = GenAddressWhitelist(r, simState.Accounts) },
+	)
+
+	r := simState.Rand
+	balances := make(map[string]sdk.Coins, len(simState.Accounts)+1)
+	for _, acc := range simState.Accounts {
+		coins := sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, randomInt(r, oneRowan.MulRaw(1e3), oneRowan.MulRaw(1e6))))
+		for _, symbol := range ExternalAssets {
+			coins = coins.Add(sdk.NewCoin(symbol, randomInt(r, oneRowan.MulRaw(1e3), oneRowan.MulRaw(1e6))))
+		}
+		balances[acc.Address.String()] = coins
+	}
+
+	var pools []*types.Pool
+	var liquidityProviders []*types.LiquidityProvider
+	poolCoins := sdk.NewCoins()
+	for _, symbol := range ExternalAssets {
+		if r.Intn(4) == 0 {
+			continue
+		}
+		asset := types.NewAsset(symbol)
+		nativeBalance := randomInt(r, oneRowan.MulRaw(1e4), oneRowan.MulRaw(1e7))
+		externalBalance := randomInt(r, oneRowan.MulRaw(1e4), oneRowan.MulRaw(1e7))
+		poolUnits := sdk.NewUintFromBigInt(nativeBalance.BigInt())
+		pool := types.NewPool(&asset, poolUnits, sdk.NewUintFromBigInt(externalBalance.BigInt()), poolUnits)
+		pool.FeeTier = feeTiers[r.Intn(len(feeTiers))]
+		pools = append(pools, &pool)
+		poolCoins = poolCoins.Add(sdk.NewCoin(types.NativeSymbol, nativeBalance)).Add(sdk.NewCoin(symbol, externalBalance))
+
+		// split the pool units between a few random providers
+		n := simtypes.RandIntBetween(r, 1, 4)
+		if n > len(simState.Accounts) {
+			n = len(simState.Accounts)
+		}
+		unitsLeft := poolUnits
+		for i, idx := range r.Perm(len(simState.Accounts))[:n] {
+			units := unitsLeft
+			if i < n-1 {
+				units = unitsLeft.QuoUint64(uint64(simtypes.RandIntBetween(r, 2, 5)))
+			}
+			unitsLeft = unitsLeft.Sub(units)
+			lp := types.NewLiquidityProvider(&asset, units, simState.Accounts[idx].Address)
+			liquidityProviders = append(liquidityProviders, &lp)
+		}
+	}
+	balances[authtypes.NewModuleAddress(types.ModuleName).String()] = poolCoins
+
+	bankGenesis := banktypes.GetGenesisStateFromAppState(simState.Cdc, simState.GenState)
+	for i, balance := range bankGenesis.Balances {
+		if coins, ok := balances[balance.Address]; ok {
+			bankGenesis.Balances[i].Coins = balance.Coins.Add(coins...)
+			bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+			delete(balances, balance.Address)
+		}
+	}
+	// iterate in account order, map iteration would break determinism
+	addresses := make([]string, 0, len(balances))
+	for _, acc := range simState.Accounts {
+		addresses = append(addresses, acc.Address.String())
+	}
+	addresses = append(addresses, authtypes.NewModuleAddress(types.ModuleName).String())
+	for _, address := range addresses {
+		coins, ok := balances[address]
+		if !ok || coins.Empty() {
+			continue
+		}
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: address, Coins: coins})
+		bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+	}
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
+
+	clpGenesis := types.GenesisState{
+		Params:             types.NewParams(minCreatePoolThreshold, feeTiers, maxReferralFee, referencePools),
+		AddressWhitelist:   addressWhitelist,
+		PoolList:           pools,
+		LiquidityProviders: liquidityProviders,
+	}
+	bz, err := json.MarshalIndent(&clpGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated clp parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&clpGenesis)
+}
+
+// randomInt returns a random integer in [min, max).
+func randomInt(r *rand.Rand, min, max sdk.Int) sdk.Int {
+	return min.Add(sdk.NewIntFromBigInt(new(big.Int).Rand(r, max.Sub(min).BigInt())))
+}
@@ -0,0 +1,283 @@
+package simulation
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreatePool        = "op_weight_msg_create_pool"
+	OpWeightMsgAddLiquidity      = "op_weight_msg_add_liquidity"
+	OpWeightMsgRemoveLiquidity   = "op_weight_msg_remove_liquidity"
+	OpWeightMsgSwap              = "op_weight_msg_swap"
+	OpWeightMsgUpdatePoolFeeTier = "op_weight_msg_update_pool_fee_tier"
+
+	DefaultWeightMsgCreatePool        = 10
+	DefaultWeightMsgAddLiquidity      = 50
+	DefaultWeightMsgRemoveLiquidity   = 30
+	DefaultWeightMsgSwap              = 100
+	DefaultWeightMsgUpdatePoolFeeTier = 5
+)
+
+// TransactionFee is paid in rowan by every simulated clp transaction, the ante
+// handler rejects swaps and liquidity changes paying less than 0.1 rowan.
+var TransactionFee = sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewIntWithDecimal(1, 17)))
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper) simulation.WeightedOperations {
+	var (
+		weightMsgCreatePool        int
+		weightMsgAddLiquidity      int
+		weightMsgRemoveLiquidity   int
+		weightMsgSwap              int
+		weightMsgUpdatePoolFeeTier int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePool, &weightMsgCreatePool, nil,
+		func(_ *rand.Rand) { weightMsgCreatePool = DefaultWeightMsgCreatePool },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddLiquidity, &weightMsgAddLiquidity, nil,
+		func(_ *rand.Rand) { weightMsgAddLiquidity = DefaultWeightMsgAddLiquidity },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRemoveLiquidity, &weightMsgRemoveLiquidity, nil,
+		func(_ *rand.Rand) { weightMsgRemoveLiquidity = DefaultWeightMsgRemoveLiquidity },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSwap, &weightMsgSwap, nil,
+		func(_ *rand.Rand) { weightMsgSwap = DefaultWeightMsgSwap },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdatePoolFeeTier, &weightMsgUpdatePoolFeeTier, nil,
+		func(_ *rand.Rand) { weightMsgUpdatePoolFeeTier = DefaultWeightMsgUpdatePoolFeeTier },
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreatePool, SimulateMsgCreatePool(k)),
+		simulation.NewWeightedOperation(weightMsgAddLiquidity, SimulateMsgAddLiquidity(k)),
+		simulation.NewWeightedOperation(weightMsgRemoveLiquidity, SimulateMsgRemoveLiquidity(k)),
+		simulation.NewWeightedOperation(weightMsgSwap, SimulateMsgSwap(k)),
+		simulation.NewWeightedOperation(weightMsgUpdatePoolFeeTier, SimulateMsgUpdatePoolFeeTier(k)),
+	}
+}
+
+// SimulateMsgCreatePool generates a MsgCreatePool for an asset held by a random
+// account that has no pool yet.
+func SimulateMsgCreatePool(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgCreatePool{}.Type()
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, simAccount.Address)
+		var candidates sdk.Coins
+		for _, coin := range spendable {
+			if coin.Denom != types.NativeSymbol && !k.ExistsPool(ctx, coin.Denom) {
+				candidates = append(candidates, coin)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no asset without a pool"), nil, nil
+		}
+		externalCoin := candidates[r.Intn(len(candidates))]
+		minNative := sdk.NewIntFromBigInt(sdk.NewUintFromString(types.PoolThrehold).BigInt())
+		maxNative := spendable.AmountOf(types.NativeSymbol).Sub(TransactionFee.AmountOf(types.NativeSymbol))
+		if maxNative.LT(minNative) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough rowan to create a pool"), nil, nil
+		}
+		nativeAmount := minNative.Add(simtypes.RandomAmount(r, maxNative.Sub(minNative)))
+		externalAmount := simtypes.RandomAmount(r, externalCoin.Amount)
+		if externalAmount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "external asset amount is zero"), nil, nil
+		}
+		msg := types.NewMsgCreatePool(simAccount.Address, types.NewAsset(externalCoin.Denom),
+			sdk.NewUintFromBigInt(nativeAmount.BigInt()), sdk.NewUintFromBigInt(externalAmount.BigInt()))
+		feeTiers := k.GetParams(ctx).FeeTiers
+		msg.FeeTier = feeTiers[r.Intn(len(feeTiers))]
+		return deliver(r, app, ctx, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgAddLiquidity generates a MsgAddLiquidity to a random pool from a
+// random account, with random and possibly asymmetric amounts.
+func SimulateMsgAddLiquidity(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgAddLiquidity{}.Type()
+		pool, ok := randomPool(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pools"), nil, nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, simAccount.Address)
+		maxNative := spendable.AmountOf(types.NativeSymbol).Sub(TransactionFee.AmountOf(types.NativeSymbol))
+		if maxNative.IsNegative() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough rowan to pay fees"), nil, nil
+		}
+		nativeAmount := simtypes.RandomAmount(r, maxNative)
+		externalAmount := simtypes.RandomAmount(r, spendable.AmountOf(pool.ExternalAsset.Symbol))
+		if nativeAmount.IsZero() && externalAmount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "nothing to add"), nil, nil
+		}
+		msg := types.NewMsgAddLiquidity(simAccount.Address, *pool.ExternalAsset,
+			sdk.NewUintFromBigInt(nativeAmount.BigInt()), sdk.NewUintFromBigInt(externalAmount.BigInt()))
+		return deliver(r, app, ctx, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgRemoveLiquidity generates a MsgRemoveLiquidity for a random
+// liquidity provider.
+func SimulateMsgRemoveLiquidity(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgRemoveLiquidity{}.Type()
+		lps, _, err := k.GetAllLiquidityProvidersPaginated(ctx, &query.PageRequest{
+			Limit: uint64(math.MaxUint64),
+		})
+		if err != nil || len(lps) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no liquidity providers"), nil, nil
+		}
+		lp := lps[r.Intn(len(lps))]
+		lpAddress, err := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid liquidity provider address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, lpAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "liquidity provider is not a simulation account"), nil, nil
+		}
+		wBasisPoints := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, types.MaxWbasis+1)))
+		asymmetry := sdk.NewInt(int64(simtypes.RandIntBetween(r, -10000, 10001)))
+		msg := types.NewMsgRemoveLiquidity(simAccount.Address, *lp.Asset, wBasisPoints, asymmetry)
+		return deliver(r, app, ctx, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgSwap generates a MsgSwap of a random amount through a random pool,
+// sometimes routed through a second pool to swap between two external assets.
+func SimulateMsgSwap(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgSwap{}.Type()
+		pool, ok := randomPool(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pools"), nil, nil
+		}
+		sentAsset, receivedAsset := types.GetSettlementAsset(), *pool.ExternalAsset
+		if r.Intn(2) == 0 {
+			sentAsset, receivedAsset = receivedAsset, sentAsset
+		}
+		if otherPool, ok := randomPool(r, ctx, k); ok && r.Intn(3) == 0 && otherPool.ExternalAsset.Symbol != pool.ExternalAsset.Symbol {
+			sentAsset, receivedAsset = *pool.ExternalAsset, *otherPool.ExternalAsset
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, simAccount.Address)
+		balance := spendable.AmountOf(sentAsset.Symbol)
+		if sentAsset.Equals(types.GetSettlementAsset()) {
+			balance = balance.Sub(TransactionFee.AmountOf(types.NativeSymbol))
+		}
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no balance to swap"), nil, nil
+		}
+		sentAmount := simtypes.RandomAmount(r, balance)
+		if sentAmount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sent amount is zero"), nil, nil
+		}
+		msg := types.NewMsgSwap(simAccount.Address, sentAsset, receivedAsset, sdk.NewUintFromBigInt(sentAmount.BigInt()), sdk.ZeroUint())
+		// refer half of the swaps from a random account, within the max referral fee
+		if maxReferralFee := k.GetParams(ctx).MaxReferralFee; maxReferralFee > 0 && r.Intn(2) == 0 {
+			referrer, _ := simtypes.RandomAcc(r, accs)
+			msg.Referrer = referrer.Address.String()
+			msg.ReferralFee = uint64(simtypes.RandIntBetween(r, 1, int(maxReferralFee)+1))
+		}
+		return deliver(r, app, ctx, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.Swap(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgUpdatePoolFeeTier generates a MsgUpdatePoolFeeTier moving a random
+// pool to a random allowed fee tier, signed by a whitelisted account.
+func SimulateMsgUpdatePoolFeeTier(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgUpdatePoolFeeTier{}.Type()
+		pool, ok := randomPool(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pools"), nil, nil
+		}
+		whitelist := k.GetClpWhiteList(ctx)
+		if len(whitelist) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no whitelisted accounts"), nil, nil
+		}
+		simAccount, found := simtypes.FindAccount(accs, whitelist[r.Intn(len(whitelist))])
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin is not a simulation account"), nil, nil
+		}
+		feeTiers := k.GetParams(ctx).FeeTiers
+		msg := types.NewMsgUpdatePoolFeeTier(simAccount.Address, pool.ExternalAsset.Symbol, feeTiers[r.Intn(len(feeTiers))])
+		return deliver(r, app, ctx, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.UpdatePoolFeeTier(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+func randomPool(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.Pool, bool) {
+	pools, _, err := k.GetPoolsPaginated(ctx, &query.PageRequest{
+		Limit: uint64(math.MaxUint64),
+	})
+	if err != nil || len(pools) == 0 {
+		return types.Pool{}, false
+	}
+	return *pools[r.Intn(len(pools))], true
+}
+
+// deliver runs the msg against a cached copy of the state first, after taking
+// the fee, and only delivers transactions the msg server accepts. Rejected msgs
+// are reported as no-ops so that the simulation keeps exercising the pool math.
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, k keeper.Keeper, simAccount simtypes.Account,
+	msg legacytx.LegacyMsg, check func(types.MsgServer, sdk.Context) error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	err := k.GetBankKeeper().SendCoinsFromAccountToModule(cacheCtx, simAccount.Address, authtypes.FeeCollectorName, TransactionFee)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "not enough rowan to pay fees"), nil, nil
+	}
+	err = check(keeper.NewMsgServerImpl(k), cacheCtx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+	}
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           nil,
+		Msg:           msg,
+		MsgType:       msg.Type(),
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: k.GetAuthKeeper(),
+		Bankkeeper:    k.GetBankKeeper(),
+		ModuleName:    types.ModuleName,
+	}
+	return simulation.GenAndDeliverTx(txCtx, TransactionFee)
+}
@@ -0,0 +1,49 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMinCreatePoolThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMinCreatePoolThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFeeTiers),
+			func(r *rand.Rand) string {
+				feeTiers := GenFeeTiers(r)
+				quoted := make([]string, len(feeTiers))
+				for i, tier := range feeTiers {
+					quoted[i] = fmt.Sprintf("\"%d\"", tier)
+				}
+				return fmt.Sprintf("[%s]", strings.Join(quoted, ","))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxReferralFee),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxReferralFee(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyReferencePools),
+			func(r *rand.Rand) string {
+				referencePools := GenReferencePools(r)
+				quoted := make([]string, len(referencePools))
+				for i, symbol := range referencePools {
+					quoted[i] = fmt.Sprintf("\"%s\"", symbol)
+				}
+				return fmt.Sprintf("[%s]", strings.Join(quoted, ","))
+			},
+		),
+	}
+}
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// OpWeightSubmitUpdatePoolFeeTierProposal app params key for the pool fee tier proposal
+const OpWeightSubmitUpdatePoolFeeTierProposal = "op_weight_submit_update_pool_fee_tier_proposal"
+
+// DefaultWeightUpdatePoolFeeTierProposal is the default weight of the pool fee tier proposal
+const DefaultWeightUpdatePoolFeeTierProposal = 5
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitUpdatePoolFeeTierProposal,
+			DefaultWeightUpdatePoolFeeTierProposal,
+			SimulateUpdatePoolFeeTierProposalContent(k),
+		),
+	}
+}
+
+// SimulateUpdatePoolFeeTierProposalContent generates a proposal moving a random
+// pool to a random allowed fee tier.
+func SimulateUpdatePoolFeeTierProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		pool, ok := randomPool(r, ctx, k)
+		if !ok {
+			return nil
+		}
+		feeTiers := k.GetParams(ctx).FeeTiers
+		return types.NewUpdatePoolFeeTierProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			pool.ExternalAsset.Symbol,
+			feeTiers[r.Intn(len(feeTiers))],
+		)
+	}
+}
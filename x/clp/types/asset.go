@@ -53,6 +53,11 @@ func GetCLPModuleAddress() sdk.AccAddress {
 	return authtypes.NewModuleAddress(ModuleName)
 }
 
+// GetTreasuryAddress returns the address of the module account holding the protocol owned liquidity
+func GetTreasuryAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(TreasuryName)
+}
+
 func GetDefaultCLPAdmin() sdk.AccAddress {
 	return authtypes.NewModuleAddress("ClpAdmin")
 }
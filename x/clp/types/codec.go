@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers concrete types on codec
@@ -15,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "clp/RemoveLiquidity", nil)
 	cdc.RegisterConcrete(&MsgSwap{}, "clp/Swap", nil)
 	cdc.RegisterConcrete(&MsgDecommissionPool{}, "clp/DecommissionPool", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolFeeTier{}, "clp/UpdatePoolFeeTier", nil)
 }
 
 var (
@@ -36,6 +38,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddLiquidity{},
 		&MsgSwap{},
 		&MsgDecommissionPool{},
+		&MsgUpdatePoolFeeTier{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdatePoolFeeTierProposal{},
+		&TreasuryAddLiquidityProposal{},
+		&TreasuryRemoveLiquidityProposal{},
+		&TreasuryRebalanceProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -32,4 +32,12 @@ var (
 	ErrUnableToParseInt                = sdkerrors.Register(ModuleName, 30, "Unable to parse to Int")
 	ErrReceivedAmountBelowExpected     = sdkerrors.Register(ModuleName, 31, "Unable to swap, received amount is below expected")
 	ErrAmountTooLow                    = sdkerrors.Register(ModuleName, 32, "Tx amount is too low")
+	ErrCostBasisNotRecorded            = sdkerrors.Register(ModuleName, 33, "Cost basis of liquidity provider not recorded")
+	ErrInvalidFeeTier                  = sdkerrors.Register(ModuleName, 34, "Invalid fee tier")
+	ErrFeeTierNotAllowed               = sdkerrors.Register(ModuleName, 35, "Fee tier not allowed by params")
+	ErrInvalidSlippage                 = sdkerrors.Register(ModuleName, 36, "Invalid max slippage, must be a percentage between 0 and 100")
+	ErrInvalidReferral                 = sdkerrors.Register(ModuleName, 37, "Invalid referral, a referrer and a positive referral fee must be set together")
+	ErrReferralFeeTooHigh              = sdkerrors.Register(ModuleName, 38, "Referral fee is above the max referral fee param")
+	ErrNoReferencePool                 = sdkerrors.Register(ModuleName, 39, "No reference pool to price rowan in USD")
+	ErrPoolHasLiabilities              = sdkerrors.Register(ModuleName, 40, "Pool has lent to margin positions which are still open")
 )
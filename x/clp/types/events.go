@@ -10,6 +10,8 @@ const (
 	EventTypeRemoveLiquidity         = "removed_liquidity"
 	EventTypeSwap                    = "swap_successful"
 	EventTypeSwapFailed              = "swap_failed"
+	EventTypeUpdatePoolFeeTier       = "update_pool_fee_tier"
+	AttributeKeyFeeTier              = "fee_tier"
 	AttributeKeyThreshold            = "min_threshold"
 	AttributeKeySwapAmount           = "swap_amount"
 	AttributeKeyLiquidityFee         = "liquidity_fee"
@@ -19,5 +21,7 @@ const (
 	AttributeKeyPool                 = "pool"
 	AttributeKeyHeight               = "height"
 	AttributeKeyLiquidityProvider    = "liquidity_provider"
+	AttributeKeyReferrer             = "referrer"
+	AttributeKeyReferralFee          = "referral_fee"
 	AttributeValueCategory           = ModuleName
 )
@@ -31,6 +31,7 @@ type GenesisState struct {
 	AddressWhitelist   []string             `protobuf:"bytes,2,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
 	PoolList           []*Pool              `protobuf:"bytes,3,rep,name=pool_list,json=poolList,proto3" json:"pool_list,omitempty"`
 	LiquidityProviders []*LiquidityProvider `protobuf:"bytes,4,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers,omitempty"`
+	ReferrerStats      []ReferrerStats      `protobuf:"bytes,5,rep,name=referrer_stats,json=referrerStats,proto3" json:"referrer_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +95,13 @@ func (m *GenesisState) GetLiquidityProviders() []*LiquidityProvider {
 	return nil
 }
 
+func (m *GenesisState) GetReferrerStats() []ReferrerStats {
+	if m != nil {
+		return m.ReferrerStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sifnode.clp.v1.GenesisState")
 }
@@ -101,27 +109,28 @@ func init() {
 func init() { proto.RegisterFile("sifnode/clp/v1/genesis.proto", fileDescriptor_cd711ee3eda6f54c) }
 
 var fileDescriptor_cd711ee3eda6f54c = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4e, 0xc2, 0x30,
-	0x18, 0xc7, 0x57, 0x21, 0x44, 0x8a, 0x31, 0x3a, 0x89, 0x21, 0xd3, 0x54, 0xf4, 0x22, 0x89, 0x49,
-	0x1b, 0xd0, 0x17, 0x90, 0x8b, 0x17, 0x0e, 0x64, 0x1c, 0x4c, 0xbc, 0x90, 0xb1, 0x95, 0xd1, 0xa4,
-	0xd0, 0xba, 0x16, 0x94, 0xb7, 0xf0, 0xb1, 0x38, 0x72, 0xf4, 0x64, 0xcc, 0xf6, 0x12, 0x1e, 0xcd,
-	0xba, 0xee, 0xe0, 0xbc, 0x35, 0xdf, 0xef, 0xf7, 0xff, 0xbe, 0xe6, 0x0f, 0x2f, 0x15, 0x9b, 0xaf,
-	0x44, 0x44, 0x49, 0xc8, 0x25, 0xd9, 0xf4, 0x49, 0x4c, 0x57, 0x54, 0x31, 0x85, 0x65, 0x22, 0xb4,
-	0x70, 0x8f, 0x2d, 0xc5, 0x21, 0x97, 0x78, 0xd3, 0xf7, 0xda, 0xb1, 0x88, 0x85, 0x41, 0x24, 0x7f,
-	0x15, 0x96, 0x77, 0x51, 0xd9, 0x21, 0x83, 0x24, 0x58, 0xda, 0x15, 0x9e, 0x57, 0x81, 0x7a, 0x2b,
-	0xa9, 0x65, 0x37, 0x3f, 0x00, 0x1e, 0x3d, 0x15, 0x07, 0x27, 0x3a, 0xd0, 0xd4, 0x7d, 0x80, 0x8d,
-	0x22, 0xdc, 0x01, 0x5d, 0xd0, 0x6b, 0x0d, 0xce, 0xf1, 0xdf, 0x0f, 0xe0, 0xb1, 0xa1, 0xc3, 0xfa,
-	0xee, 0xeb, 0xca, 0xf1, 0xad, 0xeb, 0xde, 0xc1, 0xd3, 0x20, 0x8a, 0x12, 0xaa, 0xd4, 0xf4, 0x6d,
-	0xc1, 0x34, 0xe5, 0x4c, 0xe9, 0xce, 0x41, 0xb7, 0xd6, 0x6b, 0xfa, 0x27, 0x16, 0x3c, 0x97, 0x73,
-	0xb7, 0x0f, 0x9b, 0x52, 0x08, 0x3e, 0x35, 0x52, 0xad, 0x5b, 0xeb, 0xb5, 0x06, 0xed, 0x7f, 0x57,
-	0x84, 0xe0, 0xfe, 0x61, 0xae, 0x8d, 0xf2, 0x88, 0x0f, 0xcf, 0x38, 0x7b, 0x5d, 0xb3, 0x88, 0xe9,
-	0xed, 0x54, 0x26, 0x62, 0xc3, 0x22, 0x9a, 0xa8, 0x4e, 0xdd, 0x84, 0xaf, 0xab, 0xe1, 0x51, 0xa9,
-	0x8e, 0xad, 0xe9, 0xbb, 0xbc, 0x3a, 0x52, 0xc3, 0xc7, 0x5d, 0x8a, 0xc0, 0x3e, 0x45, 0xe0, 0x3b,
-	0x45, 0xe0, 0x23, 0x43, 0xce, 0x3e, 0x43, 0xce, 0x67, 0x86, 0x9c, 0x97, 0xdb, 0x98, 0xe9, 0xc5,
-	0x7a, 0x86, 0x43, 0xb1, 0x24, 0x13, 0x36, 0x0f, 0x17, 0x01, 0x5b, 0x91, 0xb2, 0xc4, 0x77, 0x53,
-	0xa3, 0xe9, 0x70, 0xd6, 0x30, 0x25, 0xde, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x99, 0xea,
-	0x9f, 0xc3, 0x01, 0x00, 0x00,
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xc2, 0x30,
+	0x1c, 0xc6, 0x37, 0x40, 0x22, 0x45, 0x89, 0x4e, 0x62, 0x96, 0xa9, 0x13, 0xbd, 0x48, 0x62, 0xb2,
+	0x05, 0xf4, 0x05, 0xf4, 0x62, 0x62, 0x38, 0x90, 0x72, 0x30, 0xf1, 0xb2, 0x94, 0xad, 0x8c, 0x26,
+	0x85, 0xd6, 0xb6, 0xa0, 0xbc, 0x85, 0x0f, 0xe5, 0x81, 0x23, 0x47, 0x4f, 0xc6, 0xc0, 0x8b, 0x98,
+	0x95, 0xee, 0xc0, 0xbc, 0x35, 0xff, 0xef, 0xf7, 0x7d, 0xff, 0xb6, 0x1f, 0x38, 0x97, 0x64, 0x34,
+	0x65, 0x09, 0x0e, 0x63, 0xca, 0xc3, 0x79, 0x27, 0x4c, 0xf1, 0x14, 0x4b, 0x22, 0x03, 0x2e, 0x98,
+	0x62, 0x4e, 0xc3, 0xa8, 0x41, 0x4c, 0x79, 0x30, 0xef, 0x78, 0xcd, 0x94, 0xa5, 0x4c, 0x4b, 0x61,
+	0x76, 0xda, 0x52, 0xde, 0x59, 0x21, 0x83, 0x23, 0x81, 0x26, 0x26, 0xc2, 0xf3, 0x0a, 0xa2, 0x5a,
+	0x70, 0x6c, 0xb4, 0xeb, 0xaf, 0x12, 0x38, 0x78, 0xda, 0x2e, 0x1c, 0x28, 0xa4, 0xb0, 0x73, 0x0f,
+	0xaa, 0x5b, 0xb3, 0x6b, 0xb7, 0xec, 0x76, 0xbd, 0x7b, 0x1a, 0xec, 0x5e, 0x20, 0xe8, 0x6b, 0xf5,
+	0xb1, 0xb2, 0xfc, 0xb9, 0xb4, 0xa0, 0x61, 0x9d, 0x5b, 0x70, 0x8c, 0x92, 0x44, 0x60, 0x29, 0xa3,
+	0xf7, 0x31, 0x51, 0x98, 0x12, 0xa9, 0xdc, 0x52, 0xab, 0xdc, 0xae, 0xc1, 0x23, 0x23, 0xbc, 0xe4,
+	0x73, 0xa7, 0x03, 0x6a, 0x9c, 0x31, 0x1a, 0x69, 0xa8, 0xdc, 0x2a, 0xb7, 0xeb, 0xdd, 0xe6, 0xbf,
+	0x2d, 0x8c, 0x51, 0xb8, 0x9f, 0x61, 0xbd, 0xcc, 0x02, 0xc1, 0x09, 0x25, 0x6f, 0x33, 0x92, 0x10,
+	0xb5, 0x88, 0xb8, 0x60, 0x73, 0x92, 0x60, 0x21, 0xdd, 0x8a, 0x36, 0x5f, 0x15, 0xcd, 0xbd, 0x1c,
+	0xed, 0x1b, 0x12, 0x3a, 0xb4, 0x38, 0x92, 0xce, 0x33, 0x68, 0x08, 0x3c, 0xc2, 0x42, 0x60, 0x11,
+	0x49, 0x85, 0x94, 0x74, 0xf7, 0x74, 0xdc, 0x45, 0x31, 0x0e, 0x1a, 0x2a, 0xfb, 0xa0, 0xfc, 0xe1,
+	0x87, 0x62, 0x67, 0xf8, 0xb0, 0x5c, 0xfb, 0xf6, 0x6a, 0xed, 0xdb, 0xbf, 0x6b, 0xdf, 0xfe, 0xdc,
+	0xf8, 0xd6, 0x6a, 0xe3, 0x5b, 0xdf, 0x1b, 0xdf, 0x7a, 0xbd, 0x49, 0x89, 0x1a, 0xcf, 0x86, 0x41,
+	0xcc, 0x26, 0xe1, 0x80, 0x8c, 0xe2, 0x31, 0x22, 0xd3, 0x30, 0x2f, 0xe4, 0x43, 0x57, 0xa2, 0xfb,
+	0x18, 0x56, 0x75, 0x21, 0x77, 0x7f, 0x03, 0x00, 0x8b, 0xcc, 0x2c, 0xdf, 0x0f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,6 +153,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReferrerStats) > 0 {
+		for iNdEx := len(m.ReferrerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferrerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.LiquidityProviders) > 0 {
 		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -231,6 +254,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ReferrerStats) > 0 {
+		for _, e := range m.ReferrerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -402,6 +431,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferrerStats = append(m.ReferrerStats, ReferrerStats{})
+			if err := m.ReferrerStats[len(m.ReferrerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
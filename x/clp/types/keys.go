@@ -17,6 +17,9 @@ const (
 	// QuerierRoute to be used for querier msgs
 	QuerierRoute = ModuleName
 
+	// TreasuryName is the module account governance funds and directs to provide liquidity
+	TreasuryName = "clp_treasury"
+
 	NativeSymbol      = "rowan"
 	PoolThrehold      = "1000000000000000000"
 	PoolUnitsMinValue = "1000000000"
@@ -29,6 +32,7 @@ var (
 	PoolPrefix               = []byte{0x00} // key for storing Pools
 	LiquidityProviderPrefix  = []byte{0x01} // key for storing Liquidity Providers
 	WhiteListValidatorPrefix = []byte{0x02} // Key to store WhiteList , allowed to decommission pools
+	ReferrerStatsPrefix      = []byte{0x03} // key for storing the referral fees earned by referrers
 )
 
 // Generates a key for storing a specific pool
@@ -46,3 +50,10 @@ func GetLiquidityProviderKey(externalTicker string, lp string) []byte {
 	key := []byte(fmt.Sprintf("%s_%s", externalTicker, lp))
 	return append(LiquidityProviderPrefix, key...)
 }
+
+// Generate key to store the stats of a referrer
+// The key is the referrer address
+// Example : sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v and converted into bytes after adding a prefix
+func GetReferrerStatsKey(referrer string) []byte {
+	return append(ReferrerStatsPrefix, []byte(referrer)...)
+}
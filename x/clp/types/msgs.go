@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgAddLiquidity{}
 	_ sdk.Msg = &MsgSwap{}
 	_ sdk.Msg = &MsgDecommissionPool{}
+	_ sdk.Msg = &MsgUpdatePoolFeeTier{}
 )
 
 func NewMsgDecommissionPool(signer sdk.AccAddress, symbol string) MsgDecommissionPool {
@@ -54,6 +55,14 @@ func NewMsgSwap(signer sdk.AccAddress, sentAsset Asset, receivedAsset Asset, sen
 	return MsgSwap{Signer: signer.String(), SentAsset: &sentAsset, ReceivedAsset: &receivedAsset, SentAmount: sentAmount, MinReceivingAmount: minReceivingAmount}
 }
 
+// NewMsgSwapWithReferral returns a MsgSwap paying referralFee basis points of its output to the referrer
+func NewMsgSwapWithReferral(signer sdk.AccAddress, sentAsset Asset, receivedAsset Asset, sentAmount sdk.Uint, minReceivingAmount sdk.Uint, referrer sdk.AccAddress, referralFee uint64) MsgSwap {
+	msg := NewMsgSwap(signer, sentAsset, receivedAsset, sentAmount, minReceivingAmount)
+	msg.Referrer = referrer.String()
+	msg.ReferralFee = referralFee
+	return msg
+}
+
 func (m MsgSwap) Route() string {
 	return RouterKey
 }
@@ -79,6 +88,17 @@ func (m MsgSwap) ValidateBasic() error {
 	if m.SentAmount.IsZero() {
 		return sdkerrors.Wrap(ErrInValidAmount, m.SentAmount.String())
 	}
+	if (m.Referrer == "") != (m.ReferralFee == 0) {
+		return ErrInvalidReferral
+	}
+	if m.Referrer != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Referrer); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Referrer)
+		}
+		if m.ReferralFee > MaxWbasis {
+			return sdkerrors.Wrapf(ErrInvalidReferral, "referral fee must not exceed %d basis points", MaxWbasis)
+		}
+	}
 	return nil
 }
 
@@ -203,7 +223,7 @@ func (m MsgCreatePool) ValidateBasic() error {
 	if !(m.ExternalAssetAmount.GT(sdk.ZeroUint())) {
 		return sdkerrors.Wrap(ErrInValidAmount, m.NativeAssetAmount.String())
 	}
-	return nil
+	return ValidateFeeTier(m.FeeTier)
 }
 
 func (m MsgCreatePool) GetSignBytes() []byte {
@@ -217,3 +237,37 @@ func (m MsgCreatePool) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+func NewMsgUpdatePoolFeeTier(signer sdk.AccAddress, symbol string, feeTier uint64) MsgUpdatePoolFeeTier {
+	return MsgUpdatePoolFeeTier{Signer: signer.String(), Symbol: symbol, FeeTier: feeTier}
+}
+
+func (m MsgUpdatePoolFeeTier) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdatePoolFeeTier) Type() string {
+	return "update_pool_fee_tier"
+}
+
+func (m MsgUpdatePoolFeeTier) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if !VerifyRange(len(strings.TrimSpace(m.Symbol)), 0, MaxSymbolLength) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, m.Symbol)
+	}
+	return ValidateFeeTier(m.FeeTier)
+}
+
+func (m MsgUpdatePoolFeeTier) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdatePoolFeeTier) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -81,6 +81,15 @@ func TestNewMsgSwap(t *testing.T) {
 	tx = NewMsgSwap(signer, asset, GetSettlementAsset(), sdk.NewUint(0), sdk.NewUint(90))
 	err = tx.ValidateBasic()
 	assert.Error(t, err)
+	tx = NewMsgSwapWithReferral(signer, asset, GetSettlementAsset(), sdk.NewUint(100), sdk.NewUint(90), signer, 50)
+	assert.NoError(t, tx.ValidateBasic())
+	tx = NewMsgSwapWithReferral(signer, asset, GetSettlementAsset(), sdk.NewUint(100), sdk.NewUint(90), signer, 0)
+	assert.ErrorIs(t, tx.ValidateBasic(), ErrInvalidReferral)
+	tx = NewMsgSwapWithReferral(signer, asset, GetSettlementAsset(), sdk.NewUint(100), sdk.NewUint(90), signer, MaxWbasis+1)
+	assert.ErrorIs(t, tx.ValidateBasic(), ErrInvalidReferral)
+	tx = NewMsgSwap(signer, asset, GetSettlementAsset(), sdk.NewUint(100), sdk.NewUint(90))
+	tx.ReferralFee = 50
+	assert.ErrorIs(t, tx.ValidateBasic(), ErrInvalidReferral)
 }
 
 func TestNewMsgAddLiquidity(t *testing.T) {
@@ -11,11 +11,23 @@ import (
 const (
 	DefaultParamspace                    = ModuleName
 	DefaultMinCreatePoolThreshold uint64 = 100
+	// DefaultMaxReferralFee lets swaps pay up to 1% of their output to a referrer
+	DefaultMaxReferralFee uint64 = 100
 )
 
+// DefaultFeeTiers are the fee tiers, in basis points, allowed by default. The zero tier only charges the slip based
+// liquidity fee.
+var DefaultFeeTiers = []uint64{0, 5, 30, 100}
+
+// DefaultReferencePools are the USD stable coin pools the USD price of rowan is taken from by default
+var DefaultReferencePools = []string{"cusdc", "cusdt"}
+
 // Parameter store keys
 var (
 	KeyMinCreatePoolThreshold = []byte("MinCreatePoolThreshold")
+	KeyFeeTiers               = []byte("FeeTiers")
+	KeyMaxReferralFee         = []byte("MaxReferralFee")
+	KeyReferencePools         = []byte("ReferencePools")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -26,9 +38,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params object
-func NewParams(minThreshold uint64) Params {
+func NewParams(minThreshold uint64, feeTiers []uint64, maxReferralFee uint64, referencePools []string) Params {
 	return Params{
 		MinCreatePoolThreshold: minThreshold,
+		FeeTiers:               feeTiers,
+		MaxReferralFee:         maxReferralFee,
+		ReferencePools:         referencePools,
 	}
 }
 
@@ -36,16 +51,38 @@ func NewParams(minThreshold uint64) Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinCreatePoolThreshold, &p.MinCreatePoolThreshold, validateMinCreatePoolThreshold),
+		paramtypes.NewParamSetPair(KeyFeeTiers, &p.FeeTiers, validateFeeTiers),
+		paramtypes.NewParamSetPair(KeyMaxReferralFee, &p.MaxReferralFee, validateMaxReferralFee),
+		paramtypes.NewParamSetPair(KeyReferencePools, &p.ReferencePools, validateReferencePools),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(DefaultMinCreatePoolThreshold)
+	return NewParams(DefaultMinCreatePoolThreshold, DefaultFeeTiers, DefaultMaxReferralFee, DefaultReferencePools)
 }
 
 func (p Params) Validate() error {
-	return validateMinCreatePoolThreshold(p.MinCreatePoolThreshold)
+	if err := validateMinCreatePoolThreshold(p.MinCreatePoolThreshold); err != nil {
+		return err
+	}
+	if err := validateFeeTiers(p.FeeTiers); err != nil {
+		return err
+	}
+	if err := validateMaxReferralFee(p.MaxReferralFee); err != nil {
+		return err
+	}
+	return validateReferencePools(p.ReferencePools)
+}
+
+// IsFeeTierAllowed returns true if pools can use the fee tier
+func (p Params) IsFeeTierAllowed(feeTier uint64) bool {
+	for _, tier := range p.FeeTiers {
+		if tier == feeTier {
+			return true
+		}
+	}
+	return false
 }
 
 func validateMinCreatePoolThreshold(i interface{}) error {
@@ -59,8 +96,59 @@ func validateMinCreatePoolThreshold(i interface{}) error {
 	return nil
 }
 
+func validateFeeTiers(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return fmt.Errorf("at least one fee tier must be allowed")
+	}
+	seen := make(map[uint64]bool, len(v))
+	for _, tier := range v {
+		if err := ValidateFeeTier(tier); err != nil {
+			return err
+		}
+		if seen[tier] {
+			return fmt.Errorf("duplicate fee tier: %d", tier)
+		}
+		seen[tier] = true
+	}
+	return nil
+}
+
+func validateMaxReferralFee(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxWbasis {
+		return fmt.Errorf("max referral fee must not exceed %d basis points: %d", MaxWbasis, v)
+	}
+	return nil
+}
+
 func (p Params) Equal(p2 Params) bool {
 	bz1 := ModuleCdc.MustMarshalLengthPrefixed(&p)
 	bz2 := ModuleCdc.MustMarshalLengthPrefixed(&p2)
 	return bytes.Equal(bz1, bz2)
 }
+
+func validateReferencePools(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, symbol := range v {
+		asset := NewAsset(symbol)
+		if !asset.Validate() || asset.Equals(GetSettlementAsset()) {
+			return fmt.Errorf("invalid reference pool: %s", symbol)
+		}
+		if seen[symbol] {
+			return fmt.Errorf("duplicate reference pool: %s", symbol)
+		}
+		seen[symbol] = true
+	}
+	return nil
+}
@@ -26,6 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params - used for initializing default parameter for clp at genesis
 type Params struct {
 	MinCreatePoolThreshold uint64 `protobuf:"varint,1,opt,name=min_create_pool_threshold,json=minCreatePoolThreshold,proto3" json:"min_create_pool_threshold,omitempty"`
+	// fee_tiers are the swap fees, in basis points, pools can be created with.
+	FeeTiers []uint64 `protobuf:"varint,2,rep,packed,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
+	// max_referral_fee caps the referral fee, in basis points of the swap
+	// output, a swap can pay to its referrer.
+	MaxReferralFee uint64 `protobuf:"varint,3,opt,name=max_referral_fee,json=maxReferralFee,proto3" json:"max_referral_fee,omitempty"`
+	// reference_pools are the symbols of the USD stable coin pools the USD
+	// price of rowan is taken from.
+	ReferencePools []string `protobuf:"bytes,4,rep,name=reference_pools,json=referencePools,proto3" json:"reference_pools,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,6 +76,27 @@ func (m *Params) GetMinCreatePoolThreshold() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeTiers() []uint64 {
+	if m != nil {
+		return m.FeeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetMaxReferralFee() uint64 {
+	if m != nil {
+		return m.MaxReferralFee
+	}
+	return 0
+}
+
+func (m *Params) GetReferencePools() []string {
+	if m != nil {
+		return m.ReferencePools
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sifnode.clp.v1.Params")
 }
@@ -75,20 +104,25 @@ func init() {
 func init() { proto.RegisterFile("sifnode/clp/v1/params.proto", fileDescriptor_61de66e331088d04) }
 
 var fileDescriptor_61de66e331088d04 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0xce, 0x4c, 0xcb,
-	0xcb, 0x4f, 0x49, 0xd5, 0x4f, 0xce, 0x29, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x4a, 0xea, 0x25, 0xe7, 0x14, 0xe8,
-	0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xa5, 0xf4, 0x41, 0x2c, 0x88, 0x2a, 0x25,
-	0x67, 0x2e, 0xb6, 0x00, 0xb0, 0x2e, 0x21, 0x4b, 0x2e, 0xc9, 0xdc, 0xcc, 0xbc, 0xf8, 0xe4, 0xa2,
-	0xd4, 0xc4, 0x92, 0xd4, 0xf8, 0x82, 0xfc, 0xfc, 0x9c, 0xf8, 0x92, 0x8c, 0xa2, 0xd4, 0xe2, 0x8c,
-	0xfc, 0x9c, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0xb1, 0xdc, 0xcc, 0x3c, 0x67, 0xb0,
-	0x7c, 0x40, 0x7e, 0x7e, 0x4e, 0x08, 0x4c, 0xd6, 0xc9, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0xd4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x83, 0x33, 0xd3, 0x92, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x61, 0xae, 0xae, 0x00, 0xbb, 0xbb, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x1c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x27,
-	0x7c, 0xa5, 0x53, 0xd3, 0x00, 0x00, 0x00,
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0x3f, 0x4f, 0x02, 0x31,
+	0x18, 0xc6, 0xa9, 0x10, 0x22, 0x1d, 0xd0, 0x5c, 0x8c, 0x39, 0x25, 0x69, 0x88, 0x0b, 0x37, 0x5d,
+	0x43, 0x9c, 0x1c, 0xd5, 0xc4, 0x99, 0x9c, 0x4c, 0x2e, 0x4d, 0x29, 0x6f, 0xb9, 0x26, 0xed, 0xb5,
+	0x69, 0x2b, 0xc1, 0x6f, 0xe1, 0x77, 0xf1, 0x4b, 0x38, 0x32, 0x3a, 0x1a, 0xf8, 0x22, 0xe6, 0x0a,
+	0xb8, 0xb5, 0xcf, 0xef, 0x79, 0xff, 0x3d, 0x78, 0x14, 0x94, 0x6c, 0xec, 0x12, 0xa8, 0xd0, 0x8e,
+	0xae, 0xa7, 0xd4, 0x71, 0xcf, 0x4d, 0x28, 0x9d, 0xb7, 0xd1, 0x66, 0xc3, 0x23, 0x2c, 0x85, 0x76,
+	0xe5, 0x7a, 0x7a, 0x7b, 0xb5, 0xb2, 0x2b, 0x9b, 0x10, 0x6d, 0x5f, 0x07, 0xd7, 0xdd, 0x17, 0xc2,
+	0xfd, 0x59, 0x2a, 0xcb, 0x1e, 0xf0, 0x8d, 0x51, 0x0d, 0x13, 0x1e, 0x78, 0x04, 0xe6, 0xac, 0xd5,
+	0x2c, 0xd6, 0x1e, 0x42, 0x6d, 0xf5, 0x32, 0x47, 0x63, 0x54, 0xf4, 0xaa, 0x6b, 0xa3, 0x9a, 0xe7,
+	0xc4, 0x67, 0xd6, 0xea, 0xf9, 0x89, 0x66, 0x23, 0x3c, 0x90, 0x00, 0x2c, 0x2a, 0xf0, 0x21, 0x3f,
+	0x1b, 0x77, 0x8b, 0x5e, 0x75, 0x2e, 0x01, 0xe6, 0xed, 0x3f, 0x2b, 0xf0, 0xa5, 0xe1, 0x1b, 0xe6,
+	0x41, 0x82, 0xf7, 0x5c, 0x33, 0x09, 0x90, 0x77, 0x53, 0xbb, 0xa1, 0xe1, 0x9b, 0xea, 0x28, 0xbf,
+	0x00, 0x64, 0x13, 0x7c, 0x91, 0x5c, 0xd0, 0x88, 0xc3, 0x02, 0x21, 0xef, 0x8d, 0xbb, 0xc5, 0xa0,
+	0x1a, 0xfe, 0xcb, 0xed, 0xdc, 0xf0, 0xf4, 0xf8, 0xbd, 0x23, 0x68, 0xbb, 0x23, 0xe8, 0x77, 0x47,
+	0xd0, 0xe7, 0x9e, 0x74, 0xb6, 0x7b, 0xd2, 0xf9, 0xd9, 0x93, 0xce, 0xdb, 0x64, 0xa5, 0x62, 0xfd,
+	0xbe, 0x28, 0x85, 0x35, 0xf4, 0x55, 0x49, 0x51, 0x73, 0xd5, 0xd0, 0x53, 0x4c, 0x9b, 0x14, 0x54,
+	0xfc, 0x70, 0x10, 0x16, 0xfd, 0x74, 0xff, 0xfd, 0xdf, 0x00, 0x23, 0x1b, 0x1f, 0x27, 0x44, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +145,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReferencePools) > 0 {
+		for iNdEx := len(m.ReferencePools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReferencePools[iNdEx])
+			copy(dAtA[i:], m.ReferencePools[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReferencePools[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxReferralFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReferralFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeeTiers) > 0 {
+		dAtA2 := make([]byte, len(m.FeeTiers)*10)
+		var j1 int
+		for _, num := range m.FeeTiers {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MinCreatePoolThreshold != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinCreatePoolThreshold))
 		i--
@@ -139,6 +205,22 @@ func (m *Params) Size() (n int) {
 	if m.MinCreatePoolThreshold != 0 {
 		n += 1 + sovParams(uint64(m.MinCreatePoolThreshold))
 	}
+	if len(m.FeeTiers) > 0 {
+		l = 0
+		for _, e := range m.FeeTiers {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.MaxReferralFee != 0 {
+		n += 1 + sovParams(uint64(m.MaxReferralFee))
+	}
+	if len(m.ReferencePools) > 0 {
+		for _, s := range m.ReferencePools {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -196,6 +278,133 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FeeTiers = append(m.FeeTiers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FeeTiers) == 0 {
+					m.FeeTiers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FeeTiers = append(m.FeeTiers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReferralFee", wireType)
+			}
+			m.MaxReferralFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReferralFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferencePools = append(m.ReferencePools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
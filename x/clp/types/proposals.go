@@ -0,0 +1,205 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdatePoolFeeTier defines the type for a UpdatePoolFeeTierProposal
+	ProposalTypeUpdatePoolFeeTier = "UpdatePoolFeeTier"
+	// ProposalTypeTreasuryAddLiquidity defines the type for a TreasuryAddLiquidityProposal
+	ProposalTypeTreasuryAddLiquidity = "TreasuryAddLiquidity"
+	// ProposalTypeTreasuryRemoveLiquidity defines the type for a TreasuryRemoveLiquidityProposal
+	ProposalTypeTreasuryRemoveLiquidity = "TreasuryRemoveLiquidity"
+	// ProposalTypeTreasuryRebalance defines the type for a TreasuryRebalanceProposal
+	ProposalTypeTreasuryRebalance = "TreasuryRebalance"
+)
+
+var (
+	_ govtypes.Content = &UpdatePoolFeeTierProposal{}
+	_ govtypes.Content = &TreasuryAddLiquidityProposal{}
+	_ govtypes.Content = &TreasuryRemoveLiquidityProposal{}
+	_ govtypes.Content = &TreasuryRebalanceProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdatePoolFeeTier)
+	govtypes.RegisterProposalTypeCodec(&UpdatePoolFeeTierProposal{}, "clp/UpdatePoolFeeTierProposal")
+	govtypes.RegisterProposalType(ProposalTypeTreasuryAddLiquidity)
+	govtypes.RegisterProposalTypeCodec(&TreasuryAddLiquidityProposal{}, "clp/TreasuryAddLiquidityProposal")
+	govtypes.RegisterProposalType(ProposalTypeTreasuryRemoveLiquidity)
+	govtypes.RegisterProposalTypeCodec(&TreasuryRemoveLiquidityProposal{}, "clp/TreasuryRemoveLiquidityProposal")
+	govtypes.RegisterProposalType(ProposalTypeTreasuryRebalance)
+	govtypes.RegisterProposalTypeCodec(&TreasuryRebalanceProposal{}, "clp/TreasuryRebalanceProposal")
+}
+
+// NewUpdatePoolFeeTierProposal creates a new pool fee tier change proposal
+func NewUpdatePoolFeeTierProposal(title, description, symbol string, feeTier uint64) *UpdatePoolFeeTierProposal {
+	return &UpdatePoolFeeTierProposal{Title: title, Description: description, Symbol: symbol, FeeTier: feeTier}
+}
+
+func (p *UpdatePoolFeeTierProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePoolFeeTierProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePoolFeeTierProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePoolFeeTierProposal) ProposalType() string { return ProposalTypeUpdatePoolFeeTier }
+
+func (p *UpdatePoolFeeTierProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if !VerifyRange(len(strings.TrimSpace(p.Symbol)), 0, MaxSymbolLength) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, p.Symbol)
+	}
+	return ValidateFeeTier(p.FeeTier)
+}
+
+func (p UpdatePoolFeeTierProposal) String() string {
+	return fmt.Sprintf(`Update Pool Fee Tier Proposal:
+  Title:       %s
+  Description: %s
+  Symbol:      %s
+  Fee Tier:    %d
+`, p.Title, p.Description, p.Symbol, p.FeeTier)
+}
+
+// NewTreasuryAddLiquidityProposal creates a new proposal adding liquidity to a pool from the treasury
+func NewTreasuryAddLiquidityProposal(title, description, symbol string, nativeAssetAmount, externalAssetAmount sdk.Uint) *TreasuryAddLiquidityProposal {
+	return &TreasuryAddLiquidityProposal{Title: title, Description: description, Symbol: symbol,
+		NativeAssetAmount: nativeAssetAmount, ExternalAssetAmount: externalAssetAmount}
+}
+
+func (p *TreasuryAddLiquidityProposal) GetTitle() string { return p.Title }
+
+func (p *TreasuryAddLiquidityProposal) GetDescription() string { return p.Description }
+
+func (p *TreasuryAddLiquidityProposal) ProposalRoute() string { return RouterKey }
+
+func (p *TreasuryAddLiquidityProposal) ProposalType() string { return ProposalTypeTreasuryAddLiquidity }
+
+// Msg returns the MsgAddLiquidity the treasury signs when the proposal passes
+func (p *TreasuryAddLiquidityProposal) Msg() MsgAddLiquidity {
+	return NewMsgAddLiquidity(GetTreasuryAddress(), NewAsset(p.Symbol), p.NativeAssetAmount, p.ExternalAssetAmount)
+}
+
+func (p *TreasuryAddLiquidityProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.NativeAssetAmount.IsZero() && p.ExternalAssetAmount.IsZero() {
+		return sdkerrors.Wrap(ErrInValidAmount, "both asset amounts cannot be 0")
+	}
+	msg := p.Msg()
+	return msg.ValidateBasic()
+}
+
+func (p TreasuryAddLiquidityProposal) String() string {
+	return fmt.Sprintf(`Treasury Add Liquidity Proposal:
+  Title:                 %s
+  Description:           %s
+  Symbol:                %s
+  Native Asset Amount:   %s
+  External Asset Amount: %s
+`, p.Title, p.Description, p.Symbol, p.NativeAssetAmount, p.ExternalAssetAmount)
+}
+
+// NewTreasuryRemoveLiquidityProposal creates a new proposal removing treasury liquidity from a pool
+func NewTreasuryRemoveLiquidityProposal(title, description, symbol string, wBasisPoints, asymmetry sdk.Int) *TreasuryRemoveLiquidityProposal {
+	return &TreasuryRemoveLiquidityProposal{Title: title, Description: description, Symbol: symbol,
+		WBasisPoints: wBasisPoints, Asymmetry: asymmetry}
+}
+
+func (p *TreasuryRemoveLiquidityProposal) GetTitle() string { return p.Title }
+
+func (p *TreasuryRemoveLiquidityProposal) GetDescription() string { return p.Description }
+
+func (p *TreasuryRemoveLiquidityProposal) ProposalRoute() string { return RouterKey }
+
+func (p *TreasuryRemoveLiquidityProposal) ProposalType() string {
+	return ProposalTypeTreasuryRemoveLiquidity
+}
+
+// Msg returns the MsgRemoveLiquidity the treasury signs when the proposal passes
+func (p *TreasuryRemoveLiquidityProposal) Msg() MsgRemoveLiquidity {
+	return NewMsgRemoveLiquidity(GetTreasuryAddress(), NewAsset(p.Symbol), p.WBasisPoints, p.Asymmetry)
+}
+
+func (p *TreasuryRemoveLiquidityProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	msg := p.Msg()
+	return msg.ValidateBasic()
+}
+
+func (p TreasuryRemoveLiquidityProposal) String() string {
+	return fmt.Sprintf(`Treasury Remove Liquidity Proposal:
+  Title:          %s
+  Description:    %s
+  Symbol:         %s
+  W Basis Points: %s
+  Asymmetry:      %s
+`, p.Title, p.Description, p.Symbol, p.WBasisPoints, p.Asymmetry)
+}
+
+// NewTreasuryRebalanceProposal creates a new proposal moving treasury liquidity between pools
+func NewTreasuryRebalanceProposal(title, description, fromSymbol, toSymbol string, wBasisPoints sdk.Int) *TreasuryRebalanceProposal {
+	return &TreasuryRebalanceProposal{Title: title, Description: description, FromSymbol: fromSymbol,
+		ToSymbol: toSymbol, WBasisPoints: wBasisPoints}
+}
+
+func (p *TreasuryRebalanceProposal) GetTitle() string { return p.Title }
+
+func (p *TreasuryRebalanceProposal) GetDescription() string { return p.Description }
+
+func (p *TreasuryRebalanceProposal) ProposalRoute() string { return RouterKey }
+
+func (p *TreasuryRebalanceProposal) ProposalType() string { return ProposalTypeTreasuryRebalance }
+
+// RemoveMsg returns the MsgRemoveLiquidity withdrawing the moved liquidity in rowan
+func (p *TreasuryRebalanceProposal) RemoveMsg() MsgRemoveLiquidity {
+	return NewMsgRemoveLiquidity(GetTreasuryAddress(), NewAsset(p.FromSymbol), p.WBasisPoints, sdk.NewInt(-MaxWbasis))
+}
+
+func (p *TreasuryRebalanceProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(p.FromSymbol, p.ToSymbol) {
+		return sdkerrors.Wrap(ErrInValidAsset, "cannot rebalance a pool into itself")
+	}
+	if !NewAsset(p.ToSymbol).Validate() || NewAsset(p.ToSymbol).Equals(GetSettlementAsset()) {
+		return sdkerrors.Wrap(ErrInValidAsset, p.ToSymbol)
+	}
+	msg := p.RemoveMsg()
+	return msg.ValidateBasic()
+}
+
+func (p TreasuryRebalanceProposal) String() string {
+	return fmt.Sprintf(`Treasury Rebalance Proposal:
+  Title:          %s
+  Description:    %s
+  From Symbol:    %s
+  To Symbol:      %s
+  W Basis Points: %s
+`, p.Title, p.Description, p.FromSymbol, p.ToSymbol, p.WBasisPoints)
+}
+
+// ValidateFeeTier checks the fee tier, in basis points, is below 100%
+func ValidateFeeTier(feeTier uint64) error {
+	if feeTier >= uint64(MaxWbasis) {
+		return sdkerrors.Wrap(ErrInvalidFeeTier, fmt.Sprintf("fee tier must be below %d basis points: %d", MaxWbasis, feeTier))
+	}
+	return nil
+}
@@ -0,0 +1,1489 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/clp/v1/proposals.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdatePoolFeeTierProposal changes the fee tier of a pool through
+// governance.
+type UpdatePoolFeeTierProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Symbol      string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	FeeTier     uint64 `protobuf:"varint,4,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier,omitempty" yaml:"fee_tier"`
+}
+
+func (m *UpdatePoolFeeTierProposal) Reset()      { *m = UpdatePoolFeeTierProposal{} }
+func (*UpdatePoolFeeTierProposal) ProtoMessage() {}
+func (*UpdatePoolFeeTierProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{0}
+}
+func (m *UpdatePoolFeeTierProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePoolFeeTierProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePoolFeeTierProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePoolFeeTierProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePoolFeeTierProposal.Merge(m, src)
+}
+func (m *UpdatePoolFeeTierProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePoolFeeTierProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePoolFeeTierProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePoolFeeTierProposal proto.InternalMessageInfo
+
+// TreasuryAddLiquidityProposal adds liquidity to a pool from the clp treasury
+// module account.
+type TreasuryAddLiquidityProposal struct {
+	Title               string                                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description         string                                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Symbol              string                                  `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
+	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
+}
+
+func (m *TreasuryAddLiquidityProposal) Reset()      { *m = TreasuryAddLiquidityProposal{} }
+func (*TreasuryAddLiquidityProposal) ProtoMessage() {}
+func (*TreasuryAddLiquidityProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{1}
+}
+func (m *TreasuryAddLiquidityProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryAddLiquidityProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryAddLiquidityProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryAddLiquidityProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryAddLiquidityProposal.Merge(m, src)
+}
+func (m *TreasuryAddLiquidityProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryAddLiquidityProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryAddLiquidityProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryAddLiquidityProposal proto.InternalMessageInfo
+
+// TreasuryRemoveLiquidityProposal removes liquidity of the clp treasury from a
+// pool, back to the treasury module account.
+type TreasuryRemoveLiquidityProposal struct {
+	Title        string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description  string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Symbol       string                                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	WBasisPoints github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=w_basis_points,json=wBasisPoints,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"w_basis_points" yaml:"w_basis_points"`
+	Asymmetry    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=asymmetry,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"asymmetry" yaml:"asymmetry"`
+}
+
+func (m *TreasuryRemoveLiquidityProposal) Reset()      { *m = TreasuryRemoveLiquidityProposal{} }
+func (*TreasuryRemoveLiquidityProposal) ProtoMessage() {}
+func (*TreasuryRemoveLiquidityProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{2}
+}
+func (m *TreasuryRemoveLiquidityProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryRemoveLiquidityProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryRemoveLiquidityProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryRemoveLiquidityProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryRemoveLiquidityProposal.Merge(m, src)
+}
+func (m *TreasuryRemoveLiquidityProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryRemoveLiquidityProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryRemoveLiquidityProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryRemoveLiquidityProposal proto.InternalMessageInfo
+
+// TreasuryRebalanceProposal moves w_basis_points of the clp treasury position
+// in one pool to another. The liquidity is withdrawn in rowan and added to the
+// other pool on the rowan side.
+type TreasuryRebalanceProposal struct {
+	Title        string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description  string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	FromSymbol   string                                 `protobuf:"bytes,3,opt,name=from_symbol,json=fromSymbol,proto3" json:"from_symbol,omitempty" yaml:"from_symbol"`
+	ToSymbol     string                                 `protobuf:"bytes,4,opt,name=to_symbol,json=toSymbol,proto3" json:"to_symbol,omitempty" yaml:"to_symbol"`
+	WBasisPoints github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=w_basis_points,json=wBasisPoints,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"w_basis_points" yaml:"w_basis_points"`
+}
+
+func (m *TreasuryRebalanceProposal) Reset()      { *m = TreasuryRebalanceProposal{} }
+func (*TreasuryRebalanceProposal) ProtoMessage() {}
+func (*TreasuryRebalanceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{3}
+}
+func (m *TreasuryRebalanceProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryRebalanceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryRebalanceProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryRebalanceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryRebalanceProposal.Merge(m, src)
+}
+func (m *TreasuryRebalanceProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryRebalanceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryRebalanceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryRebalanceProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdatePoolFeeTierProposal)(nil), "sifnode.clp.v1.UpdatePoolFeeTierProposal")
+	proto.RegisterType((*TreasuryAddLiquidityProposal)(nil), "sifnode.clp.v1.TreasuryAddLiquidityProposal")
+	proto.RegisterType((*TreasuryRemoveLiquidityProposal)(nil), "sifnode.clp.v1.TreasuryRemoveLiquidityProposal")
+	proto.RegisterType((*TreasuryRebalanceProposal)(nil), "sifnode.clp.v1.TreasuryRebalanceProposal")
+}
+
+func init() { proto.RegisterFile("sifnode/clp/v1/proposals.proto", fileDescriptor_91ec28629c263e02) }
+
+var fileDescriptor_91ec28629c263e02 = []byte{
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xed, 0xbe, 0xd1, 0x5c, 0x43, 0x69, 0x9d, 0x16, 0xa5, 0x55, 0x65, 0x57, 0x37, 0x94,
+	0x32, 0x60, 0x2b, 0x62, 0x00, 0x75, 0x8b, 0x07, 0x10, 0x12, 0x88, 0xc8, 0x69, 0x17, 0x16, 0x73,
+	0xb1, 0x2f, 0xe9, 0x09, 0xdb, 0x67, 0x7c, 0x97, 0xb4, 0x1e, 0x98, 0x58, 0x58, 0x90, 0x18, 0x19,
+	0xf3, 0x49, 0x98, 0x3b, 0x76, 0x44, 0x0c, 0x16, 0x4a, 0xa4, 0x8a, 0x39, 0x9f, 0x00, 0xe5, 0xce,
+	0x69, 0x9c, 0xaa, 0x48, 0x74, 0x00, 0x75, 0xb2, 0xef, 0xf9, 0x3f, 0xef, 0x3f, 0xd9, 0x07, 0x74,
+	0x46, 0xda, 0x11, 0xf5, 0xb1, 0xe5, 0x05, 0xb1, 0xd5, 0xab, 0x59, 0x71, 0x42, 0x63, 0xca, 0x50,
+	0xc0, 0xcc, 0x38, 0xa1, 0x9c, 0x6a, 0xab, 0xb9, 0x6e, 0x7a, 0x41, 0x6c, 0xf6, 0x6a, 0xdb, 0x1b,
+	0x1d, 0xda, 0xa1, 0x42, 0xb2, 0xc6, 0x6f, 0xd2, 0x0b, 0x5e, 0xa8, 0x60, 0xeb, 0x28, 0xf6, 0x11,
+	0xc7, 0x0d, 0x4a, 0x83, 0x67, 0x18, 0x1f, 0x12, 0x9c, 0x34, 0xf2, 0x54, 0xda, 0x1e, 0x58, 0xe4,
+	0x84, 0x07, 0xb8, 0xaa, 0xee, 0xaa, 0xfb, 0x25, 0x7b, 0x6d, 0x94, 0x19, 0xe5, 0x14, 0x85, 0xc1,
+	0x01, 0x14, 0x66, 0xe8, 0x48, 0x59, 0x7b, 0x0a, 0x56, 0x7c, 0xcc, 0xbc, 0x84, 0xc4, 0x9c, 0xd0,
+	0xa8, 0x3a, 0x27, 0xbc, 0xef, 0x8f, 0x32, 0x43, 0x93, 0xde, 0x05, 0x11, 0x3a, 0x45, 0x57, 0xed,
+	0x21, 0x58, 0x62, 0x69, 0xd8, 0xa2, 0x41, 0x75, 0x5e, 0x04, 0xad, 0x8f, 0x32, 0xe3, 0xae, 0x0c,
+	0x92, 0x76, 0xe8, 0xe4, 0x0e, 0x9a, 0x09, 0x96, 0xdb, 0x18, 0xbb, 0x9c, 0xe0, 0xa4, 0xba, 0xb0,
+	0xab, 0xee, 0x2f, 0xd8, 0x95, 0x51, 0x66, 0xdc, 0x93, 0xce, 0x13, 0x05, 0x3a, 0x77, 0xda, 0x72,
+	0x88, 0x83, 0xf2, 0xa7, 0xbe, 0xa1, 0x7c, 0xed, 0x1b, 0xca, 0xaf, 0xbe, 0xa1, 0xc0, 0x6f, 0xf3,
+	0x60, 0xe7, 0x30, 0xc1, 0x88, 0x75, 0x93, 0xb4, 0xee, 0xfb, 0x2f, 0xc9, 0xfb, 0x2e, 0xf1, 0x09,
+	0x4f, 0x6f, 0xe7, 0xac, 0x1f, 0x40, 0x25, 0x42, 0x9c, 0xf4, 0xb0, 0x8b, 0x18, 0xc3, 0xdc, 0x45,
+	0x21, 0xed, 0x46, 0x5c, 0x8c, 0x5d, 0xb2, 0x5f, 0x9d, 0x65, 0x86, 0xf2, 0x23, 0x33, 0x1e, 0x74,
+	0x08, 0x3f, 0xee, 0xb6, 0x4c, 0x8f, 0x86, 0x96, 0x47, 0x59, 0x48, 0x59, 0xfe, 0x78, 0xc4, 0xfc,
+	0x77, 0x16, 0x4f, 0x63, 0xcc, 0xcc, 0x23, 0x12, 0xf1, 0x51, 0x66, 0x6c, 0xcb, 0x32, 0xd7, 0xe4,
+	0x84, 0xce, 0xba, 0xb4, 0xd6, 0xc7, 0xc6, 0xba, 0xb0, 0x69, 0x1f, 0x55, 0xb0, 0x89, 0x4f, 0x39,
+	0x4e, 0x22, 0x14, 0xcc, 0x76, 0xb0, 0x28, 0x3a, 0x78, 0x7d, 0xf3, 0x0e, 0x76, 0x64, 0x07, 0xd7,
+	0x66, 0x85, 0x4e, 0x65, 0x62, 0x2f, 0x74, 0x71, 0x05, 0xe0, 0xe7, 0x79, 0x60, 0x4c, 0x00, 0x3a,
+	0x38, 0xa4, 0x3d, 0x7c, 0xcb, 0x19, 0x86, 0x60, 0xf5, 0xc4, 0x6d, 0x21, 0x46, 0x98, 0x1b, 0x53,
+	0x12, 0x71, 0x96, 0xe3, 0x7b, 0x9e, 0x2f, 0x6f, 0xef, 0x2f, 0x96, 0xf7, 0x42, 0xec, 0x6e, 0x53,
+	0x16, 0x98, 0xcd, 0x06, 0x9d, 0xf2, 0x89, 0x3d, 0x3e, 0x37, 0xc4, 0x51, 0x7b, 0x0b, 0x4a, 0x88,
+	0xa5, 0x61, 0x88, 0x79, 0x92, 0xe6, 0x98, 0xec, 0x1b, 0x57, 0x5a, 0x93, 0x95, 0x2e, 0x13, 0x41,
+	0x67, 0x9a, 0xf4, 0x0a, 0x8f, 0x8b, 0x39, 0xb0, 0x35, 0xe5, 0xd1, 0x42, 0x01, 0x8a, 0x3c, 0xfc,
+	0x1f, 0x49, 0x3c, 0x01, 0x2b, 0xed, 0x84, 0x86, 0xee, 0x0c, 0x8e, 0x42, 0x64, 0x41, 0x84, 0x0e,
+	0x18, 0x9f, 0x9a, 0x92, 0x4b, 0x0d, 0x94, 0x38, 0x9d, 0x84, 0x49, 0x24, 0x1b, 0xd3, 0xd1, 0x2f,
+	0x25, 0xe8, 0x2c, 0x73, 0xda, 0xfc, 0x13, 0xca, 0xc5, 0x7f, 0x88, 0x72, 0x76, 0xd1, 0x76, 0xfd,
+	0x6c, 0xa0, 0xab, 0xe7, 0x03, 0x5d, 0xfd, 0x39, 0xd0, 0xd5, 0x2f, 0x43, 0x5d, 0x39, 0x1f, 0xea,
+	0xca, 0xf7, 0xa1, 0xae, 0xbc, 0x29, 0x7e, 0x7e, 0x4d, 0xd2, 0xf6, 0x8e, 0x11, 0x89, 0xac, 0xc9,
+	0xb5, 0x70, 0x2a, 0x2e, 0x06, 0x51, 0xbb, 0xb5, 0x24, 0x7e, 0xf6, 0x8f, 0x7f, 0x0f, 0x00, 0x8d,
+	0x95, 0xa1, 0xfe, 0x34, 0x06, 0x00, 0x00,
+}
+
+func (m *UpdatePoolFeeTierProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePoolFeeTierProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePoolFeeTierProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeTier != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.FeeTier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryAddLiquidityProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryAddLiquidityProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryAddLiquidityProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryRemoveLiquidityProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryRemoveLiquidityProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryRemoveLiquidityProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Asymmetry.Size()
+		i -= size
+		if _, err := m.Asymmetry.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.WBasisPoints.Size()
+		i -= size
+		if _, err := m.WBasisPoints.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryRebalanceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryRebalanceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryRebalanceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WBasisPoints.Size()
+		i -= size
+		if _, err := m.WBasisPoints.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ToSymbol) > 0 {
+		i -= len(m.ToSymbol)
+		copy(dAtA[i:], m.ToSymbol)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ToSymbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromSymbol) > 0 {
+		i -= len(m.FromSymbol)
+		copy(dAtA[i:], m.FromSymbol)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.FromSymbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdatePoolFeeTierProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.FeeTier != 0 {
+		n += 1 + sovProposals(uint64(m.FeeTier))
+	}
+	return n
+}
+
+func (m *TreasuryAddLiquidityProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *TreasuryRemoveLiquidityProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.WBasisPoints.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = m.Asymmetry.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *TreasuryRebalanceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.FromSymbol)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ToSymbol)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.WBasisPoints.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func sovProposals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposals(x uint64) (n int) {
+	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdatePoolFeeTierProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePoolFeeTierProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePoolFeeTierProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
+			}
+			m.FeeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryAddLiquidityProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryAddLiquidityProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryAddLiquidityProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryRemoveLiquidityProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryRemoveLiquidityProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryRemoveLiquidityProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WBasisPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asymmetry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asymmetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryRebalanceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryRebalanceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryRebalanceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WBasisPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposals(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposals
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposals
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposals
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposals        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposals          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposals = fmt.Errorf("proto: unexpected end of group")
+)
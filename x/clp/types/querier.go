@@ -10,6 +10,12 @@ const (
 	QueryAssetList             = "assetList"
 	QueryLiquidityProvider     = "liquidityProvider"
 	QueryLiquidityProviderData = "liquidityProviderData"
+	QueryLiquidityProviderPnL  = "liquidityProviderPnL"
+	QueryReferrerStats         = "referrerStats"
+	QueryTreasury              = "treasury"
+	QueryPoolUSDValue          = "poolUSDValue"
+	QueryLPUSDValue            = "lpUSDValue"
+	QueryTVL                   = "tvl"
 	QueryLPList                = "lpList"
 	QueryAllLP                 = "allLp"
 )
@@ -22,6 +28,22 @@ func NewQueryReqLiquidityProvider(symbol string, lpAddress sdk.AccAddress) Liqui
 	return LiquidityProviderReq{Symbol: symbol, LpAddress: lpAddress.String()}
 }
 
+func NewQueryReqLiquidityProviderPnL(symbol string, lpAddress sdk.AccAddress) LiquidityProviderPnLReq {
+	return LiquidityProviderPnLReq{Symbol: symbol, LpAddress: lpAddress.String()}
+}
+
+func NewQueryReqReferrerStats(referrer sdk.AccAddress) ReferrerStatsReq {
+	return ReferrerStatsReq{Referrer: referrer.String()}
+}
+
+func NewQueryReqPoolUSDValue(symbol string) PoolUSDValueReq {
+	return PoolUSDValueReq{Symbol: symbol}
+}
+
+func NewQueryReqLiquidityProviderUSDValue(symbol string, lpAddress sdk.AccAddress) LiquidityProviderUSDValueReq {
+	return LiquidityProviderUSDValueReq{Symbol: symbol, LpAddress: lpAddress.String()}
+}
+
 func NewQueryReqGetAssetList(lpAddress sdk.AccAddress) AssetListReq {
 	return AssetListReq{LpAddress: lpAddress.String()}
 }
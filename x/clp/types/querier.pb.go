@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -345,6 +347,309 @@ func (m *LiquidityProviderRes) GetHeight() int64 {
 	return 0
 }
 
+type LiquidityProviderPnLReq struct {
+	Symbol    string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	LpAddress string `protobuf:"bytes,2,opt,name=lp_address,json=lpAddress,proto3" json:"lp_address,omitempty"`
+}
+
+func (m *LiquidityProviderPnLReq) Reset()         { *m = LiquidityProviderPnLReq{} }
+func (m *LiquidityProviderPnLReq) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderPnLReq) ProtoMessage()    {}
+func (*LiquidityProviderPnLReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{6}
+}
+func (m *LiquidityProviderPnLReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderPnLReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderPnLReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityProviderPnLReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderPnLReq.Merge(m, src)
+}
+func (m *LiquidityProviderPnLReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderPnLReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderPnLReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityProviderPnLReq proto.InternalMessageInfo
+
+type TreasuryReq struct {
+}
+
+func (m *TreasuryReq) Reset()         { *m = TreasuryReq{} }
+func (m *TreasuryReq) String() string { return proto.CompactTextString(m) }
+func (*TreasuryReq) ProtoMessage()    {}
+func (*TreasuryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{7}
+}
+func (m *TreasuryReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryReq.Merge(m, src)
+}
+func (m *TreasuryReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryReq proto.InternalMessageInfo
+
+// TreasuryRes reports the balances of the clp treasury module account and the
+// value of its liquidity positions.
+type TreasuryRes struct {
+	Address   string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balances  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	Positions []LiquidityProviderPnLRes                `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+	Height    int64                                    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TreasuryRes) Reset()         { *m = TreasuryRes{} }
+func (m *TreasuryRes) String() string { return proto.CompactTextString(m) }
+func (*TreasuryRes) ProtoMessage()    {}
+func (*TreasuryRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{8}
+}
+func (m *TreasuryRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryRes.Merge(m, src)
+}
+func (m *TreasuryRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryRes proto.InternalMessageInfo
+
+func (m *TreasuryRes) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TreasuryRes) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *TreasuryRes) GetPositions() []LiquidityProviderPnLRes {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *TreasuryRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReferrerStatsReq struct {
+	Referrer string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+}
+
+func (m *ReferrerStatsReq) Reset()         { *m = ReferrerStatsReq{} }
+func (m *ReferrerStatsReq) String() string { return proto.CompactTextString(m) }
+func (*ReferrerStatsReq) ProtoMessage()    {}
+func (*ReferrerStatsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{9}
+}
+func (m *ReferrerStatsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerStatsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerStatsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerStatsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerStatsReq.Merge(m, src)
+}
+func (m *ReferrerStatsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerStatsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerStatsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerStatsReq proto.InternalMessageInfo
+
+type ReferrerStatsRes struct {
+	Stats  *ReferrerStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Height int64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReferrerStatsRes) Reset()         { *m = ReferrerStatsRes{} }
+func (m *ReferrerStatsRes) String() string { return proto.CompactTextString(m) }
+func (*ReferrerStatsRes) ProtoMessage()    {}
+func (*ReferrerStatsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{10}
+}
+func (m *ReferrerStatsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerStatsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerStatsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerStatsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerStatsRes.Merge(m, src)
+}
+func (m *ReferrerStatsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerStatsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerStatsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerStatsRes proto.InternalMessageInfo
+
+func (m *ReferrerStatsRes) GetStats() *ReferrerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *ReferrerStatsRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// LiquidityProviderPnLRes values a liquidity provider position in rowan.
+type LiquidityProviderPnLRes struct {
+	LiquidityProvider    *LiquidityProvider                      `protobuf:"bytes,1,opt,name=liquidity_provider,json=liquidityProvider,proto3" json:"liquidity_provider,omitempty"`
+	NativeAssetBalance   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=native_asset_balance,json=nativeAssetBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_balance"`
+	ExternalAssetBalance github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=external_asset_balance,json=externalAssetBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_balance"`
+	// current_value is the value of the position at the current pool ratio.
+	CurrentValue github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=current_value,json=currentValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"current_value"`
+	// hold_value is what the deposited assets would be worth had they been held
+	// instead, at the current pool ratio.
+	HoldValue github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=hold_value,json=holdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"hold_value"`
+	// deposit_value is the value of the deposits at the time they were made.
+	DepositValue github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=deposit_value,json=depositValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"deposit_value"`
+	// fee_earnings is the part of current_value earned from swap fees since
+	// the deposits.
+	FeeEarnings github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,7,opt,name=fee_earnings,json=feeEarnings,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"fee_earnings"`
+	// impermanent_loss is hold_value minus current_value without fee_earnings,
+	// negative values are gains.
+	ImpermanentLoss github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=impermanent_loss,json=impermanentLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"impermanent_loss"`
+	// profit_and_loss is current_value minus deposit_value.
+	ProfitAndLoss github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=profit_and_loss,json=profitAndLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"profit_and_loss"`
+	Height        int64                                  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *LiquidityProviderPnLRes) Reset()         { *m = LiquidityProviderPnLRes{} }
+func (m *LiquidityProviderPnLRes) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderPnLRes) ProtoMessage()    {}
+func (*LiquidityProviderPnLRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{11}
+}
+func (m *LiquidityProviderPnLRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderPnLRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderPnLRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityProviderPnLRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderPnLRes.Merge(m, src)
+}
+func (m *LiquidityProviderPnLRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderPnLRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderPnLRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityProviderPnLRes proto.InternalMessageInfo
+
+func (m *LiquidityProviderPnLRes) GetLiquidityProvider() *LiquidityProvider {
+	if m != nil {
+		return m.LiquidityProvider
+	}
+	return nil
+}
+
+func (m *LiquidityProviderPnLRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type AssetListReq struct {
 	LpAddress  string             `protobuf:"bytes,1,opt,name=lp_address,json=lpAddress,proto3" json:"lp_address,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -354,7 +659,7 @@ func (m *AssetListReq) Reset()         { *m = AssetListReq{} }
 func (m *AssetListReq) String() string { return proto.CompactTextString(m) }
 func (*AssetListReq) ProtoMessage()    {}
 func (*AssetListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{6}
+	return fileDescriptor_5f4edede314ca3fd, []int{12}
 }
 func (m *AssetListReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +698,7 @@ func (m *AssetListRes) Reset()         { *m = AssetListRes{} }
 func (m *AssetListRes) String() string { return proto.CompactTextString(m) }
 func (*AssetListRes) ProtoMessage()    {}
 func (*AssetListRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{7}
+	return fileDescriptor_5f4edede314ca3fd, []int{13}
 }
 func (m *AssetListRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +757,7 @@ func (m *LiquidityProviderDataReq) Reset()         { *m = LiquidityProviderDataR
 func (m *LiquidityProviderDataReq) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProviderDataReq) ProtoMessage()    {}
 func (*LiquidityProviderDataReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{8}
+	return fileDescriptor_5f4edede314ca3fd, []int{14}
 }
 func (m *LiquidityProviderDataReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +796,7 @@ func (m *LiquidityProviderDataRes) Reset()         { *m = LiquidityProviderDataR
 func (m *LiquidityProviderDataRes) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProviderDataRes) ProtoMessage()    {}
 func (*LiquidityProviderDataRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{9}
+	return fileDescriptor_5f4edede314ca3fd, []int{15}
 }
 func (m *LiquidityProviderDataRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +856,7 @@ func (m *LiquidityProviderListReq) Reset()         { *m = LiquidityProviderListR
 func (m *LiquidityProviderListReq) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProviderListReq) ProtoMessage()    {}
 func (*LiquidityProviderListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{10}
+	return fileDescriptor_5f4edede314ca3fd, []int{16}
 }
 func (m *LiquidityProviderListReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +896,7 @@ func (m *LiquidityProviderListRes) Reset()         { *m = LiquidityProviderListR
 func (m *LiquidityProviderListRes) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProviderListRes) ProtoMessage()    {}
 func (*LiquidityProviderListRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{11}
+	return fileDescriptor_5f4edede314ca3fd, []int{17}
 }
 func (m *LiquidityProviderListRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +954,7 @@ func (m *LiquidityProvidersReq) Reset()         { *m = LiquidityProvidersReq{} }
 func (m *LiquidityProvidersReq) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProvidersReq) ProtoMessage()    {}
 func (*LiquidityProvidersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{12}
+	return fileDescriptor_5f4edede314ca3fd, []int{18}
 }
 func (m *LiquidityProvidersReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +993,7 @@ func (m *LiquidityProvidersRes) Reset()         { *m = LiquidityProvidersRes{} }
 func (m *LiquidityProvidersRes) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProvidersRes) ProtoMessage()    {}
 func (*LiquidityProvidersRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f4edede314ca3fd, []int{13}
+	return fileDescriptor_5f4edede314ca3fd, []int{19}
 }
 func (m *LiquidityProvidersRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,128 +1043,461 @@ func (m *LiquidityProvidersRes) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*PoolReq)(nil), "sifnode.clp.v1.PoolReq")
-	proto.RegisterType((*PoolRes)(nil), "sifnode.clp.v1.PoolRes")
-	proto.RegisterType((*PoolsReq)(nil), "sifnode.clp.v1.PoolsReq")
-	proto.RegisterType((*PoolsRes)(nil), "sifnode.clp.v1.PoolsRes")
-	proto.RegisterType((*LiquidityProviderReq)(nil), "sifnode.clp.v1.LiquidityProviderReq")
-	proto.RegisterType((*LiquidityProviderRes)(nil), "sifnode.clp.v1.LiquidityProviderRes")
-	proto.RegisterType((*AssetListReq)(nil), "sifnode.clp.v1.AssetListReq")
-	proto.RegisterType((*AssetListRes)(nil), "sifnode.clp.v1.AssetListRes")
-	proto.RegisterType((*LiquidityProviderDataReq)(nil), "sifnode.clp.v1.LiquidityProviderDataReq")
-	proto.RegisterType((*LiquidityProviderDataRes)(nil), "sifnode.clp.v1.LiquidityProviderDataRes")
-	proto.RegisterType((*LiquidityProviderListReq)(nil), "sifnode.clp.v1.LiquidityProviderListReq")
-	proto.RegisterType((*LiquidityProviderListRes)(nil), "sifnode.clp.v1.LiquidityProviderListRes")
-	proto.RegisterType((*LiquidityProvidersReq)(nil), "sifnode.clp.v1.LiquidityProvidersReq")
-	proto.RegisterType((*LiquidityProvidersRes)(nil), "sifnode.clp.v1.LiquidityProvidersRes")
+type PoolUSDValueReq struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
 
-func init() { proto.RegisterFile("sifnode/clp/v1/querier.proto", fileDescriptor_5f4edede314ca3fd) }
-
-var fileDescriptor_5f4edede314ca3fd = []byte{
-	// 914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc4, 0x69, 0x92, 0x0e, 0x15, 0x82, 0x87, 0x93, 0x5a, 0x56, 0x70, 0xc3, 0xaa, 0xa4,
-	0x56, 0x28, 0xbb, 0x75, 0x0b, 0x42, 0x05, 0x71, 0x48, 0x85, 0x9a, 0x4b, 0x91, 0x82, 0x39, 0x20,
-	0x21, 0x81, 0x35, 0x5e, 0x4f, 0x9d, 0x91, 0x26, 0x3b, 0x6b, 0xbf, 0xb1, 0x55, 0xab, 0x54, 0x48,
-	0x88, 0x03, 0x12, 0x17, 0xa4, 0xde, 0x51, 0x2f, 0x1c, 0x38, 0xf0, 0x67, 0x20, 0x71, 0x40, 0xa2,
-	0x12, 0x17, 0xb8, 0xa1, 0xa4, 0x87, 0xfe, 0x19, 0x68, 0x67, 0x67, 0x93, 0xec, 0x0f, 0xc7, 0xab,
-	0x28, 0x02, 0xf5, 0x96, 0xdd, 0xf7, 0xcd, 0x9b, 0xef, 0x7d, 0xef, 0xcb, 0x7b, 0x5e, 0xba, 0x8e,
-	0xe2, 0x7e, 0xa0, 0xfa, 0xdc, 0xf3, 0x65, 0xe8, 0x4d, 0xda, 0xde, 0x70, 0xcc, 0x47, 0x82, 0x8f,
-	0xdc, 0x70, 0xa4, 0xb4, 0x82, 0x97, 0x6d, 0xd4, 0xf5, 0x65, 0xe8, 0x4e, 0xda, 0x8d, 0xda, 0x40,
-	0x0d, 0x94, 0x09, 0x79, 0xd1, 0x5f, 0x31, 0xaa, 0xd1, 0xc8, 0xe4, 0xd0, 0xd3, 0x90, 0xa3, 0x8d,
-	0x6d, 0xf9, 0x0a, 0xf7, 0x15, 0x7a, 0x3d, 0x86, 0xdc, 0x24, 0x9f, 0x7a, 0x93, 0x76, 0x8f, 0x6b,
-	0xd6, 0xf6, 0x42, 0x36, 0x10, 0x01, 0xd3, 0x42, 0x05, 0x16, 0xbb, 0x3e, 0x50, 0x6a, 0x20, 0xb9,
-	0xc7, 0x42, 0xe1, 0xb1, 0x20, 0x50, 0xda, 0x04, 0x6d, 0x26, 0xe7, 0x2d, 0xba, 0xbc, 0xab, 0x94,
-	0xec, 0xf0, 0x21, 0xac, 0xd1, 0x25, 0x9c, 0xee, 0xf7, 0x94, 0xac, 0x93, 0x0d, 0xd2, 0xba, 0xd8,
-	0xb1, 0x4f, 0xef, 0xaf, 0x7c, 0xf7, 0xe4, 0x4a, 0xe5, 0xf9, 0x93, 0x2b, 0x15, 0x67, 0x9a, 0x80,
-	0x11, 0x5a, 0x74, 0x31, 0x54, 0x16, 0xfa, 0xd2, 0xcd, 0x9a, 0x9b, 0x2e, 0xc9, 0x35, 0x30, 0x83,
-	0x80, 0xeb, 0x14, 0x7c, 0x19, 0x76, 0xf7, 0x55, 0x7f, 0x2c, 0x79, 0x97, 0xf5, 0xfb, 0x23, 0x8e,
-	0x58, 0x5f, 0x30, 0x57, 0xbc, 0xe2, 0xcb, 0xf0, 0x63, 0x13, 0xd8, 0x8e, 0xdf, 0x47, 0x24, 0xf6,
-	0xb8, 0x18, 0xec, 0xe9, 0x7a, 0x75, 0x83, 0xb4, 0xaa, 0x1d, 0xfb, 0xe4, 0x74, 0xe8, 0x4a, 0x94,
-	0x13, 0x23, 0xa2, 0x77, 0x29, 0x3d, 0xae, 0xd2, 0x32, 0xd8, 0x74, 0x63, 0x49, 0xdc, 0x48, 0x12,
-	0xd7, 0x48, 0xe2, 0x5a, 0x49, 0xdc, 0x5d, 0x36, 0xe0, 0x1d, 0x3e, 0x1c, 0x73, 0xd4, 0x9d, 0x13,
-	0x27, 0x9d, 0x5f, 0xc9, 0x51, 0x52, 0x84, 0x2d, 0x7a, 0x21, 0xa2, 0x8b, 0x75, 0xb2, 0x51, 0x9d,
-	0x59, 0x51, 0x0c, 0x39, 0x9f, 0x92, 0x60, 0x27, 0x55, 0xc6, 0xa2, 0x29, 0xe3, 0xda, 0xdc, 0x32,
-	0x30, 0x54, 0x01, 0xf2, 0x54, 0x1d, 0x9f, 0xd1, 0xda, 0x3d, 0x31, 0x1c, 0x8b, 0xbe, 0xd0, 0xd3,
-	0xdd, 0x91, 0x9a, 0x88, 0x3e, 0x1f, 0x9d, 0xd2, 0x50, 0x78, 0x9d, 0x52, 0x19, 0x66, 0x68, 0x5f,
-	0x94, 0xa1, 0xe5, 0x7b, 0xa2, 0xdf, 0xcf, 0x49, 0x61, 0x66, 0x84, 0x5d, 0x0a, 0x32, 0x79, 0xdf,
-	0x0d, 0x6d, 0xc0, 0x76, 0xe2, 0x8d, 0xac, 0x72, 0xf9, 0x0c, 0xaf, 0xca, 0xec, 0x2b, 0xb8, 0x41,
-	0x6b, 0x51, 0x35, 0x13, 0xde, 0x65, 0x88, 0x5c, 0x77, 0x7b, 0x4c, 0xb2, 0xc0, 0xe7, 0x96, 0x1d,
-	0xc4, 0xb1, 0xed, 0x28, 0x74, 0x27, 0x8e, 0xc0, 0x3b, 0x74, 0x8d, 0x3f, 0xd0, 0x7c, 0x14, 0x30,
-	0x99, 0x39, 0x53, 0x35, 0x67, 0x6a, 0x49, 0x34, 0x75, 0xea, 0xb8, 0x19, 0x8b, 0x29, 0x7f, 0x7d,
-	0x4d, 0x2f, 0x19, 0xdc, 0x3d, 0x81, 0x3a, 0xd2, 0x2e, 0xad, 0x11, 0xc9, 0x68, 0x94, 0xb1, 0xe0,
-	0xc2, 0x59, 0x2d, 0x78, 0x42, 0xeb, 0x1f, 0x49, 0x8a, 0x01, 0xc2, 0xdb, 0x74, 0xc9, 0x94, 0x95,
-	0x38, 0x72, 0x35, 0xab, 0xab, 0x41, 0x77, 0x2c, 0xe8, 0x44, 0x61, 0x0b, 0xa7, 0xb8, 0xac, 0x7a,
-	0x76, 0x97, 0x7d, 0x4f, 0x68, 0x3d, 0xd7, 0xca, 0x8f, 0x98, 0x66, 0xff, 0x8b, 0x5c, 0x7f, 0xcf,
-	0x66, 0x83, 0xf0, 0x05, 0xbd, 0x9c, 0xb7, 0x67, 0xb7, 0xcf, 0x34, 0xb3, 0x5a, 0xbe, 0x39, 0xd7,
-	0xa3, 0x26, 0xd5, 0xaa, 0x2c, 0x7a, 0x3d, 0x53, 0xea, 0xbb, 0x05, 0x52, 0x9f, 0x65, 0x2e, 0x7d,
-	0x5b, 0x54, 0x5b, 0x62, 0xcc, 0x59, 0xff, 0xd4, 0xe7, 0x2f, 0xf1, 0x1f, 0xb3, 0x69, 0x20, 0x74,
-	0xe8, 0x6b, 0x79, 0x89, 0x13, 0xab, 0x96, 0x18, 0x01, 0x90, 0x93, 0xf6, 0x3f, 0xb0, 0xb0, 0xa0,
-	0xab, 0x39, 0x26, 0x05, 0x1b, 0xe5, 0x3c, 0xc4, 0xfb, 0x9d, 0x14, 0xdf, 0xf5, 0x62, 0x2a, 0x77,
-	0xf3, 0xd9, 0x32, 0xbd, 0xf0, 0x49, 0x04, 0x05, 0x9f, 0x2e, 0xef, 0x70, 0x1d, 0x6d, 0x43, 0xb8,
-	0x5c, 0xb8, 0x23, 0xf9, 0xb0, 0x31, 0x23, 0x80, 0xce, 0xe6, 0x37, 0x7f, 0x3e, 0x7b, 0xbc, 0xb0,
-	0x01, 0x4d, 0x0f, 0xc5, 0x7d, 0x7f, 0x8f, 0x89, 0x20, 0xf9, 0x75, 0x13, 0x2d, 0x56, 0xef, 0x61,
-	0xec, 0xe5, 0x47, 0xf0, 0x25, 0x5d, 0xb1, 0x97, 0x20, 0xd4, 0x8b, 0x92, 0x45, 0x5d, 0x6b, 0xcc,
-	0x8a, 0xa0, 0xd3, 0x34, 0xf7, 0xd4, 0x61, 0xad, 0xf0, 0x1e, 0x84, 0x9f, 0x08, 0xad, 0xed, 0x44,
-	0xa3, 0x36, 0xbb, 0x86, 0xae, 0xce, 0xd7, 0x9f, 0x0f, 0x1b, 0x65, 0x50, 0xe8, 0x6c, 0x1b, 0x12,
-	0x1f, 0xc0, 0xed, 0x1c, 0x89, 0x7c, 0xff, 0x8f, 0x4a, 0xf7, 0x1e, 0x1e, 0xcf, 0xd1, 0x47, 0xf0,
-	0x0b, 0xa1, 0xf5, 0x22, 0x9e, 0x66, 0x0c, 0xb5, 0xca, 0x0d, 0x31, 0x3e, 0x6c, 0x94, 0x45, 0xa2,
-	0xf3, 0xa1, 0xe1, 0xfc, 0x1e, 0xbc, 0x5b, 0x82, 0xb3, 0x19, 0xa8, 0x69, 0xbe, 0x5f, 0xd1, 0x4b,
-	0x3b, 0x5c, 0x1f, 0xad, 0x31, 0x58, 0x2f, 0xdc, 0x59, 0x76, 0x94, 0x35, 0x4e, 0x8b, 0xa2, 0x73,
-	0xc3, 0x50, 0xd9, 0x82, 0x56, 0x8e, 0x4a, 0xbc, 0xed, 0xa5, 0x40, 0x9d, 0xbe, 0xfd, 0x31, 0xa1,
-	0xab, 0x45, 0x6a, 0x21, 0xcc, 0x9f, 0xf7, 0xc6, 0x50, 0xa5, 0x60, 0xe8, 0x5c, 0x37, 0xcc, 0x36,
-	0xe1, 0x6a, 0x09, 0x91, 0x10, 0x7e, 0x9e, 0xd1, 0x43, 0x23, 0xd0, 0xfc, 0xce, 0x24, 0x62, 0x95,
-	0x45, 0xa2, 0x73, 0xdb, 0xd0, 0xbb, 0x05, 0xed, 0x32, 0x3d, 0x8c, 0x55, 0xb4, 0xe6, 0xbb, 0xb3,
-	0xfd, 0xdb, 0x41, 0x93, 0x3c, 0x3d, 0x68, 0x92, 0x7f, 0x0e, 0x9a, 0xe4, 0x87, 0xc3, 0x66, 0xe5,
-	0xe9, 0x61, 0xb3, 0xf2, 0xd7, 0x61, 0xb3, 0xf2, 0xf9, 0xb5, 0x81, 0xd0, 0x7b, 0xe3, 0x9e, 0xeb,
-	0xab, 0x7d, 0xef, 0xd3, 0x24, 0x6d, 0xf2, 0x85, 0xf2, 0xc0, 0x5c, 0x60, 0x3e, 0x50, 0x7a, 0x4b,
-	0xe6, 0xbb, 0xe2, 0xd6, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x99, 0x09, 0x80, 0x03, 0x0d,
-	0x00, 0x00,
+func (m *PoolUSDValueReq) Reset()         { *m = PoolUSDValueReq{} }
+func (m *PoolUSDValueReq) String() string { return proto.CompactTextString(m) }
+func (*PoolUSDValueReq) ProtoMessage()    {}
+func (*PoolUSDValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{20}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error)
-	GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error)
-	GetLiquidityProvider(ctx context.Context, in *LiquidityProviderReq, opts ...grpc.CallOption) (*LiquidityProviderRes, error)
-	GetLiquidityProviderData(ctx context.Context, in *LiquidityProviderDataReq, opts ...grpc.CallOption) (*LiquidityProviderDataRes, error)
-	GetAssetList(ctx context.Context, in *AssetListReq, opts ...grpc.CallOption) (*AssetListRes, error)
-	GetLiquidityProviders(ctx context.Context, in *LiquidityProvidersReq, opts ...grpc.CallOption) (*LiquidityProvidersRes, error)
-	GetLiquidityProviderList(ctx context.Context, in *LiquidityProviderListReq, opts ...grpc.CallOption) (*LiquidityProviderListRes, error)
+func (m *PoolUSDValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *PoolUSDValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolUSDValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *PoolUSDValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolUSDValueReq.Merge(m, src)
+}
+func (m *PoolUSDValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolUSDValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolUSDValueReq.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error) {
-	out := new(PoolRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_PoolUSDValueReq proto.InternalMessageInfo
+
+type PoolUSDValueRes struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// rowan_price is the USD price of one rowan, from the reference pools.
+	RowanPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rowan_price,json=rowanPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rowan_price"`
+	Value      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	Height     int64                                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (c *queryClient) GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error) {
-	out := new(PoolsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPools", in, out, opts...)
+func (m *PoolUSDValueRes) Reset()         { *m = PoolUSDValueRes{} }
+func (m *PoolUSDValueRes) String() string { return proto.CompactTextString(m) }
+func (*PoolUSDValueRes) ProtoMessage()    {}
+func (*PoolUSDValueRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{21}
+}
+func (m *PoolUSDValueRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolUSDValueRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolUSDValueRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolUSDValueRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolUSDValueRes.Merge(m, src)
+}
+func (m *PoolUSDValueRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolUSDValueRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolUSDValueRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolUSDValueRes proto.InternalMessageInfo
+
+func (m *PoolUSDValueRes) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *PoolUSDValueRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type LiquidityProviderUSDValueReq struct {
+	Symbol    string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	LpAddress string `protobuf:"bytes,2,opt,name=lp_address,json=lpAddress,proto3" json:"lp_address,omitempty"`
+}
+
+func (m *LiquidityProviderUSDValueReq) Reset()         { *m = LiquidityProviderUSDValueReq{} }
+func (m *LiquidityProviderUSDValueReq) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderUSDValueReq) ProtoMessage()    {}
+func (*LiquidityProviderUSDValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{22}
+}
+func (m *LiquidityProviderUSDValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderUSDValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderUSDValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityProviderUSDValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderUSDValueReq.Merge(m, src)
+}
+func (m *LiquidityProviderUSDValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderUSDValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderUSDValueReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityProviderUSDValueReq proto.InternalMessageInfo
+
+type LiquidityProviderUSDValueRes struct {
+	Symbol     string                                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	LpAddress  string                                 `protobuf:"bytes,2,opt,name=lp_address,json=lpAddress,proto3" json:"lp_address,omitempty"`
+	RowanPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rowan_price,json=rowanPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rowan_price"`
+	Value      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	Height     int64                                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *LiquidityProviderUSDValueRes) Reset()         { *m = LiquidityProviderUSDValueRes{} }
+func (m *LiquidityProviderUSDValueRes) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderUSDValueRes) ProtoMessage()    {}
+func (*LiquidityProviderUSDValueRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{23}
+}
+func (m *LiquidityProviderUSDValueRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderUSDValueRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderUSDValueRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityProviderUSDValueRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderUSDValueRes.Merge(m, src)
+}
+func (m *LiquidityProviderUSDValueRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderUSDValueRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderUSDValueRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityProviderUSDValueRes proto.InternalMessageInfo
+
+func (m *LiquidityProviderUSDValueRes) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *LiquidityProviderUSDValueRes) GetLpAddress() string {
+	if m != nil {
+		return m.LpAddress
+	}
+	return ""
+}
+
+func (m *LiquidityProviderUSDValueRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type TVLReq struct {
+}
+
+func (m *TVLReq) Reset()         { *m = TVLReq{} }
+func (m *TVLReq) String() string { return proto.CompactTextString(m) }
+func (*TVLReq) ProtoMessage()    {}
+func (*TVLReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{24}
+}
+func (m *TVLReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TVLReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TVLReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TVLReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TVLReq.Merge(m, src)
+}
+func (m *TVLReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TVLReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TVLReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TVLReq proto.InternalMessageInfo
+
+type TVLRes struct {
+	RowanPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rowan_price,json=rowanPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rowan_price"`
+	// tvl is the USD value of the liquidity of all pools.
+	Tvl    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tvl"`
+	Height int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TVLRes) Reset()         { *m = TVLRes{} }
+func (m *TVLRes) String() string { return proto.CompactTextString(m) }
+func (*TVLRes) ProtoMessage()    {}
+func (*TVLRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{25}
+}
+func (m *TVLRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TVLRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TVLRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TVLRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TVLRes.Merge(m, src)
+}
+func (m *TVLRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TVLRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TVLRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TVLRes proto.InternalMessageInfo
+
+func (m *TVLRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PoolReq)(nil), "sifnode.clp.v1.PoolReq")
+	proto.RegisterType((*PoolRes)(nil), "sifnode.clp.v1.PoolRes")
+	proto.RegisterType((*PoolsReq)(nil), "sifnode.clp.v1.PoolsReq")
+	proto.RegisterType((*PoolsRes)(nil), "sifnode.clp.v1.PoolsRes")
+	proto.RegisterType((*LiquidityProviderReq)(nil), "sifnode.clp.v1.LiquidityProviderReq")
+	proto.RegisterType((*LiquidityProviderRes)(nil), "sifnode.clp.v1.LiquidityProviderRes")
+	proto.RegisterType((*LiquidityProviderPnLReq)(nil), "sifnode.clp.v1.LiquidityProviderPnLReq")
+	proto.RegisterType((*TreasuryReq)(nil), "sifnode.clp.v1.TreasuryReq")
+	proto.RegisterType((*TreasuryRes)(nil), "sifnode.clp.v1.TreasuryRes")
+	proto.RegisterType((*ReferrerStatsReq)(nil), "sifnode.clp.v1.ReferrerStatsReq")
+	proto.RegisterType((*ReferrerStatsRes)(nil), "sifnode.clp.v1.ReferrerStatsRes")
+	proto.RegisterType((*LiquidityProviderPnLRes)(nil), "sifnode.clp.v1.LiquidityProviderPnLRes")
+	proto.RegisterType((*AssetListReq)(nil), "sifnode.clp.v1.AssetListReq")
+	proto.RegisterType((*AssetListRes)(nil), "sifnode.clp.v1.AssetListRes")
+	proto.RegisterType((*LiquidityProviderDataReq)(nil), "sifnode.clp.v1.LiquidityProviderDataReq")
+	proto.RegisterType((*LiquidityProviderDataRes)(nil), "sifnode.clp.v1.LiquidityProviderDataRes")
+	proto.RegisterType((*LiquidityProviderListReq)(nil), "sifnode.clp.v1.LiquidityProviderListReq")
+	proto.RegisterType((*LiquidityProviderListRes)(nil), "sifnode.clp.v1.LiquidityProviderListRes")
+	proto.RegisterType((*LiquidityProvidersReq)(nil), "sifnode.clp.v1.LiquidityProvidersReq")
+	proto.RegisterType((*LiquidityProvidersRes)(nil), "sifnode.clp.v1.LiquidityProvidersRes")
+	proto.RegisterType((*PoolUSDValueReq)(nil), "sifnode.clp.v1.PoolUSDValueReq")
+	proto.RegisterType((*PoolUSDValueRes)(nil), "sifnode.clp.v1.PoolUSDValueRes")
+	proto.RegisterType((*LiquidityProviderUSDValueReq)(nil), "sifnode.clp.v1.LiquidityProviderUSDValueReq")
+	proto.RegisterType((*LiquidityProviderUSDValueRes)(nil), "sifnode.clp.v1.LiquidityProviderUSDValueRes")
+	proto.RegisterType((*TVLReq)(nil), "sifnode.clp.v1.TVLReq")
+	proto.RegisterType((*TVLRes)(nil), "sifnode.clp.v1.TVLRes")
+}
+
+func init() { proto.RegisterFile("sifnode/clp/v1/querier.proto", fileDescriptor_5f4edede314ca3fd) }
+
+var fileDescriptor_5f4edede314ca3fd = []byte{
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xb3, 0xf9, 0x7c, 0x49, 0x9a, 0x30, 0xe4, 0x63, 0xeb, 0xa6, 0x9b, 0xd4, 0x2a, 0x69,
+	0x14, 0xd2, 0x75, 0xd3, 0x80, 0xa0, 0xa0, 0x0a, 0x92, 0xa6, 0x8d, 0x50, 0x03, 0x04, 0x37, 0x0d,
+	0xa2, 0x12, 0x2c, 0xce, 0xee, 0x64, 0x63, 0x70, 0x6c, 0xaf, 0x67, 0x76, 0x69, 0x54, 0x2a, 0x24,
+	0x54, 0x24, 0x04, 0x17, 0xa4, 0xde, 0x51, 0x2f, 0x1c, 0x40, 0xe2, 0x02, 0x7f, 0x03, 0xd0, 0x03,
+	0x12, 0x95, 0xb8, 0x14, 0x0e, 0x05, 0xb5, 0x1c, 0xfa, 0x0f, 0x70, 0x47, 0x33, 0x1e, 0x6f, 0xd6,
+	0x5f, 0xbb, 0xee, 0x12, 0x40, 0x9c, 0xb2, 0xf6, 0xbc, 0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x7b, 0x6f,
+	0x3c, 0x13, 0x98, 0x24, 0xc6, 0xb6, 0x65, 0x97, 0xb0, 0x5a, 0x34, 0x1d, 0xb5, 0xb6, 0xa0, 0x56,
+	0xaa, 0xd8, 0x35, 0xb0, 0x9b, 0x77, 0x5c, 0x9b, 0xda, 0xe8, 0x90, 0x18, 0xcd, 0x17, 0x4d, 0x27,
+	0x5f, 0x5b, 0x90, 0x47, 0xcb, 0x76, 0xd9, 0xe6, 0x43, 0x2a, 0xfb, 0xe5, 0x59, 0xc9, 0x72, 0x08,
+	0x83, 0xee, 0x39, 0x98, 0x88, 0xb1, 0xb9, 0xa2, 0x4d, 0x76, 0x6d, 0xa2, 0x6e, 0xe9, 0x04, 0x73,
+	0xf0, 0x3d, 0xb5, 0xb6, 0xb0, 0x85, 0xa9, 0xbe, 0xa0, 0x3a, 0x7a, 0xd9, 0xb0, 0x74, 0x6a, 0xd8,
+	0x96, 0xb0, 0x1d, 0x6f, 0xb4, 0x2d, 0xda, 0x86, 0xff, 0x7e, 0xb2, 0x6c, 0xdb, 0x65, 0x13, 0xab,
+	0xba, 0x63, 0xa8, 0xba, 0x65, 0xd9, 0x94, 0x4f, 0x12, 0x1e, 0x94, 0x27, 0xa1, 0x77, 0xdd, 0xb6,
+	0x4d, 0x0d, 0x57, 0xd0, 0x38, 0xf4, 0x90, 0xbd, 0xdd, 0x2d, 0xdb, 0xcc, 0x4a, 0xd3, 0xd2, 0x6c,
+	0xbf, 0x26, 0x9e, 0x9e, 0xeb, 0xfb, 0xf8, 0xd6, 0x54, 0xc7, 0xc3, 0x5b, 0x53, 0x1d, 0xca, 0x9e,
+	0x6f, 0x4c, 0xd0, 0x2c, 0x74, 0x39, 0xb6, 0x30, 0x1d, 0x38, 0x3d, 0x9a, 0x0f, 0x86, 0x9a, 0xe7,
+	0x66, 0xdc, 0x02, 0xcd, 0x03, 0x2a, 0x9a, 0x4e, 0x61, 0xd7, 0x2e, 0x55, 0x4d, 0x5c, 0xd0, 0x4b,
+	0x25, 0x17, 0x13, 0x92, 0xed, 0xe4, 0x2e, 0x46, 0x8a, 0xa6, 0xf3, 0x32, 0x1f, 0x58, 0xf2, 0xde,
+	0x33, 0x12, 0x3b, 0xd8, 0x28, 0xef, 0xd0, 0x6c, 0x66, 0x5a, 0x9a, 0xcd, 0x68, 0xe2, 0x49, 0xd1,
+	0xa0, 0x8f, 0x61, 0x12, 0x46, 0xf4, 0x02, 0xc0, 0x7e, 0xf4, 0x82, 0xc1, 0x4c, 0xde, 0x0b, 0x3f,
+	0xcf, 0xc2, 0xcf, 0x73, 0xa9, 0xf2, 0x42, 0xaa, 0xfc, 0xba, 0x5e, 0xc6, 0x1a, 0xae, 0x54, 0x31,
+	0xa1, 0x5a, 0xc3, 0x4c, 0xe5, 0x3b, 0xa9, 0x0e, 0x4a, 0xd0, 0x1c, 0x74, 0x33, 0xba, 0x24, 0x2b,
+	0x4d, 0x67, 0x12, 0x23, 0xf2, 0x4c, 0x0e, 0x26, 0x24, 0xb4, 0x1a, 0x08, 0xa3, 0x8b, 0x87, 0x71,
+	0xa2, 0x65, 0x18, 0xc4, 0xb1, 0x2d, 0x82, 0x03, 0x71, 0xbc, 0x0e, 0xa3, 0x6b, 0x46, 0xa5, 0x6a,
+	0x94, 0x0c, 0xba, 0xb7, 0xee, 0xda, 0x35, 0xa3, 0x84, 0xdd, 0x26, 0x0b, 0x8a, 0x8e, 0x02, 0x98,
+	0x4e, 0x88, 0x76, 0xbf, 0xe9, 0x08, 0xbe, 0x0d, 0xeb, 0xfd, 0x50, 0x8a, 0x45, 0x26, 0x68, 0x1d,
+	0x90, 0xe9, 0xbf, 0x2f, 0x38, 0x62, 0x40, 0xac, 0xc4, 0xb1, 0xb0, 0x72, 0x51, 0x84, 0xc7, 0xcc,
+	0xf0, 0x2b, 0x74, 0x0a, 0x46, 0x59, 0x34, 0x35, 0x5c, 0xd0, 0x09, 0xc1, 0xb4, 0xb0, 0xa5, 0x9b,
+	0xba, 0x55, 0xc4, 0x82, 0x1d, 0xf2, 0xc6, 0x96, 0xd8, 0xd0, 0xb2, 0x37, 0x82, 0x9e, 0x82, 0x71,
+	0x7c, 0x95, 0x62, 0xd7, 0xd2, 0xcd, 0xd0, 0x9c, 0x0c, 0x9f, 0x33, 0xea, 0x8f, 0x06, 0x66, 0xed,
+	0x2f, 0x46, 0x57, 0x20, 0xbf, 0xae, 0xc0, 0x44, 0x84, 0xe7, 0xba, 0xb5, 0x76, 0x20, 0x32, 0x0e,
+	0xc1, 0xc0, 0x86, 0x8b, 0x75, 0x52, 0x75, 0xf7, 0x34, 0x5c, 0x51, 0xfe, 0x94, 0x1a, 0x9f, 0x09,
+	0xca, 0x42, 0xaf, 0x0f, 0xe2, 0x39, 0xf0, 0x1f, 0x51, 0x19, 0xfa, 0x44, 0x4c, 0x0c, 0x9f, 0xa5,
+	0xe5, 0xe1, 0x40, 0x7e, 0xf8, 0x99, 0x71, 0xce, 0x36, 0xac, 0xe5, 0x53, 0xb7, 0xef, 0x4d, 0x75,
+	0x7c, 0xf5, 0xdb, 0xd4, 0x6c, 0xd9, 0xa0, 0x3b, 0xd5, 0xad, 0x7c, 0xd1, 0xde, 0x55, 0x45, 0x4b,
+	0xf0, 0xfe, 0x9c, 0x24, 0xa5, 0x77, 0x45, 0x77, 0x61, 0x13, 0x88, 0x56, 0x07, 0x47, 0x17, 0xa1,
+	0xdf, 0xb1, 0x89, 0xc1, 0x1b, 0x43, 0x36, 0xc3, 0x3d, 0x9d, 0x68, 0xb9, 0x8c, 0x5c, 0x1e, 0xb2,
+	0xdc, 0xc5, 0xfc, 0x6a, 0xfb, 0xf3, 0x13, 0x25, 0x7e, 0x16, 0x46, 0x34, 0xbc, 0x8d, 0x5d, 0x17,
+	0xbb, 0x97, 0xa8, 0x4e, 0x79, 0x29, 0xcb, 0xd0, 0xe7, 0x8a, 0x77, 0x22, 0xf8, 0xfa, 0x73, 0x83,
+	0x80, 0x85, 0xc8, 0x4c, 0x82, 0x16, 0xa1, 0x9b, 0xb0, 0xdf, 0x22, 0xeb, 0x8e, 0x86, 0xe9, 0x06,
+	0x27, 0x78, 0xb6, 0x0d, 0xd4, 0x3a, 0x03, 0xd4, 0xee, 0xf4, 0x24, 0x2d, 0xff, 0x3f, 0x91, 0xeb,
+	0x7a, 0xb3, 0x5c, 0x5f, 0x56, 0x99, 0x9e, 0xbf, 0xde, 0x9b, 0x3a, 0x91, 0x62, 0x1d, 0x2f, 0x1b,
+	0x16, 0x8d, 0x2d, 0x0e, 0xdc, 0xbc, 0x38, 0x1e, 0xdd, 0x49, 0x7c, 0x35, 0x6d, 0xc0, 0x50, 0xb1,
+	0xea, 0xba, 0xd8, 0xa2, 0x85, 0x9a, 0x6e, 0x56, 0x71, 0xb6, 0xab, 0x3d, 0xf4, 0x41, 0x81, 0xb2,
+	0xc9, 0x40, 0xd0, 0x2b, 0x00, 0x3b, 0xb6, 0x59, 0x12, 0x90, 0xdd, 0xed, 0x41, 0xf6, 0x33, 0x08,
+	0x0f, 0x6f, 0x03, 0x86, 0x4a, 0x98, 0xe7, 0xa7, 0x80, 0xec, 0x69, 0x93, 0xa5, 0x40, 0xf1, 0x50,
+	0x35, 0x18, 0xdc, 0xc6, 0xb8, 0x80, 0x75, 0xd7, 0x32, 0xac, 0x32, 0xc9, 0xf6, 0xb6, 0x07, 0x3a,
+	0xb0, 0x8d, 0xf1, 0x79, 0x81, 0x81, 0xde, 0x80, 0x11, 0x63, 0xd7, 0xc1, 0xee, 0xae, 0x6e, 0x31,
+	0x4d, 0x4d, 0x9b, 0x90, 0x6c, 0x1f, 0xc7, 0xcd, 0x0b, 0xdc, 0x99, 0x14, 0xb8, 0x2f, 0x59, 0x54,
+	0x1b, 0x6e, 0xc0, 0x59, 0xb3, 0x09, 0x41, 0x9b, 0x30, 0xec, 0xb8, 0xf6, 0xb6, 0x41, 0x0b, 0xba,
+	0x55, 0xf2, 0x90, 0xfb, 0xdb, 0x42, 0x1e, 0xf2, 0x60, 0x96, 0xac, 0x12, 0xc7, 0xdd, 0x2f, 0x29,
+	0x08, 0x94, 0xd4, 0x07, 0x30, 0xc8, 0x53, 0x65, 0xcd, 0x20, 0x94, 0x55, 0x7a, 0xb0, 0x5b, 0x4a,
+	0xa1, 0x6e, 0x19, 0xda, 0xd3, 0x3b, 0xdb, 0xdd, 0xd3, 0x1b, 0x9a, 0xc6, 0xe7, 0x52, 0x80, 0x01,
+	0x41, 0x27, 0xa1, 0x87, 0x97, 0x82, 0xbf, 0xc5, 0x8f, 0x85, 0x8b, 0x97, 0x5b, 0x6b, 0xc2, 0x28,
+	0xa9, 0x57, 0x84, 0xb6, 0xed, 0x4c, 0xfb, 0xdb, 0xf6, 0xa7, 0x12, 0x64, 0x23, 0xfd, 0x62, 0x45,
+	0xa7, 0xfa, 0x7f, 0x22, 0xd7, 0x2f, 0xc9, 0x6c, 0x08, 0x7a, 0x13, 0x26, 0xa2, 0x3d, 0xb0, 0x50,
+	0xd2, 0xa9, 0x2e, 0xb4, 0x7c, 0xa2, 0x65, 0x23, 0xe4, 0x50, 0x63, 0x66, 0xdc, 0xeb, 0x44, 0xa9,
+	0x2f, 0xc4, 0x48, 0xdd, 0xce, 0x87, 0xde, 0x8d, 0xb8, 0xd8, 0xfc, 0xc4, 0x4c, 0xda, 0xde, 0x0f,
+	0x5e, 0xe2, 0x9f, 0x92, 0x69, 0x10, 0xa4, 0xc1, 0xe3, 0x51, 0x89, 0xfd, 0x54, 0x4d, 0xb1, 0xcf,
+	0xa0, 0x88, 0xb4, 0xff, 0x42, 0x0a, 0x1b, 0x30, 0x16, 0x61, 0x12, 0xf3, 0x89, 0x7e, 0x10, 0xe2,
+	0xfd, 0x28, 0xc5, 0xfb, 0xfa, 0x9f, 0x2a, 0xb7, 0x08, 0xc3, 0xec, 0x44, 0x71, 0xf9, 0xd2, 0x0a,
+	0xdf, 0x4d, 0xd2, 0x9d, 0xbf, 0xee, 0x4a, 0xe1, 0x59, 0x24, 0x31, 0x7d, 0x5f, 0x85, 0x01, 0xd7,
+	0x7e, 0x4f, 0xb7, 0x0a, 0x8e, 0x6b, 0xd4, 0xbf, 0x2d, 0x1e, 0xa5, 0xd7, 0xaf, 0xe0, 0xa2, 0x06,
+	0x1c, 0x62, 0x9d, 0x21, 0xa0, 0x15, 0xe8, 0xf6, 0x76, 0xcf, 0x4c, 0x5b, 0x50, 0xde, 0xe4, 0xc4,
+	0x8f, 0xc3, 0x02, 0x4c, 0x46, 0x56, 0x26, 0x85, 0x38, 0xe9, 0x3f, 0xc2, 0x6f, 0x74, 0x36, 0xf5,
+	0x40, 0xda, 0xf4, 0x10, 0xd6, 0x39, 0x73, 0x70, 0x3a, 0x77, 0x1d, 0x8c, 0xce, 0xdd, 0x01, 0x9d,
+	0xfb, 0xa0, 0x67, 0x63, 0x93, 0x1d, 0x6b, 0x94, 0x6f, 0x25, 0xf1, 0x33, 0x12, 0x83, 0xf4, 0xb7,
+	0x63, 0x78, 0x11, 0x32, 0xb4, 0x66, 0xb6, 0x99, 0x74, 0x6c, 0x6a, 0xd2, 0xa1, 0xf9, 0xf4, 0xf7,
+	0x87, 0xa0, 0xfb, 0x35, 0x56, 0x62, 0xa8, 0x08, 0xbd, 0xab, 0x98, 0xb2, 0x72, 0x40, 0x13, 0xb1,
+	0x87, 0x75, 0x5c, 0x91, 0x13, 0x06, 0x88, 0x32, 0xf3, 0xe1, 0xcf, 0x7f, 0xdc, 0xec, 0x9c, 0x46,
+	0x39, 0x95, 0x18, 0xdb, 0xc5, 0x1d, 0xdd, 0xb0, 0xfc, 0xeb, 0x17, 0x76, 0xc2, 0x57, 0xaf, 0x79,
+	0x6b, 0x7f, 0x1d, 0xbd, 0x05, 0x7d, 0xc2, 0x09, 0x41, 0xd9, 0x38, 0x30, 0xd6, 0xed, 0xe4, 0xa4,
+	0x11, 0xa2, 0xe4, 0xb8, 0x9f, 0x2c, 0x1a, 0x8f, 0xf5, 0x43, 0xd0, 0x17, 0x12, 0x8c, 0xae, 0x62,
+	0x1a, 0x49, 0x4c, 0x74, 0xbc, 0x75, 0xdf, 0xc2, 0x15, 0x39, 0x8d, 0x15, 0x51, 0x96, 0x38, 0x89,
+	0xe7, 0xd1, 0x99, 0x08, 0x89, 0x68, 0xdf, 0xac, 0x87, 0xae, 0x5e, 0xdb, 0xcf, 0xfa, 0xeb, 0xe8,
+	0x6b, 0x09, 0xb2, 0x71, 0x3c, 0xf9, 0xf6, 0x3d, 0x9b, 0x6e, 0xf3, 0xc7, 0x15, 0x39, 0xad, 0x25,
+	0x51, 0xce, 0x72, 0xce, 0xcf, 0xa0, 0xa7, 0x53, 0x70, 0xe6, 0x1f, 0x22, 0x41, 0xbe, 0xdf, 0x48,
+	0x30, 0x11, 0xc7, 0x77, 0xdd, 0x5a, 0x43, 0x29, 0x4f, 0xb6, 0x15, 0x39, 0xa5, 0x21, 0x51, 0xce,
+	0x73, 0xb2, 0x2f, 0xa0, 0xb3, 0x69, 0xc8, 0x3a, 0x96, 0x99, 0x20, 0xf2, 0x3b, 0x30, 0xb0, 0x8a,
+	0xa9, 0x7f, 0x35, 0x80, 0x8e, 0x84, 0xdd, 0x37, 0x5c, 0x22, 0xc8, 0x4d, 0x06, 0x89, 0x72, 0x8c,
+	0xf3, 0x39, 0x82, 0x0e, 0x47, 0xf8, 0x50, 0x1f, 0xfc, 0x23, 0x09, 0x86, 0x45, 0x66, 0xfb, 0x4d,
+	0x10, 0x4d, 0xc5, 0xa5, 0x71, 0x43, 0x13, 0x96, 0x5b, 0x18, 0x10, 0xe5, 0x14, 0x77, 0x3c, 0x87,
+	0x66, 0x23, 0x8e, 0xab, 0x44, 0x9c, 0xe7, 0x42, 0x05, 0xf6, 0x83, 0x04, 0x93, 0x71, 0x0b, 0x55,
+	0x27, 0x35, 0xdf, 0x72, 0x11, 0x1a, 0x19, 0x3e, 0x8a, 0x35, 0x51, 0x2e, 0x72, 0xba, 0xe7, 0xd1,
+	0xb9, 0x26, 0x74, 0x53, 0x97, 0xc8, 0xdb, 0xd0, 0xc3, 0x56, 0x6f, 0x73, 0x0d, 0x8d, 0x47, 0xd6,
+	0x86, 0x77, 0x5c, 0x39, 0xfe, 0x7d, 0xb3, 0x66, 0xb4, 0x4f, 0x83, 0xf5, 0xc4, 0x4f, 0x24, 0x18,
+	0x59, 0xc5, 0x34, 0x70, 0xb3, 0x81, 0xa6, 0x9b, 0x5f, 0x7c, 0xe0, 0x8a, 0xdc, 0xca, 0x82, 0x28,
+	0xa7, 0x39, 0x81, 0x79, 0x34, 0x17, 0x21, 0xe0, 0x5f, 0xc6, 0x14, 0xf8, 0xfd, 0x89, 0x7a, 0xcd,
+	0x7f, 0xbe, 0x8e, 0xde, 0x87, 0xc1, 0x55, 0x4c, 0xeb, 0x07, 0x2c, 0x34, 0x19, 0x7b, 0x9a, 0x12,
+	0x1f, 0xd9, 0x72, 0xb3, 0xd1, 0x66, 0x69, 0xe3, 0xdd, 0x5d, 0x98, 0x06, 0xa1, 0x41, 0xb1, 0x6f,
+	0x4a, 0x30, 0x16, 0x97, 0x36, 0x04, 0xb5, 0x3e, 0x89, 0x70, 0x51, 0x52, 0x99, 0x11, 0x65, 0x9e,
+	0x33, 0x9b, 0x41, 0xc7, 0x53, 0x54, 0x36, 0x41, 0x5f, 0x26, 0x74, 0x49, 0x2e, 0x50, 0xeb, 0xde,
+	0xe7, 0x8b, 0x95, 0xd6, 0x92, 0x28, 0x67, 0x38, 0xbd, 0x45, 0xb4, 0x90, 0xa6, 0xf1, 0x78, 0x2a,
+	0x8a, 0xdc, 0x5d, 0x5e, 0xba, 0x7d, 0x3f, 0x27, 0xdd, 0xb9, 0x9f, 0x93, 0x7e, 0xbf, 0x9f, 0x93,
+	0x3e, 0x7b, 0x90, 0xeb, 0xb8, 0xf3, 0x20, 0xd7, 0x71, 0xf7, 0x41, 0xae, 0xe3, 0x4a, 0xe3, 0xd5,
+	0xc5, 0x25, 0x1f, 0xd6, 0xff, 0x27, 0xc5, 0x55, 0xee, 0x80, 0x6f, 0xd6, 0x5b, 0x3d, 0xfc, 0x5f,
+	0x08, 0x8b, 0x7f, 0x0d, 0x00, 0x71, 0x71, 0x78, 0x62, 0x06, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error)
+	GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error)
+	GetLiquidityProvider(ctx context.Context, in *LiquidityProviderReq, opts ...grpc.CallOption) (*LiquidityProviderRes, error)
+	GetLiquidityProviderData(ctx context.Context, in *LiquidityProviderDataReq, opts ...grpc.CallOption) (*LiquidityProviderDataRes, error)
+	GetLiquidityProviderPnL(ctx context.Context, in *LiquidityProviderPnLReq, opts ...grpc.CallOption) (*LiquidityProviderPnLRes, error)
+	GetTreasury(ctx context.Context, in *TreasuryReq, opts ...grpc.CallOption) (*TreasuryRes, error)
+	GetPoolUSDValue(ctx context.Context, in *PoolUSDValueReq, opts ...grpc.CallOption) (*PoolUSDValueRes, error)
+	GetLiquidityProviderUSDValue(ctx context.Context, in *LiquidityProviderUSDValueReq, opts ...grpc.CallOption) (*LiquidityProviderUSDValueRes, error)
+	GetTVL(ctx context.Context, in *TVLReq, opts ...grpc.CallOption) (*TVLRes, error)
+	GetReferrerStats(ctx context.Context, in *ReferrerStatsReq, opts ...grpc.CallOption) (*ReferrerStatsRes, error)
+	GetAssetList(ctx context.Context, in *AssetListReq, opts ...grpc.CallOption) (*AssetListRes, error)
+	GetLiquidityProviders(ctx context.Context, in *LiquidityProvidersReq, opts ...grpc.CallOption) (*LiquidityProvidersRes, error)
+	GetLiquidityProviderList(ctx context.Context, in *LiquidityProviderListReq, opts ...grpc.CallOption) (*LiquidityProviderListRes, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error) {
+	out := new(PoolRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error) {
+	out := new(PoolsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -884,6 +1522,60 @@ func (c *queryClient) GetLiquidityProviderData(ctx context.Context, in *Liquidit
 	return out, nil
 }
 
+func (c *queryClient) GetLiquidityProviderPnL(ctx context.Context, in *LiquidityProviderPnLReq, opts ...grpc.CallOption) (*LiquidityProviderPnLRes, error) {
+	out := new(LiquidityProviderPnLRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviderPnL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTreasury(ctx context.Context, in *TreasuryReq, opts ...grpc.CallOption) (*TreasuryRes, error) {
+	out := new(TreasuryRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetTreasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPoolUSDValue(ctx context.Context, in *PoolUSDValueReq, opts ...grpc.CallOption) (*PoolUSDValueRes, error) {
+	out := new(PoolUSDValueRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPoolUSDValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetLiquidityProviderUSDValue(ctx context.Context, in *LiquidityProviderUSDValueReq, opts ...grpc.CallOption) (*LiquidityProviderUSDValueRes, error) {
+	out := new(LiquidityProviderUSDValueRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviderUSDValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTVL(ctx context.Context, in *TVLReq, opts ...grpc.CallOption) (*TVLRes, error) {
+	out := new(TVLRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetTVL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReferrerStats(ctx context.Context, in *ReferrerStatsReq, opts ...grpc.CallOption) (*ReferrerStatsRes, error) {
+	out := new(ReferrerStatsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetReferrerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetAssetList(ctx context.Context, in *AssetListReq, opts ...grpc.CallOption) (*AssetListRes, error) {
 	out := new(AssetListRes)
 	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetAssetList", in, out, opts...)
@@ -917,6 +1609,12 @@ type QueryServer interface {
 	GetPools(context.Context, *PoolsReq) (*PoolsRes, error)
 	GetLiquidityProvider(context.Context, *LiquidityProviderReq) (*LiquidityProviderRes, error)
 	GetLiquidityProviderData(context.Context, *LiquidityProviderDataReq) (*LiquidityProviderDataRes, error)
+	GetLiquidityProviderPnL(context.Context, *LiquidityProviderPnLReq) (*LiquidityProviderPnLRes, error)
+	GetTreasury(context.Context, *TreasuryReq) (*TreasuryRes, error)
+	GetPoolUSDValue(context.Context, *PoolUSDValueReq) (*PoolUSDValueRes, error)
+	GetLiquidityProviderUSDValue(context.Context, *LiquidityProviderUSDValueReq) (*LiquidityProviderUSDValueRes, error)
+	GetTVL(context.Context, *TVLReq) (*TVLRes, error)
+	GetReferrerStats(context.Context, *ReferrerStatsReq) (*ReferrerStatsRes, error)
 	GetAssetList(context.Context, *AssetListReq) (*AssetListRes, error)
 	GetLiquidityProviders(context.Context, *LiquidityProvidersReq) (*LiquidityProvidersRes, error)
 	GetLiquidityProviderList(context.Context, *LiquidityProviderListReq) (*LiquidityProviderListRes, error)
@@ -938,6 +1636,24 @@ func (*UnimplementedQueryServer) GetLiquidityProvider(ctx context.Context, req *
 func (*UnimplementedQueryServer) GetLiquidityProviderData(ctx context.Context, req *LiquidityProviderDataReq) (*LiquidityProviderDataRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderData not implemented")
 }
+func (*UnimplementedQueryServer) GetLiquidityProviderPnL(ctx context.Context, req *LiquidityProviderPnLReq) (*LiquidityProviderPnLRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderPnL not implemented")
+}
+func (*UnimplementedQueryServer) GetTreasury(ctx context.Context, req *TreasuryReq) (*TreasuryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTreasury not implemented")
+}
+func (*UnimplementedQueryServer) GetPoolUSDValue(ctx context.Context, req *PoolUSDValueReq) (*PoolUSDValueRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolUSDValue not implemented")
+}
+func (*UnimplementedQueryServer) GetLiquidityProviderUSDValue(ctx context.Context, req *LiquidityProviderUSDValueReq) (*LiquidityProviderUSDValueRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderUSDValue not implemented")
+}
+func (*UnimplementedQueryServer) GetTVL(ctx context.Context, req *TVLReq) (*TVLRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTVL not implemented")
+}
+func (*UnimplementedQueryServer) GetReferrerStats(ctx context.Context, req *ReferrerStatsReq) (*ReferrerStatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferrerStats not implemented")
+}
 func (*UnimplementedQueryServer) GetAssetList(ctx context.Context, req *AssetListReq) (*AssetListRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssetList not implemented")
 }
@@ -1024,49 +1740,157 @@ func _Query_GetLiquidityProviderData_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetAssetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetListReq)
+func _Query_GetLiquidityProviderPnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProviderPnLReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetAssetList(ctx, in)
+		return srv.(QueryServer).GetLiquidityProviderPnL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetAssetList",
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviderPnL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAssetList(ctx, req.(*AssetListReq))
+		return srv.(QueryServer).GetLiquidityProviderPnL(ctx, req.(*LiquidityProviderPnLReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetLiquidityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityProvidersReq)
+func _Query_GetTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreasuryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetLiquidityProviders(ctx, in)
+		return srv.(QueryServer).GetTreasury(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviders",
+		FullMethod: "/sifnode.clp.v1.Query/GetTreasury",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLiquidityProviders(ctx, req.(*LiquidityProvidersReq))
+		return srv.(QueryServer).GetTreasury(ctx, req.(*TreasuryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetLiquidityProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityProviderListReq)
+func _Query_GetPoolUSDValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolUSDValueReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetLiquidityProviderList(ctx, in)
+		return srv.(QueryServer).GetPoolUSDValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetPoolUSDValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPoolUSDValue(ctx, req.(*PoolUSDValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLiquidityProviderUSDValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProviderUSDValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProviderUSDValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviderUSDValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidityProviderUSDValue(ctx, req.(*LiquidityProviderUSDValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTVL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TVLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTVL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetTVL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTVL(ctx, req.(*TVLReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReferrerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferrerStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReferrerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetReferrerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReferrerStats(ctx, req.(*ReferrerStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAssetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAssetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetAssetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAssetList(ctx, req.(*AssetListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLiquidityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProvidersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidityProviders(ctx, req.(*LiquidityProvidersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLiquidityProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProviderListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProviderList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1098,6 +1922,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLiquidityProviderData",
 			Handler:    _Query_GetLiquidityProviderData_Handler,
 		},
+		{
+			MethodName: "GetLiquidityProviderPnL",
+			Handler:    _Query_GetLiquidityProviderPnL_Handler,
+		},
+		{
+			MethodName: "GetTreasury",
+			Handler:    _Query_GetTreasury_Handler,
+		},
+		{
+			MethodName: "GetPoolUSDValue",
+			Handler:    _Query_GetPoolUSDValue_Handler,
+		},
+		{
+			MethodName: "GetLiquidityProviderUSDValue",
+			Handler:    _Query_GetLiquidityProviderUSDValue_Handler,
+		},
+		{
+			MethodName: "GetTVL",
+			Handler:    _Query_GetTVL_Handler,
+		},
+		{
+			MethodName: "GetReferrerStats",
+			Handler:    _Query_GetReferrerStats_Handler,
+		},
 		{
 			MethodName: "GetAssetList",
 			Handler:    _Query_GetAssetList_Handler,
@@ -1379,7 +2227,7 @@ func (m *LiquidityProviderRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetListReq) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityProviderPnLReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1389,39 +2237,34 @@ func (m *AssetListReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetListReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityProviderPnLReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityProviderPnLReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.LpAddress) > 0 {
 		i -= len(m.LpAddress)
 		copy(dAtA[i:], m.LpAddress)
 		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetListRes) Marshal() (dAtA []byte, err error) {
+func (m *TreasuryReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1431,37 +2274,48 @@ func (m *AssetListRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetListRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *TreasuryReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TreasuryReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Height != 0 {
 		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1469,13 +2323,34 @@ func (m *AssetListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuerier(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderDataReq) Marshal() (dAtA []byte, err error) {
+func (m *ReferrerStatsReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1485,39 +2360,27 @@ func (m *LiquidityProviderDataReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityProviderDataReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReferrerStatsReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProviderDataReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReferrerStatsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Referrer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderDataRes) Marshal() (dAtA []byte, err error) {
+func (m *ReferrerStatsRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1527,19 +2390,24 @@ func (m *LiquidityProviderDataRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityProviderDataRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReferrerStatsRes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProviderDataRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReferrerStatsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Stats != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1547,31 +2415,12 @@ func (m *LiquidityProviderDataRes) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.LiquidityProviderData) > 0 {
-		for iNdEx := len(m.LiquidityProviderData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityProviderData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuerier(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderListReq) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityProviderPnLRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1581,19 +2430,104 @@ func (m *LiquidityProviderListReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityProviderListReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityProviderPnLRes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProviderListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityProviderPnLRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.ProfitAndLoss.Size()
+		i -= size
+		if _, err := m.ProfitAndLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ImpermanentLoss.Size()
+		i -= size
+		if _, err := m.ImpermanentLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FeeEarnings.Size()
+		i -= size
+		if _, err := m.FeeEarnings.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DepositValue.Size()
+		i -= size
+		if _, err := m.DepositValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.HoldValue.Size()
+		i -= size
+		if _, err := m.HoldValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentValue.Size()
+		i -= size
+		if _, err := m.CurrentValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExternalAssetBalance.Size()
+		i -= size
+		if _, err := m.ExternalAssetBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetBalance.Size()
+		i -= size
+		if _, err := m.NativeAssetBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LiquidityProvider != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1601,19 +2535,12 @@ func (m *LiquidityProviderListReq) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderListRes) Marshal() (dAtA []byte, err error) {
+func (m *AssetListReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1623,12 +2550,54 @@ func (m *LiquidityProviderListRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityProviderListRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetListReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProviderListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetListRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetListRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1650,10 +2619,10 @@ func (m *LiquidityProviderListRes) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.LiquidityProviders) > 0 {
-		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1667,7 +2636,7 @@ func (m *LiquidityProviderListRes) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProvidersReq) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityProviderDataReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1677,12 +2646,12 @@ func (m *LiquidityProvidersReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityProvidersReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityProviderDataReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProvidersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityProviderDataReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1699,10 +2668,17 @@ func (m *LiquidityProvidersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProvidersRes) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityProviderDataRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1712,12 +2688,12 @@ func (m *LiquidityProvidersRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityProvidersRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityProviderDataRes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProvidersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityProviderDataRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1739,10 +2715,10 @@ func (m *LiquidityProvidersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.LiquidityProviders) > 0 {
-		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LiquidityProviderData) > 0 {
+		for iNdEx := len(m.LiquidityProviderData) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LiquidityProviderData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1756,289 +2732,2599 @@ func (m *LiquidityProvidersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuerier(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuerier(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LiquidityProviderListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PoolReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
+
+func (m *LiquidityProviderListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityProviderListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.ClpModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+func (m *LiquidityProviderListRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolsRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityProviderListRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
-	}
-	l = len(m.ClpModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProvidersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProvidersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProvidersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityProvidersRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProvidersRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProvidersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LiquidityProvider != nil {
-		l = m.LiquidityProvider.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.NativeAssetBalance)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.ExternalAssetBalance)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetListReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolUSDValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolUSDValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolUSDValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetListRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolUSDValueRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolUSDValueRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolUSDValueRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
-		}
-	}
 	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RowanPrice.Size()
+		i -= size
+		if _, err := m.RowanPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderDataReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProviderUSDValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderUSDValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderUSDValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderDataRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProviderUSDValueRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderUSDValueRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderUSDValueRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityProviderData) > 0 {
-		for _, e := range m.LiquidityProviderData {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RowanPrice.Size()
+		i -= size
+		if _, err := m.RowanPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderListReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TVLReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TVLReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TVLReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderListRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TVLRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LiquidityProviders) > 0 {
-		for _, e := range m.LiquidityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TVLRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TVLRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RowanPrice.Size()
+		i -= size
+		if _, err := m.RowanPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuerier(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuerier(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.ClpModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PoolsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	l = len(m.ClpModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidityProvider != nil {
+		l = m.LiquidityProvider.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.NativeAssetBalance)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.ExternalAssetBalance)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LiquidityProviderPnLReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *TreasuryReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TreasuryRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ReferrerStatsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *ReferrerStatsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LiquidityProviderPnLRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidityProvider != nil {
+		l = m.LiquidityProvider.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = m.NativeAssetBalance.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.ExternalAssetBalance.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.CurrentValue.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.HoldValue.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.DepositValue.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.FeeEarnings.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.ImpermanentLoss.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.ProfitAndLoss.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AssetListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderDataReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderDataRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviderData) > 0 {
+		for _, e := range m.LiquidityProviderData {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProvidersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProvidersRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolUSDValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolUSDValueRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = m.RowanPrice.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LiquidityProviderUSDValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderUSDValueRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = m.RowanPrice.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *TVLReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TVLRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RowanPrice.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.Tvl.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuerier(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuerier(x uint64) (n int) {
+	return sovQuerier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityProvider == nil {
+				m.LiquidityProvider = &LiquidityProvider{}
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeAssetBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalAssetBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderPnLReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderPnLReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderPnLReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, LiquidityProviderPnLRes{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerStatsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerStatsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerStatsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerStatsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerStatsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerStatsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ReferrerStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderPnLRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderPnLRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderPnLRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityProvider == nil {
+				m.LiquidityProvider = &LiquidityProvider{}
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HoldValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeEarnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpermanentLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ImpermanentLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitAndLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfitAndLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
-}
-
-func (m *LiquidityProvidersReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
-}
 
-func (m *LiquidityProvidersRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LiquidityProviders) > 0 {
-		for _, e := range m.LiquidityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
-		}
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuerier(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuerier(x uint64) (n int) {
-	return sovQuerier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PoolReq) Unmarshal(dAtA []byte) error {
+func (m *AssetListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2061,15 +5347,15 @@ func (m *PoolReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2097,7 +5383,43 @@ func (m *PoolReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2120,7 +5442,7 @@ func (m *PoolReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolRes) Unmarshal(dAtA []byte) error {
+func (m *AssetListRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2143,15 +5465,15 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetListRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetListRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2178,18 +5500,16 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assets = append(m.Assets, &Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -2199,29 +5519,16 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -2231,11 +5538,28 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -2257,7 +5581,7 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsReq) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2280,13 +5604,45 @@ func (m *PoolsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProviderDataReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProviderDataReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2343,7 +5699,7 @@ func (m *PoolsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRes) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2366,51 +5722,17 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pools = append(m.Pools, &Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: LiquidityProviderDataRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderDataRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -2420,25 +5742,27 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
+			m.LiquidityProviderData = append(m.LiquidityProviderData, &LiquidityProviderData{})
+			if err := m.LiquidityProviderData[len(m.LiquidityProviderData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -2457,7 +5781,7 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2487,7 +5811,7 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2514,7 +5838,7 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2537,10 +5861,10 @@ func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProviderListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProviderListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2577,9 +5901,9 @@ func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -2589,23 +5913,27 @@ func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2628,7 +5956,7 @@ func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2651,15 +5979,15 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProviderListRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProviderListRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2686,18 +6014,16 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LiquidityProvider == nil {
-				m.LiquidityProvider = &LiquidityProvider{}
-			}
-			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -2707,29 +6033,16 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NativeAssetBalance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -2739,43 +6052,28 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalAssetBalance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -2797,7 +6095,7 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetListReq) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2820,44 +6118,12 @@ func (m *AssetListReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetListReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProvidersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProvidersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -2915,7 +6181,7 @@ func (m *AssetListReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetListRes) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2938,15 +6204,15 @@ func (m *AssetListRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetListRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProvidersRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProvidersRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2973,8 +6239,8 @@ func (m *AssetListRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, &Asset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3054,7 +6320,7 @@ func (m *AssetListRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
+func (m *PoolUSDValueReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3077,15 +6343,15 @@ func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderDataReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolUSDValueReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderDataReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolUSDValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3113,43 +6379,7 @@ func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3172,7 +6402,7 @@ func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
+func (m *PoolUSDValueRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3195,17 +6425,17 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderDataRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolUSDValueRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderDataRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolUSDValueRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3215,31 +6445,29 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviderData = append(m.LiquidityProviderData, &LiquidityProviderData{})
-			if err := m.LiquidityProviderData[len(m.LiquidityProviderData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowanPrice", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3249,16 +6477,31 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RowanPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3268,28 +6511,45 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -3311,7 +6571,7 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProviderUSDValueReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3334,10 +6594,10 @@ func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderListReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProviderUSDValueReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProviderUSDValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3374,9 +6634,9 @@ func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3386,27 +6646,23 @@ func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3429,7 +6685,7 @@ func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProviderUSDValueRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3452,17 +6708,17 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderListRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProviderUSDValueRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProviderUSDValueRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3472,31 +6728,29 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
-			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3506,16 +6760,29 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowanPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3525,28 +6792,79 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.RowanPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -3568,7 +6886,7 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
+func (m *TVLReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3591,48 +6909,12 @@ func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProvidersReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: TVLReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProvidersReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TVLReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -3654,7 +6936,7 @@ func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
+func (m *TVLRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3677,17 +6959,17 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProvidersRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: TVLRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProvidersRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TVLRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowanPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3697,31 +6979,31 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
-			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RowanPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3731,16 +7013,31 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3750,28 +7047,11 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -83,7 +83,13 @@ func (m *MsgRemoveLiquidity) GetExternalAsset() *Asset {
 	return nil
 }
 
+// MsgRemoveLiquidityResponse reports the amounts sent back to the liquidity
+// provider, after any asymmetric swap, and the units burned.
 type MsgRemoveLiquidityResponse struct {
+	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
+	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
+	LpUnitsRemoved      github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=lp_units_removed,json=lpUnitsRemoved,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"lp_units_removed" yaml:"lp_units_removed"`
+	LpUnitsLeft         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=lp_units_left,json=lpUnitsLeft,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"lp_units_left" yaml:"lp_units_left"`
 }
 
 func (m *MsgRemoveLiquidityResponse) Reset()         { *m = MsgRemoveLiquidityResponse{} }
@@ -124,6 +130,7 @@ type MsgCreatePool struct {
 	ExternalAsset       *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
 	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
 	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
+	FeeTier             uint64                                  `protobuf:"varint,5,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier,omitempty" yaml:"fee_tier"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
@@ -173,7 +180,18 @@ func (m *MsgCreatePool) GetExternalAsset() *Asset {
 	return nil
 }
 
+func (m *MsgCreatePool) GetFeeTier() uint64 {
+	if m != nil {
+		return m.FeeTier
+	}
+	return 0
+}
+
+// MsgCreatePoolResponse reports the new pool and the units credited to its
+// creator.
 type MsgCreatePoolResponse struct {
+	Pool    Pool                                    `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool" yaml:"pool"`
+	LpUnits github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=lp_units,json=lpUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"lp_units" yaml:"lp_units"`
 }
 
 func (m *MsgCreatePoolResponse) Reset()         { *m = MsgCreatePoolResponse{} }
@@ -209,6 +227,13 @@ func (m *MsgCreatePoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreatePoolResponse proto.InternalMessageInfo
 
+func (m *MsgCreatePoolResponse) GetPool() Pool {
+	if m != nil {
+		return m.Pool
+	}
+	return Pool{}
+}
+
 type MsgAddLiquidity struct {
 	Signer              string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	ExternalAsset       *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
@@ -263,7 +288,12 @@ func (m *MsgAddLiquidity) GetExternalAsset() *Asset {
 	return nil
 }
 
+// MsgAddLiquidityResponse reports the units minted for this deposit and the
+// liquidity provider's resulting total.
 type MsgAddLiquidityResponse struct {
+	LpUnitsAdded           github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=lp_units_added,json=lpUnitsAdded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"lp_units_added" yaml:"lp_units_added"`
+	LiquidityProviderUnits github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_provider_units,json=liquidityProviderUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_provider_units" yaml:"liquidity_provider_units"`
+	PoolUnits              github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=pool_units,json=poolUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"pool_units" yaml:"pool_units"`
 }
 
 func (m *MsgAddLiquidityResponse) Reset()         { *m = MsgAddLiquidityResponse{} }
@@ -305,6 +335,9 @@ type MsgSwap struct {
 	ReceivedAsset      *Asset                                  `protobuf:"bytes,3,opt,name=received_asset,json=receivedAsset,proto3" json:"received_asset,omitempty" yaml:"received_asset"`
 	SentAmount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
 	MinReceivingAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=min_receiving_amount,json=minReceivingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_receiving_amount" yaml:"min_receiving_amount"`
+	// referrer optionally receives referral_fee basis points of the swap output.
+	Referrer    string `protobuf:"bytes,6,opt,name=referrer,proto3" json:"referrer,omitempty" yaml:"referrer"`
+	ReferralFee uint64 `protobuf:"varint,7,opt,name=referral_fee,json=referralFee,proto3" json:"referral_fee,omitempty" yaml:"referral_fee"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -361,7 +394,28 @@ func (m *MsgSwap) GetReceivedAsset() *Asset {
 	return nil
 }
 
+func (m *MsgSwap) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *MsgSwap) GetReferralFee() uint64 {
+	if m != nil {
+		return m.ReferralFee
+	}
+	return 0
+}
+
+// MsgSwapResponse reports the amount received by the signer, the total
+// liquidity fee in the received asset, the price impact of the swap and the
+// amount paid to the referrer.
 type MsgSwapResponse struct {
+	ReceivedAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=received_amount,json=receivedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"received_amount" yaml:"received_amount"`
+	LiquidityFee   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_fee,json=liquidityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_fee" yaml:"liquidity_fee"`
+	PriceImpact    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price_impact" yaml:"price_impact"`
+	ReferralFee    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=referral_fee,json=referralFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"referral_fee" yaml:"referral_fee"`
 }
 
 func (m *MsgSwapResponse) Reset()         { *m = MsgSwapResponse{} }
@@ -485,6 +539,102 @@ func (m *MsgDecommissionPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDecommissionPoolResponse proto.InternalMessageInfo
 
+type MsgUpdatePoolFeeTier struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Symbol  string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	FeeTier uint64 `protobuf:"varint,3,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier,omitempty" yaml:"fee_tier"`
+}
+
+func (m *MsgUpdatePoolFeeTier) Reset()         { *m = MsgUpdatePoolFeeTier{} }
+func (m *MsgUpdatePoolFeeTier) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolFeeTier) ProtoMessage()    {}
+func (*MsgUpdatePoolFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{10}
+}
+func (m *MsgUpdatePoolFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolFeeTier.Merge(m, src)
+}
+func (m *MsgUpdatePoolFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolFeeTier proto.InternalMessageInfo
+
+func (m *MsgUpdatePoolFeeTier) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUpdatePoolFeeTier) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MsgUpdatePoolFeeTier) GetFeeTier() uint64 {
+	if m != nil {
+		return m.FeeTier
+	}
+	return 0
+}
+
+type MsgUpdatePoolFeeTierResponse struct {
+}
+
+func (m *MsgUpdatePoolFeeTierResponse) Reset()         { *m = MsgUpdatePoolFeeTierResponse{} }
+func (m *MsgUpdatePoolFeeTierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolFeeTierResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolFeeTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{11}
+}
+func (m *MsgUpdatePoolFeeTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolFeeTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolFeeTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolFeeTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolFeeTierResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolFeeTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolFeeTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolFeeTierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolFeeTierResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRemoveLiquidity)(nil), "sifnode.clp.v1.MsgRemoveLiquidity")
 	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "sifnode.clp.v1.MsgRemoveLiquidityResponse")
@@ -496,58 +646,87 @@ func init() {
 	proto.RegisterType((*MsgSwapResponse)(nil), "sifnode.clp.v1.MsgSwapResponse")
 	proto.RegisterType((*MsgDecommissionPool)(nil), "sifnode.clp.v1.MsgDecommissionPool")
 	proto.RegisterType((*MsgDecommissionPoolResponse)(nil), "sifnode.clp.v1.MsgDecommissionPoolResponse")
+	proto.RegisterType((*MsgUpdatePoolFeeTier)(nil), "sifnode.clp.v1.MsgUpdatePoolFeeTier")
+	proto.RegisterType((*MsgUpdatePoolFeeTierResponse)(nil), "sifnode.clp.v1.MsgUpdatePoolFeeTierResponse")
 }
 
 func init() { proto.RegisterFile("sifnode/clp/v1/tx.proto", fileDescriptor_a3bff5b30808c4f3) }
 
 var fileDescriptor_a3bff5b30808c4f3 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x52, 0xc4, 0xf0, 0xa0, 0x85, 0x2e, 0x34, 0x2d, 0x0b, 0xb4, 0x64, 0x8c, 0x82, 0x1a,
-	0xdb, 0x80, 0x37, 0x4f, 0xb6, 0x6a, 0x8c, 0xd1, 0x2a, 0x59, 0x62, 0x30, 0x5e, 0xea, 0xb6, 0x1d,
-	0x96, 0x09, 0xdd, 0x99, 0xda, 0x19, 0x0a, 0x3d, 0x98, 0x98, 0xf8, 0x07, 0x3c, 0x1a, 0x7f, 0x8c,
-	0x37, 0x13, 0x8e, 0x1c, 0x8d, 0x87, 0xc6, 0xc0, 0xc9, 0x2b, 0xbf, 0xc0, 0xec, 0xcc, 0xee, 0xd2,
-	0x2d, 0xab, 0xb4, 0x37, 0x0e, 0x9e, 0xda, 0x7d, 0xef, 0x7b, 0xdf, 0xf7, 0xe6, 0x7d, 0x3b, 0x3b,
-	0x03, 0x19, 0x4e, 0x76, 0x28, 0x6b, 0xe0, 0x62, 0xbd, 0xd9, 0x2a, 0x76, 0xd6, 0x8b, 0xe2, 0xb0,
-	0xd0, 0x6a, 0x33, 0xc1, 0xf4, 0xa4, 0x97, 0x28, 0xd4, 0x9b, 0xad, 0x42, 0x67, 0xdd, 0x98, 0xb7,
-	0x99, 0xcd, 0x64, 0xaa, 0xe8, 0xfe, 0x53, 0x28, 0xc3, 0x18, 0x2c, 0xef, 0xb6, 0x30, 0x57, 0x39,
-	0xf4, 0x7b, 0x0c, 0xf4, 0x0a, 0xb7, 0x4d, 0xec, 0xb0, 0x0e, 0x7e, 0x41, 0xde, 0xef, 0x93, 0x06,
-	0x11, 0x5d, 0xfd, 0x36, 0x4c, 0x70, 0x62, 0x53, 0xdc, 0xce, 0x6a, 0x2b, 0xda, 0xda, 0x64, 0x39,
-	0x75, 0xd6, 0xcb, 0x27, 0xba, 0x96, 0xd3, 0x7c, 0x80, 0x54, 0x1c, 0x99, 0x1e, 0x40, 0xdf, 0x86,
-	0x24, 0x3e, 0x14, 0xb8, 0x4d, 0xad, 0x66, 0xd5, 0xe2, 0x1c, 0x8b, 0xec, 0xd8, 0x8a, 0xb6, 0x36,
-	0xb5, 0x91, 0x2e, 0x84, 0x9b, 0x2b, 0x94, 0xdc, 0x64, 0x79, 0xe1, 0xac, 0x97, 0x4f, 0x2b, 0xa6,
-	0x70, 0x19, 0x32, 0x13, 0x7e, 0x40, 0x22, 0x75, 0x07, 0x92, 0x07, 0xd5, 0x9a, 0xc5, 0x09, 0xaf,
-	0xb6, 0x18, 0xa1, 0x82, 0x67, 0xe3, 0xb2, 0x97, 0xa7, 0x47, 0xbd, 0x7c, 0xec, 0x67, 0x2f, 0x7f,
-	0xcb, 0x26, 0x62, 0x77, 0xbf, 0x56, 0xa8, 0x33, 0xa7, 0x58, 0x67, 0xdc, 0x61, 0xdc, 0xfb, 0xb9,
-	0xc7, 0x1b, 0x7b, 0xde, 0x22, 0x9f, 0x51, 0x71, 0xae, 0x17, 0x66, 0x43, 0xe6, 0xf4, 0x41, 0xd9,
-	0x7d, 0xde, 0x94, 0x8f, 0xfa, 0x3b, 0x98, 0xb4, 0x78, 0xd7, 0x71, 0xb0, 0x68, 0x77, 0xb3, 0xe3,
-	0x52, 0xa9, 0x3c, 0xb2, 0xd2, 0xac, 0x52, 0x0a, 0x88, 0x90, 0x79, 0x4e, 0x8a, 0x96, 0xc0, 0xb8,
-	0x38, 0x6a, 0x13, 0xf3, 0x16, 0xa3, 0x1c, 0xa3, 0x2f, 0x71, 0x48, 0x54, 0xb8, 0xfd, 0xa8, 0x8d,
-	0x2d, 0x81, 0x37, 0x19, 0x6b, 0x5e, 0x09, 0x13, 0x3e, 0xc0, 0x1c, 0xb5, 0x04, 0xe9, 0x60, 0x95,
-	0xaf, 0x5a, 0x0e, 0xdb, 0xa7, 0xc2, 0x73, 0xa2, 0xe2, 0xcd, 0x67, 0x75, 0x88, 0xf9, 0xbc, 0x26,
-	0x72, 0x40, 0x86, 0x52, 0x8d, 0xe0, 0x44, 0x66, 0x4a, 0x45, 0xa5, 0x70, 0x49, 0xc6, 0xf4, 0x4f,
-	0x1a, 0xa4, 0xc3, 0x1d, 0xfa, 0x1d, 0x28, 0x87, 0x5e, 0x8d, 0xde, 0xc1, 0x52, 0xd4, 0xba, 0x83,
-	0x1e, 0xe6, 0x42, 0xcb, 0x57, 0x5d, 0xa0, 0x0c, 0xa4, 0x43, 0xce, 0x04, 0x9e, 0x7d, 0x8d, 0xc3,
-	0x4c, 0x85, 0xdb, 0xa5, 0x46, 0xe3, 0x6a, 0x6d, 0x9d, 0xff, 0xae, 0x51, 0x81, 0x16, 0x20, 0x33,
-	0xe0, 0x4d, 0xe0, 0xdb, 0xf7, 0x38, 0x5c, 0xaf, 0x70, 0x7b, 0xeb, 0xc0, 0x6a, 0x8d, 0xe2, 0xd7,
-	0x73, 0x00, 0x8e, 0xa9, 0x18, 0xc6, 0xab, 0xf4, 0x59, 0x2f, 0x9f, 0xf2, 0x58, 0x82, 0x12, 0x64,
-	0x4e, 0xba, 0x0f, 0xca, 0xa3, 0x6d, 0x48, 0xb6, 0x71, 0x1d, 0x93, 0x0e, 0x6e, 0x78, 0x84, 0xf1,
-	0x21, 0xcd, 0x0f, 0x97, 0x21, 0x33, 0xe1, 0x07, 0x14, 0xf1, 0x0e, 0x4c, 0x29, 0xc9, 0xfe, 0x91,
-	0x3f, 0x19, 0x7d, 0xe4, 0x7a, 0x7f, 0xfb, 0xde, 0xa0, 0xe5, 0xfa, 0x3d, 0x97, 0x3f, 0x6a, 0x30,
-	0xef, 0x10, 0x5a, 0x55, 0xea, 0x84, 0xda, 0xbe, 0xe2, 0x35, 0xa9, 0xf8, 0x72, 0x74, 0xc5, 0x45,
-	0xa5, 0x18, 0x45, 0x8a, 0x4c, 0xdd, 0x21, 0xd4, 0xf4, 0xa3, 0x9e, 0xc5, 0x29, 0xb9, 0xfd, 0x5c,
-	0x1b, 0x03, 0x6b, 0xf7, 0x60, 0xae, 0xc2, 0xed, 0xc7, 0xb8, 0xce, 0x1c, 0x87, 0x70, 0x4e, 0x18,
-	0x1d, 0xf5, 0x5b, 0xea, 0x42, 0xbb, 0x4e, 0x8d, 0x35, 0xa5, 0xc3, 0x61, 0xa8, 0x8c, 0xbb, 0x50,
-	0xf5, 0x67, 0x19, 0x16, 0x23, 0xc4, 0xfc, 0x5e, 0x36, 0xbe, 0xc5, 0x21, 0x5e, 0xe1, 0xb6, 0x6e,
-	0xc1, 0xcc, 0xe0, 0x01, 0x8b, 0x06, 0x5d, 0xbe, 0x78, 0x32, 0x18, 0x77, 0x2e, 0xc7, 0xf8, 0x52,
-	0xba, 0x09, 0xd0, 0x77, 0x72, 0x2c, 0x47, 0x54, 0x9e, 0xa7, 0x8d, 0x9b, 0xff, 0x4c, 0x07, 0x9c,
-	0x6f, 0x60, 0x3a, 0xf4, 0x65, 0xcb, 0x47, 0x94, 0xf5, 0x03, 0x8c, 0xd5, 0x4b, 0x00, 0x01, 0xf3,
-	0x43, 0x18, 0x97, 0x7b, 0x2f, 0x13, 0x51, 0xe0, 0x26, 0x8c, 0xfc, 0x5f, 0x12, 0x01, 0x43, 0x03,
-	0x66, 0x2f, 0x78, 0x7c, 0x23, 0xa2, 0x68, 0x10, 0x64, 0xdc, 0x1d, 0x02, 0xe4, 0xab, 0x94, 0x4b,
-	0x47, 0x27, 0x39, 0xed, 0xf8, 0x24, 0xa7, 0xfd, 0x3a, 0xc9, 0x69, 0x9f, 0x4f, 0x73, 0xb1, 0xe3,
-	0xd3, 0x5c, 0xec, 0xc7, 0x69, 0x2e, 0xf6, 0xb6, 0xff, 0xad, 0xde, 0x22, 0x3b, 0xf5, 0x5d, 0x8b,
-	0xd0, 0xa2, 0x7f, 0xcf, 0x3a, 0x94, 0x37, 0x2d, 0xf9, 0x6a, 0xd7, 0x26, 0xe4, 0x3d, 0xeb, 0xfe,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xc0, 0x86, 0xbd, 0xc4, 0x09, 0x00, 0x00,
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x6e, 0x7e, 0x3c, 0xc7, 0x4e, 0xb2, 0x8e, 0x1b, 0x7f, 0xdd, 0xd6, 0x8e, 0xe6,
+	0x0b, 0x34, 0xfc, 0xb2, 0xd5, 0x70, 0xab, 0x84, 0x44, 0x4c, 0x09, 0x04, 0x6a, 0x88, 0xa6, 0x44,
+	0x45, 0x48, 0x68, 0xd9, 0x78, 0xc7, 0xdb, 0x51, 0xf6, 0x17, 0x3b, 0x1b, 0x27, 0x3e, 0x20, 0x21,
+	0xc1, 0x91, 0x03, 0x67, 0xc4, 0x9d, 0x3b, 0x12, 0xff, 0x43, 0xb8, 0xf5, 0x88, 0x38, 0x58, 0x28,
+	0x39, 0x71, 0xcd, 0x1d, 0x09, 0xed, 0xcc, 0xec, 0xda, 0x6b, 0x3b, 0xad, 0x57, 0x15, 0xd0, 0x03,
+	0x27, 0xcf, 0xcc, 0x7b, 0xef, 0xf3, 0xf9, 0xcc, 0xbc, 0xf7, 0xc6, 0x63, 0xc3, 0x06, 0xa3, 0x5d,
+	0xc7, 0x35, 0x48, 0xb3, 0x63, 0x79, 0xcd, 0xde, 0x9d, 0x66, 0x70, 0xda, 0xf0, 0x7c, 0x37, 0x70,
+	0xd5, 0xa2, 0x34, 0x34, 0x3a, 0x96, 0xd7, 0xe8, 0xdd, 0xa9, 0xae, 0x9b, 0xae, 0xe9, 0x72, 0x53,
+	0x33, 0x1c, 0x09, 0xaf, 0x6a, 0x75, 0x3c, 0xbc, 0xef, 0x11, 0x26, 0x6c, 0xe8, 0x8f, 0x0c, 0xa8,
+	0x6d, 0x66, 0x62, 0x62, 0xbb, 0x3d, 0x72, 0x9f, 0x7e, 0x71, 0x4c, 0x0d, 0x1a, 0xf4, 0xd5, 0x97,
+	0x61, 0x9e, 0x51, 0xd3, 0x21, 0x7e, 0x45, 0xd9, 0x54, 0xb6, 0x96, 0x5a, 0x6b, 0x97, 0x83, 0x7a,
+	0xa1, 0xaf, 0xdb, 0xd6, 0x5d, 0x24, 0xd6, 0x11, 0x96, 0x0e, 0xea, 0x43, 0x28, 0x92, 0xd3, 0x80,
+	0xf8, 0x8e, 0x6e, 0x69, 0x3a, 0x63, 0x24, 0xa8, 0x64, 0x36, 0x95, 0xad, 0xfc, 0x76, 0xb9, 0x91,
+	0x14, 0xd7, 0xd8, 0x09, 0x8d, 0xad, 0xff, 0x5d, 0x0e, 0xea, 0x65, 0x81, 0x94, 0x0c, 0x43, 0xb8,
+	0x10, 0x2d, 0x70, 0x4f, 0xd5, 0x86, 0xe2, 0x89, 0x76, 0xa8, 0x33, 0xca, 0x34, 0xcf, 0xa5, 0x4e,
+	0xc0, 0x2a, 0x59, 0xae, 0xe5, 0xdd, 0xb3, 0x41, 0x7d, 0xee, 0xb7, 0x41, 0xfd, 0x25, 0x93, 0x06,
+	0x8f, 0x8e, 0x0f, 0x1b, 0x1d, 0xd7, 0x6e, 0x76, 0x5c, 0x66, 0xbb, 0x4c, 0x7e, 0xbc, 0xce, 0x8c,
+	0x23, 0xb9, 0xc9, 0x3d, 0x27, 0x18, 0xf2, 0x25, 0xd1, 0x10, 0x5e, 0x3e, 0x69, 0x85, 0xf3, 0x7d,
+	0x3e, 0x55, 0x3f, 0x87, 0x25, 0x9d, 0xf5, 0x6d, 0x9b, 0x04, 0x7e, 0xbf, 0x92, 0xe3, 0x4c, 0xad,
+	0xd4, 0x4c, 0xab, 0x82, 0x29, 0x06, 0x42, 0x78, 0x08, 0x8a, 0xbe, 0xc9, 0x41, 0x75, 0xf2, 0xac,
+	0x31, 0x61, 0x9e, 0xeb, 0x30, 0xa2, 0x7e, 0x09, 0x25, 0x47, 0x0f, 0x68, 0x8f, 0x88, 0xf3, 0xd0,
+	0x74, 0xdb, 0x3d, 0x76, 0x02, 0x99, 0x80, 0xb6, 0x94, 0x72, 0x7b, 0x06, 0x29, 0x07, 0x94, 0x6b,
+	0xa9, 0x0a, 0x2d, 0x53, 0x30, 0x11, 0x5e, 0x13, 0xab, 0xfc, 0xa0, 0x77, 0xf8, 0x9a, 0xfa, 0xb5,
+	0x02, 0xe5, 0x64, 0x46, 0x22, 0x05, 0x19, 0xae, 0xe0, 0xa3, 0xf4, 0x0a, 0x6e, 0x4e, 0xcb, 0x73,
+	0xac, 0xa1, 0x94, 0x48, 0xb7, 0x54, 0x11, 0xc0, 0xaa, 0xe5, 0x69, 0xc7, 0x0e, 0x0d, 0x98, 0xe6,
+	0xf3, 0x83, 0x32, 0x64, 0xda, 0xdf, 0x4f, 0xcf, 0xbf, 0x21, 0xf8, 0xc7, 0x01, 0x11, 0x2e, 0x5a,
+	0xde, 0x41, 0xb8, 0x22, 0x52, 0x61, 0xa8, 0x47, 0x50, 0x88, 0x9d, 0x2c, 0xd2, 0x0d, 0x2a, 0xb9,
+	0x44, 0xa5, 0xa5, 0xa0, 0x5c, 0x1f, 0xa3, 0x0c, 0xd1, 0x10, 0xce, 0x4b, 0xbe, 0xfb, 0xe1, 0xec,
+	0x2c, 0x0b, 0x85, 0x36, 0x33, 0xdf, 0xf6, 0x89, 0x1e, 0x90, 0x7d, 0xd7, 0xb5, 0x9e, 0x8b, 0x6e,
+	0xbb, 0xa2, 0xfa, 0xb2, 0xff, 0x7a, 0xf5, 0xe5, 0xfe, 0xc1, 0xea, 0x6b, 0xc0, 0x62, 0x97, 0x10,
+	0x2d, 0xa0, 0xc4, 0xaf, 0x5c, 0xdb, 0x54, 0xb6, 0x72, 0xad, 0xd2, 0xe5, 0xa0, 0xbe, 0x22, 0x80,
+	0x22, 0x0b, 0xc2, 0x0b, 0x5d, 0x42, 0x3e, 0x0e, 0x47, 0x3f, 0x2b, 0x50, 0x4e, 0xa4, 0x32, 0x6e,
+	0xe6, 0x37, 0x21, 0xe7, 0xb9, 0xae, 0xc5, 0x13, 0x9a, 0xdf, 0x5e, 0x1f, 0xcf, 0x4e, 0xe8, 0xdb,
+	0x2a, 0x85, 0x7b, 0xba, 0x1c, 0xd4, 0xf3, 0x02, 0x3f, 0xf4, 0x47, 0x98, 0x87, 0xa9, 0x9f, 0xc1,
+	0x62, 0x54, 0x42, 0x95, 0x4c, 0xe2, 0x2e, 0x4a, 0x71, 0x00, 0x2b, 0xc9, 0x5a, 0x44, 0x78, 0x41,
+	0x96, 0x21, 0xfa, 0x3e, 0x0b, 0x2b, 0x6d, 0x66, 0xee, 0x18, 0xc6, 0xf3, 0x75, 0xe5, 0xff, 0x57,
+	0x84, 0x4e, 0x80, 0xfe, 0xcc, 0xc0, 0xc6, 0x58, 0x72, 0xe2, 0xb2, 0x72, 0xa0, 0x18, 0x5f, 0x2d,
+	0xba, 0x61, 0x10, 0x43, 0x26, 0xeb, 0xbd, 0xf4, 0xca, 0xca, 0x63, 0x37, 0x15, 0x87, 0x43, 0x78,
+	0x59, 0xd6, 0xc8, 0x4e, 0x38, 0x55, 0xbf, 0x55, 0xa0, 0x62, 0x45, 0x2a, 0x34, 0xcf, 0x77, 0x7b,
+	0xd4, 0x20, 0x7e, 0xa2, 0x30, 0x71, 0x7a, 0xea, 0xba, 0xa4, 0xbe, 0x02, 0x18, 0xe1, 0xeb, 0xb1,
+	0x69, 0x5f, 0x5a, 0xb8, 0x26, 0xb5, 0x03, 0x10, 0xb6, 0x87, 0xe4, 0x17, 0x65, 0x71, 0x2f, 0x3d,
+	0xff, 0xda, 0xb0, 0xe1, 0x22, 0xc6, 0xa5, 0x70, 0x22, 0x9a, 0xe3, 0xa7, 0x1c, 0x2c, 0xb4, 0x99,
+	0xf9, 0xe0, 0x44, 0xf7, 0xd2, 0x34, 0xc5, 0x07, 0x00, 0x8c, 0x38, 0xc1, 0x2c, 0x0d, 0x51, 0x1e,
+	0x6a, 0x18, 0x86, 0x20, 0xbc, 0x14, 0x4e, 0x44, 0x23, 0x3c, 0x84, 0xa2, 0x4f, 0x3a, 0x84, 0xf6,
+	0x88, 0x21, 0x01, 0xb3, 0x33, 0x76, 0x58, 0x32, 0x0c, 0xe1, 0x42, 0xb4, 0x20, 0x80, 0xbb, 0x90,
+	0x17, 0x94, 0xa3, 0x75, 0xfd, 0x4e, 0xfa, 0x23, 0x54, 0x47, 0xe5, 0xcb, 0x6a, 0xe6, 0xfb, 0x97,
+	0xad, 0xf4, 0x95, 0x02, 0xeb, 0x36, 0x75, 0x34, 0xc1, 0x4e, 0x1d, 0x33, 0x62, 0xbc, 0xc6, 0x19,
+	0x3f, 0x4c, 0xcf, 0x78, 0x43, 0x30, 0x4e, 0x03, 0x45, 0x58, 0xb5, 0xa9, 0x83, 0xa3, 0x55, 0x29,
+	0xa1, 0x09, 0x8b, 0x3e, 0xe9, 0x12, 0xdf, 0x27, 0x7e, 0x65, 0x9e, 0xb3, 0x8e, 0x5c, 0xe6, 0x91,
+	0x05, 0xe1, 0xd8, 0x49, 0xbd, 0x0b, 0xcb, 0x62, 0xac, 0x5b, 0x5a, 0x97, 0x90, 0xca, 0x02, 0xff,
+	0x06, 0xd8, 0xb8, 0x1c, 0xd4, 0x4b, 0xa3, 0x41, 0xc2, 0x8a, 0x70, 0x3e, 0x9a, 0xee, 0x12, 0x82,
+	0x7e, 0x11, 0x37, 0x6a, 0x58, 0x34, 0x71, 0xb3, 0xfa, 0xb0, 0x32, 0xcc, 0xc6, 0xe8, 0x63, 0x6e,
+	0x2f, 0xfd, 0xee, 0xaf, 0x8f, 0x67, 0x57, 0x6e, 0x3c, 0x2e, 0x13, 0xb9, 0x69, 0x0b, 0x0a, 0xc3,
+	0xb6, 0x0a, 0x37, 0x91, 0x79, 0xd6, 0x97, 0xcc, 0x28, 0x5a, 0x78, 0x3d, 0x44, 0xf3, 0x5d, 0x42,
+	0x54, 0x0a, 0xcb, 0x9e, 0x4f, 0x3b, 0x44, 0xa3, 0xb6, 0xa7, 0x77, 0xa2, 0x8b, 0x7a, 0x37, 0x3d,
+	0x99, 0x3c, 0xe0, 0x51, 0x30, 0x84, 0xf3, 0x7c, 0xba, 0xc7, 0x67, 0x21, 0x55, 0x22, 0x39, 0xb9,
+	0x67, 0xa4, 0x7a, 0x42, 0x2e, 0x8f, 0xa0, 0xd4, 0x66, 0xe6, 0x3d, 0xd2, 0x71, 0x6d, 0x9b, 0x32,
+	0x46, 0x5d, 0x27, 0xed, 0x2b, 0x2d, 0x74, 0xed, 0xdb, 0x87, 0xae, 0x55, 0xc9, 0x4c, 0xb8, 0xf2,
+	0xf5, 0xd0, 0x55, 0x0c, 0x6e, 0xc1, 0x8d, 0x29, 0x64, 0x51, 0x0d, 0xa1, 0x1f, 0x14, 0x58, 0x6f,
+	0x33, 0xf3, 0xc0, 0x33, 0xe4, 0x0b, 0x63, 0x57, 0x3c, 0x3d, 0xfe, 0x1e, 0x35, 0x89, 0x07, 0x50,
+	0x76, 0x86, 0x07, 0x50, 0x0d, 0x6e, 0x4e, 0x53, 0x17, 0xc9, 0xdf, 0xfe, 0x31, 0x07, 0xd9, 0x36,
+	0x33, 0x55, 0x1d, 0x56, 0xc6, 0x7f, 0x62, 0xa2, 0xf1, 0xab, 0x6c, 0xf2, 0xa7, 0x51, 0xf5, 0x95,
+	0xa7, 0xfb, 0xc4, 0xdd, 0x86, 0x01, 0x46, 0x9e, 0xd4, 0xb7, 0xa6, 0x44, 0x0e, 0xcd, 0xd5, 0x17,
+	0x9f, 0x68, 0x8e, 0x31, 0x3f, 0x81, 0xe5, 0xc4, 0x1b, 0xa9, 0x3e, 0x25, 0x6c, 0xd4, 0xa1, 0x7a,
+	0xfb, 0x29, 0x0e, 0x31, 0xf2, 0x5b, 0x90, 0xe3, 0x5f, 0x30, 0x1b, 0x53, 0x02, 0x42, 0x43, 0xb5,
+	0x7e, 0x85, 0x21, 0x46, 0x30, 0x60, 0x75, 0xa2, 0x44, 0xff, 0x3f, 0x25, 0x68, 0xdc, 0xa9, 0xfa,
+	0xea, 0x0c, 0x4e, 0x31, 0x8b, 0x09, 0x6b, 0x93, 0xb5, 0xf7, 0xc2, 0x14, 0x84, 0x09, 0xaf, 0xea,
+	0x6b, 0xb3, 0x78, 0x45, 0x44, 0xad, 0x9d, 0xb3, 0xf3, 0x9a, 0xf2, 0xf8, 0xbc, 0xa6, 0xfc, 0x7e,
+	0x5e, 0x53, 0xbe, 0xbb, 0xa8, 0xcd, 0x3d, 0xbe, 0xa8, 0xcd, 0xfd, 0x7a, 0x51, 0x9b, 0xfb, 0x74,
+	0xb4, 0xb7, 0x1f, 0xd0, 0x6e, 0xe7, 0x91, 0x4e, 0x9d, 0xa6, 0x84, 0x6e, 0x9e, 0xf2, 0x3f, 0x35,
+	0x78, 0x83, 0x1f, 0xce, 0xf3, 0xbf, 0x34, 0xde, 0xf8, 0x6b, 0x00, 0x14, 0x22, 0x89, 0xbc, 0x2f,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -567,6 +746,7 @@ type MsgClient interface {
 	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
 	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
 	DecommissionPool(ctx context.Context, in *MsgDecommissionPool, opts ...grpc.CallOption) (*MsgDecommissionPoolResponse, error)
+	UpdatePoolFeeTier(ctx context.Context, in *MsgUpdatePoolFeeTier, opts ...grpc.CallOption) (*MsgUpdatePoolFeeTierResponse, error)
 }
 
 type msgClient struct {
@@ -622,6 +802,15 @@ func (c *msgClient) DecommissionPool(ctx context.Context, in *MsgDecommissionPoo
 	return out, nil
 }
 
+func (c *msgClient) UpdatePoolFeeTier(ctx context.Context, in *MsgUpdatePoolFeeTier, opts ...grpc.CallOption) (*MsgUpdatePoolFeeTierResponse, error) {
+	out := new(MsgUpdatePoolFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdatePoolFeeTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
@@ -629,6 +818,7 @@ type MsgServer interface {
 	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
 	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
 	DecommissionPool(context.Context, *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error)
+	UpdatePoolFeeTier(context.Context, *MsgUpdatePoolFeeTier) (*MsgUpdatePoolFeeTierResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -650,6 +840,9 @@ func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwap
 func (*UnimplementedMsgServer) DecommissionPool(ctx context.Context, req *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecommissionPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePoolFeeTier(ctx context.Context, req *MsgUpdatePoolFeeTier) (*MsgUpdatePoolFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolFeeTier not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -745,6 +938,24 @@ func _Msg_DecommissionPool_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePoolFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolFeeTier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdatePoolFeeTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolFeeTier(ctx, req.(*MsgUpdatePoolFeeTier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sifnode.clp.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -769,6 +980,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DecommissionPool",
 			Handler:    _Msg_DecommissionPool_Handler,
 		},
+		{
+			MethodName: "UpdatePoolFeeTier",
+			Handler:    _Msg_UpdatePoolFeeTier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sifnode/clp/v1/tx.proto",
@@ -856,6 +1071,46 @@ func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LpUnitsLeft.Size()
+		i -= size
+		if _, err := m.LpUnitsLeft.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LpUnitsRemoved.Size()
+		i -= size
+		if _, err := m.LpUnitsRemoved.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -879,6 +1134,11 @@ func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeTier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeTier))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.ExternalAssetAmount.Size()
 		i -= size
@@ -941,6 +1201,26 @@ func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LpUnits.Size()
+		i -= size
+		if _, err := m.LpUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1026,6 +1306,36 @@ func (m *MsgAddLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PoolUnits.Size()
+		i -= size
+		if _, err := m.PoolUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityProviderUnits.Size()
+		i -= size
+		if _, err := m.LiquidityProviderUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LpUnitsAdded.Size()
+		i -= size
+		if _, err := m.LpUnitsAdded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1049,6 +1359,18 @@ func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReferralFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReferralFee))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.MinReceivingAmount.Size()
 		i -= size
@@ -1123,6 +1445,46 @@ func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReferralFee.Size()
+		i -= size
+		if _, err := m.ReferralFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityFee.Size()
+		i -= size
+		if _, err := m.LiquidityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ReceivedAmount.Size()
+		i -= size
+		if _, err := m.ReceivedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1186,36 +1548,101 @@ func (m *MsgDecommissionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdatePoolFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRemoveLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdatePoolFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.FeeTier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeTier))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.WBasisPoints.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Asymmetry.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolFeeTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRemoveLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WBasisPoints.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Asymmetry.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
 
 func (m *MsgRemoveLiquidityResponse) Size() (n int) {
@@ -1224,6 +1651,14 @@ func (m *MsgRemoveLiquidityResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LpUnitsRemoved.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LpUnitsLeft.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1245,6 +1680,9 @@ func (m *MsgCreatePool) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.ExternalAssetAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.FeeTier != 0 {
+		n += 1 + sovTx(uint64(m.FeeTier))
+	}
 	return n
 }
 
@@ -1254,6 +1692,10 @@ func (m *MsgCreatePoolResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LpUnits.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1284,6 +1726,12 @@ func (m *MsgAddLiquidityResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.LpUnitsAdded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityProviderUnits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PoolUnits.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1309,6 +1757,13 @@ func (m *MsgSwap) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinReceivingAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReferralFee != 0 {
+		n += 1 + sovTx(uint64(m.ReferralFee))
+	}
 	return n
 }
 
@@ -1318,6 +1773,14 @@ func (m *MsgSwapResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.ReceivedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ReferralFee.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1347,6 +1810,35 @@ func (m *MsgDecommissionPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdatePoolFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeTier != 0 {
+		n += 1 + sovTx(uint64(m.FeeTier))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolFeeTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1568,59 +2060,9 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1648,13 +2090,15 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1664,31 +2108,29 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpUnitsRemoved", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1716,13 +2158,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LpUnitsRemoved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpUnitsLeft", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1750,7 +2192,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LpUnitsLeft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1775,57 +2217,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1848,10 +2240,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1990,6 +2382,25 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
+			}
+			m.FeeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2011,7 +2422,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2034,18 +2445,696 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LpUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpUnitsAdded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LpUnitsAdded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityProviderUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
+			}
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFee", wireType)
+			}
+			m.ReferralFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferralFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
@@ -2061,7 +3150,7 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2084,15 +3173,15 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2120,13 +3209,15 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.ReceivedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2136,33 +3227,31 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentAsset == nil {
-				m.SentAsset = &Asset{}
-			}
-			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2172,31 +3261,29 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReceivedAsset == nil {
-				m.ReceivedAsset = &Asset{}
-			}
-			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2224,13 +3311,63 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReferralFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecommissionPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecommissionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2258,9 +3395,39 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2283,7 +3450,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,10 +3473,10 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDecommissionPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDecommissionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2333,7 +3500,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolFeeTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,10 +3523,10 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecommissionPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolFeeTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecommissionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2426,6 +3593,25 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 			}
 			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
+			}
+			m.FeeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2447,7 +3633,7 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolFeeTierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2470,10 +3656,10 @@ func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecommissionPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolFeeTierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecommissionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16,11 +16,36 @@ func NewPool(externalAsset *Asset, nativeAssetBalance, externalAssetBalance, poo
 	pool := Pool{ExternalAsset: externalAsset,
 		NativeAssetBalance:   nativeAssetBalance,
 		ExternalAssetBalance: externalAssetBalance,
-		PoolUnits:            poolUnits}
+		PoolUnits:            poolUnits,
+		NativeLiabilities:    sdk.ZeroUint(),
+		ExternalLiabilities:  sdk.ZeroUint()}
 
 	return pool
 }
 
+// GetNativeLiabilities returns the native asset lent to margin positions, zero for pools stored before it was tracked
+func (p Pool) GetNativeLiabilities() sdk.Uint {
+	if p.NativeLiabilities == (sdk.Uint{}) {
+		return sdk.ZeroUint()
+	}
+	return p.NativeLiabilities
+}
+
+// GetExternalLiabilities returns the external asset lent to margin positions, zero for pools stored before it was
+// tracked
+func (p Pool) GetExternalLiabilities() sdk.Uint {
+	if p.ExternalLiabilities == (sdk.Uint{}) {
+		return sdk.ZeroUint()
+	}
+	return p.ExternalLiabilities
+}
+
+// CoversLiabilities returns whether the balances of the pool still include what it lent to margin positions, that is
+// whether the clp module account holds the part of the balances which is not lent
+func (p Pool) CoversLiabilities() bool {
+	return p.NativeAssetBalance.GTE(p.GetNativeLiabilities()) && p.ExternalAssetBalance.GTE(p.GetExternalLiabilities())
+}
+
 type Pools []Pool
 type LiquidityProviders []LiquidityProvider
 
@@ -55,3 +80,27 @@ func NewLiquidityProviderDataResponse(liquidityProviderData []*LiquidityProvider
 func NewLiquidityProviderData(liquidityProvider LiquidityProvider, nativeBalance string, externalBalance string) LiquidityProviderData {
 	return LiquidityProviderData{LiquidityProvider: &liquidityProvider, NativeAssetBalance: nativeBalance, ExternalAssetBalance: externalBalance}
 }
+
+// SwapQuote is the expected outcome of a swap at the current depth of the pools, as computed by clients before
+// broadcasting a MsgSwap.
+type SwapQuote struct {
+	SentAsset          Asset    `json:"sent_asset" yaml:"sent_asset"`
+	SentAmount         sdk.Uint `json:"sent_amount" yaml:"sent_amount"`
+	ReceivedAsset      Asset    `json:"received_asset" yaml:"received_asset"`
+	ReceivedAmount     sdk.Uint `json:"received_amount" yaml:"received_amount"`
+	MinReceivingAmount sdk.Uint `json:"min_receiving_amount" yaml:"min_receiving_amount"`
+	LiquidityFee       sdk.Uint `json:"liquidity_fee" yaml:"liquidity_fee"`
+	PriceImpact        sdk.Uint `json:"price_impact" yaml:"price_impact"`
+	ReferralFee        sdk.Uint `json:"referral_fee" yaml:"referral_fee"`
+}
+
+// GetMinReceivingAmount returns the least amount out of a swap quoted at receivedAmount that stays within
+// maxSlippage, a percentage between 0 and 100.
+func GetMinReceivingAmount(receivedAmount sdk.Uint, maxSlippage sdk.Dec) (sdk.Uint, error) {
+	if maxSlippage.IsNil() || maxSlippage.IsNegative() || maxSlippage.GT(sdk.NewDec(100)) {
+		return sdk.Uint{}, ErrInvalidSlippage
+	}
+	kept := sdk.OneDec().Sub(maxSlippage.QuoInt64(100))
+	minReceivingAmount := sdk.NewDecFromBigInt(receivedAmount.BigInt()).Mul(kept).TruncateInt()
+	return sdk.NewUintFromBigInt(minReceivingAmount.BigInt()), nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -73,6 +74,16 @@ type Pool struct {
 	NativeAssetBalance   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=native_asset_balance,json=nativeAssetBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_balance" yaml:"native_asset_balance"`
 	ExternalAssetBalance github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=external_asset_balance,json=externalAssetBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_balance" yaml:"external_asset_balance"`
 	PoolUnits            github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=pool_units,json=poolUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"pool_units" yaml:"pool_units"`
+	// fee_tier is the swap fee in basis points charged on the swap output on
+	// top of the slip based liquidity fee, it must be one of the fee tiers
+	// allowed by the clp params.
+	FeeTier uint64 `protobuf:"varint,5,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier,omitempty" yaml:"fee_tier"`
+	// native_liabilities and external_liabilities are the amounts lent to
+	// margin positions, with their accrued interest. They are counted in the
+	// balances but held by no account until the positions repay them, so the
+	// balances can not be withdrawn or swapped out below them.
+	NativeLiabilities   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=native_liabilities,json=nativeLiabilities,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_liabilities" yaml:"native_liabilities"`
+	ExternalLiabilities github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,7,opt,name=external_liabilities,json=externalLiabilities,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_liabilities" yaml:"external_liabilities"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -115,10 +126,20 @@ func (m *Pool) GetExternalAsset() *Asset {
 	return nil
 }
 
+func (m *Pool) GetFeeTier() uint64 {
+	if m != nil {
+		return m.FeeTier
+	}
+	return 0
+}
+
 type LiquidityProvider struct {
 	Asset                    *Asset                                  `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
 	LiquidityProviderUnits   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_provider_units,json=liquidityProviderUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_provider_units" yaml:"liquidity_provider_units"`
 	LiquidityProviderAddress string                                  `protobuf:"bytes,3,opt,name=liquidity_provider_address,json=liquidityProviderAddress,proto3" json:"liquidity_provider_address,omitempty"`
+	// cost_basis is what the liquidity provider deposited into the pool, net of
+	// withdrawals. It is nil for positions created before it was recorded.
+	CostBasis *LiquidityProviderCostBasis `protobuf:"bytes,4,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty" yaml:"cost_basis"`
 }
 
 func (m *LiquidityProvider) Reset()         { *m = LiquidityProvider{} }
@@ -168,6 +189,118 @@ func (m *LiquidityProvider) GetLiquidityProviderAddress() string {
 	return ""
 }
 
+func (m *LiquidityProvider) GetCostBasis() *LiquidityProviderCostBasis {
+	if m != nil {
+		return m.CostBasis
+	}
+	return nil
+}
+
+type LiquidityProviderCostBasis struct {
+	NativeAssetDeposited   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=native_asset_deposited,json=nativeAssetDeposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_deposited" yaml:"native_asset_deposited"`
+	ExternalAssetDeposited github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=external_asset_deposited,json=externalAssetDeposited,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_deposited" yaml:"external_asset_deposited"`
+	// native_value_at_deposit is the value of the deposits in rowan, priced at
+	// the pool ratio at the time of each deposit.
+	NativeValueAtDeposit github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=native_value_at_deposit,json=nativeValueAtDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_value_at_deposit" yaml:"native_value_at_deposit"`
+	// pool_depth_per_unit is sqrt(native * external) / pool units at the time
+	// of the deposits, weighted by the units added. Swap fees make it grow.
+	PoolDepthPerUnit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=pool_depth_per_unit,json=poolDepthPerUnit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_depth_per_unit" yaml:"pool_depth_per_unit"`
+}
+
+func (m *LiquidityProviderCostBasis) Reset()         { *m = LiquidityProviderCostBasis{} }
+func (m *LiquidityProviderCostBasis) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderCostBasis) ProtoMessage()    {}
+func (*LiquidityProviderCostBasis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{3}
+}
+func (m *LiquidityProviderCostBasis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderCostBasis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderCostBasis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityProviderCostBasis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderCostBasis.Merge(m, src)
+}
+func (m *LiquidityProviderCostBasis) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderCostBasis) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderCostBasis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityProviderCostBasis proto.InternalMessageInfo
+
+// ReferrerStats totals the referral fees a referrer earned on swaps.
+type ReferrerStats struct {
+	Referrer   string                                   `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty" yaml:"referrer"`
+	FeesEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees_earned,json=feesEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_earned" yaml:"fees_earned"`
+	SwapCount  uint64                                   `protobuf:"varint,3,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty" yaml:"swap_count"`
+}
+
+func (m *ReferrerStats) Reset()         { *m = ReferrerStats{} }
+func (m *ReferrerStats) String() string { return proto.CompactTextString(m) }
+func (*ReferrerStats) ProtoMessage()    {}
+func (*ReferrerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{4}
+}
+func (m *ReferrerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerStats.Merge(m, src)
+}
+func (m *ReferrerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerStats proto.InternalMessageInfo
+
+func (m *ReferrerStats) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *ReferrerStats) GetFeesEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesEarned
+	}
+	return nil
+}
+
+func (m *ReferrerStats) GetSwapCount() uint64 {
+	if m != nil {
+		return m.SwapCount
+	}
+	return 0
+}
+
 type WhiteList struct {
 	ValidatorList []string `protobuf:"bytes,1,rep,name=validator_list,json=validatorList,proto3" json:"validator_list,omitempty"`
 }
@@ -176,7 +309,7 @@ func (m *WhiteList) Reset()         { *m = WhiteList{} }
 func (m *WhiteList) String() string { return proto.CompactTextString(m) }
 func (*WhiteList) ProtoMessage()    {}
 func (*WhiteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09f92a67752e669, []int{3}
+	return fileDescriptor_a09f92a67752e669, []int{5}
 }
 func (m *WhiteList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +355,7 @@ func (m *LiquidityProviderData) Reset()         { *m = LiquidityProviderData{} }
 func (m *LiquidityProviderData) String() string { return proto.CompactTextString(m) }
 func (*LiquidityProviderData) ProtoMessage()    {}
 func (*LiquidityProviderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a09f92a67752e669, []int{4}
+	return fileDescriptor_a09f92a67752e669, []int{6}
 }
 func (m *LiquidityProviderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,6 +409,8 @@ func init() {
 	proto.RegisterType((*Asset)(nil), "sifnode.clp.v1.Asset")
 	proto.RegisterType((*Pool)(nil), "sifnode.clp.v1.Pool")
 	proto.RegisterType((*LiquidityProvider)(nil), "sifnode.clp.v1.LiquidityProvider")
+	proto.RegisterType((*LiquidityProviderCostBasis)(nil), "sifnode.clp.v1.LiquidityProviderCostBasis")
+	proto.RegisterType((*ReferrerStats)(nil), "sifnode.clp.v1.ReferrerStats")
 	proto.RegisterType((*WhiteList)(nil), "sifnode.clp.v1.WhiteList")
 	proto.RegisterType((*LiquidityProviderData)(nil), "sifnode.clp.v1.LiquidityProviderData")
 }
@@ -283,39 +418,63 @@ func init() {
 func init() { proto.RegisterFile("sifnode/clp/v1/types.proto", fileDescriptor_a09f92a67752e669) }
 
 var fileDescriptor_a09f92a67752e669 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xd6, 0x6d, 0x52, 0x8d, 0x56, 0xa9, 0x56, 0x57, 0x45, 0x45, 0x24, 0x60, 0x09, 0x31,
-	0x09, 0x91, 0xb0, 0xc1, 0x09, 0xed, 0xd2, 0x6a, 0xc7, 0x09, 0x55, 0x46, 0x13, 0x12, 0x97, 0xc8,
-	0x4d, 0xbc, 0xd6, 0xc2, 0x8d, 0x43, 0xec, 0x46, 0xeb, 0x8d, 0x13, 0x27, 0x90, 0xf8, 0x32, 0x7c,
-	0x87, 0x1d, 0xc7, 0x0d, 0x71, 0xa8, 0x50, 0xfb, 0x0d, 0xf8, 0x04, 0x28, 0x76, 0x3a, 0xe8, 0x12,
-	0xa6, 0xf5, 0x14, 0xdb, 0xef, 0xbd, 0xdf, 0xef, 0xf7, 0xfe, 0xe4, 0x81, 0xae, 0x64, 0xe7, 0xb1,
-	0x88, 0xa8, 0x1f, 0xf2, 0xc4, 0xcf, 0x0e, 0x7d, 0x35, 0x4b, 0xa8, 0xf4, 0x92, 0x54, 0x28, 0x01,
-	0x9b, 0x85, 0xcd, 0x0b, 0x79, 0xe2, 0x65, 0x87, 0xdd, 0xf6, 0x48, 0x8c, 0x84, 0x36, 0xf9, 0xf9,
-	0xc9, 0x78, 0x21, 0x17, 0xec, 0xf4, 0xa4, 0xa4, 0x0a, 0x76, 0xc0, 0xae, 0x9c, 0x4d, 0x86, 0x82,
-	0xdb, 0xd6, 0x43, 0xeb, 0xa0, 0x81, 0x8b, 0x1b, 0xfa, 0x56, 0x07, 0xdb, 0x03, 0x21, 0x38, 0x3c,
-	0x06, 0x4d, 0x7a, 0xa1, 0x68, 0x1a, 0x13, 0x1e, 0x90, 0x3c, 0x44, 0x3b, 0xde, 0x3b, 0xda, 0xf7,
-	0xd6, 0x89, 0x3c, 0x8d, 0x87, 0xf7, 0x56, 0xce, 0x06, 0xfe, 0xa3, 0x05, 0xda, 0x31, 0x51, 0x2c,
-	0xa3, 0x26, 0x38, 0x18, 0x12, 0x4e, 0xe2, 0x90, 0xda, 0x5b, 0x39, 0x5b, 0xff, 0xf5, 0xe5, 0xdc,
-	0xad, 0xfd, 0x9c, 0xbb, 0x4f, 0x46, 0x4c, 0x8d, 0xa7, 0x43, 0x2f, 0x14, 0x13, 0x3f, 0x14, 0x72,
-	0x22, 0x64, 0xf1, 0x79, 0x26, 0xa3, 0xf7, 0x45, 0x7a, 0x67, 0x2c, 0x56, 0xbf, 0xe7, 0xee, 0xfd,
-	0x19, 0x99, 0xf0, 0x57, 0xa8, 0x0a, 0x14, 0x61, 0x68, 0x9e, 0x35, 0x77, 0xdf, 0x3c, 0xc2, 0x4f,
-	0x16, 0xe8, 0xac, 0x67, 0x70, 0x2d, 0xa2, 0xae, 0x45, 0x0c, 0x36, 0x17, 0xf1, 0xc0, 0x88, 0xa8,
-	0x86, 0x45, 0xb8, 0xbd, 0x56, 0x84, 0x95, 0x90, 0x10, 0x80, 0x44, 0x08, 0x1e, 0x4c, 0x63, 0xa6,
-	0xa4, 0xbd, 0xad, 0xb9, 0x4f, 0x36, 0xe7, 0x6e, 0x19, 0xee, 0xbf, 0x50, 0x08, 0x37, 0xf2, 0xcb,
-	0x99, 0x3e, 0x7f, 0xd9, 0x02, 0xad, 0x53, 0xf6, 0x61, 0xca, 0x22, 0xa6, 0x66, 0x83, 0x54, 0x64,
-	0x2c, 0xa2, 0x29, 0x7c, 0x0a, 0x76, 0xee, 0xd0, 0x3b, 0xe3, 0x03, 0x3f, 0x5b, 0xc0, 0xe6, 0x2b,
-	0x88, 0x20, 0x29, 0x30, 0x0a, 0xd9, 0xa6, 0x6f, 0x78, 0x73, 0xd9, 0xae, 0x91, 0xfd, 0x3f, 0x60,
-	0x84, 0x3b, 0xfc, 0xa6, 0x6c, 0x9d, 0x11, 0x3c, 0x06, 0xdd, 0x8a, 0x20, 0x12, 0x45, 0x29, 0x95,
-	0xd2, 0xb4, 0x10, 0xdb, 0xa5, 0xd8, 0x9e, 0xb1, 0xa3, 0x23, 0xd0, 0x78, 0x3b, 0x66, 0x8a, 0x9e,
-	0x32, 0xa9, 0xe0, 0x63, 0xd0, 0xcc, 0x08, 0x67, 0x11, 0x51, 0x22, 0x0d, 0x38, 0x93, 0x79, 0x3d,
-	0xea, 0x07, 0x0d, 0xbc, 0x77, 0xfd, 0x9a, 0xbb, 0xa1, 0xef, 0x16, 0xd8, 0x2f, 0xd5, 0xf0, 0x84,
-	0x28, 0x02, 0x07, 0x00, 0x96, 0xb5, 0x14, 0x45, 0x7d, 0x74, 0xb3, 0xa8, 0x25, 0x08, 0xdc, 0x2a,
-	0xc9, 0x84, 0xcf, 0x6f, 0xfb, 0x3f, 0x2a, 0xe7, 0xf9, 0xe5, 0xed, 0xe3, 0x5c, 0x3d, 0x7c, 0xfd,
-	0xde, 0xe5, 0xc2, 0xb1, 0xae, 0x16, 0x8e, 0xf5, 0x6b, 0xe1, 0x58, 0x5f, 0x97, 0x4e, 0xed, 0x6a,
-	0xe9, 0xd4, 0x7e, 0x2c, 0x9d, 0xda, 0xbb, 0x7f, 0x7b, 0xf8, 0x86, 0x9d, 0x87, 0x63, 0xc2, 0x62,
-	0x7f, 0xb5, 0x60, 0x2e, 0xf4, 0x8a, 0xd1, 0x8d, 0x1c, 0xee, 0xea, 0xd5, 0xf1, 0xe2, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xa8, 0x93, 0xbf, 0x36, 0x7e, 0x04, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0xb7, 0x69, 0x77, 0x33, 0x55, 0x0b, 0x9d, 0xb6, 0xc1, 0x1b, 0x44, 0xbc, 0x8c, 0x04,
+	0x44, 0x20, 0x6c, 0x5a, 0xf6, 0x84, 0xf6, 0xd2, 0x34, 0x70, 0x8a, 0x50, 0x98, 0x65, 0x41, 0xe2,
+	0x62, 0x26, 0xf6, 0xa4, 0x19, 0xe1, 0x78, 0x8c, 0x67, 0x12, 0x36, 0xda, 0x0b, 0x70, 0x40, 0x1c,
+	0x38, 0xf0, 0x3b, 0xf8, 0x0d, 0xfc, 0x80, 0x3d, 0x2e, 0x37, 0xc4, 0x21, 0xa0, 0x56, 0xe2, 0x8a,
+	0x94, 0x2b, 0x17, 0x34, 0x33, 0x76, 0x12, 0x27, 0x69, 0x69, 0xd4, 0x93, 0xed, 0xf7, 0xe3, 0x99,
+	0xe7, 0xfd, 0x1c, 0x83, 0xaa, 0x60, 0xdd, 0x98, 0x87, 0xd4, 0x0b, 0xa2, 0xc4, 0x1b, 0x1e, 0x7b,
+	0x72, 0x94, 0x50, 0xe1, 0x26, 0x29, 0x97, 0x1c, 0xee, 0x65, 0x3a, 0x37, 0x88, 0x12, 0x77, 0x78,
+	0x5c, 0x3d, 0x3c, 0xe7, 0xe7, 0x5c, 0xab, 0x3c, 0xf5, 0x66, 0xac, 0xaa, 0x95, 0x80, 0x8b, 0x3e,
+	0x17, 0x5e, 0x87, 0x08, 0xea, 0x05, 0x9c, 0xc5, 0x46, 0x8e, 0x1c, 0xb0, 0x75, 0x2a, 0x04, 0x95,
+	0xb0, 0x02, 0xb6, 0xc5, 0xa8, 0xdf, 0xe1, 0x91, 0x6d, 0x3d, 0xb0, 0xea, 0x65, 0x9c, 0x7d, 0xa1,
+	0xbf, 0xb7, 0x40, 0xa9, 0xcd, 0x79, 0x04, 0x1f, 0x81, 0x3d, 0xfa, 0x54, 0xd2, 0x34, 0x26, 0x91,
+	0x4f, 0x94, 0x8b, 0x36, 0xdc, 0x39, 0x39, 0x72, 0x8b, 0x04, 0x5c, 0x8d, 0x87, 0x77, 0x73, 0x63,
+	0x03, 0xff, 0xad, 0x05, 0x0e, 0x63, 0x22, 0xd9, 0x90, 0x1a, 0x67, 0xbf, 0x43, 0x22, 0x12, 0x07,
+	0xd4, 0xbe, 0xa3, 0x4e, 0x6b, 0x7c, 0xfc, 0x7c, 0xec, 0x6c, 0xfc, 0x31, 0x76, 0xde, 0x3a, 0x67,
+	0xb2, 0x37, 0xe8, 0xb8, 0x01, 0xef, 0x7b, 0x19, 0x63, 0xf3, 0x78, 0x57, 0x84, 0x5f, 0x65, 0x61,
+	0x3f, 0x61, 0xb1, 0x9c, 0x8c, 0x9d, 0x57, 0x47, 0xa4, 0x1f, 0x7d, 0x80, 0x56, 0x81, 0x22, 0x0c,
+	0x8d, 0x58, 0x9f, 0xdd, 0x30, 0x42, 0xf8, 0x83, 0x05, 0x2a, 0xc5, 0x08, 0xa6, 0x24, 0x36, 0x35,
+	0x89, 0xf6, 0xfa, 0x24, 0x5e, 0x33, 0x24, 0x56, 0xc3, 0x22, 0x7c, 0x58, 0x48, 0x42, 0x4e, 0x24,
+	0x00, 0x20, 0xe1, 0x3c, 0xf2, 0x07, 0x31, 0x93, 0xc2, 0x2e, 0xe9, 0xb3, 0x9b, 0xeb, 0x9f, 0xbd,
+	0x6f, 0xce, 0x9e, 0x41, 0x21, 0x5c, 0x56, 0x1f, 0x4f, 0xd4, 0x3b, 0x74, 0xc1, 0xbd, 0x2e, 0xa5,
+	0xbe, 0x64, 0x34, 0xb5, 0xb7, 0x1e, 0x58, 0xf5, 0x52, 0xe3, 0x60, 0x32, 0x76, 0x5e, 0x32, 0x3e,
+	0xb9, 0x06, 0xe1, 0xbb, 0x5d, 0x4a, 0x3f, 0x65, 0x34, 0x85, 0xcf, 0x40, 0x96, 0x33, 0x3f, 0x62,
+	0xa4, 0xc3, 0x22, 0x26, 0x19, 0x15, 0xf6, 0xb6, 0x26, 0xd7, 0x5a, 0x9f, 0xdc, 0xfd, 0x42, 0x75,
+	0xe6, 0x20, 0x11, 0xde, 0x37, 0xc2, 0xd6, 0x4c, 0x06, 0xbf, 0xb3, 0xc0, 0x34, 0x55, 0x85, 0xf3,
+	0xef, 0xde, 0xb2, 0x3b, 0x56, 0x81, 0x22, 0x7c, 0x90, 0x8b, 0xe7, 0x38, 0xa0, 0x7f, 0xee, 0x80,
+	0xfd, 0x16, 0xfb, 0x7a, 0xc0, 0x42, 0x26, 0x47, 0xed, 0x94, 0x0f, 0x59, 0x48, 0x53, 0xf8, 0x0e,
+	0xd8, 0xba, 0x41, 0xb3, 0x1b, 0x1b, 0xf8, 0x93, 0x05, 0xec, 0x28, 0x87, 0xf0, 0x93, 0x0c, 0x23,
+	0xab, 0xb3, 0x69, 0x74, 0xbc, 0x7e, 0x28, 0x8e, 0x09, 0xe5, 0x2a, 0x60, 0x84, 0x2b, 0xd1, 0x22,
+	0x6d, 0xd3, 0x02, 0x8f, 0x40, 0x75, 0x85, 0x13, 0x09, 0xc3, 0x94, 0x0a, 0x61, 0x7a, 0x1e, 0xdb,
+	0x4b, 0xbe, 0xa7, 0x46, 0x0f, 0xbf, 0x04, 0x20, 0xe0, 0x42, 0x35, 0xb3, 0x60, 0xa6, 0x4b, 0x77,
+	0x4e, 0xde, 0x5e, 0x0c, 0x7f, 0x29, 0x61, 0x67, 0x5c, 0xc8, 0x86, 0xf2, 0x68, 0x1c, 0xcd, 0x5a,
+	0x74, 0x86, 0x83, 0x70, 0x39, 0xc8, 0x2d, 0xd0, 0xaf, 0x25, 0x50, 0xbd, 0x1a, 0x40, 0xcf, 0x6b,
+	0x61, 0xba, 0x43, 0x9a, 0x70, 0xc1, 0x24, 0x0d, 0x6d, 0xeb, 0x96, 0xf3, 0xba, 0x1a, 0x16, 0xe1,
+	0xc3, 0xb9, 0xb5, 0xd1, 0xcc, 0xc5, 0xba, 0xac, 0x0b, 0x13, 0x3e, 0xa3, 0x72, 0xdb, 0xb2, 0x5e,
+	0x05, 0x8c, 0x70, 0xa5, 0xb0, 0x3c, 0x66, 0x74, 0x7e, 0xb4, 0xc0, 0x2b, 0x59, 0x00, 0x43, 0x12,
+	0x0d, 0xa8, 0x4f, 0xa6, 0x6e, 0xd9, 0x22, 0xfb, 0x64, 0x7d, 0x36, 0xb5, 0x42, 0x62, 0x16, 0x71,
+	0xa7, 0x99, 0xf9, 0x4c, 0x29, 0x4e, 0x73, 0x32, 0xf0, 0x19, 0x38, 0xd0, 0xeb, 0x27, 0xa4, 0x89,
+	0xec, 0xf9, 0x49, 0xd6, 0x92, 0x76, 0xa9, 0xb0, 0x35, 0xde, 0xbc, 0x01, 0x8b, 0x26, 0x0d, 0x26,
+	0x63, 0xa7, 0x3a, 0xb7, 0xd1, 0x8a, 0x90, 0x08, 0xbf, 0xac, 0xa4, 0x4d, 0x25, 0x6c, 0x9b, 0xfe,
+	0x46, 0xff, 0x5a, 0x60, 0x17, 0xd3, 0x2e, 0x4d, 0x53, 0x9a, 0x3e, 0x96, 0x44, 0x0a, 0xe8, 0x81,
+	0x7b, 0x69, 0x26, 0xc8, 0x5a, 0x64, 0x6e, 0xe7, 0xe5, 0x1a, 0x84, 0xa7, 0x46, 0xf0, 0x7b, 0x0b,
+	0xec, 0x74, 0x29, 0x15, 0x3e, 0x25, 0x69, 0xac, 0x8b, 0xb9, 0x59, 0xdf, 0x39, 0xb9, 0xef, 0x1a,
+	0x7e, 0xae, 0xba, 0x2c, 0xdd, 0xe1, 0x71, 0x87, 0x4a, 0x72, 0xec, 0x9e, 0x71, 0x16, 0x37, 0x3e,
+	0x52, 0x31, 0x4d, 0xc6, 0x0e, 0x9c, 0xee, 0xd1, 0xdc, 0x17, 0xfd, 0xf2, 0xa7, 0x53, 0xbf, 0x41,
+	0xa4, 0x0a, 0x46, 0x60, 0xa0, 0x3c, 0x3f, 0xd4, 0x8e, 0xf0, 0x21, 0x00, 0xe2, 0x1b, 0x92, 0xf8,
+	0x01, 0x1f, 0xc4, 0xa6, 0x82, 0xa5, 0xf9, 0xe1, 0x99, 0xe9, 0x10, 0x2e, 0xab, 0x8f, 0x33, 0xfd,
+	0x7e, 0x02, 0xca, 0x9f, 0xf7, 0x98, 0xa4, 0x2d, 0x26, 0x24, 0x7c, 0x03, 0xec, 0x0d, 0x49, 0xc4,
+	0x42, 0x22, 0x79, 0xea, 0x47, 0x4c, 0xa8, 0x75, 0xb5, 0x59, 0x2f, 0xe3, 0xdd, 0xa9, 0x54, 0x99,
+	0xa1, 0xdf, 0x2c, 0x70, 0xb4, 0x34, 0x70, 0x4d, 0x22, 0x09, 0x6c, 0x03, 0xb8, 0xbc, 0x2a, 0xb2,
+	0x9d, 0xf7, 0xfa, 0xff, 0x0e, 0x3d, 0xde, 0x5f, 0xda, 0x22, 0xf0, 0xbd, 0xeb, 0xee, 0xfb, 0x95,
+	0xf7, 0xf3, 0xc3, 0xeb, 0xaf, 0xe7, 0xd5, 0x97, 0x69, 0xe3, 0xf4, 0xf9, 0x45, 0xcd, 0x7a, 0x71,
+	0x51, 0xb3, 0xfe, 0xba, 0xa8, 0x59, 0x3f, 0x5f, 0xd6, 0x36, 0x5e, 0x5c, 0xd6, 0x36, 0x7e, 0xbf,
+	0xac, 0x6d, 0x7c, 0x31, 0xdf, 0xfd, 0x8f, 0x59, 0x37, 0xe8, 0x11, 0x16, 0x7b, 0xf9, 0x8f, 0xd4,
+	0x53, 0xfd, 0x2b, 0xa5, 0x4b, 0xd2, 0xd9, 0xd6, 0xbf, 0x42, 0xef, 0xff, 0x37, 0x00, 0x37, 0x4e,
+	0x6a, 0x8a, 0x66, 0x09, 0x00, 0x00,
 }
 
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -368,6 +527,31 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExternalLiabilities.Size()
+		i -= size
+		if _, err := m.ExternalLiabilities.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NativeLiabilities.Size()
+		i -= size
+		if _, err := m.NativeLiabilities.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.FeeTier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FeeTier))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.PoolUnits.Size()
 		i -= size
@@ -433,6 +617,18 @@ func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CostBasis != nil {
+		{
+			size, err := m.CostBasis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.LiquidityProviderAddress) > 0 {
 		i -= len(m.LiquidityProviderAddress)
 		copy(dAtA[i:], m.LiquidityProviderAddress)
@@ -465,6 +661,118 @@ func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LiquidityProviderCostBasis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderCostBasis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderCostBasis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PoolDepthPerUnit.Size()
+		i -= size
+		if _, err := m.PoolDepthPerUnit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NativeValueAtDeposit.Size()
+		i -= size
+		if _, err := m.NativeValueAtDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExternalAssetDeposited.Size()
+		i -= size
+		if _, err := m.ExternalAssetDeposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NativeAssetDeposited.Size()
+		i -= size
+		if _, err := m.NativeAssetDeposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferrerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferrerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferrerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeesEarned) > 0 {
+		for iNdEx := len(m.FeesEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WhiteList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -586,6 +894,13 @@ func (m *Pool) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.PoolUnits.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.FeeTier != 0 {
+		n += 1 + sovTypes(uint64(m.FeeTier))
+	}
+	l = m.NativeLiabilities.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ExternalLiabilities.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -605,6 +920,49 @@ func (m *LiquidityProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.CostBasis != nil {
+		l = m.CostBasis.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderCostBasis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NativeAssetDeposited.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ExternalAssetDeposited.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.NativeValueAtDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PoolDepthPerUnit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ReferrerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.FeesEarned) > 0 {
+		for _, e := range m.FeesEarned {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SwapCount != 0 {
+		n += 1 + sovTypes(uint64(m.SwapCount))
+	}
 	return n
 }
 
@@ -899,22 +1257,109 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.FeeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeLiabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			if err := m.NativeLiabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalLiabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalLiabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -1051,6 +1496,363 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.LiquidityProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBasis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CostBasis == nil {
+				m.CostBasis = &LiquidityProviderCostBasis{}
+			}
+			if err := m.CostBasis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderCostBasis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderCostBasis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderCostBasis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetDeposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetDeposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetDeposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetDeposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeValueAtDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeValueAtDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDepthPerUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolDepthPerUnit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesEarned = append(m.FeesEarned, types.Coin{})
+			if err := m.FeesEarned[len(m.FeesEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
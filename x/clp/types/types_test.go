@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMinReceivingAmount(t *testing.T) {
+	receivedAmount := sdk.NewUint(1000000)
+	for _, tc := range []struct {
+		maxSlippage string
+		expected    sdk.Uint
+	}{
+		{"0", sdk.NewUint(1000000)},
+		{"0.5", sdk.NewUint(995000)},
+		{"1.23456", sdk.NewUint(987654)},
+		{"100", sdk.ZeroUint()},
+	} {
+		minReceivingAmount, err := GetMinReceivingAmount(receivedAmount, sdk.MustNewDecFromStr(tc.maxSlippage))
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected.String(), minReceivingAmount.String(), tc.maxSlippage)
+	}
+	_, err := GetMinReceivingAmount(receivedAmount, sdk.MustNewDecFromStr("-1"))
+	assert.ErrorIs(t, err, ErrInvalidSlippage)
+	_, err = GetMinReceivingAmount(receivedAmount, sdk.MustNewDecFromStr("100.1"))
+	assert.ErrorIs(t, err, ErrInvalidSlippage)
+}
@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/Sifchain/sifnode/x/dispensation/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding dispensation type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.DistributionRecordPrefixPending),
+			bytes.Equal(kvA.Key[:1], types.DistributionRecordPrefixCompleted),
+			bytes.Equal(kvA.Key[:1], types.DistributionRecordPrefixFailed):
+			var recordA, recordB types.DistributionRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.DistributionsPrefix):
+			var distributionA, distributionB types.Distribution
+			cdc.MustUnmarshal(kvA.Value, &distributionA)
+			cdc.MustUnmarshal(kvB.Value, &distributionB)
+			return fmt.Sprintf("%v\n%v", distributionA, distributionB)
+		case bytes.Equal(kvA.Key[:1], types.UserClaimPrefix):
+			var claimA, claimB types.UserClaim
+			cdc.MustUnmarshal(kvA.Value, &claimA)
+			cdc.MustUnmarshal(kvB.Value, &claimB)
+			return fmt.Sprintf("%v\n%v", claimA, claimB)
+		default:
+			panic(fmt.Sprintf("invalid dispensation key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/Sifchain/sifnode/x/dispensation/types"
+)
+
+// Simulation parameter constants
+const (
+	Claims = "claims"
+)
+
+// ClaimTypes are the distribution types users can claim
+var ClaimTypes = []types.DistributionType{
+	types.DistributionType_DISTRIBUTION_TYPE_LIQUIDITY_MINING,
+	types.DistributionType_DISTRIBUTION_TYPE_VALIDATOR_SUBSIDY,
+}
+
+// GenClaims randomized user claims, each account has a one in four chance to
+// have claimed each of the claim types.
+func GenClaims(simState *module.SimulationState) []*types.UserClaim {
+	var claims []*types.UserClaim
+	for _, acc := range simState.Accounts {
+		for _, claimType := range ClaimTypes {
+			if simState.Rand.Intn(4) != 0 {
+				continue
+			}
+			claim, err := types.NewUserClaim(acc.Address.String(), claimType, simState.GenTimestamp)
+			if err != nil {
+				panic(err)
+			}
+			claims = append(claims, &claim)
+		}
+	}
+	return claims
+}
+
+// RandomizedGenState generates a random GenesisState for dispensation. There
+// are no distributions at genesis, those would need funds in the module account.
+func RandomizedGenState(simState *module.SimulationState) {
+	var claims []*types.UserClaim
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Claims, &claims, simState.Rand,
+		func(_ *rand.Rand) { claims = GenClaims(simState) },
+	)
+
+	dispensationGenesis := types.GenesisState{
+		DistributionRecords: &types.DistributionRecords{},
+		Distributions:       &types.Distributions{},
+		Claims:              &types.UserClaims{UserClaims: claims},
+	}
+	fmt.Printf("Selected %d randomly generated dispensation user claims\n", len(claims))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&dispensationGenesis)
+}
@@ -0,0 +1,175 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Sifchain/sifnode/x/dispensation/keeper"
+	"github.com/Sifchain/sifnode/x/dispensation/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateDistribution = "op_weight_msg_create_distribution"
+	OpWeightMsgRunDistribution    = "op_weight_msg_run_distribution"
+	OpWeightMsgCreateUserClaim    = "op_weight_msg_create_user_claim"
+
+	DefaultWeightMsgCreateDistribution = 10
+	DefaultWeightMsgRunDistribution    = 30
+	DefaultWeightMsgCreateUserClaim    = 20
+)
+
+// TransactionFee is paid in rowan by every simulated dispensation transaction,
+// the ante handler rejects user claims paying less than 0.1 rowan.
+var TransactionFee = sdk.NewCoins(sdk.NewCoin("rowan", sdk.NewIntWithDecimal(1, 17)))
+
+// DistributionTypes are the distribution types distributors can create
+var DistributionTypes = []types.DistributionType{
+	types.DistributionType_DISTRIBUTION_TYPE_AIRDROP,
+	types.DistributionType_DISTRIBUTION_TYPE_LIQUIDITY_MINING,
+	types.DistributionType_DISTRIBUTION_TYPE_VALIDATOR_SUBSIDY,
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateDistribution int
+		weightMsgRunDistribution    int
+		weightMsgCreateUserClaim    int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDistribution, &weightMsgCreateDistribution, nil,
+		func(_ *rand.Rand) { weightMsgCreateDistribution = DefaultWeightMsgCreateDistribution },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRunDistribution, &weightMsgRunDistribution, nil,
+		func(_ *rand.Rand) { weightMsgRunDistribution = DefaultWeightMsgRunDistribution },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateUserClaim, &weightMsgCreateUserClaim, nil,
+		func(_ *rand.Rand) { weightMsgCreateUserClaim = DefaultWeightMsgCreateUserClaim },
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateDistribution, SimulateMsgCreateDistribution(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRunDistribution, SimulateMsgRunDistribution(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgCreateUserClaim, SimulateMsgCreateUserClaim(ak, bk, k)),
+	}
+}
+
+// SimulateMsgCreateDistribution generates a MsgCreateDistribution of random
+// funds of a random distributor to a few random recipients.
+func SimulateMsgCreateDistribution(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgCreateDistribution{}.Type()
+		distributor, _ := simtypes.RandomAcc(r, accs)
+		runner, _ := simtypes.RandomAcc(r, accs)
+		budget, hasNeg := bk.SpendableCoins(ctx, distributor.Address).SafeSub(TransactionFee)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough rowan to pay fees"), nil, nil
+		}
+		n := simtypes.RandIntBetween(r, 1, 6)
+		if n > len(accs) {
+			n = len(accs)
+		}
+		var outputs []banktypes.Output
+		for _, idx := range r.Perm(len(accs))[:n] {
+			coins := simtypes.RandSubsetCoins(r, budget)
+			if coins.Empty() {
+				continue
+			}
+			budget = budget.Sub(coins)
+			outputs = append(outputs, banktypes.NewOutput(accs[idx].Address, coins))
+		}
+		if len(outputs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "nothing to distribute"), nil, nil
+		}
+		distributionType := DistributionTypes[r.Intn(len(DistributionTypes))]
+		msg := types.NewMsgCreateDistribution(distributor.Address, distributionType, outputs, runner.Address.String())
+		return deliver(r, app, ctx, ak, bk, k, distributor, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.CreateDistribution(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgRunDistribution generates a MsgRunDistribution for the
+// distribution of a random pending record, signed by its authorized runner.
+func SimulateMsgRunDistribution(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgRunDistribution{}.Type()
+		records := k.GetLimitedRecordsForStatus(ctx, types.DistributionStatus_DISTRIBUTION_STATUS_PENDING).DistributionRecords
+		if len(records) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pending distribution records"), nil, nil
+		}
+		record := records[r.Intn(len(records))]
+		runnerAddress, err := sdk.AccAddressFromBech32(record.AuthorizedRunner)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid runner address"), nil, err
+		}
+		runner, found := simtypes.FindAccount(accs, runnerAddress)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "runner is not a simulation account"), nil, nil
+		}
+		msg := types.NewMsgRunDistribution(record.AuthorizedRunner, record.DistributionName, record.DistributionType)
+		return deliver(r, app, ctx, ak, bk, k, runner, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.RunDistribution(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgCreateUserClaim generates a MsgCreateUserClaim of a random claim
+// type for a random account.
+func SimulateMsgCreateUserClaim(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		claimType := ClaimTypes[r.Intn(len(ClaimTypes))]
+		msg := types.NewMsgCreateUserClaim(simAccount.Address, claimType)
+		return deliver(r, app, ctx, ak, bk, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.CreateUserClaim(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// deliver runs the msg against a cached copy of the state first, after taking
+// the fee, and only delivers transactions the msg server accepts. Rejected msgs
+// are reported as no-ops.
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	k keeper.Keeper, simAccount simtypes.Account, msg legacytx.LegacyMsg, check func(types.MsgServer, sdk.Context) error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	err := bk.SendCoinsFromAccountToModule(cacheCtx, simAccount.Address, authtypes.FeeCollectorName, TransactionFee)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "not enough rowan to pay fees"), nil, nil
+	}
+	err = check(keeper.NewMsgServerImpl(k), cacheCtx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+	}
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           nil,
+		Msg:           msg,
+		MsgType:       msg.Type(),
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	}
+	return simulation.GenAndDeliverTx(txCtx, TransactionFee)
+}
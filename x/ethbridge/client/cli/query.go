@@ -95,3 +95,464 @@ func GetCmdGetBlacklist() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdGetNetworks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "networks",
+		Short: "Query the registered EVM networks",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetNetworks(context.Background(), &types.QueryNetworksRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdGetNetworkPeggyTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "network-peggy-tokens [ethereum-chain-id]",
+		Short: "Query the pegged denoms minted for tokens locked on an EVM network",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ethereumChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNetworkPeggyTokensRequest{EthereumChainId: ethereumChainID}
+
+			res, err := queryClient.GetNetworkPeggyTokens(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdGetTransferUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-usage [denom]",
+		Short: "Query the bridge volume of a denom counted against its rolling transfer limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetTransferUsage(context.Background(), &types.QueryTransferUsageRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdGetPauses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pauses",
+		Short: "Query the paused bridge transfers",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetPauses(context.Background(), &types.QueryPausesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdGetOutboundTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-transfers [cosmos-sender]",
+		Short: "Query the locks and burns of a cosmos sender and their relay status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetOutboundTransfers(context.Background(), &types.QueryOutboundTransfersRequest{
+				CosmosSender: args[0],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outbound-transfers")
+
+	return cmd
+}
+
+func GetCmdGetOutboundTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-transfer [cosmos-sender] [cosmos-sender-sequence]",
+		Short: "Query a lock or burn by its cosmos sender and sequence",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetOutboundTransfer(context.Background(), &types.QueryOutboundTransferRequest{
+				CosmosSender:         args[0],
+				CosmosSenderSequence: sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdGetUnclaimedInbounds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unclaimed-inbounds [cosmos-receiver]",
+		Short: "Query the escrowed coins of claims that could not be sent to their receiver, of all receivers when omitted",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnclaimedInboundsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.CosmosReceiver = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetUnclaimedInbounds(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unclaimed-inbounds")
+
+	return cmd
+}
+
+func GetCmdGetBlacklistEntries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklist-entries",
+		Short: "Query the blacklisted addresses with their reason and expiry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetBlacklistEntries(context.Background(), &types.QueryBlacklistEntriesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blacklist-entries")
+
+	return cmd
+}
+
+func GetCmdGetCrossChainFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-chain-fee [ethereum-chain-id]",
+		Short: "Query the minimum ceth_amount of locks and burns to an EVM network",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ethereumChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetCrossChainFee(context.Background(), &types.QueryCrossChainFeeRequest{EthereumChainId: ethereumChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdGetNftClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-classes",
+		Short: "Query the ERC-721 contracts mirrored on sifchain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetNftClasses(context.Background(), &types.QueryNftClassesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nft-classes")
+
+	return cmd
+}
+
+func GetCmdGetNfts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts [class-id] [owner]",
+		Short: "Query the NFTs of a class, of all owners when the owner is omitted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryNftsRequest{ClassId: args[0], Pagination: pageReq}
+			if len(args) > 1 {
+				req.Owner = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetNfts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+
+	return cmd
+}
+
+func GetCmdGetAdminRoleHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-role-holders [role]",
+		Short: "Query the accounts holding an admin role (validator-set, fee, compliance or rescuer), of all roles when the role is omitted",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAdminRoleHoldersRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.Role, err = types.ParseAdminRole(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetAdminRoleHolders(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "admin-role-holders")
+
+	return cmd
+}
+
+func GetCmdGetClaimRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claims",
+		Short: "Query the history of the prophecies of lock and burn claims, oldest first",
+		Long: `Query the history of the prophecies of lock and burn claims, oldest first. Filter them by cosmos receiver,
+ethereum sender and status (pending, success or failed):
+
+$ sifnoded q ethbridge claims --cosmos-receiver=sif1... --status=pending`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClaimRecordsRequest{Pagination: pageReq}
+			req.CosmosReceiver, err = cmd.Flags().GetString(types.FlagCosmosReceiver)
+			if err != nil {
+				return err
+			}
+			req.EthereumSender, err = cmd.Flags().GetString(types.FlagEthereumSender)
+			if err != nil {
+				return err
+			}
+			status, err := cmd.Flags().GetString(types.FlagStatus)
+			if err != nil {
+				return err
+			}
+			if status != "" {
+				req.Status, err = types.ParseClaimStatus(status)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetClaimRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(types.FlagCosmosReceiver, "", "Only the claims of a cosmos receiver")
+	cmd.Flags().String(types.FlagEthereumSender, "", "Only the claims of an ethereum sender")
+	cmd.Flags().String(types.FlagStatus, "", "Only the claims with a status: pending, success or failed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claims")
+
+	return cmd
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/spf13/cobra"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 )
@@ -337,8 +339,8 @@ func GetCmdUpdateCethReceiverAccount() *cobra.Command {
 // GetCmdRescueCeth is the CLI command to send the message to transfer ceth from ethbridge module to account
 func GetCmdRescueCeth() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "rescue_ceth [cosmos-sender-address] [ceth_receiver_account] [ceth_amount]",
-		Short: "This should be used to send ceth from ethbridge to an account.",
+		Use:   "rescue_ceth [cosmos-sender-address] [ceth_receiver_account] [ceth_amount] --ethereum-chain-id [ethereum-chain-id]",
+		Short: "This should be used to send ceth, or the native token of another network, from ethbridge to an account.",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -346,6 +348,16 @@ func GetCmdRescueCeth() *cobra.Command {
 				return err
 			}
 
+			ethereumChainIDStr, err := cmd.Flags().GetString(types.FlagEthereumChainID)
+			if err != nil {
+				return err
+			}
+
+			ethereumChainID, err := strconv.Atoi(ethereumChainIDStr)
+			if err != nil {
+				return err
+			}
+
 			cosmosSender, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
@@ -361,7 +373,7 @@ func GetCmdRescueCeth() *cobra.Command {
 				return errors.New("Error parsing ceth amount")
 			}
 
-			msg := types.NewMsgRescueCeth(cosmosSender, cethReceiverAccount, cethAmount)
+			msg := types.NewMsgRescueCeth(int64(ethereumChainID), cosmosSender, cethReceiverAccount, cethAmount)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -417,3 +429,442 @@ func GetCmdSetBlacklist() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSetNetwork is the CLI command to register an EVM network
+func GetCmdSetNetwork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-network [ethereum-chain-id] [name] [bridge-contract-address] [denom-prefix] [native-token]",
+		Short: "Register an EVM network with its bridge contract, the prefix of its pegged denoms and the pegged denom of its gas token.",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ethereumChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[2]) {
+				return errors.Errorf("invalid bridge contract address: %v", args[2])
+			}
+
+			outboundGas, err := cmd.Flags().GetUint64(types.FlagOutboundGas)
+			if err != nil {
+				return err
+			}
+
+			network := types.NewNetwork(ethereumChainID, args[1], types.NewEthereumAddress(args[2]), args[3], args[4])
+			network.OutboundGas = outboundGas
+			msg := types.NewMsgSetNetwork(clientCtx.GetFromAddress(), network)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(types.FlagOutboundGas, 0, "gas relayers spend delivering a lock or burn to the network, zero for no minimum cross-chain fee")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetPause is the CLI command to pause or resume bridge transfers
+func GetCmdSetPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pause [outbound] [inbound] [symbol]",
+		Short: "Pause or resume outbound locks and burns and inbound claims of a symbol, or of all symbols when the symbol is omitted.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pause, err := parsePauseArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPause(clientCtx.GetFromAddress(), pause)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitSetPauseProposal implements the command to submit a proposal pausing or resuming bridge transfers
+func GetCmdSubmitSetPauseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bridge-pause [outbound] [inbound] [symbol]",
+		Short: "Submit a proposal to pause or resume bridge transfers of a symbol, or of all symbols when the symbol is omitted",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pause, err := parsePauseArgs(args)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPauseProposal(title, description, pause)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func parsePauseArgs(args []string) (types.Pause, error) {
+	outbound, err := strconv.ParseBool(args[0])
+	if err != nil {
+		return types.Pause{}, err
+	}
+	inbound, err := strconv.ParseBool(args[1])
+	if err != nil {
+		return types.Pause{}, err
+	}
+	var symbol string
+	if len(args) > 2 {
+		symbol = args[2]
+	}
+	return types.NewPause(symbol, outbound, inbound), nil
+}
+
+// GetCmdReportOutboundTransfer is the CLI command for a relayer to report the ethereum transaction of an outbound transfer
+func GetCmdReportOutboundTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-outbound-transfer [cosmos-sender] [cosmos-sender-sequence] [ethereum-tx-hash]",
+		Short: "Report the ethereum transaction relaying a lock or burn, signed by a whitelisted validator",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReportOutboundTransfer(sdk.ValAddress(clientCtx.GetFromAddress()), args[0], sequence, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRefundOutboundTransfer is the CLI command for the admin to refund an outbound transfer that timed out
+func GetCmdRefundOutboundTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-outbound-transfer [cosmos-sender] [cosmos-sender-sequence]",
+		Short: "Refund a lock or burn that was not relayed to ethereum within the refund timeout",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundOutboundTransfer(clientCtx.GetFromAddress(), args[0], sequence)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRedirectUnclaimedInbound is the CLI command to send escrowed inbound coins to a recipient
+func GetCmdRedirectUnclaimedInbound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redirect-unclaimed-inbound [id] [recipient]",
+		Short: "Send the escrowed coins of a claim that could not be delivered to a recipient, signed by their intended receiver or the admin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedirectUnclaimedInbound(clientCtx.GetFromAddress(), id, recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAddToBlacklist is the CLI command to add addresses to the blacklist with a reason
+func GetCmdAddToBlacklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-blacklist [reason] [address]...",
+		Short: "Add addresses to the blacklist with a reason, signed by the admin",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := cmd.Flags().GetInt64(types.FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddToBlacklist(clientCtx.GetFromAddress(), args[1:], args[0], expiryHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Int64(types.FlagExpiryHeight, 0, "height the entries expire at, zero for never")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveFromBlacklist is the CLI command to remove addresses from the blacklist with a reason
+func GetCmdRemoveFromBlacklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-from-blacklist [reason] [address]...",
+		Short: "Remove addresses from the blacklist with a reason, signed by the admin",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFromBlacklist(clientCtx.GetFromAddress(), args[1:], args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdReportGasPrice is the CLI command for a relayer to report the gas price of a network
+func GetCmdReportGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-gas-price [validator-address] [ethereum-chain-id] [gas-price]",
+		Short: "Report the gas price of an EVM network, in the smallest unit of its native token, signed by a whitelisted relayer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			ethereumChainID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			gasPrice, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return errors.Errorf("invalid gas price: %v", args[2])
+			}
+
+			msg := types.NewMsgReportGasPrice(validatorAddress, ethereumChainID, gasPrice)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBurnNft is the CLI command for burning an NFT minted by a claim to unlock its token on its network
+func GetCmdBurnNft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-nft [class-id] [token-id] [ethereum-receiver-address] [cethAmount]",
+		Short: "Burn an NFT bridged from an EVM network so that its ERC-721 token is unlocked to the ethereum receiver",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[2]) {
+				return errors.Errorf("invalid [ethereum-receiver-address]: %s", args[2])
+			}
+			ethereumReceiver := types.NewEthereumAddress(args[2])
+
+			cethAmount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return errors.New("Error parsing ceth amount")
+			}
+
+			msg := types.NewMsgBurnNft(clientCtx.GetFromAddress(), args[0], args[1], ethereumReceiver, cethAmount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitSetAdminRoleProposal implements the command to submit a proposal granting or revoking an admin role
+func GetCmdSubmitSetAdminRoleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bridge-admin-role [role] [address] [revoke]",
+		Short: "Submit a proposal to grant an admin role (validator-set, fee, compliance or rescuer) to an account, or to revoke it when revoke is true",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseAdminRole(args[0])
+			if err != nil {
+				return err
+			}
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			revoke, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetAdminRoleProposal(title, description, types.NewAdminRoleGrant(role, address), revoke)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -25,7 +25,11 @@ func GetQueryCmd() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(ethBridgeQueryCmd)
 
-	ethBridgeQueryCmd.AddCommand(cli.GetCmdGetEthBridgeProphecy(), cli.GetCmdGetBlacklist())
+	ethBridgeQueryCmd.AddCommand(cli.GetCmdGetEthBridgeProphecy(), cli.GetCmdGetBlacklist(),
+		cli.GetCmdGetNetworks(), cli.GetCmdGetNetworkPeggyTokens(), cli.GetCmdGetTransferUsage(), cli.GetCmdGetPauses(),
+		cli.GetCmdGetOutboundTransfers(), cli.GetCmdGetOutboundTransfer(), cli.GetCmdGetUnclaimedInbounds(),
+		cli.GetCmdGetBlacklistEntries(), cli.GetCmdGetCrossChainFee(), cli.GetCmdGetNftClasses(), cli.GetCmdGetNfts(),
+		cli.GetCmdGetAdminRoleHolders(), cli.GetCmdGetClaimRecords())
 
 	return ethBridgeQueryCmd
 }
@@ -50,6 +54,15 @@ func GetTxCmd() *cobra.Command {
 		cli.GetCmdUpdateCethReceiverAccount(),
 		cli.GetCmdRescueCeth(),
 		cli.GetCmdSetBlacklist(),
+		cli.GetCmdSetNetwork(),
+		cli.GetCmdSetPause(),
+		cli.GetCmdReportOutboundTransfer(),
+		cli.GetCmdRefundOutboundTransfer(),
+		cli.GetCmdRedirectUnclaimedInbound(),
+		cli.GetCmdAddToBlacklist(),
+		cli.GetCmdRemoveFromBlacklist(),
+		cli.GetCmdReportGasPrice(),
+		cli.GetCmdBurnNft(),
 	)
 
 	return ethBridgeTxCmd
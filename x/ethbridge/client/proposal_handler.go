@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/client/cli"
+	"github.com/Sifchain/sifnode/x/ethbridge/client/rest"
+)
+
+// SetPauseProposalHandler is the governance client handler for bridge pause proposals
+var SetPauseProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetPauseProposal, rest.SetPauseProposalRESTHandler)
+
+// SetAdminRoleProposalHandler is the governance client handler for bridge admin role proposals
+var SetAdminRoleProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetAdminRoleProposal, rest.SetAdminRoleProposalRESTHandler)
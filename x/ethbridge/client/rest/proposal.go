@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+// SetPauseProposalReq defines a set pause proposal request body
+type SetPauseProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Deposit     sdk.Coins    `json:"deposit"`
+	Symbol      string       `json:"symbol"`   // Symbol to pause, empty to pause all symbols
+	Outbound    bool         `json:"outbound"` // Pause locks and burns
+	Inbound     bool         `json:"inbound"`  // Pause claims
+}
+
+// SetPauseProposalRESTHandler returns the governance REST handler for bridge pause proposals
+func SetPauseProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_bridge_pause",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetPauseProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			baseReq := req.BaseReq.Sanitize()
+			if !baseReq.ValidateBasic(w) {
+				return
+			}
+			from, err := sdk.AccAddressFromBech32(baseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			content := types.NewSetPauseProposal(req.Title, req.Description, types.NewPause(req.Symbol, req.Outbound, req.Inbound))
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, from)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+			tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+		},
+	}
+}
+
+// SetAdminRoleProposalReq defines a set admin role proposal request body
+type SetAdminRoleProposalReq struct {
+	BaseReq     rest.BaseReq    `json:"base_req"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Deposit     sdk.Coins       `json:"deposit"`
+	Role        types.AdminRole `json:"role"`
+	Address     string          `json:"address"`
+	Revoke      bool            `json:"revoke"` // Revoke the role instead of granting it
+}
+
+// SetAdminRoleProposalRESTHandler returns the governance REST handler for bridge admin role proposals
+func SetAdminRoleProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_bridge_admin_role",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetAdminRoleProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			baseReq := req.BaseReq.Sanitize()
+			if !baseReq.ValidateBasic(w) {
+				return
+			}
+			from, err := sdk.AccAddressFromBech32(baseReq.From)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			grant := types.AdminRoleGrant{Role: req.Role, Address: req.Address}
+			content := types.NewSetAdminRoleProposal(req.Title, req.Description, grant, req.Revoke)
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, from)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+			tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+		},
+	}
+}
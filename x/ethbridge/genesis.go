@@ -2,6 +2,7 @@ package ethbridge
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
@@ -29,6 +30,68 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 		}
 	}
 
+	for _, network := range data.Networks {
+		keeper.SetNetwork(ctx, network)
+	}
+
+	for _, token := range data.NetworkPeggyTokens {
+		keeper.AddNetworkPeggyToken(ctx, token.EthereumChainId, token.Denom)
+	}
+
+	for _, usage := range data.TransferUsages {
+		keeper.SetTransferUsage(ctx, usage)
+	}
+
+	for _, pause := range data.Pauses {
+		keeper.SetPause(ctx, pause)
+	}
+
+	for _, transfer := range data.OutboundTransfers {
+		keeper.SetOutboundTransfer(ctx, transfer)
+	}
+
+	for _, unclaimed := range data.UnclaimedInbounds {
+		keeper.SetUnclaimedInbound(ctx, unclaimed)
+	}
+	keeper.SetNextUnclaimedInboundID(ctx, data.NextUnclaimedInboundId)
+
+	for _, entry := range data.Blacklist {
+		keeper.SetBlacklistEntry(ctx, entry)
+	}
+
+	for _, report := range data.GasPriceReports {
+		keeper.SetGasPriceReport(ctx, report)
+	}
+
+	for _, class := range data.NftClasses {
+		keeper.SetNftClass(ctx, class)
+	}
+
+	for _, nft := range data.Nfts {
+		keeper.SetNft(ctx, nft)
+	}
+
+	// A genesis exported before the bridge supplies were tracked starts tracking them from the bank supply
+	if len(data.BridgeSupplies) == 0 {
+		keeper.SeedBridgeSupplies(ctx)
+	}
+	for _, supply := range data.BridgeSupplies {
+		keeper.SetBridgeSupply(ctx, supply)
+	}
+
+	for _, grant := range data.AdminRoleGrants {
+		keeper.SetAdminRoleGrant(ctx, grant)
+		keeper.SetAdminRoleConfigured(ctx, grant.Role)
+	}
+	for _, role := range data.ConfiguredAdminRoles {
+		keeper.SetAdminRoleConfigured(ctx, role)
+	}
+
+	for _, record := range data.ClaimRecords {
+		keeper.SetClaimRecord(ctx, record)
+	}
+	keeper.SetNextClaimRecordID(ctx, data.NextClaimRecordId)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -37,11 +100,116 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	receiveAccount := keeper.GetCethReceiverAccount(ctx)
 
 	return &types.GenesisState{
-		PeggyTokens:        peggyTokens.Tokens,
-		CethReceiveAccount: receiveAccount.String(),
+		PeggyTokens:            peggyTokens.Tokens,
+		CethReceiveAccount:     receiveAccount.String(),
+		Networks:               keeper.GetNetworks(ctx),
+		NetworkPeggyTokens:     keeper.GetNetworkPeggyTokens(ctx, 0),
+		TransferUsages:         keeper.GetTransferUsages(ctx),
+		Pauses:                 keeper.GetPauses(ctx),
+		OutboundTransfers:      keeper.GetOutboundTransfers(ctx),
+		UnclaimedInbounds:      keeper.GetUnclaimedInbounds(ctx),
+		NextUnclaimedInboundId: keeper.GetNextUnclaimedInboundID(ctx),
+		Blacklist:              keeper.GetBlacklistEntries(ctx),
+		GasPriceReports:        keeper.GetGasPriceReports(ctx),
+		NftClasses:             keeper.GetNftClasses(ctx),
+		Nfts:                   keeper.GetNfts(ctx),
+		BridgeSupplies:         keeper.GetBridgeSupplies(ctx),
+		AdminRoleGrants:        keeper.GetAdminRoleGrants(ctx),
+		ClaimRecords:           keeper.GetClaimRecords(ctx),
+		NextClaimRecordId:      keeper.GetNextClaimRecordID(ctx),
+		ConfiguredAdminRoles:   keeper.GetConfiguredAdminRoles(ctx),
 	}
 }
 
 func ValidateGenesis(data types.GenesisState) error {
+	denomPrefixes := make(map[string]int64, len(data.Networks))
+	for _, network := range data.Networks {
+		if err := network.Validate(); err != nil {
+			return err
+		}
+		if chainID, ok := denomPrefixes[network.DenomPrefix]; ok {
+			return sdkerrors.Wrapf(types.ErrInvalidNetwork, "chain ids %d and %d share denom prefix %s",
+				chainID, network.EthereumChainId, network.DenomPrefix)
+		}
+		denomPrefixes[network.DenomPrefix] = network.EthereumChainId
+	}
+	for _, usage := range data.TransferUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, pause := range data.Pauses {
+		if err := pause.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, transfer := range data.OutboundTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, unclaimed := range data.UnclaimedInbounds {
+		if err := unclaimed.Validate(); err != nil {
+			return err
+		}
+		if unclaimed.Id >= data.NextUnclaimedInboundId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unclaimed inbound id %d is not below the next id %d",
+				unclaimed.Id, data.NextUnclaimedInboundId)
+		}
+	}
+	for _, entry := range data.Blacklist {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, report := range data.GasPriceReports {
+		if err := report.Validate(); err != nil {
+			return err
+		}
+	}
+	classes := make(map[string]bool, len(data.NftClasses))
+	for _, class := range data.NftClasses {
+		if err := class.Validate(); err != nil {
+			return err
+		}
+		classes[class.Id] = true
+	}
+	for _, nft := range data.Nfts {
+		if err := nft.Validate(); err != nil {
+			return err
+		}
+		if !classes[nft.ClassId] {
+			return sdkerrors.Wrapf(types.ErrNftNotFound, "class %s of token %s", nft.ClassId, nft.TokenId)
+		}
+	}
+	for _, supply := range data.BridgeSupplies {
+		if err := supply.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, grant := range data.AdminRoleGrants {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, role := range data.ConfiguredAdminRoles {
+		if err := types.ValidateAdminRole(role); err != nil {
+			return err
+		}
+	}
+	prophecyIDs := make(map[string]bool)
+	for _, record := range data.ClaimRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.Id >= data.NextClaimRecordId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "claim record id %d is not below the next id %d",
+				record.Id, data.NextClaimRecordId)
+		}
+		if prophecyIDs[record.ProphecyId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate claim record of prophecy %s", record.ProphecyId)
+		}
+		prophecyIDs[record.ProphecyId] = true
+	}
 	return nil
 }
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/Sifchain/sifnode/x/ethbridge"
+	ethbridgekeeper "github.com/Sifchain/sifnode/x/ethbridge/keeper"
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -61,3 +63,246 @@ func CreateState(ctx sdk.Context, keeper ethbridge.Keeper, t *testing.T) (int, s
 
 	return tokenscount, receiver.String()
 }
+
+func TestGenesisNetworks(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	network := types.NewNetwork(56, "bsc", types.NewEthereumAddress("0x782D10cC8c352D0524a1639eD261d29F47023922"), "cbsc", "cbscbnb")
+	keeper1.SetNetwork(ctx1, network)
+	keeper1.AddNetworkPeggyToken(ctx1, 56, "cbscusdt")
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.Network{network}, state.Networks)
+	assert.Equal(t, []types.NetworkPeggyToken{{EthereumChainId: 56, Denom: "cbscusdt"}}, state.NetworkPeggyTokens)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	registered, ok := keeper2.GetNetwork(ctx2, 56)
+	assert.True(t, ok)
+	assert.Equal(t, network, registered)
+	assert.True(t, keeper2.ExistsPeggyToken(ctx2, "cbscusdt"))
+	chainID, ok := keeper2.GetPeggyTokenNetwork(ctx2, "cbscusdt")
+	assert.True(t, ok)
+	assert.Equal(t, int64(56), chainID)
+
+	// Networks cannot share a denom prefix
+	polygon := network
+	polygon.EthereumChainId = 137
+	state.Networks = append(state.Networks, polygon)
+	assert.ErrorIs(t, ethbridge.ValidateGenesis(*state), types.ErrInvalidNetwork)
+}
+
+func TestGenesisTransferUsages(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	usage := types.TransferUsage{Denom: "ceth", WindowStart: 100, Amount: sdk.NewInt(10), PreviousAmount: sdk.NewInt(5)}
+	keeper1.SetTransferUsage(ctx1, usage)
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.TransferUsage{usage}, state.TransferUsages)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, usage, keeper2.GetTransferUsage(ctx2, "ceth"))
+
+	state.TransferUsages[0].Amount = sdk.NewInt(-1)
+	assert.ErrorIs(t, ethbridge.ValidateGenesis(*state), types.ErrInvalidAmount)
+}
+
+func TestGenesisPauses(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	pauses := []types.Pause{types.NewPause("", true, false), types.NewPause("ceth", false, true)}
+	for _, pause := range pauses {
+		keeper1.SetPause(ctx1, pause)
+	}
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, pauses, state.Pauses)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, pauses, keeper2.GetPauses(ctx2))
+}
+
+func TestGenesisOutboundTransfers(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	sender, err := sdk.AccAddressFromBech32(types.TestAddress)
+	assert.NoError(t, err)
+	transfers := []types.OutboundTransfer{
+		types.NewOutboundTransfer(sender, 1, types.TestEthereumChainID, types.NewEthereumAddress(types.TestEthereumAddress),
+			"rowan", sdk.NewInt(10), sdk.NewInt(1), types.ClaimType_CLAIM_TYPE_LOCK, 5),
+		types.NewOutboundTransfer(sender, 2, types.TestEthereumChainID, types.NewEthereumAddress(types.TestEthereumAddress),
+			"ceth", sdk.NewInt(10), sdk.NewInt(1), types.ClaimType_CLAIM_TYPE_BURN, 6),
+	}
+	for _, transfer := range transfers {
+		keeper1.SetOutboundTransfer(ctx1, transfer)
+	}
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, transfers, state.OutboundTransfers)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, transfers, keeper2.GetOutboundTransfers(ctx2))
+}
+
+func TestGenesisUnclaimedInbounds(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	assert.NoError(t, err)
+	claim := types.NewOracleClaimContent(types.TestEthereumChainID, receiver, sdk.NewInt(10), "ceth",
+		types.NewEthereumAddress(types.TestTokenContractAddress), types.ClaimType_CLAIM_TYPE_BURN)
+	unclaimed := keeper1.EscrowUnclaimedInbound(ctx1, claim, "ceth", "receiver is blacklisted")
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.UnclaimedInbound{unclaimed}, state.UnclaimedInbounds)
+	assert.Equal(t, uint64(1), state.NextUnclaimedInboundId)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, []types.UnclaimedInbound{unclaimed}, keeper2.GetUnclaimedInbounds(ctx2))
+	assert.Equal(t, uint64(1), keeper2.GetNextUnclaimedInboundID(ctx2))
+}
+
+func TestGenesisBlacklist(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	admin, err := sdk.AccAddressFromBech32(types.TestAddress)
+	assert.NoError(t, err)
+	entry, err := types.NewBlacklistEntry(types.TestEthereumAddress, "sanctioned", admin, 1, 100)
+	assert.NoError(t, err)
+	keeper1.SetBlacklistEntry(ctx1, entry)
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.BlacklistEntry{entry}, state.Blacklist)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, []types.BlacklistEntry{entry}, keeper2.GetBlacklistEntries(ctx2))
+
+	state.Blacklist = append(state.Blacklist, types.BlacklistEntry{Address: types.TestEthereumAddress, ExpiryHeight: -1})
+	assert.Error(t, ethbridge.ValidateGenesis(*state))
+}
+
+func TestGenesisGasPriceReports(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	address, err := sdk.AccAddressFromBech32(types.TestAddress)
+	assert.NoError(t, err)
+	validator := sdk.ValAddress(address)
+	report := types.NewGasPriceReport(validator, types.TestEthereumChainID, sdk.NewInt(20000000000), 5)
+	keeper1.SetGasPriceReport(ctx1, report)
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.GasPriceReport{report}, state.GasPriceReports)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, []types.GasPriceReport{report}, keeper2.GetGasPriceReports(ctx2))
+
+	state.GasPriceReports[0].GasPrice = sdk.ZeroInt()
+	assert.Error(t, ethbridge.ValidateGenesis(*state))
+}
+
+func TestGenesisNfts(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	address, err := sdk.AccAddressFromBech32(types.TestAddress)
+	assert.NoError(t, err)
+	class := types.NewNftClass(types.TestEthereumChainID, types.NewEthereumAddress(types.TestTokenContractAddress), "PUNK")
+	nft := types.NewNft(class.Id, "7", address, "ipfs://punk/7")
+	keeper1.SetNftClass(ctx1, class)
+	keeper1.SetNft(ctx1, nft)
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.NftClass{class}, state.NftClasses)
+	assert.Equal(t, []types.Nft{nft}, state.Nfts)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, []types.NftClass{class}, keeper2.GetNftClasses(ctx2))
+	assert.Equal(t, []types.Nft{nft}, keeper2.GetNfts(ctx2))
+
+	state.NftClasses = nil
+	assert.ErrorIs(t, ethbridge.ValidateGenesis(*state), types.ErrNftNotFound)
+}
+
+func TestGenesisBridgeSupplies(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	keeper1.AddBridgeMinted(ctx1, "ceth", sdk.NewInt(20))
+	keeper1.AddBridgeBurned(ctx1, "ceth", sdk.NewInt(5))
+	keeper1.AddBridgeLocked(ctx1, "rowan", sdk.NewInt(7))
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Len(t, state.BridgeSupplies, 2)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.Equal(t, state.BridgeSupplies, keeper2.GetBridgeSupplies(ctx2))
+
+	state.BridgeSupplies[0].Burned = sdk.NewInt(-1)
+	assert.ErrorIs(t, ethbridge.ValidateGenesis(*state), types.ErrInvalidAmount)
+}
+
+func TestGenesisSeedsBridgeSupplies(t *testing.T) {
+	ctx, keeper := test.CreateTestAppEthBridge(false)
+	state := types.GenesisState{PeggyTokens: []string{"stake"}}
+
+	// The bank supply of the peggy tokens of a genesis without bridge supplies is taken as minted by the bridge
+	ethbridge.InitGenesis(ctx, keeper, state)
+	assert.True(t, keeper.GetBridgeSupply(ctx, "stake").Minted.IsPositive())
+	_, broken := ethbridgekeeper.AllInvariants(keeper)(ctx)
+	assert.False(t, broken)
+}
+
+func TestGenesisAdminRoles(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	address, err := sdk.AccAddressFromBech32(types.TestAddress)
+	assert.NoError(t, err)
+	grant := types.NewAdminRoleGrant(types.AdminRole_ADMIN_ROLE_COMPLIANCE, address)
+	keeper1.SetAdminRoleGrant(ctx1, grant)
+	keeper1.SetAdminRoleConfigured(ctx1, types.AdminRole_ADMIN_ROLE_COMPLIANCE)
+	// the rescuer role was granted and revoked, it has no holders
+	keeper1.SetAdminRoleConfigured(ctx1, types.AdminRole_ADMIN_ROLE_RESCUER)
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.AdminRoleGrant{grant}, state.AdminRoleGrants)
+	assert.Equal(t, []types.AdminRole{types.AdminRole_ADMIN_ROLE_COMPLIANCE, types.AdminRole_ADMIN_ROLE_RESCUER},
+		state.ConfiguredAdminRoles)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	assert.True(t, keeper2.HasAdminRole(ctx2, types.AdminRole_ADMIN_ROLE_COMPLIANCE, address))
+	assert.True(t, keeper2.IsAdminRoleConfigured(ctx2, types.AdminRole_ADMIN_ROLE_RESCUER))
+	assert.False(t, keeper2.IsAdminRoleConfigured(ctx2, types.AdminRole_ADMIN_ROLE_FEE))
+
+	state.ConfiguredAdminRoles[1] = types.AdminRole(9)
+	assert.ErrorIs(t, ethbridge.ValidateGenesis(*state), types.ErrInvalidAdminRole)
+	state.ConfiguredAdminRoles[1] = types.AdminRole_ADMIN_ROLE_RESCUER
+	state.AdminRoleGrants[0].Role = types.AdminRole_ADMIN_ROLE_UNSPECIFIED
+	assert.ErrorIs(t, ethbridge.ValidateGenesis(*state), types.ErrInvalidAdminRole)
+}
+
+func TestGenesisClaimRecords(t *testing.T) {
+	ctx1, keeper1 := test.CreateTestAppEthBridge(false)
+	ctx2, keeper2 := test.CreateTestAppEthBridge(false)
+	claim := types.CreateTestEthClaim(t, types.NewEthereumAddress(types.TestBridgeContractAddress),
+		types.NewEthereumAddress(types.TestTokenContractAddress), sdk.ValAddress{},
+		types.NewEthereumAddress(types.TestEthereumAddress), types.TestCoinsAmount, types.TestCoinsSymbol,
+		types.ClaimType_CLAIM_TYPE_LOCK)
+	record := types.NewClaimRecord(0, "prophecy", claim, ctx1.BlockHeight())
+	keeper1.SetClaimRecord(ctx1, record)
+	keeper1.SetNextClaimRecordID(ctx1, 1)
+	state := ethbridge.ExportGenesis(ctx1, keeper1)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
+	assert.Equal(t, []types.ClaimRecord{record}, state.ClaimRecords)
+	assert.Equal(t, uint64(1), state.NextClaimRecordId)
+
+	ethbridge.InitGenesis(ctx2, keeper2, *state)
+	imported, ok := keeper2.GetClaimRecordByProphecyID(ctx2, "prophecy")
+	assert.True(t, ok)
+	assert.Equal(t, record, imported)
+	assert.Equal(t, uint64(1), keeper2.GetNextClaimRecordID(ctx2))
+
+	state.NextClaimRecordId = 0
+	assert.Error(t, ethbridge.ValidateGenesis(*state))
+	state.NextClaimRecordId = 2
+	state.ClaimRecords = append(state.ClaimRecords, record)
+	state.ClaimRecords[1].Id = 1
+	assert.Error(t, ethbridge.ValidateGenesis(*state))
+}
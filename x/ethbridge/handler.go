@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
@@ -38,9 +39,55 @@ func NewHandler(k Keeper) sdk.Handler {
 		case *types.MsgSetBlacklist:
 			res, err := msgServer.SetBlacklist(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetNetwork:
+			res, err := msgServer.SetNetwork(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetPause:
+			res, err := msgServer.SetPause(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReportOutboundTransfer:
+			res, err := msgServer.ReportOutboundTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRefundOutboundTransfer:
+			res, err := msgServer.RefundOutboundTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRedirectUnclaimedInbound:
+			res, err := msgServer.RedirectUnclaimedInbound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddToBlacklist:
+			res, err := msgServer.AddToBlacklist(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveFromBlacklist:
+			res, err := msgServer.RemoveFromBlacklist(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReportGasPrice:
+			res, err := msgServer.ReportGasPrice(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateEthBridgeNftClaim:
+			res, err := msgServer.CreateEthBridgeNftClaim(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBurnNft:
+			res, err := msgServer.BurnNft(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized ethbridge message type: %v", sdk.MsgTypeURL(msg))
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 		}
 	}
 }
+
+// NewProposalHandler creates a govtypes.Handler for the ethbridge governance proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetPauseProposal:
+			k.SetPause(ctx, c.Pause)
+			return nil
+		case *types.SetAdminRoleProposal:
+			return k.ProcessSetAdminRoleProposal(ctx, c)
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s proposal content type: %T", types.ModuleName, c)
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
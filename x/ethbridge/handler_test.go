@@ -14,7 +14,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Sifchain/sifnode/x/ethbridge"
 	ethbridgekeeper "github.com/Sifchain/sifnode/x/ethbridge/keeper"
@@ -32,8 +34,27 @@ const (
 
 var (
 	UnregisteredValidatorAddress = sdk.ValAddress("cosmos1xdp5tvt7lxh8rf9xx07wy2xlagzhq24ha48xtq")
+	testBridgeContractAddress    = types.NewEthereumAddress(types.TestBridgeContractAddress)
 )
 
+// isTypedEvent returns whether an event is a typed protobuf event, whose attribute values are JSON
+func isTypedEvent(event abci.Event) bool {
+	return strings.HasPrefix(event.Type, "sifnode.")
+}
+
+// findTypedEvent returns the typed event of the type of the given event among the events
+func findTypedEvent(t *testing.T, events []abci.Event, event proto.Message) proto.Message {
+	for _, e := range events {
+		if e.Type == proto.MessageName(event) {
+			typedEvent, err := sdk.ParseTypedEvent(e)
+			require.NoError(t, err)
+			return typedEvent
+		}
+	}
+	require.Fail(t, fmt.Sprintf("no %s event", proto.MessageName(event)))
+	return nil
+}
+
 func TestBasicMsgs(t *testing.T) {
 	//Setup
 	ctx, _, _, _, handler, validatorAddresses, _ := CreateTestHandler(t, 0.7, []int64{3, 7})
@@ -49,6 +70,9 @@ func TestBasicMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	for _, event := range res.Events {
+		if isTypedEvent(event) {
+			continue
+		}
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
 			switch key := string(attribute.Key); key {
@@ -79,6 +103,16 @@ func TestBasicMsgs(t *testing.T) {
 			}
 		}
 	}
+	var response types.MsgCreateEthBridgeClaimResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &response))
+	require.Equal(t, types.GetClaimProphecyID(normalCreateMsg.EthBridgeClaim), response.ProphecyId)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, response.Status)
+	require.Equal(t, &types.EventCreateClaim{
+		Claim:      normalCreateMsg.EthBridgeClaim,
+		ProphecyId: response.ProphecyId,
+		Status:     oracletypes.StatusText_STATUS_TEXT_PENDING,
+		Height:     ctx.BlockHeight(),
+	}, findTypedEvent(t, res.Events, &types.EventCreateClaim{}))
 	//Bad Creation
 	badCreateMsg := types.CreateTestEthMsg(t, valAddress, types.ClaimType_CLAIM_TYPE_LOCK)
 	badCreateMsg.EthBridgeClaim.Nonce = -1
@@ -94,6 +128,9 @@ func TestDuplicateMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	for _, event := range res.Events {
+		if isTypedEvent(event) {
+			continue
+		}
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
 			if string(attribute.Key) == statusString {
@@ -130,6 +167,9 @@ func TestMintSuccess(t *testing.T) {
 	expectedCoins := sdk.NewCoins(sdk.NewInt64Coin(types.TestCoinsLockedSymbol, types.TestCoinIntAmount))
 	require.True(t, receiverCoins.IsEqual(expectedCoins))
 	for _, event := range res.Events {
+		if isTypedEvent(event) {
+			continue
+		}
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
 			if string(attribute.Key) == statusString {
@@ -157,15 +197,15 @@ func TestNoMintFail(t *testing.T) {
 	testTokenContractAddress := types.NewEthereumAddress(types.TestTokenContractAddress)
 	testEthereumAddress := types.NewEthereumAddress(types.TestEthereumAddress)
 	ethClaim1 := types.CreateTestEthClaim(
-		t, testEthereumAddress, testTokenContractAddress,
+		t, testBridgeContractAddress, testTokenContractAddress,
 		valAddressVal1Pow3, testEthereumAddress, types.TestCoinsAmount, types.TestCoinsSymbol, types.ClaimType_CLAIM_TYPE_LOCK)
 	ethMsg1 := types.NewMsgCreateEthBridgeClaim(ethClaim1)
 	ethClaim2 := types.CreateTestEthClaim(
-		t, testEthereumAddress, testTokenContractAddress,
+		t, testBridgeContractAddress, testTokenContractAddress,
 		valAddressVal2Pow4, testEthereumAddress, types.TestCoinsAmount, types.TestCoinsSymbol, types.ClaimType_CLAIM_TYPE_LOCK)
 	ethMsg2 := types.NewMsgCreateEthBridgeClaim(ethClaim2)
 	ethClaim3 := types.CreateTestEthClaim(
-		t, testEthereumAddress, testTokenContractAddress,
+		t, testBridgeContractAddress, testTokenContractAddress,
 		valAddressVal3Pow3, testEthereumAddress, types.AltTestCoinsAmountSDKInt, types.AltTestCoinsSymbol, types.ClaimType_CLAIM_TYPE_LOCK)
 	ethMsg3 := types.NewMsgCreateEthBridgeClaim(ethClaim3)
 	//Initial message
@@ -173,6 +213,9 @@ func TestNoMintFail(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	for _, event := range res.Events {
+		if isTypedEvent(event) {
+			continue
+		}
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
 			if string(attribute.Key) == statusString {
@@ -185,6 +228,9 @@ func TestNoMintFail(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	for _, event := range res.Events {
+		if isTypedEvent(event) {
+			continue
+		}
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
 			if string(attribute.Key) == statusString {
@@ -197,6 +243,9 @@ func TestNoMintFail(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	for _, event := range res.Events {
+		if isTypedEvent(event) {
+			continue
+		}
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
 			if string(attribute.Key) == statusString {
@@ -237,7 +286,7 @@ func TestBurnEthFail(t *testing.T) {
 }
 
 func TestBurnEthSuccess(t *testing.T) {
-	ctx, _, bankKeeper, _, handler, validatorAddresses, _ := CreateTestHandler(t, 0.5, []int64{5})
+	ctx, _, bankKeeper, accountKeeper, handler, validatorAddresses, _ := CreateTestHandler(t, 0.5, []int64{5})
 	valAddressVal1Pow5 := validatorAddresses[0]
 	senderSequence := "0"
 	coinsToMintAmount := sdk.NewInt(7)
@@ -247,7 +296,7 @@ func TestBurnEthSuccess(t *testing.T) {
 	testEthereumAddress := types.NewEthereumAddress(types.TestEthereumAddress)
 	ethereumReceiver := types.NewEthereumAddress(types.AltTestEthereumAddress)
 	// Initial message to mint some eth
-	ethClaim1 := types.CreateTestEthClaim(t, testEthereumAddress, testTokenContractAddress, valAddressVal1Pow5,
+	ethClaim1 := types.CreateTestEthClaim(t, testBridgeContractAddress, testTokenContractAddress, valAddressVal1Pow5,
 		testEthereumAddress, coinsToMintAmount, coinsToMintSymbol, types.ClaimType_CLAIM_TYPE_LOCK)
 	ethMsg1 := types.NewMsgCreateEthBridgeClaim(ethClaim1)
 	res, err := handler(ctx, &ethMsg1)
@@ -263,7 +312,7 @@ func TestBurnEthSuccess(t *testing.T) {
 	coinsToMintAmount = sdk.NewInt(65000000000 * 300000)
 	coinsToMintSymbol = "eth"
 	testEthereumAddress = types.NewEthereumAddress(types.AltTestEthereumAddress)
-	ethClaim1 = types.CreateTestEthClaim(t, testEthereumAddress, testTokenContractAddress, valAddressVal1Pow5,
+	ethClaim1 = types.CreateTestEthClaim(t, testBridgeContractAddress, testTokenContractAddress, valAddressVal1Pow5,
 		testEthereumAddress, coinsToMintAmount, coinsToMintSymbol, types.ClaimType_CLAIM_TYPE_LOCK)
 	ethMsg1 = types.NewMsgCreateEthBridgeClaim(ethClaim1)
 	res, err = handler(ctx, &ethMsg1)
@@ -291,6 +340,9 @@ func TestBurnEthSuccess(t *testing.T) {
 	eventSymbol := ""
 	eventCethAmount := sdk.NewInt(0)
 	for _, event := range res.Events {
+		if isTypedEvent(event) {
+			continue
+		}
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
 			switch key := string(attribute.Key); key {
@@ -352,7 +404,7 @@ func TestBurnEthSuccess(t *testing.T) {
 	coinsToMintAmount = sdk.NewInt(65000000000 * 300000)
 	coinsToMintSymbol = "eth"
 	testEthereumAddress = types.NewEthereumAddress(types.Alt2TestEthereumAddress)
-	ethClaim1 = types.CreateTestEthClaim(t, testEthereumAddress, testTokenContractAddress,
+	ethClaim1 = types.CreateTestEthClaim(t, testBridgeContractAddress, testTokenContractAddress,
 		valAddressVal1Pow5, testEthereumAddress, coinsToMintAmount, coinsToMintSymbol, types.ClaimType_CLAIM_TYPE_LOCK)
 	ethMsg1 = types.NewMsgCreateEthBridgeClaim(ethClaim1)
 	// Initial message succeeds and mints eth
@@ -364,7 +416,10 @@ func TestBurnEthSuccess(t *testing.T) {
 	_, err = handler(ctx, &lockMsg)
 	require.NotNil(t, err)
 	require.Equal(t, "Pegged token cether can't be lock.", err.Error())
-	// Fourth message OK
+	// Fourth message OK, in a new transaction with the next sender sequence
+	account := accountKeeper.GetAccount(ctx, senderAddress)
+	require.NoError(t, account.SetSequence(account.GetSequence()+1))
+	accountKeeper.SetAccount(ctx, account)
 	_, err = handler(ctx, &burnMsg)
 	require.Nil(t, err)
 	// Fifth message fails, not enough eth
@@ -386,6 +441,14 @@ func TestUpdateCethReceiverAccountMsg(t *testing.T) {
 	res, err := handler(ctx, &testUpdateCethReceiverAccountMsg)
 	require.NoError(t, err)
 	require.NotNil(t, res)
+	for _, event := range res.Events {
+		require.NotEqual(t, types.EventTypeLock, event.Type)
+	}
+	require.Equal(t, &types.EventUpdateCethReceiverAccount{
+		CosmosSender:        types.TestAddress,
+		CethReceiverAccount: types.TestAddress,
+		Height:              ctx.BlockHeight(),
+	}, findTypedEvent(t, res.Events, &types.EventUpdateCethReceiverAccount{}))
 }
 
 func TestRescueCethMsg(t *testing.T) {
@@ -393,7 +456,7 @@ func TestRescueCethMsg(t *testing.T) {
 	coins := sdk.NewCoins(sdk.NewCoin(types.CethSymbol, sdk.NewInt(10000)))
 	err := bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	require.NoError(t, err)
-	testRescueCethMsg := types.CreateTestRescueCethMsg(t, types.TestAddress, types.TestAddress, sdk.NewInt(10000))
+	testRescueCethMsg := types.CreateTestRescueCethMsg(t, types.TestEthereumChainID, types.TestAddress, types.TestAddress, sdk.NewInt(10000))
 	cosmosSender, err := sdk.AccAddressFromBech32(types.TestAddress)
 	require.NoError(t, err)
 	accountKeeper.SetAccount(ctx, authtypes.NewBaseAccountWithAddress(cosmosSender))
@@ -405,6 +468,14 @@ func TestRescueCethMsg(t *testing.T) {
 	res, err := handler(ctx, &testRescueCethMsg)
 	require.NoError(t, err)
 	require.NotNil(t, res)
+	require.Equal(t, &types.EventRescueCeth{
+		CosmosSender:    types.TestAddress,
+		CosmosReceiver:  types.TestAddress,
+		CethAmount:      sdk.NewInt(10000),
+		Height:          ctx.BlockHeight(),
+		EthereumChainId: types.TestEthereumChainID,
+		Denom:           types.CethSymbol,
+	}, findTypedEvent(t, res.Events, &types.EventRescueCeth{}))
 }
 
 func TestUpdateWhiteListValidator(t *testing.T) {
@@ -507,3 +578,273 @@ func CreateTestHandler(t *testing.T, consensusNeeded float64, validatorAmounts [
 	handler := ethbridge.NewHandler(keeper)
 	return ctx, keeper, bankKeeper, accountKeeper, handler, validatorAddresses, oracleKeeper
 }
+
+func TestPause(t *testing.T) {
+	ctx, keeper, _, _, handler, validatorAddresses, oracleKeeper := CreateTestHandler(t, 0.5, []int64{5})
+	adminAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	testTokenContractAddress := types.NewEthereumAddress(types.TestTokenContractAddress)
+	testEthereumAddress := types.NewEthereumAddress(types.TestEthereumAddress)
+
+	pauseMsg := types.NewMsgSetPause(adminAddress, types.NewPause("", true, false))
+	_, err = handler(ctx, &pauseMsg)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+	oracleKeeper.SetAdminAccount(ctx, adminAddress)
+	_, err = handler(ctx, &pauseMsg)
+	require.NoError(t, err)
+
+	// A global outbound pause stops locks but not claims
+	lockMsg := types.CreateTestLockMsg(t, types.TestAddress, testEthereumAddress, sdk.NewInt(1), "stake")
+	_, err = handler(ctx, &lockMsg)
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+	ethClaim := types.CreateTestEthClaim(t, testBridgeContractAddress, testTokenContractAddress, validatorAddresses[0],
+		testEthereumAddress, sdk.NewInt(1), "eth", types.ClaimType_CLAIM_TYPE_LOCK)
+	claimMsg := types.NewMsgCreateEthBridgeClaim(ethClaim)
+	_, err = handler(ctx, &claimMsg)
+	require.NoError(t, err)
+
+	// An inbound pause of a symbol stops claims minting it
+	pauseMsg = types.NewMsgSetPause(adminAddress, types.NewPause("cether", false, true))
+	_, err = handler(ctx, &pauseMsg)
+	require.NoError(t, err)
+	altEthereumAddress := types.NewEthereumAddress(types.AltTestEthereumAddress)
+	ethClaim = types.CreateTestEthClaim(t, testBridgeContractAddress, testTokenContractAddress, validatorAddresses[0],
+		altEthereumAddress, sdk.NewInt(1), "ether", types.ClaimType_CLAIM_TYPE_LOCK)
+	claimMsg = types.NewMsgCreateEthBridgeClaim(ethClaim)
+	_, err = handler(ctx, &claimMsg)
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+
+	// Resuming removes the pause
+	pauseMsg = types.NewMsgSetPause(adminAddress, types.NewPause("", false, false))
+	_, err = handler(ctx, &pauseMsg)
+	require.NoError(t, err)
+	require.Equal(t, []types.Pause{types.NewPause("cether", false, true)}, keeper.GetPauses(ctx))
+
+	// Governance can pause and resume the bridge
+	proposalHandler := ethbridge.NewProposalHandler(keeper)
+	err = proposalHandler(ctx, types.NewSetPauseProposal("resume", "resume cether", types.NewPause("cether", false, false)))
+	require.NoError(t, err)
+	require.Empty(t, keeper.GetPauses(ctx))
+	_, err = handler(ctx, &claimMsg)
+	require.NoError(t, err)
+}
+
+func TestBlacklistedEthereumSender(t *testing.T) {
+	ctx, keeper, _, _, handler, validatorAddresses, oracleKeeper := CreateTestHandler(t, 0.5, []int64{5})
+	adminAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	oracleKeeper.SetAdminAccount(ctx, adminAddress)
+	testTokenContractAddress := types.NewEthereumAddress(types.TestTokenContractAddress)
+	testEthereumAddress := types.NewEthereumAddress(types.TestEthereumAddress)
+
+	addMsg := types.NewMsgAddToBlacklist(adminAddress, []string{types.TestEthereumAddress}, "sanctioned", ctx.BlockHeight()+10)
+	_, err = handler(ctx, &addMsg)
+	require.NoError(t, err)
+
+	// Claims of transfers sent by a blacklisted ethereum address are refused
+	ethClaim := types.CreateTestEthClaim(t, testBridgeContractAddress, testTokenContractAddress, validatorAddresses[0],
+		testEthereumAddress, sdk.NewInt(1), "eth", types.ClaimType_CLAIM_TYPE_LOCK)
+	claimMsg := types.NewMsgCreateEthBridgeClaim(ethClaim)
+	_, err = handler(ctx, &claimMsg)
+	require.ErrorIs(t, err, types.ErrBlacklisted)
+
+	// Until the entry expires
+	_, err = handler(ctx.WithBlockHeight(ctx.BlockHeight()+10), &claimMsg)
+	require.NoError(t, err)
+
+	removeMsg := types.NewMsgRemoveFromBlacklist(adminAddress, []string{types.TestEthereumAddress}, "cleared")
+	_, err = handler(ctx, &removeMsg)
+	require.NoError(t, err)
+	require.Empty(t, keeper.GetBlacklist(ctx))
+}
+
+func TestOutboundTransfers(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper, handler, validatorAddresses, oracleKeeper := CreateTestHandler(t, 0.7, []int64{3, 3})
+	senderAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	ethereumReceiver := types.NewEthereumAddress(types.TestEthereumAddress)
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10)), sdk.NewCoin(types.CethSymbol, sdk.NewInt(65000000000*300000*2)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddress, coins))
+	txHash := "0x" + strings.Repeat("ab", 32)
+
+	// A lock is recorded as a pending transfer under the sender sequence
+	lockMsg := types.CreateTestLockMsg(t, types.TestAddress, ethereumReceiver, sdk.NewInt(4), "stake")
+	res, err := handler(ctx, &lockMsg)
+	require.NoError(t, err)
+	var lockResponse types.MsgLockResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &lockResponse))
+	require.Equal(t, types.MsgLockResponse{
+		CosmosSenderSequence: 0,
+		ProphecyId:           types.GetOutboundTransferProphecyID(types.TestAddress, 0),
+	}, lockResponse)
+	require.Equal(t, &types.EventLock{
+		EthereumChainId:      types.TestEthereumChainID,
+		CosmosSender:         types.TestAddress,
+		CosmosSenderSequence: 0,
+		EthereumReceiver:     lockMsg.EthereumReceiver,
+		Symbol:               "stake",
+		Amount:               sdk.NewInt(4),
+		CethAmount:           lockMsg.CethAmount,
+		ProphecyId:           lockResponse.ProphecyId,
+		Height:               ctx.BlockHeight(),
+	}, findTypedEvent(t, res.Events, &types.EventLock{}))
+	transfer, ok := keeper.GetOutboundTransfer(ctx, senderAddress, 0)
+	require.True(t, ok)
+	require.Equal(t, types.NewOutboundTransfer(senderAddress, 0, types.TestEthereumChainID, ethereumReceiver, "stake",
+		sdk.NewInt(4), lockMsg.CethAmount, types.ClaimType_CLAIM_TYPE_LOCK, ctx.BlockHeight()), transfer)
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, keeper.RecordOutboundTransfer(cacheCtx, transfer), types.ErrOutboundExists)
+
+	// A second lock of the same transaction is recorded under the next sequence
+	res, err = handler(cacheCtx, &lockMsg)
+	require.NoError(t, err)
+	var secondLockResponse types.MsgLockResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &secondLockResponse))
+	require.Equal(t, uint64(1), secondLockResponse.CosmosSenderSequence)
+	transfers, _, err := keeper.GetOutboundTransfersForSenderPaginated(cacheCtx, senderAddress, nil)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	require.Equal(t, uint64(1), transfers[1].CosmosSenderSequence)
+	require.Equal(t, uint64(2), keeper.NextOutboundSequence(cacheCtx, senderAddress, 1))
+	require.Equal(t, uint64(5), keeper.NextOutboundSequence(cacheCtx, senderAddress, 5))
+
+	// The transfer is relayed on the first report and completed once the reports reach consensus
+	reportMsg := types.NewMsgReportOutboundTransfer(validatorAddresses[0], types.TestAddress, 0, txHash)
+	res, err = handler(ctx, &reportMsg)
+	require.NoError(t, err)
+	var reportResponse types.MsgReportOutboundTransferResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &reportResponse))
+	require.Equal(t, lockResponse.ProphecyId, reportResponse.ProphecyId)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, reportResponse.Status)
+	transfer, _ = keeper.GetOutboundTransfer(ctx, senderAddress, 0)
+	require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_RELAYED, transfer.Status)
+	reportMsg = types.NewMsgReportOutboundTransfer(validatorAddresses[1], types.TestAddress, 0, txHash)
+	res, err = handler(ctx, &reportMsg)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(res.Data, &reportResponse))
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, reportResponse.Status)
+	transfer, _ = keeper.GetOutboundTransfer(ctx, senderAddress, 0)
+	require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_COMPLETED, transfer.Status)
+	require.Equal(t, txHash, transfer.EthereumTxHash)
+
+	// Only the admin can refund a transfer, once it has timed out
+	account := accountKeeper.GetAccount(ctx, senderAddress)
+	require.NoError(t, account.SetSequence(1))
+	accountKeeper.SetAccount(ctx, account)
+	_, err = handler(ctx, &lockMsg)
+	require.NoError(t, err)
+	refundMsg := types.NewMsgRefundOutboundTransfer(senderAddress, types.TestAddress, 1)
+	_, err = handler(ctx, &refundMsg)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+	oracleKeeper.SetAdminAccount(ctx, senderAddress)
+	_, err = handler(ctx, &refundMsg)
+	require.ErrorIs(t, err, types.ErrOutboundNotTimedOut)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.OutboundRefundTimeout)
+	_, err = handler(ctx, &refundMsg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(6), bankKeeper.GetBalance(ctx, senderAddress, "stake").Amount)
+	transfer, _ = keeper.GetOutboundTransfer(ctx, senderAddress, 1)
+	require.Equal(t, types.OutboundStatus_OUTBOUND_STATUS_REFUNDED, transfer.Status)
+
+	// Completed and refunded transfers are closed
+	_, err = handler(ctx, &refundMsg)
+	require.ErrorIs(t, err, types.ErrOutboundStatus)
+	refundMsg = types.NewMsgRefundOutboundTransfer(senderAddress, types.TestAddress, 0)
+	_, err = handler(ctx, &refundMsg)
+	require.ErrorIs(t, err, types.ErrOutboundStatus)
+	reportMsg = types.NewMsgReportOutboundTransfer(validatorAddresses[0], types.TestAddress, 1, txHash)
+	_, err = handler(ctx, &reportMsg)
+	require.ErrorIs(t, err, types.ErrOutboundStatus)
+
+	transfers, _, err = keeper.GetOutboundTransfersForSenderPaginated(ctx, senderAddress, nil)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+
+	// Refunded locks are no longer counted as locked
+	require.Equal(t, sdk.NewInt(4), keeper.GetBridgeSupply(ctx, "stake").Locked)
+	_, broken := ethbridgekeeper.LockedOutboundInvariant(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAdminRoles(t *testing.T) {
+	ctx, keeper, _, _, handler, _, oracleKeeper := CreateTestHandler(t, 0.5, []int64{5})
+	adminAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	oracleKeeper.SetAdminAccount(ctx, adminAddress)
+	_, _, officer := testdata.KeyTestPubAddr()
+	blacklistMsg := types.NewMsgAddToBlacklist(adminAddress, []string{types.TestEthereumAddress}, "sanctioned", 0)
+
+	// Once governance grants the compliance role, the admin account no longer manages the blacklist
+	proposalHandler := ethbridge.NewProposalHandler(keeper)
+	err = proposalHandler(ctx, types.NewSetAdminRoleProposal("grant", "grant the compliance officer",
+		types.NewAdminRoleGrant(types.AdminRole_ADMIN_ROLE_COMPLIANCE, officer), false))
+	require.NoError(t, err)
+	require.Contains(t, fmt.Sprint(ctx.EventManager().Events()), types.EventTypeGrantAdminRole)
+	_, err = handler(ctx, &blacklistMsg)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+	blacklistMsg.CosmosSender = officer.String()
+	_, err = handler(ctx, &blacklistMsg)
+	require.NoError(t, err)
+
+	// Governance revokes the role, which does not return it to the admin account
+	err = proposalHandler(ctx, types.NewSetAdminRoleProposal("revoke", "revoke the compliance officer",
+		types.NewAdminRoleGrant(types.AdminRole_ADMIN_ROLE_COMPLIANCE, officer), true))
+	require.NoError(t, err)
+	_, err = handler(ctx, &blacklistMsg)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+	blacklistMsg.CosmosSender = adminAddress.String()
+	_, err = handler(ctx, &blacklistMsg)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+}
+
+func TestNftBridge(t *testing.T) {
+	ctx, keeper, bankKeeper, _, handler, validatorAddresses, oracleKeeper := CreateTestHandler(t, 0.5, []int64{5})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	testTokenContractAddress := types.NewEthereumAddress(types.TestTokenContractAddress)
+	testEthereumAddress := types.NewEthereumAddress(types.TestEthereumAddress)
+	classID := types.NftClassID(types.TestEthereumChainID, testTokenContractAddress)
+	claimMsg := types.NewMsgCreateEthBridgeNftClaim(types.NewEthBridgeNftClaim(types.TestEthereumChainID,
+		testBridgeContractAddress, 1, "PUNK", testTokenContractAddress, testEthereumAddress, receiver, validatorAddresses[0],
+		"7", "ipfs://punk/7"))
+
+	// Claims to a blacklisted receiver are refused
+	oracleKeeper.SetAdminAccount(ctx, receiver)
+	addMsg := types.NewMsgAddToBlacklist(receiver, []string{types.TestAddress}, "sanctioned", 0)
+	_, err = handler(ctx, &addMsg)
+	require.NoError(t, err)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = handler(cacheCtx, &claimMsg)
+	require.ErrorIs(t, err, types.ErrBlacklisted)
+	removeMsg := types.NewMsgRemoveFromBlacklist(receiver, []string{types.TestAddress}, "cleared")
+	_, err = handler(ctx, &removeMsg)
+	require.NoError(t, err)
+
+	// The claim reaches consensus and mints the NFT to the receiver
+	res, err := handler(ctx, &claimMsg)
+	require.NoError(t, err)
+	var minted bool
+	for _, event := range res.Events {
+		minted = minted || event.Type == types.EventTypeMintNft
+	}
+	require.True(t, minted)
+	nft, ok := keeper.GetNft(ctx, classID, "7")
+	require.True(t, ok)
+	require.Equal(t, types.NewNft(classID, "7", receiver, "ipfs://punk/7"), nft)
+
+	// Burning the NFT emits the event relayers unlock its token on
+	fee := sdk.NewCoins(sdk.NewCoin(types.CethSymbol, sdk.NewInt(65000000000*300000*2)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, fee))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, fee))
+	burnMsg := types.NewMsgBurnNft(receiver, classID, "7", testEthereumAddress, fee.AmountOf(types.CethSymbol))
+	res, err = handler(ctx, &burnMsg)
+	require.NoError(t, err)
+	var burned bool
+	for _, event := range res.Events {
+		burned = burned || event.Type == types.EventTypeBurnNft
+	}
+	require.True(t, burned)
+	_, ok = keeper.GetNft(ctx, classID, "7")
+	require.False(t, ok)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+// SetAdminRoleGrant stores the grant of an admin role, its address must be valid
+func (k Keeper) SetAdminRoleGrant(ctx sdk.Context, grant types.AdminRoleGrant) {
+	address, err := sdk.AccAddressFromBech32(grant.Address)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAdminRoleKey(grant.Role, address), k.cdc.MustMarshal(&grant))
+}
+
+// DeleteAdminRoleGrant removes the grant of an admin role to an account
+func (k Keeper) DeleteAdminRoleGrant(ctx sdk.Context, role types.AdminRole, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAdminRoleKey(role, address))
+}
+
+// GetAdminRoleGrants returns the grants of all admin roles
+func (k Keeper) GetAdminRoleGrants(ctx sdk.Context) []types.AdminRoleGrant {
+	var grants []types.AdminRoleGrant
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AdminRolePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AdminRoleGrant
+		k.cdc.MustUnmarshal(iter.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// GetAdminRoleGrantsPaginated returns a page of the grants of an admin role, of all admin roles when it is unspecified
+func (k Keeper) GetAdminRoleGrantsPaginated(ctx sdk.Context, role types.AdminRole,
+	pagination *query.PageRequest) ([]types.AdminRoleGrant, *query.PageResponse, error) {
+	var grants []types.AdminRoleGrant
+	keyPrefix := types.AdminRolePrefix
+	if role != types.AdminRole_ADMIN_ROLE_UNSPECIFIED {
+		keyPrefix = types.GetAdminRoleHoldersKey(role)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		var grant types.AdminRoleGrant
+		if err := k.cdc.Unmarshal(value, &grant); err != nil {
+			return err
+		}
+		grants = append(grants, grant)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return grants, pageRes, nil
+}
+
+// SetAdminRoleConfigured records that an admin role has been granted, the admin account no longer holds it
+func (k Keeper) SetAdminRoleConfigured(ctx sdk.Context, role types.AdminRole) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConfiguredAdminRoleKey(role), []byte{1})
+}
+
+// IsAdminRoleConfigured returns whether an admin role has ever been granted
+func (k Keeper) IsAdminRoleConfigured(ctx sdk.Context, role types.AdminRole) bool {
+	return k.Exists(ctx, types.GetConfiguredAdminRoleKey(role))
+}
+
+// GetConfiguredAdminRoles returns the admin roles which have been granted
+func (k Keeper) GetConfiguredAdminRoles(ctx sdk.Context) []types.AdminRole {
+	var roles []types.AdminRole
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ConfiguredAdminRolePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		roles = append(roles, types.AdminRole(iter.Key()[len(types.ConfiguredAdminRolePrefix)]))
+	}
+	return roles
+}
+
+// HasAdminRole returns whether an account holds an admin role. A role that has never been granted is held by the admin
+// account, once it has been granted only its holders hold it, even after the last of them is revoked.
+func (k Keeper) HasAdminRole(ctx sdk.Context, role types.AdminRole, address sdk.AccAddress) bool {
+	if k.Exists(ctx, types.GetAdminRoleKey(role, address)) {
+		return true
+	}
+	return !k.IsAdminRoleConfigured(ctx, role) && k.oracleKeeper.IsAdminAccount(ctx, address)
+}
+
+// ProcessSetAdminRoleProposal grants or revokes an admin role through governance, the only way roles are assigned
+func (k Keeper) ProcessSetAdminRoleProposal(ctx sdk.Context, proposal *types.SetAdminRoleProposal) error {
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	if proposal.Revoke {
+		return k.revokeAdminRole(ctx, proposal.Grant, govAddress)
+	}
+	return k.grantAdminRole(ctx, proposal.Grant, govAddress)
+}
+
+func (k Keeper) grantAdminRole(ctx sdk.Context, grant types.AdminRoleGrant, grantedBy string) error {
+	k.SetAdminRoleGrant(ctx, grant)
+	k.SetAdminRoleConfigured(ctx, grant.Role)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGrantAdminRole,
+		sdk.NewAttribute(types.AttributeKeyRole, grant.Role.String()),
+		sdk.NewAttribute(types.AttributeKeyAddress, grant.Address),
+		sdk.NewAttribute(types.AttributeKeyCosmosSender, grantedBy),
+	))
+	return ctx.EventManager().EmitTypedEvent(&types.EventGrantAdminRole{
+		CosmosSender: grantedBy,
+		Grant:        grant,
+		Height:       ctx.BlockHeight(),
+	})
+}
+
+func (k Keeper) revokeAdminRole(ctx sdk.Context, grant types.AdminRoleGrant, revokedBy string) error {
+	address, err := sdk.AccAddressFromBech32(grant.Address)
+	if err != nil {
+		return err
+	}
+	k.DeleteAdminRoleGrant(ctx, grant.Role, address)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeAdminRole,
+		sdk.NewAttribute(types.AttributeKeyRole, grant.Role.String()),
+		sdk.NewAttribute(types.AttributeKeyAddress, grant.Address),
+		sdk.NewAttribute(types.AttributeKeyCosmosSender, revokedBy),
+	))
+	return ctx.EventManager().EmitTypedEvent(&types.EventRevokeAdminRole{
+		CosmosSender: revokedBy,
+		Grant:        grant,
+		Height:       ctx.BlockHeight(),
+	})
+}
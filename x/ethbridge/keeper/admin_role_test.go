@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func TestAdminRoles(t *testing.T) {
+	ctx, keeper, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3}, "")
+	addresses, _ := test.CreateTestAddrs(3)
+	admin, officer, manager := addresses[0], addresses[1], addresses[2]
+	oracleKeeper.SetAdminAccount(ctx, admin)
+	compliance := types.AdminRole_ADMIN_ROLE_COMPLIANCE
+
+	// Roles which have never been granted are held by the admin account
+	require.True(t, keeper.HasAdminRole(ctx, compliance, admin))
+	require.False(t, keeper.HasAdminRole(ctx, compliance, officer))
+
+	// Governance grants roles, the admin account loses them
+	grant := func(role types.AdminRole, address sdk.AccAddress, revoke bool) {
+		proposal := types.NewSetAdminRoleProposal("role", "grant or revoke a role", types.NewAdminRoleGrant(role, address), revoke)
+		require.NoError(t, keeper.ProcessSetAdminRoleProposal(ctx, proposal))
+	}
+	grant(compliance, officer, false)
+	require.True(t, keeper.HasAdminRole(ctx, compliance, officer))
+	require.False(t, keeper.HasAdminRole(ctx, compliance, admin))
+	require.True(t, keeper.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_FEE, admin))
+	require.False(t, keeper.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_FEE, officer))
+
+	// Each operation checks its own role
+	pause := types.NewMsgSetPause(admin, types.NewPause("", true, false))
+	require.ErrorIs(t, keeper.ProcessSetPause(ctx, &pause), oracletypes.ErrNotAdminAccount)
+	pause = types.NewMsgSetPause(officer, types.NewPause("", true, false))
+	require.NoError(t, keeper.ProcessSetPause(ctx, &pause))
+	require.Error(t, keeper.ProcessUpdateCethReceiverAccount(ctx, officer, officer))
+	require.NoError(t, keeper.ProcessUpdateCethReceiverAccount(ctx, admin, officer))
+
+	// The validator set manager updates the whitelist of validators
+	grant(types.AdminRole_ADMIN_ROLE_VALIDATOR_SET, manager, false)
+	require.ErrorIs(t, keeper.ProcessUpdateWhiteListValidator(ctx, admin, validatorAddresses[0], "remove"),
+		oracletypes.ErrNotAdminAccount)
+	require.NoError(t, keeper.ProcessUpdateWhiteListValidator(ctx, manager, validatorAddresses[0], "remove"))
+	require.Empty(t, oracleKeeper.GetOracleWhiteList(ctx))
+	require.ErrorIs(t, keeper.ProcessUpdateWhiteListValidator(ctx, manager, validatorAddresses[0], "replace"),
+		oracletypes.ErrInvalidOperationType)
+
+	grants, _, err := keeper.GetAdminRoleGrantsPaginated(ctx, compliance, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.AdminRoleGrant{types.NewAdminRoleGrant(compliance, officer)}, grants)
+	grants, pageRes, err := keeper.GetAdminRoleGrantsPaginated(ctx, types.AdminRole_ADMIN_ROLE_UNSPECIFIED,
+		&query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	require.Equal(t, uint64(2), pageRes.Total)
+
+	// Revoking the last holder of a role does not return it to the admin account
+	grant(compliance, officer, true)
+	require.False(t, keeper.HasAdminRole(ctx, compliance, officer))
+	require.False(t, keeper.HasAdminRole(ctx, compliance, admin))
+	grant(types.AdminRole_ADMIN_ROLE_RESCUER, officer, false)
+	require.True(t, keeper.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_RESCUER, officer))
+	grant(types.AdminRole_ADMIN_ROLE_RESCUER, officer, true)
+	require.False(t, keeper.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_RESCUER, officer))
+	require.False(t, keeper.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_RESCUER, admin))
+	require.True(t, keeper.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_FEE, admin))
+	require.Equal(t, []types.AdminRoleGrant{
+		types.NewAdminRoleGrant(types.AdminRole_ADMIN_ROLE_VALIDATOR_SET, manager),
+	}, keeper.GetAdminRoleGrants(ctx))
+	require.Equal(t, []types.AdminRole{
+		types.AdminRole_ADMIN_ROLE_VALIDATOR_SET, compliance, types.AdminRole_ADMIN_ROLE_RESCUER,
+	}, keeper.GetConfiguredAdminRoles(ctx))
+}
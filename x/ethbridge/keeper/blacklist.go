@@ -1,72 +1,187 @@
 package keeper
 
 import (
+	"bytes"
+	"strconv"
+
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
+// legacyBlacklistValue is the value of entries written before blacklist entries had metadata
+var legacyBlacklistValue = []byte{0x01}
+
+// IsBlacklisted returns whether an address has a blacklist entry that has not expired
 func (k Keeper) IsBlacklisted(ctx sdk.Context, address string) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(append(types.BlacklistPrefix, []byte(address)...))
+	for _, key := range []string{types.NormalizeBlacklistAddress(address), address} {
+		if entry, ok := k.GetBlacklistEntry(ctx, key); ok {
+			return entry.IsActive(ctx.BlockHeight())
+		}
+	}
+	return false
 }
 
+// SetBlacklist replaces the blacklist with the addresses of the message. Entries of addresses that remain
+// blacklisted are kept.
 func (k Keeper) SetBlacklist(ctx sdk.Context, msg *types.MsgSetBlacklist) error {
 	from, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
 		return err
 	}
 
-	if !k.oracleKeeper.IsAdminAccount(ctx, from) {
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_COMPLIANCE, from) {
 		return oracletypes.ErrNotAdminAccount
 	}
 
-	store := ctx.KVStore(k.storeKey)
+	remaining := make(map[string]bool, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		remaining[types.NormalizeBlacklistAddress(address)] = true
+	}
 	// Process removals
-	var removals []string
-	iter := store.Iterator(types.BlacklistPrefix, nil)
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		if len(key) > 1 {
-			address := string(key[1:])
-			remains := false
-			for _, current := range msg.Addresses {
-				if current == address {
-					remains = true
-				}
-			}
-
-			if !remains {
-				removals = append(removals, address)
+	for _, entry := range k.GetBlacklistEntries(ctx) {
+		if !remaining[entry.Address] {
+			k.removeBlacklistEntry(ctx, entry.Address, "", msg.From)
+		}
+	}
+	// Process additions
+	for _, address := range msg.Addresses {
+		if _, ok := k.GetBlacklistEntry(ctx, types.NormalizeBlacklistAddress(address)); !ok {
+			entry, err := types.NewBlacklistEntry(address, "", from, ctx.BlockHeight(), 0)
+			if err != nil {
+				return err
 			}
+			k.addBlacklistEntry(ctx, entry)
 		}
 	}
-	err = iter.Close()
+
+	return nil
+}
+
+// ProcessAddToBlacklist adds the addresses of the message to the blacklist, replacing their existing entries
+func (k Keeper) ProcessAddToBlacklist(ctx sdk.Context, msg *types.MsgAddToBlacklist) error {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
 	if err != nil {
 		return err
 	}
-
-	for _, address := range removals {
-		store.Delete(append(types.BlacklistPrefix, []byte(address)...))
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_COMPLIANCE, cosmosSender) {
+		return oracletypes.ErrNotAdminAccount
 	}
-	// Process additions
 	for _, address := range msg.Addresses {
-		store.Set(append(types.BlacklistPrefix, []byte(address)...), []byte{0x01})
+		entry, err := types.NewBlacklistEntry(address, msg.Reason, cosmosSender, ctx.BlockHeight(), msg.ExpiryHeight)
+		if err != nil {
+			return err
+		}
+		k.addBlacklistEntry(ctx, entry)
 	}
+	return nil
+}
 
+// ProcessRemoveFromBlacklist removes the addresses of the message from the blacklist
+func (k Keeper) ProcessRemoveFromBlacklist(ctx sdk.Context, msg *types.MsgRemoveFromBlacklist) error {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		return err
+	}
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_COMPLIANCE, cosmosSender) {
+		return oracletypes.ErrNotAdminAccount
+	}
+	for _, address := range msg.Addresses {
+		k.removeBlacklistEntry(ctx, types.NormalizeBlacklistAddress(address), msg.Reason, msg.CosmosSender)
+		k.removeBlacklistEntry(ctx, address, msg.Reason, msg.CosmosSender)
+	}
 	return nil
 }
 
-func (k Keeper) GetBlacklist(ctx sdk.Context) []string {
-	var addresses []string
+// SetBlacklistEntry stores a blacklist entry
+func (k Keeper) SetBlacklistEntry(ctx sdk.Context, entry types.BlacklistEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(types.BlacklistPrefix, []byte(entry.Address)...), k.cdc.MustMarshal(&entry))
+}
+
+// GetBlacklistEntry returns the blacklist entry of an address
+func (k Keeper) GetBlacklistEntry(ctx sdk.Context, address string) (types.BlacklistEntry, bool) {
+	if address == "" {
+		return types.BlacklistEntry{}, false
+	}
 	store := ctx.KVStore(k.storeKey)
-	iter := store.Iterator(types.BlacklistPrefix, nil)
+	bz := store.Get(append(types.BlacklistPrefix, []byte(address)...))
+	if bz == nil {
+		return types.BlacklistEntry{}, false
+	}
+	return k.unmarshalBlacklistEntry(address, bz), true
+}
+
+// GetBlacklistEntries returns all blacklist entries, including expired ones
+func (k Keeper) GetBlacklistEntries(ctx sdk.Context) []types.BlacklistEntry {
+	var entries []types.BlacklistEntry
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BlacklistPrefix)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		address := string(key[1:])
-		addresses = append(addresses, address)
+		entries = append(entries, k.unmarshalBlacklistEntry(string(iter.Key()[1:]), iter.Value()))
+	}
+	return entries
+}
+
+// GetBlacklistEntriesPaginated returns the blacklist entries in address order
+func (k Keeper) GetBlacklistEntriesPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.BlacklistEntry, *query.PageResponse, error) {
+	var entries []types.BlacklistEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlacklistPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		entries = append(entries, k.unmarshalBlacklistEntry(string(key), value))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// GetBlacklist returns the blacklisted addresses
+func (k Keeper) GetBlacklist(ctx sdk.Context) []string {
+	var addresses []string
+	for _, entry := range k.GetBlacklistEntries(ctx) {
+		addresses = append(addresses, entry.Address)
 	}
 
 	return addresses
 }
+
+// unmarshalBlacklistEntry decodes a stored blacklist entry, legacy entries only have their address
+func (k Keeper) unmarshalBlacklistEntry(address string, bz []byte) types.BlacklistEntry {
+	if bytes.Equal(bz, legacyBlacklistValue) {
+		return types.BlacklistEntry{Address: address}
+	}
+	var entry types.BlacklistEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry
+}
+
+func (k Keeper) addBlacklistEntry(ctx sdk.Context, entry types.BlacklistEntry) {
+	k.SetBlacklistEntry(ctx, entry)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddToBlacklist,
+		sdk.NewAttribute(types.AttributeKeyAddress, entry.Address),
+		sdk.NewAttribute(types.AttributeKeyReason, entry.Reason),
+		sdk.NewAttribute(types.AttributeKeyCosmosSender, entry.AddedBy),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(entry.ExpiryHeight, 10)),
+	))
+}
+
+func (k Keeper) removeBlacklistEntry(ctx sdk.Context, address string, reason string, removedBy string) {
+	store := ctx.KVStore(k.storeKey)
+	key := append(types.BlacklistPrefix, []byte(address)...)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveFromBlacklist,
+		sdk.NewAttribute(types.AttributeKeyAddress, address),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+		sdk.NewAttribute(types.AttributeKeyCosmosSender, removedBy),
+	))
+}
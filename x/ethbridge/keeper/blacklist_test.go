@@ -7,6 +7,7 @@ import (
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -130,3 +131,97 @@ func TestKeeper_SetBlacklist_Nonadmin(t *testing.T) {
 	})
 	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
 }
+
+func TestAddAndRemoveFromBlacklist(t *testing.T) {
+	var ctx, keeper, _, _, oracleKeeper, _, _ = test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	adminAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	oracleKeeper.SetAdminAccount(ctx, adminAddress)
+	ctx = ctx.WithBlockHeight(10)
+
+	permanent := "0x782D10cC8c352D0524a1639eD261d29F47023922"
+	temporary := "0x782d10cc8c352d0524a1639ed261d29f47023923"
+	add := types.NewMsgAddToBlacklist(adminAddress, []string{permanent}, "sanctioned", 0)
+	require.NoError(t, keeper.ProcessAddToBlacklist(ctx, &add))
+	add = types.NewMsgAddToBlacklist(adminAddress, []string{temporary}, "under investigation", 20)
+	require.NoError(t, keeper.ProcessAddToBlacklist(ctx, &add))
+
+	// Addresses are stored checksummed and matched regardless of their case
+	checksummed := types.NormalizeBlacklistAddress(temporary)
+	require.NotEqual(t, temporary, checksummed)
+	entry, ok := keeper.GetBlacklistEntry(ctx, checksummed)
+	require.True(t, ok)
+	require.Equal(t, types.BlacklistEntry{
+		Address:      checksummed,
+		Reason:       "under investigation",
+		AddedBy:      adminAddress.String(),
+		AddedHeight:  10,
+		ExpiryHeight: 20,
+	}, entry)
+	require.True(t, keeper.IsBlacklisted(ctx, temporary))
+
+	// Entries stop applying at their expiry height
+	require.True(t, keeper.IsBlacklisted(ctx.WithBlockHeight(19), temporary))
+	require.False(t, keeper.IsBlacklisted(ctx.WithBlockHeight(20), temporary))
+	require.True(t, keeper.IsBlacklisted(ctx.WithBlockHeight(1000), permanent))
+
+	entries, pageRes, err := keeper.GetBlacklistEntriesPaginated(ctx, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, types.NormalizeBlacklistAddress(permanent), entries[0].Address)
+	require.Equal(t, uint64(2), pageRes.Total)
+
+	remove := types.NewMsgRemoveFromBlacklist(adminAddress, []string{temporary}, "cleared")
+	require.NoError(t, keeper.ProcessRemoveFromBlacklist(ctx, &remove))
+	require.False(t, keeper.IsBlacklisted(ctx, temporary))
+	require.Equal(t, []string{types.NormalizeBlacklistAddress(permanent)}, keeper.GetBlacklist(ctx))
+
+	// Only the admin can change the blacklist
+	nonAdmin := sdk.AccAddress([]byte("non-admin-address___"))
+	add = types.NewMsgAddToBlacklist(nonAdmin, []string{temporary}, "sanctioned", 0)
+	require.ErrorIs(t, keeper.ProcessAddToBlacklist(ctx, &add), oracletypes.ErrNotAdminAccount)
+	remove = types.NewMsgRemoveFromBlacklist(nonAdmin, []string{permanent}, "cleared")
+	require.ErrorIs(t, keeper.ProcessRemoveFromBlacklist(ctx, &remove), oracletypes.ErrNotAdminAccount)
+	require.True(t, keeper.IsBlacklisted(ctx, permanent))
+}
+
+func TestBlacklistedCosmosReceiver(t *testing.T) {
+	var ctx, keeper, bankKeeper, _, oracleKeeper, _, validatorAddresses = test.CreateTestKeepers(t, 0.7, []int64{3}, "")
+	adminAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	oracleKeeper.SetAdminAccount(ctx, adminAddress)
+
+	// Only ethereum and cosmos account addresses can be blacklisted
+	_, err = types.NewBlacklistEntry("not-an-address", "sanctioned", adminAddress, 1, 0)
+	require.ErrorIs(t, err, types.ErrInvalidBlacklist)
+	add := types.NewMsgAddToBlacklist(adminAddress, []string{"0x782D10cC8c352D0524a1639eD261d29F4702392"}, "sanctioned", 0)
+	require.ErrorIs(t, add.ValidateBasic(), types.ErrInvalidBlacklist)
+	require.ErrorIs(t, keeper.ProcessAddToBlacklist(ctx, &add), types.ErrInvalidBlacklist)
+
+	add = types.NewMsgAddToBlacklist(adminAddress, []string{cosmosReceivers[0].String()}, "sanctioned", 0)
+	require.NoError(t, add.ValidateBasic())
+	require.NoError(t, keeper.ProcessAddToBlacklist(ctx, &add))
+	require.True(t, keeper.IsBlacklisted(ctx, cosmosReceivers[0].String()))
+
+	// The coins of a successful claim to a blacklisted receiver are escrowed
+	claim := types.NewEthBridgeClaim(ethereumChainID, ethBridgeAddress, 1, "stake", tokenContractAddress, ethereumSender,
+		cosmosReceivers[0], validatorAddresses[0], sdk.NewInt(10), types.ClaimType_CLAIM_TYPE_BURN)
+	status, err := keeper.ProcessClaim(ctx, claim)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+	require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, status.FinalClaim))
+	require.True(t, bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake").IsZero())
+	unclaimed, ok := keeper.GetUnclaimedInbound(ctx, 0)
+	require.True(t, ok)
+	require.Equal(t, cosmosReceivers[0].String(), unclaimed.CosmosReceiver)
+	require.Equal(t, "receiver is blacklisted", unclaimed.Reason)
+
+	// and only the rescuer can redirect them
+	redirect := types.NewMsgRedirectUnclaimedInbound(cosmosReceivers[0], unclaimed.Id, adminAddress)
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+	redirect = types.NewMsgRedirectUnclaimedInbound(adminAddress, unclaimed.Id, adminAddress)
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(10), bankKeeper.GetBalance(ctx, adminAddress, "stake").Amount)
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+// SetBridgeSupply stores the bridge supply of a denom
+func (k Keeper) SetBridgeSupply(ctx sdk.Context, supply types.BridgeSupply) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBridgeSupplyKey(supply.Denom), k.cdc.MustMarshal(&supply))
+}
+
+// GetBridgeSupply returns the bridge supply of a denom, or an empty supply if the bridge never minted, burned or
+// locked it
+func (k Keeper) GetBridgeSupply(ctx sdk.Context, denom string) types.BridgeSupply {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBridgeSupplyKey(denom))
+	if bz == nil {
+		return types.NewBridgeSupply(denom)
+	}
+	var supply types.BridgeSupply
+	k.cdc.MustUnmarshal(bz, &supply)
+	return supply
+}
+
+// GetBridgeSupplies returns the bridge supplies of all denoms
+func (k Keeper) GetBridgeSupplies(ctx sdk.Context) []types.BridgeSupply {
+	var supplies []types.BridgeSupply
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BridgeSupplyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var supply types.BridgeSupply
+		k.cdc.MustUnmarshal(iter.Value(), &supply)
+		supplies = append(supplies, supply)
+	}
+	return supplies
+}
+
+// AddBridgeMinted records an amount of a pegged denom minted by the bridge
+func (k Keeper) AddBridgeMinted(ctx sdk.Context, denom string, amount sdk.Int) {
+	supply := k.GetBridgeSupply(ctx, denom)
+	supply.Minted = supply.Minted.Add(amount)
+	k.SetBridgeSupply(ctx, supply)
+}
+
+// AddBridgeBurned records an amount of a pegged denom burned by the bridge
+func (k Keeper) AddBridgeBurned(ctx sdk.Context, denom string, amount sdk.Int) {
+	supply := k.GetBridgeSupply(ctx, denom)
+	supply.Burned = supply.Burned.Add(amount)
+	k.SetBridgeSupply(ctx, supply)
+}
+
+// AddBridgeLocked records an amount of a native denom burned by a lock
+func (k Keeper) AddBridgeLocked(ctx sdk.Context, denom string, amount sdk.Int) {
+	supply := k.GetBridgeSupply(ctx, denom)
+	supply.Locked = supply.Locked.Add(amount)
+	k.SetBridgeSupply(ctx, supply)
+}
+
+// SubBridgeLocked removes an amount of a native denom minted back by the refund of a lock from its locked amount
+func (k Keeper) SubBridgeLocked(ctx sdk.Context, denom string, amount sdk.Int) {
+	supply := k.GetBridgeSupply(ctx, denom)
+	supply.Locked = supply.Locked.Sub(amount)
+	k.SetBridgeSupply(ctx, supply)
+}
+
+// SeedBridgeSupplies starts tracking the bridge supplies of a state that predates them. The bank supply of every
+// peggy token is taken as minted by the bridge and the amounts of the recorded outbound locks that were not refunded
+// as locked.
+func (k Keeper) SeedBridgeSupplies(ctx sdk.Context) {
+	for _, token := range k.GetPeggyToken(ctx).Tokens {
+		supply := k.GetBridgeSupply(ctx, token)
+		supply.Minted = k.bankKeeper.GetSupply(ctx, token).Amount
+		supply.Burned = sdk.ZeroInt()
+		k.SetBridgeSupply(ctx, supply)
+	}
+	locked := make(map[string]sdk.Int)
+	var denoms []string
+	for _, transfer := range k.GetOutboundTransfers(ctx) {
+		if !transfer.IsLocked() {
+			continue
+		}
+		if _, ok := locked[transfer.Symbol]; !ok {
+			locked[transfer.Symbol] = sdk.ZeroInt()
+			denoms = append(denoms, transfer.Symbol)
+		}
+		locked[transfer.Symbol] = locked[transfer.Symbol].Add(transfer.Amount)
+	}
+	for _, denom := range denoms {
+		supply := k.GetBridgeSupply(ctx, denom)
+		supply.Locked = locked[denom]
+		k.SetBridgeSupply(ctx, supply)
+	}
+}
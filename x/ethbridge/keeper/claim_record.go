@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// GetNextClaimRecordID returns the id the next claim record is assigned
+func (k Keeper) GetNextClaimRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextClaimRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextClaimRecordID sets the id the next claim record is assigned
+func (k Keeper) SetNextClaimRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextClaimRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetClaimRecord stores a claim record and indexes it by prophecy id, cosmos receiver, ethereum sender and status,
+// replacing the index entries of the record it overwrites
+func (k Keeper) SetClaimRecord(ctx sdk.Context, record types.ClaimRecord) {
+	store := ctx.KVStore(k.storeKey)
+	if old, ok := k.GetClaimRecord(ctx, record.Id); ok {
+		for _, key := range claimRecordIndexKeys(old) {
+			store.Delete(key)
+		}
+	}
+	id := sdk.Uint64ToBigEndian(record.Id)
+	store.Set(types.GetClaimRecordKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.GetClaimRecordIDKey(record.ProphecyId), id)
+	for _, key := range claimRecordIndexKeys(record) {
+		store.Set(key, id)
+	}
+}
+
+// claimRecordIndexKeys returns the keys of the secondary index entries of a claim record
+func claimRecordIndexKeys(record types.ClaimRecord) [][]byte {
+	id := sdk.Uint64ToBigEndian(record.Id)
+	keys := [][]byte{
+		append(types.GetClaimsBySenderKey(types.NewEthereumAddress(record.EthereumSender)), id...),
+		append(types.GetClaimsByStatusKey(record.Status), id...),
+	}
+	if receiver, err := sdk.AccAddressFromBech32(record.CosmosReceiver); err == nil {
+		keys = append(keys, append(types.GetClaimsByReceiverKey(receiver), id...))
+	}
+	return keys
+}
+
+// GetClaimRecord returns a claim record by id
+func (k Keeper) GetClaimRecord(ctx sdk.Context, id uint64) (types.ClaimRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetClaimRecordKey(id))
+	if bz == nil {
+		return types.ClaimRecord{}, false
+	}
+	var record types.ClaimRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetClaimRecordByProphecyID returns the claim record of a prophecy
+func (k Keeper) GetClaimRecordByProphecyID(ctx sdk.Context, prophecyID string) (types.ClaimRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetClaimRecordIDKey(prophecyID))
+	if bz == nil {
+		return types.ClaimRecord{}, false
+	}
+	return k.GetClaimRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetClaimRecords returns all claim records
+func (k Keeper) GetClaimRecords(ctx sdk.Context) []types.ClaimRecord {
+	var records []types.ClaimRecord
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ClaimRecordPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.ClaimRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetClaimRecordsPaginated returns the claim records in id order, only those of a cosmos receiver, of an ethereum
+// sender and with a status when they are set. The most selective index of the filters set is iterated.
+func (k Keeper) GetClaimRecordsPaginated(ctx sdk.Context, cosmosReceiver sdk.AccAddress, ethereumSender string,
+	status oracletypes.StatusText, pagination *query.PageRequest) ([]types.ClaimRecord, *query.PageResponse, error) {
+	var indexPrefix []byte
+	switch {
+	case !cosmosReceiver.Empty():
+		indexPrefix = types.GetClaimsByReceiverKey(cosmosReceiver)
+	case ethereumSender != "":
+		indexPrefix = types.GetClaimsBySenderKey(types.NewEthereumAddress(ethereumSender))
+	case status != oracletypes.StatusText_STATUS_TEXT_UNSPECIFIED:
+		indexPrefix = types.GetClaimsByStatusKey(status)
+	}
+
+	var records []types.ClaimRecord
+	filter := func(record types.ClaimRecord, accumulate bool) bool {
+		if !cosmosReceiver.Empty() && record.CosmosReceiver != cosmosReceiver.String() {
+			return false
+		}
+		if ethereumSender != "" &&
+			types.NewEthereumAddress(record.EthereumSender) != types.NewEthereumAddress(ethereumSender) {
+			return false
+		}
+		if status != oracletypes.StatusText_STATUS_TEXT_UNSPECIFIED && record.Status != status {
+			return false
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true
+	}
+
+	var pageRes *query.PageResponse
+	var err error
+	if indexPrefix == nil {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClaimRecordPrefix)
+		pageRes, err = query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			var record types.ClaimRecord
+			if err := k.cdc.Unmarshal(value, &record); err != nil {
+				return false, err
+			}
+			return filter(record, accumulate), nil
+		})
+	} else {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+		pageRes, err = query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			record, ok := k.GetClaimRecord(ctx, sdk.BigEndianToUint64(value))
+			if !ok {
+				return false, nil
+			}
+			return filter(record, accumulate), nil
+		})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, pageRes, nil
+}
+
+// recordClaim creates the claim record of the prophecy of a claim on its first claim and updates its status
+func (k Keeper) recordClaim(ctx sdk.Context, claim *types.EthBridgeClaim, prophecyID string,
+	status oracletypes.Status) error {
+	record, ok := k.GetClaimRecordByProphecyID(ctx, prophecyID)
+	if !ok {
+		id := k.GetNextClaimRecordID(ctx)
+		k.SetNextClaimRecordID(ctx, id+1)
+		record = types.NewClaimRecord(id, prophecyID, claim, ctx.BlockHeight())
+	}
+	if err := record.UpdateStatus(status, ctx.BlockHeight()); err != nil {
+		return err
+	}
+	k.SetClaimRecord(ctx, record)
+	return nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimRecords(t *testing.T) {
+	ctx, ethbridgeKeeper, _, _, _, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	receivers, _ := test.CreateTestAddrs(2)
+	otherSender := types.NewEthereumAddress("0x1111111111111111111111111111111111111111")
+	claim := func(validator sdk.ValAddress, nonce int64, sender types.EthereumAddress,
+		receiver sdk.AccAddress) oracletypes.StatusText {
+		claim := types.NewEthBridgeClaim(ethereumChainID, ethBridgeAddress, nonce, symbol, tokenContractAddress,
+			sender, receiver, validator, amount, types.ClaimType_CLAIM_TYPE_LOCK)
+		status, err := ethbridgeKeeper.ProcessClaim(ctx, claim)
+		require.NoError(t, err)
+		return status.Text
+	}
+
+	// The first claim of a prophecy creates its record, the claims that complete it update its status
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, claim(validatorAddresses[0], 1, ethereumSender, receivers[0]))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, claim(validatorAddresses[0], 2, otherSender, receivers[1]))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, claim(validatorAddresses[1], 1, ethereumSender, receivers[0]))
+	require.Equal(t, uint64(2), ethbridgeKeeper.GetNextClaimRecordID(ctx))
+
+	succeeded, ok := ethbridgeKeeper.GetClaimRecord(ctx, 0)
+	require.True(t, ok)
+	require.Equal(t, types.ClaimRecord{
+		Id:                   0,
+		ProphecyId:           "31" + ethereumSender.String(),
+		EthereumChainId:      ethereumChainID,
+		Nonce:                1,
+		EthereumSender:       ethereumSender.String(),
+		CosmosReceiver:       receivers[0].String(),
+		Symbol:               symbol,
+		TokenContractAddress: tokenContractAddress.String(),
+		Amount:               amount,
+		ClaimType:            types.ClaimType_CLAIM_TYPE_LOCK,
+		Status:               oracletypes.StatusText_STATUS_TEXT_SUCCESS,
+		CreatedHeight:        ctx.BlockHeight() - 2,
+		CompletedHeight:      ctx.BlockHeight(),
+	}, succeeded)
+	record, ok := ethbridgeKeeper.GetClaimRecordByProphecyID(ctx, succeeded.ProphecyId)
+	require.True(t, ok)
+	require.Equal(t, succeeded, record)
+	pending, ok := ethbridgeKeeper.GetClaimRecord(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, pending.Status)
+	require.Zero(t, pending.CompletedHeight)
+
+	queryServer := keeper.NewQueryServer(ethbridgeKeeper)
+	records := func(req *types.QueryClaimRecordsRequest) []types.ClaimRecord {
+		res, err := queryServer.GetClaimRecords(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		return res.Records
+	}
+	require.Equal(t, []types.ClaimRecord{succeeded, pending}, records(&types.QueryClaimRecordsRequest{}))
+	require.Equal(t, []types.ClaimRecord{succeeded}, records(&types.QueryClaimRecordsRequest{
+		CosmosReceiver: receivers[0].String(),
+	}))
+	require.Equal(t, []types.ClaimRecord{pending}, records(&types.QueryClaimRecordsRequest{
+		EthereumSender: "0x1111111111111111111111111111111111111111",
+	}))
+	require.Equal(t, []types.ClaimRecord{succeeded}, records(&types.QueryClaimRecordsRequest{
+		Status: oracletypes.StatusText_STATUS_TEXT_SUCCESS,
+	}))
+	// The status index is rewritten when the status changes
+	require.Equal(t, []types.ClaimRecord{pending}, records(&types.QueryClaimRecordsRequest{
+		Status: oracletypes.StatusText_STATUS_TEXT_PENDING,
+	}))
+	require.Empty(t, records(&types.QueryClaimRecordsRequest{
+		CosmosReceiver: receivers[0].String(),
+		Status:         oracletypes.StatusText_STATUS_TEXT_PENDING,
+	}))
+
+	res, err := queryServer.GetClaimRecords(sdk.WrapSDKContext(ctx), &types.QueryClaimRecordsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ClaimRecord{succeeded}, res.Records)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	_, err = queryServer.GetClaimRecords(sdk.WrapSDKContext(ctx), &types.QueryClaimRecordsRequest{CosmosReceiver: "sif"})
+	require.Error(t, err)
+	_, err = queryServer.GetClaimRecords(sdk.WrapSDKContext(ctx), &types.QueryClaimRecordsRequest{EthereumSender: "0x11"})
+	require.Error(t, err)
+	_, err = queryServer.GetClaimRecords(sdk.WrapSDKContext(ctx), &types.QueryClaimRecordsRequest{
+		Pagination: &query.PageRequest{Limit: keeper.MaxPageLimit + 1},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetGasPriceReport stores the gas price report of a relayer, replacing its previous report for the network
+func (k Keeper) SetGasPriceReport(ctx sdk.Context, report types.GasPriceReport) {
+	validator, err := sdk.ValAddressFromBech32(report.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGasPriceReportKey(report.EthereumChainId, validator), k.cdc.MustMarshal(&report))
+}
+
+// GetGasPriceReports returns the gas price reports of all networks
+func (k Keeper) GetGasPriceReports(ctx sdk.Context) []types.GasPriceReport {
+	return k.getGasPriceReports(ctx, types.GasPriceReportPrefix)
+}
+
+// GetRecentGasPriceReports returns the gas price reports of a network that count towards its median gas price, those
+// of whitelisted relayers that have not timed out
+func (k Keeper) GetRecentGasPriceReports(ctx sdk.Context, ethereumChainID int64) []types.GasPriceReport {
+	var recent []types.GasPriceReport
+	for _, report := range k.getGasPriceReports(ctx, types.GetGasPriceReportsKey(ethereumChainID)) {
+		validator, err := sdk.ValAddressFromBech32(report.ValidatorAddress)
+		if err != nil {
+			continue
+		}
+		if report.IsRecent(ctx.BlockHeight()) && k.oracleKeeper.ValidateAddress(ctx, validator) {
+			recent = append(recent, report)
+		}
+	}
+	return recent
+}
+
+// GetMinCethAmount returns the smallest cross-chain fee of a lock or burn to a network, with the median gas price and
+// the number of reports it is taken of. The minimum is zero when the network is not registered, has no outbound gas or
+// no recent reports.
+func (k Keeper) GetMinCethAmount(ctx sdk.Context, ethereumChainID int64) (sdk.Int, sdk.Int, int) {
+	reports := k.GetRecentGasPriceReports(ctx, ethereumChainID)
+	gasPrice := types.MedianGasPrice(reports)
+	network, _ := k.GetNetwork(ctx, ethereumChainID)
+	return gasPrice.Mul(sdk.NewIntFromUint64(network.OutboundGas)), gasPrice, len(reports)
+}
+
+// GetGasPriceQuorum returns the number of whitelisted relayers whose recent reports the median gas price of a network
+// must be taken of, the oracle consensus threshold of the whitelist rounded up and at least one
+func (k Keeper) GetGasPriceQuorum(ctx sdk.Context) int {
+	relayers := sdk.NewDec(int64(len(k.oracleKeeper.GetOracleWhiteList(ctx))))
+	quorum := int(relayers.Mul(k.oracleKeeper.GetConsensusNeeded(ctx)).Ceil().TruncateInt64())
+	if quorum < 1 {
+		return 1
+	}
+	return quorum
+}
+
+// CheckCethAmount returns an error if the cross-chain fee of a lock or burn does not cover the gas of delivering it.
+// Transfers to a network with outbound gas are refused until a quorum of relayers has reported its gas price, so no
+// single relayer sets the median.
+func (k Keeper) CheckCethAmount(ctx sdk.Context, ethereumChainID int64, cethAmount sdk.Int) error {
+	network, _ := k.GetNetwork(ctx, ethereumChainID)
+	if network.OutboundGas == 0 {
+		return nil
+	}
+	minCethAmount, _, reports := k.GetMinCethAmount(ctx, ethereumChainID)
+	if quorum := k.GetGasPriceQuorum(ctx); reports < quorum {
+		return sdkerrors.Wrapf(types.ErrGasPriceQuorum, "%d of %d reports on chain id %d", reports, quorum, ethereumChainID)
+	}
+	if cethAmount.LT(minCethAmount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientCethFee, "%s is below %s", cethAmount, minCethAmount)
+	}
+	return nil
+}
+
+// ProcessReportGasPrice records the gas price of a network reported by a whitelisted relayer
+func (k Keeper) ProcessReportGasPrice(ctx sdk.Context, msg *types.MsgReportGasPrice) error {
+	validator, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.oracleKeeper.EnsureAddressIsInWhitelist(ctx, msg.ValidatorAddress); err != nil {
+		return err
+	}
+	k.SetGasPriceReport(ctx, types.NewGasPriceReport(validator, msg.EthereumChainId, msg.GasPrice, ctx.BlockHeight()))
+	return nil
+}
+
+func (k Keeper) getGasPriceReports(ctx sdk.Context, prefix []byte) []types.GasPriceReport {
+	var reports []types.GasPriceReport
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var report types.GasPriceReport
+		k.cdc.MustUnmarshal(iter.Value(), &report)
+		reports = append(reports, report)
+	}
+	return reports
+}
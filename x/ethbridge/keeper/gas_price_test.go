@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossChainFee(t *testing.T) {
+	ctx, keeper, bankKeeper, _, _, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 3, 3}, "")
+	network := bscNetwork
+	network.OutboundGas = 100000
+	keeper.SetNetwork(ctx, network)
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)), sdk.NewCoin(network.NativeToken, sdk.NewInt(1000000000000000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins))
+	lock := func(ctx sdk.Context, cethAmount int64) error {
+		msg := types.NewMsgLock(56, cosmosReceivers[0], ethereumSender, sdk.NewInt(1), "stake", sdk.NewInt(cethAmount))
+		return keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
+	}
+	report := func(ctx sdk.Context, validator sdk.ValAddress, gasPrice int64) error {
+		msg := types.NewMsgReportGasPrice(validator, 56, sdk.NewInt(gasPrice))
+		return keeper.ProcessReportGasPrice(ctx, &msg)
+	}
+
+	// Without reports there is no median gas price, and transfers are refused
+	minCethAmount, gasPrice, reports := keeper.GetMinCethAmount(ctx, 56)
+	require.True(t, minCethAmount.IsZero())
+	require.True(t, gasPrice.IsZero())
+	require.Zero(t, reports)
+	require.Equal(t, 3, keeper.GetGasPriceQuorum(ctx))
+	require.ErrorIs(t, lock(ctx, 0), types.ErrGasPriceQuorum)
+
+	// Only whitelisted relayers report gas prices
+	require.ErrorIs(t, report(ctx, sdk.ValAddress(cosmosReceivers[0]), 1), oracletypes.ErrValidatorNotInWhiteList)
+
+	// The fee is the outbound gas at the median gas price
+	require.NoError(t, report(ctx, validatorAddresses[0], 10))
+	require.NoError(t, report(ctx, validatorAddresses[1], 30))
+	minCethAmount, gasPrice, reports = keeper.GetMinCethAmount(ctx, 56)
+	require.Equal(t, sdk.NewInt(20), gasPrice)
+	require.Equal(t, sdk.NewInt(2000000), minCethAmount)
+	require.Equal(t, 2, reports)
+
+	// Until a quorum of the relayers has reported, so a single relayer does not set the fee
+	require.ErrorIs(t, lock(ctx, 1000000000), types.ErrGasPriceQuorum)
+	require.NoError(t, report(ctx.WithBlockHeight(ctx.BlockHeight()+1), validatorAddresses[2], 1000))
+	_, gasPrice, _ = keeper.GetMinCethAmount(ctx, 56)
+	require.Equal(t, sdk.NewInt(30), gasPrice)
+
+	require.ErrorIs(t, lock(ctx, 2999999), types.ErrInsufficientCethFee)
+	require.NoError(t, lock(ctx, 3000000))
+
+	// A new report of a relayer replaces its previous one
+	require.NoError(t, report(ctx, validatorAddresses[1], 40))
+	_, gasPrice, _ = keeper.GetMinCethAmount(ctx, 56)
+	require.Equal(t, sdk.NewInt(40), gasPrice)
+	require.Len(t, keeper.GetGasPriceReports(ctx), 3)
+
+	// Reports time out
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.GasPriceReportTimeout)
+	_, gasPrice, reports = keeper.GetMinCethAmount(ctx, 56)
+	require.Equal(t, sdk.NewInt(1000), gasPrice)
+	require.Equal(t, 1, reports)
+	require.ErrorIs(t, lock(ctx, 1000000000), types.ErrGasPriceQuorum)
+
+	// Networks without outbound gas have no minimum fee
+	minCethAmount, _, _ = keeper.GetMinCethAmount(ctx, ethereumChainID)
+	require.True(t, minCethAmount.IsZero())
+	msg := types.NewMsgLock(ethereumChainID, cosmosReceivers[0], ethereumSender, sdk.NewInt(1), "stake", sdk.ZeroInt())
+	require.NoError(t, keeper.ProcessLock(ctx, cosmosReceivers[0], &msg))
+}
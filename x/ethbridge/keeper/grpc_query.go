@@ -2,14 +2,22 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// MaxPageLimit is the largest page size of the paginated ethbridge queries
+const MaxPageLimit = 200
+
 var _ types.QueryServer = queryServer{}
 
 type queryServer struct {
@@ -24,6 +32,21 @@ func (srv queryServer) GetBlacklist(ctx context.Context, _ *types.QueryBlacklist
 	return &types.QueryBlacklistResponse{Addresses: addresses}, nil
 }
 
+func (srv queryServer) GetNetworks(ctx context.Context, _ *types.QueryNetworksRequest) (*types.QueryNetworksResponse, error) {
+	networks := srv.Keeper.GetNetworks(sdk.UnwrapSDKContext(ctx))
+
+	return &types.QueryNetworksResponse{Networks: networks}, nil
+}
+
+func (srv queryServer) GetNetworkPeggyTokens(ctx context.Context, req *types.QueryNetworkPeggyTokensRequest) (*types.QueryNetworkPeggyTokensResponse, error) {
+	var tokens []string
+	for _, token := range srv.Keeper.GetNetworkPeggyTokens(sdk.UnwrapSDKContext(ctx), req.EthereumChainId) {
+		tokens = append(tokens, token.Denom)
+	}
+
+	return &types.QueryNetworkPeggyTokensResponse{Tokens: tokens}, nil
+}
+
 // NewQueryServer returns an implementation of the ethbridge QueryServer interface,
 // for the provided Keeper.
 func NewQueryServer(keeper Keeper) types.QueryServer {
@@ -60,3 +83,183 @@ func (srv queryServer) EthProphecy(ctx context.Context, req *types.QueryEthProph
 
 	return &res, nil
 }
+
+func (srv queryServer) GetTransferUsage(ctx context.Context, req *types.QueryTransferUsageRequest) (*types.QueryTransferUsageResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryTransferUsageResponse{
+		Usage:         srv.Keeper.GetTransferUsage(sdkCtx, req.Denom),
+		RollingAmount: srv.Keeper.GetRollingTransferAmount(sdkCtx, req.Denom),
+		Height:        sdkCtx.BlockHeight(),
+	}, nil
+}
+
+func (srv queryServer) GetPauses(ctx context.Context, _ *types.QueryPausesRequest) (*types.QueryPausesResponse, error) {
+	pauses := srv.Keeper.GetPauses(sdk.UnwrapSDKContext(ctx))
+
+	return &types.QueryPausesResponse{Pauses: pauses}, nil
+}
+
+func (srv queryServer) GetOutboundTransfers(ctx context.Context, req *types.QueryOutboundTransfersRequest) (*types.QueryOutboundTransfersResponse, error) {
+	cosmosSender, err := sdk.AccAddressFromBech32(req.CosmosSender)
+	if err != nil {
+		return nil, err
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	transfers, pageRes, err := srv.Keeper.GetOutboundTransfersForSenderPaginated(sdk.UnwrapSDKContext(ctx), cosmosSender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOutboundTransfersResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+func (srv queryServer) GetOutboundTransfer(ctx context.Context, req *types.QueryOutboundTransferRequest) (*types.QueryOutboundTransferResponse, error) {
+	cosmosSender, err := sdk.AccAddressFromBech32(req.CosmosSender)
+	if err != nil {
+		return nil, err
+	}
+	transfer, ok := srv.Keeper.GetOutboundTransfer(sdk.UnwrapSDKContext(ctx), cosmosSender, req.CosmosSenderSequence)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrOutboundNotFound, "%s sequence %d", req.CosmosSender, req.CosmosSenderSequence)
+	}
+
+	return &types.QueryOutboundTransferResponse{Transfer: transfer}, nil
+}
+
+func (srv queryServer) GetUnclaimedInbounds(ctx context.Context, req *types.QueryUnclaimedInboundsRequest) (*types.QueryUnclaimedInboundsResponse, error) {
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	unclaimedInbounds, pageRes, err := srv.Keeper.GetUnclaimedInboundsPaginated(sdk.UnwrapSDKContext(ctx), req.CosmosReceiver, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnclaimedInboundsResponse{UnclaimedInbounds: unclaimedInbounds, Pagination: pageRes}, nil
+}
+
+func (srv queryServer) GetBlacklistEntries(ctx context.Context, req *types.QueryBlacklistEntriesRequest) (*types.QueryBlacklistEntriesResponse, error) {
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	entries, pageRes, err := srv.Keeper.GetBlacklistEntriesPaginated(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlacklistEntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+func (srv queryServer) GetCrossChainFee(ctx context.Context, req *types.QueryCrossChainFeeRequest) (*types.QueryCrossChainFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	network, err := srv.Keeper.GetRegisteredNetwork(sdkCtx, req.EthereumChainId)
+	if err != nil {
+		return nil, err
+	}
+	minCethAmount, gasPrice, reports := srv.Keeper.GetMinCethAmount(sdkCtx, req.EthereumChainId)
+
+	return &types.QueryCrossChainFeeResponse{
+		NativeToken:   network.NativeToken,
+		GasPrice:      gasPrice,
+		OutboundGas:   network.OutboundGas,
+		MinCethAmount: minCethAmount,
+		Reports:       uint32(reports),
+	}, nil
+}
+
+func (srv queryServer) GetNftClasses(ctx context.Context, req *types.QueryNftClassesRequest) (*types.QueryNftClassesResponse, error) {
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	classes, pageRes, err := srv.Keeper.GetNftClassesPaginated(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNftClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+func (srv queryServer) GetNfts(ctx context.Context, req *types.QueryNftsRequest) (*types.QueryNftsResponse, error) {
+	if req.ClassId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty class id")
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	nfts, pageRes, err := srv.Keeper.GetNftsPaginated(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Owner, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNftsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+func (srv queryServer) GetAdminRoleHolders(ctx context.Context,
+	req *types.QueryAdminRoleHoldersRequest) (*types.QueryAdminRoleHoldersResponse, error) {
+	if req.Role != types.AdminRole_ADMIN_ROLE_UNSPECIFIED {
+		if err := types.ValidateAdminRole(req.Role); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	grants, pageRes, err := srv.Keeper.GetAdminRoleGrantsPaginated(sdk.UnwrapSDKContext(ctx), req.Role, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAdminRoleHoldersResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+func (srv queryServer) GetClaimRecords(ctx context.Context,
+	req *types.QueryClaimRecordsRequest) (*types.QueryClaimRecordsResponse, error) {
+	var cosmosReceiver sdk.AccAddress
+	if req.CosmosReceiver != "" {
+		var err error
+		cosmosReceiver, err = sdk.AccAddressFromBech32(req.CosmosReceiver)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.EthereumSender != "" && !gethCommon.IsHexAddress(req.EthereumSender) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid ethereum sender %s", req.EthereumSender))
+	}
+	if _, ok := oracletypes.StatusText_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid status %d", req.Status))
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	records, pageRes, err := srv.Keeper.GetClaimRecordsPaginated(sdk.UnwrapSDKContext(ctx), cosmosReceiver,
+		req.EthereumSender, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClaimRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
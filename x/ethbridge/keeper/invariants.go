@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+// RegisterInvariants registers the ethbridge module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "peggy-supply", PeggySupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "locked-outbound", LockedOutboundInvariant(k))
+}
+
+// AllInvariants runs all invariants of the ethbridge module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := PeggySupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return LockedOutboundInvariant(k)(ctx)
+	}
+}
+
+// PeggySupplyInvariant checks that the bank supply of every peggy token is the amount the bridge minted minus the
+// amount it burned
+func PeggySupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		for _, token := range k.GetPeggyToken(ctx).Tokens {
+			supply := k.GetBridgeSupply(ctx, token)
+			bankSupply := k.bankKeeper.GetSupply(ctx, token).Amount
+			if !bankSupply.Equal(supply.Outstanding()) {
+				count++
+				msg += fmt.Sprintf("\t%s has a bank supply of %s, the bridge minted %s and burned %s\n",
+					token, bankSupply, supply.Minted, supply.Burned)
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "peggy-supply",
+			fmt.Sprintf("found %d peggy tokens with a bank supply other than their bridge supply\n%s", count, msg)), broken
+	}
+}
+
+// LockedOutboundInvariant checks that the amount of every native denom burned by locks is the amount of the outbound
+// lock transfers recorded for it that were not refunded
+func LockedOutboundInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		reported := make(map[string]sdk.Int)
+		for _, transfer := range k.GetOutboundTransfers(ctx) {
+			if !transfer.IsLocked() {
+				continue
+			}
+			amount, ok := reported[transfer.Symbol]
+			if !ok {
+				amount = sdk.ZeroInt()
+			}
+			reported[transfer.Symbol] = amount.Add(transfer.Amount)
+		}
+		locked := make(map[string]sdk.Int)
+		for _, supply := range k.GetBridgeSupplies(ctx) {
+			if !supply.Locked.IsZero() {
+				locked[supply.Denom] = supply.Locked
+			}
+		}
+		denoms := make([]string, 0, len(locked)+len(reported))
+		for denom := range locked {
+			denoms = append(denoms, denom)
+		}
+		for denom := range reported {
+			if _, ok := locked[denom]; !ok {
+				denoms = append(denoms, denom)
+			}
+		}
+		sort.Strings(denoms)
+
+		var msg string
+		count := 0
+		for _, denom := range denoms {
+			lockedAmount, ok := locked[denom]
+			if !ok {
+				lockedAmount = sdk.ZeroInt()
+			}
+			reportedAmount, ok := reported[denom]
+			if !ok {
+				reportedAmount = sdk.ZeroInt()
+			}
+			if !lockedAmount.Equal(reportedAmount) {
+				count++
+				msg += fmt.Sprintf("\t%s locked %s, outbound lock transfers of %s\n", denom, lockedAmount, reportedAmount)
+			}
+		}
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "locked-outbound",
+			fmt.Sprintf("found %d denoms locked for other amounts than their outbound transfers\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+func TestPeggySupplyInvariant(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	invariant := keeper.PeggySupplyInvariant(bridgeKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// Coins minted by a lock claim are tracked as minted
+	claimContent := types.NewOracleClaimContent(ethereumChainID, cosmosReceivers[0], amount, symbol, tokenContractAddress,
+		types.ClaimType_CLAIM_TYPE_LOCK)
+	claimBytes, err := json.Marshal(claimContent)
+	require.NoError(t, err)
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+	require.Equal(t, doubleAmount, bridgeKeeper.GetBridgeSupply(ctx, "cstake").Minted)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// Coins burned by a burn are tracked as burned
+	fee := sdk.NewCoins(sdk.NewCoin(types.CethSymbol, amount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, fee))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], fee))
+	msg := types.NewMsgBurn(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "cstake", amount)
+	require.NoError(t, bridgeKeeper.ProcessBurn(ctx, cosmosReceivers[0], &msg))
+	supply := bridgeKeeper.GetBridgeSupply(ctx, "cstake")
+	require.Equal(t, amount, supply.Burned)
+	require.Equal(t, amount, supply.Outstanding())
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// A mint outside of the bridge breaks the invariant
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("cstake", amount))))
+	res, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, res, "cstake has a bank supply of 20, the bridge minted 20 and burned 10")
+}
+
+func TestLockedOutboundInvariant(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	invariant := keeper.LockedOutboundInvariant(bridgeKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", amount), sdk.NewCoin(types.CethSymbol, amount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins))
+	msg := types.NewMsgLock(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
+	require.NoError(t, bridgeKeeper.ProcessLock(ctx, cosmosReceivers[0], &msg))
+	require.Equal(t, amount, bridgeKeeper.GetBridgeSupply(ctx, "stake").Locked)
+
+	// A lock without its outbound transfer breaks the invariant
+	res, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, res, "stake locked 10, outbound lock transfers of 0")
+
+	transfer := types.NewOutboundTransfer(cosmosReceivers[0], 0, ethereumChainID, ethereumSender, "stake", amount,
+		amount, types.ClaimType_CLAIM_TYPE_LOCK, ctx.BlockHeight())
+	require.NoError(t, bridgeKeeper.RecordOutboundTransfer(ctx, transfer))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	_, broken = keeper.AllInvariants(bridgeKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestSeedBridgeSupplies(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+
+	// A state that predates the bridge supplies breaks the invariants until they are seeded
+	bridgeKeeper.AddPeggyToken(ctx, "cstake")
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("cstake", amount))))
+	transfer := types.NewOutboundTransfer(cosmosReceivers[0], 0, ethereumChainID, ethereumSender, "stake", amount,
+		amount, types.ClaimType_CLAIM_TYPE_LOCK, ctx.BlockHeight())
+	require.NoError(t, bridgeKeeper.RecordOutboundTransfer(ctx, transfer))
+	_, broken := keeper.PeggySupplyInvariant(bridgeKeeper)(ctx)
+	require.True(t, broken)
+	_, broken = keeper.LockedOutboundInvariant(bridgeKeeper)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, keeper.NewMigrator(bridgeKeeper).MigrateToVer2(ctx))
+	require.Equal(t, amount, bridgeKeeper.GetBridgeSupply(ctx, "cstake").Minted)
+	require.Equal(t, amount, bridgeKeeper.GetBridgeSupply(ctx, "stake").Locked)
+	_, broken = keeper.AllInvariants(bridgeKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestRefundOutboundTransfer(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, oracleKeeper, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	oracleKeeper.SetAdminAccount(ctx, cosmosReceivers[0])
+	coins := sdk.NewCoins(sdk.NewCoin("stake", amount), sdk.NewCoin(types.CethSymbol, doubleAmount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins))
+	claimContent := types.NewOracleClaimContent(ethereumChainID, cosmosReceivers[0], amount, symbol, tokenContractAddress,
+		types.ClaimType_CLAIM_TYPE_LOCK)
+	claimBytes, err := json.Marshal(claimContent)
+	require.NoError(t, err)
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+
+	lockMsg := types.NewMsgLock(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
+	require.NoError(t, bridgeKeeper.ProcessLock(ctx, cosmosReceivers[0], &lockMsg))
+	require.NoError(t, bridgeKeeper.RecordOutboundTransfer(ctx, types.NewOutboundTransfer(cosmosReceivers[0], 0,
+		ethereumChainID, ethereumSender, "stake", amount, amount, types.ClaimType_CLAIM_TYPE_LOCK, ctx.BlockHeight())))
+	burnMsg := types.NewMsgBurn(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "cstake", amount)
+	require.NoError(t, bridgeKeeper.ProcessBurn(ctx, cosmosReceivers[0], &burnMsg))
+	require.NoError(t, bridgeKeeper.RecordOutboundTransfer(ctx, types.NewOutboundTransfer(cosmosReceivers[0], 1,
+		ethereumChainID, ethereumSender, "cstake", amount, amount, types.ClaimType_CLAIM_TYPE_BURN, ctx.BlockHeight())))
+	require.Equal(t, amount, bridgeKeeper.GetBridgeSupply(ctx, "stake").Locked)
+	require.True(t, bridgeKeeper.GetBridgeSupply(ctx, "cstake").Outstanding().IsZero())
+	_, broken := keeper.AllInvariants(bridgeKeeper)(ctx)
+	require.False(t, broken)
+
+	// The coins of a refunded lock are minted back and no longer counted as locked
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.OutboundRefundTimeout)
+	refundMsg := types.NewMsgRefundOutboundTransfer(cosmosReceivers[0], cosmosReceivers[0].String(), 0)
+	_, err = bridgeKeeper.ProcessRefundOutboundTransfer(ctx, &refundMsg)
+	require.NoError(t, err)
+	require.Equal(t, amount, bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake").Amount)
+	require.True(t, bridgeKeeper.GetBridgeSupply(ctx, "stake").Locked.IsZero())
+	_, broken = keeper.AllInvariants(bridgeKeeper)(ctx)
+	require.False(t, broken)
+
+	// The coins of a refunded burn are minted back and counted as minted
+	refundMsg = types.NewMsgRefundOutboundTransfer(cosmosReceivers[0], cosmosReceivers[0].String(), 1)
+	_, err = bridgeKeeper.ProcessRefundOutboundTransfer(ctx, &refundMsg)
+	require.NoError(t, err)
+	require.Equal(t, amount, bankKeeper.GetBalance(ctx, cosmosReceivers[0], "cstake").Amount)
+	require.Equal(t, amount, bridgeKeeper.GetBridgeSupply(ctx, "cstake").Outstanding())
+	_, broken = keeper.AllInvariants(bridgeKeeper)(ctx)
+	require.False(t, broken)
+
+	// Seeding does not count refunded locks as locked
+	bridgeKeeper.SeedBridgeSupplies(ctx)
+	require.True(t, bridgeKeeper.GetBridgeSupply(ctx, "stake").Locked.IsZero())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 )
@@ -24,16 +25,18 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	oracleKeeper  types.OracleKeeper
+	tokenRegistry types.TokenRegistryKeeper
 	storeKey      sdk.StoreKey
 }
 
 // NewKeeper creates new instances of the oracle Keeper
-func NewKeeper(cdc codec.BinaryCodec, bankKeeper types.BankKeeper, oracleKeeper types.OracleKeeper, accountKeeper types.AccountKeeper, storeKey sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, bankKeeper types.BankKeeper, oracleKeeper types.OracleKeeper, accountKeeper types.AccountKeeper, tokenRegistryKeeper types.TokenRegistryKeeper, storeKey sdk.StoreKey) Keeper {
 	return Keeper{
 		cdc:           cdc,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		oracleKeeper:  oracleKeeper,
+		tokenRegistry: tokenRegistryKeeper,
 		storeKey:      storeKey,
 	}
 }
@@ -46,13 +49,30 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // ProcessClaim processes a new claim coming in from a validator
 func (k Keeper) ProcessClaim(ctx sdk.Context, claim *types.EthBridgeClaim) (oracletypes.Status, error) {
 	logger := k.Logger(ctx)
+	network, err := k.GetRegisteredNetwork(ctx, claim.EthereumChainId)
+	if err != nil {
+		return oracletypes.Status{}, err
+	}
+	if !network.IsBridgeContract(claim.BridgeContractAddress) {
+		return oracletypes.Status{}, sdkerrors.Wrapf(types.ErrInvalidBridgeContract, "%s on chain id %d",
+			claim.BridgeContractAddress, claim.EthereumChainId)
+	}
 	oracleClaim, err := types.CreateOracleClaimFromEthClaim(claim)
 	if err != nil {
 		logger.Error("failed to create oracle claim from eth claim.",
 			errorMessageKey, err.Error())
 		return oracletypes.Status{}, err
 	}
-	return k.oracleKeeper.ProcessClaim(ctx, oracleClaim)
+	status, err := k.oracleKeeper.ProcessClaim(ctx, oracleClaim)
+	if err != nil {
+		return oracletypes.Status{}, err
+	}
+	if err := k.recordClaim(ctx, claim, oracleClaim.Id, status); err != nil {
+		logger.Error("failed to record the claim.",
+			errorMessageKey, err.Error())
+		return oracletypes.Status{}, err
+	}
+	return status, nil
 }
 
 // ProcessSuccessfulClaim processes a claim that has just completed successfully with consensus
@@ -65,16 +85,19 @@ func (k Keeper) ProcessSuccessfulClaim(ctx sdk.Context, claim string) error {
 		return err
 	}
 	receiverAddress := oracleClaim.CosmosReceiver
-	var coins sdk.Coins
+	var symbol string
 	switch oracleClaim.ClaimType {
 	case types.ClaimType_CLAIM_TYPE_LOCK:
-		symbol := fmt.Sprintf("%v%v", types.PeggedCoinPrefix, oracleClaim.Symbol)
-		k.AddPeggyToken(ctx, symbol)
-		coins = sdk.NewCoins(sdk.NewCoin(symbol, oracleClaim.Amount))
-		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+		var network types.Network
+		network, err = k.GetRegisteredNetwork(ctx, oracleClaim.EthereumChainID)
+		if err != nil {
+			break
+		}
+		symbol = network.Denom(oracleClaim.Symbol)
+		k.AddNetworkPeggyToken(ctx, oracleClaim.EthereumChainID, symbol)
+		k.RegisterProvisionalToken(ctx, oracleClaim, symbol)
 	case types.ClaimType_CLAIM_TYPE_BURN:
-		coins = sdk.NewCoins(sdk.NewCoin(oracleClaim.Symbol, oracleClaim.Amount))
-		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+		symbol = oracleClaim.Symbol
 	default:
 		err = types.ErrInvalidClaimType
 	}
@@ -83,11 +106,35 @@ func (k Keeper) ProcessSuccessfulClaim(ctx sdk.Context, claim string) error {
 			errorMessageKey, err.Error())
 		return err
 	}
+	coins := sdk.NewCoins(sdk.NewCoin(symbol, oracleClaim.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		logger.Error("failed to process successful claim.",
+			errorMessageKey, err.Error())
+		return err
+	}
+	if oracleClaim.ClaimType == types.ClaimType_CLAIM_TYPE_LOCK {
+		k.AddBridgeMinted(ctx, symbol, oracleClaim.Amount)
+	}
+	// Coins that cannot be sent to the receiver stay escrowed in the module account rather than failing the claim
+	if k.IsBlacklisted(ctx, receiverAddress.String()) {
+		k.EscrowUnclaimedInbound(ctx, oracleClaim, symbol, "receiver is blacklisted")
+		return nil
+	}
+	// Coins over the transfer limits stay escrowed until the transfer window rolls over
+	if releaseHeight, err := k.CheckInboundTransferLimit(ctx, symbol, oracleClaim.Amount); err != nil {
+		k.HoldUnclaimedInbound(ctx, oracleClaim, symbol, err.Error(), releaseHeight)
+		return nil
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, receiverAddress, coins,
+		cacheCtx, types.ModuleName, receiverAddress, coins,
 	); err != nil {
-		panic(err)
+		logger.Error("failed to send minted coins to the claim receiver.",
+			errorMessageKey, err.Error())
+		k.EscrowUnclaimedInbound(ctx, oracleClaim, symbol, err.Error())
+		return nil
 	}
+	writeCache()
 	return nil
 }
 
@@ -100,8 +147,26 @@ func (k Keeper) ProcessBurn(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 		return types.ErrInvalidEthAddress
 	}
 
+	network, err := k.GetRegisteredNetwork(ctx, msg.EthereumChainId)
+	if err != nil {
+		return err
+	}
+
+	if chainID, ok := k.GetPeggyTokenNetwork(ctx, msg.Symbol); ok && chainID != msg.EthereumChainId {
+		return sdkerrors.Wrapf(types.ErrWrongNetwork, "%s is pegged to chain id %d", msg.Symbol, chainID)
+	}
+
+	if err := k.CheckTransferLimit(ctx, msg.Symbol, msg.Amount); err != nil {
+		return err
+	}
+
+	if err := k.CheckCethAmount(ctx, msg.EthereumChainId, msg.CethAmount); err != nil {
+		return err
+	}
+
+	feeDenom := network.NativeToken
 	if k.IsCethReceiverAccountSet(ctx) {
-		coins = sdk.NewCoins(sdk.NewCoin(types.CethSymbol, msg.CethAmount))
+		coins = sdk.NewCoins(sdk.NewCoin(feeDenom, msg.CethAmount))
 		err := k.bankKeeper.SendCoins(ctx, cosmosSender, k.GetCethReceiverAccount(ctx), coins)
 		if err != nil {
 			logger.Error("failed to send ceth from account to account.",
@@ -110,13 +175,13 @@ func (k Keeper) ProcessBurn(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 		}
 		coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount))
 	} else {
-		if msg.Symbol == types.CethSymbol {
-			coins = sdk.NewCoins(sdk.NewCoin(types.CethSymbol, msg.CethAmount.Add(msg.Amount)))
+		if msg.Symbol == feeDenom {
+			coins = sdk.NewCoins(sdk.NewCoin(feeDenom, msg.CethAmount.Add(msg.Amount)))
 		} else {
-			coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount), sdk.NewCoin(types.CethSymbol, msg.CethAmount))
+			coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount), sdk.NewCoin(feeDenom, msg.CethAmount))
 		}
 	}
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosSender, types.ModuleName, coins)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosSender, types.ModuleName, coins)
 	if err != nil {
 		logger.Error("failed to send ceth from module to account.",
 			errorMessageKey, err.Error())
@@ -129,6 +194,7 @@ func (k Keeper) ProcessBurn(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 			errorMessageKey, err.Error())
 		return err
 	}
+	k.AddBridgeBurned(ctx, msg.Symbol, msg.Amount)
 	return nil
 }
 
@@ -140,9 +206,23 @@ func (k Keeper) ProcessLock(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 		return types.ErrInvalidEthAddress
 	}
 
+	network, err := k.GetRegisteredNetwork(ctx, msg.EthereumChainId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.CheckTransferLimit(ctx, msg.Symbol, msg.Amount); err != nil {
+		return err
+	}
+
+	if err := k.CheckCethAmount(ctx, msg.EthereumChainId, msg.CethAmount); err != nil {
+		return err
+	}
+
 	var coins sdk.Coins
+	feeDenom := network.NativeToken
 	if k.IsCethReceiverAccountSet(ctx) {
-		coins = sdk.NewCoins(sdk.NewCoin(types.CethSymbol, msg.CethAmount))
+		coins = sdk.NewCoins(sdk.NewCoin(feeDenom, msg.CethAmount))
 		err := k.bankKeeper.SendCoins(ctx, cosmosSender, k.GetCethReceiverAccount(ctx), coins)
 		if err != nil {
 			logger.Error("failed to send ceth from account to account.",
@@ -151,9 +231,9 @@ func (k Keeper) ProcessLock(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 		}
 		coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount))
 	} else {
-		coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount), sdk.NewCoin(types.CethSymbol, msg.CethAmount))
+		coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount), sdk.NewCoin(feeDenom, msg.CethAmount))
 	}
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosSender, types.ModuleName, coins)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosSender, types.ModuleName, coins)
 	if err != nil {
 		logger.Error("failed to transfer coin from account to module.", errorMessageKey, err.Error())
 		return err
@@ -164,18 +244,31 @@ func (k Keeper) ProcessLock(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 		logger.Error("failed to burn burned coin.", errorMessageKey, err.Error())
 		return err
 	}
+	k.AddBridgeLocked(ctx, msg.Symbol, msg.Amount)
 	return nil
 }
 
-// ProcessUpdateWhiteListValidator processes the update whitelist validator from admin
+// ProcessUpdateWhiteListValidator processes the update whitelist validator from the validator set manager
 func (k Keeper) ProcessUpdateWhiteListValidator(ctx sdk.Context, cosmosSender sdk.AccAddress, validator sdk.ValAddress, operationtype string) error {
-	return k.oracleKeeper.ProcessUpdateWhiteListValidator(ctx, cosmosSender, validator, operationtype)
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_VALIDATOR_SET, cosmosSender) {
+		k.Logger(ctx).Error("cosmos sender is not validator set manager.")
+		return oracletypes.ErrNotAdminAccount
+	}
+	switch operationtype {
+	case "add":
+		k.oracleKeeper.AddOracleWhiteList(ctx, validator)
+	case "remove":
+		k.oracleKeeper.RemoveOracleWhiteList(ctx, validator)
+	default:
+		return oracletypes.ErrInvalidOperationType
+	}
+	return nil
 }
 
-// ProcessUpdateCethReceiverAccount processes the update whitelist validator from admin
+// ProcessUpdateCethReceiverAccount processes the update of the ceth receiver account from the fee manager
 func (k Keeper) ProcessUpdateCethReceiverAccount(ctx sdk.Context, cosmosSender sdk.AccAddress, cethReceiverAccount sdk.AccAddress) error {
 	logger := k.Logger(ctx)
-	if !k.oracleKeeper.IsAdminAccount(ctx, cosmosSender) {
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_FEE, cosmosSender) {
 		logger.Error("cosmos sender is not admin account.")
 		return errors.New("only admin account can update ceth receiver account")
 	}
@@ -183,7 +276,8 @@ func (k Keeper) ProcessUpdateCethReceiverAccount(ctx sdk.Context, cosmosSender s
 	return nil
 }
 
-// ProcessRescueCeth transfer ceth from ethbridge module to an account
+// ProcessRescueCeth transfer the native token of the network of the message, the token its cross-chain fees are paid
+// in, from ethbridge module to an account
 func (k Keeper) ProcessRescueCeth(ctx sdk.Context, msg *types.MsgRescueCeth) error {
 	logger := k.Logger(ctx)
 	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
@@ -194,11 +288,15 @@ func (k Keeper) ProcessRescueCeth(ctx sdk.Context, msg *types.MsgRescueCeth) err
 	if err != nil {
 		return err
 	}
-	if !k.oracleKeeper.IsAdminAccount(ctx, cosmosSender) {
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_RESCUER, cosmosSender) {
 		logger.Error("cosmos sender is not admin account.")
 		return errors.New("only admin account can call rescue ceth")
 	}
-	coins := sdk.NewCoins(sdk.NewCoin(types.CethSymbol, msg.CethAmount))
+	network, err := k.GetRegisteredNetwork(ctx, msg.EthereumChainId)
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(network.NativeToken, msg.CethAmount))
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceiver, coins)
 	if err != nil {
 		logger.Error("failed to transfer coin from module to account.",
@@ -19,9 +19,10 @@ var (
 	amount             = sdk.NewInt(10)
 	doubleAmount       = sdk.NewInt(20)
 
+	ethereumChainID      = int64(types.TestEthereumChainID)
 	symbol               = "stake"
 	tokenContractAddress = types.NewEthereumAddress("0xbbbbca6a901c926f240b89eacb641d8aec7aeafd")
-	ethBridgeAddress     = types.NewEthereumAddress(strings.ToLower("0x30753E4A8aad7F8597332E813735Def5dD395028"))
+	ethBridgeAddress     = types.NewEthereumAddress(types.TestBridgeContractAddress)
 	ethereumSender       = types.NewEthereumAddress("0x627306090abaB3A6e1400e9345bC60c78a8BEf57")
 	//BadValidatorAddress                        = sdk.ValAddress(CreateTestPubKeys(1)[0].Address().Bytes())
 )
@@ -42,7 +43,7 @@ func TestProcessClaimLock(t *testing.T) {
 	require.Equal(t, claimType, types.ClaimType_CLAIM_TYPE_LOCK)
 
 	ethBridgeClaim := types.NewEthBridgeClaim(
-		5777,
+		ethereumChainID,
 		ethBridgeAddress, // bridge registry
 		nonce,
 		symbol,
@@ -66,7 +67,7 @@ func TestProcessClaimLock(t *testing.T) {
 	// other validator execute
 
 	ethBridgeClaim = types.NewEthBridgeClaim(
-		5777,
+		ethereumChainID,
 		ethBridgeAddress, // bridge registry
 		nonce,
 		symbol,
@@ -93,7 +94,7 @@ func TestProcessClaimBurn(t *testing.T) {
 	claimType := types.ClaimType_CLAIM_TYPE_BURN
 
 	ethBridgeClaim := types.NewEthBridgeClaim(
-		5777,
+		ethereumChainID,
 		ethBridgeAddress, // bridge registry
 		nonce,
 		symbol,
@@ -117,7 +118,7 @@ func TestProcessClaimBurn(t *testing.T) {
 	// other validator execute
 
 	ethBridgeClaim = types.NewEthBridgeClaim(
-		5777,
+		ethereumChainID,
 		ethBridgeAddress, // bridge registry
 		nonce,
 		symbol,
@@ -141,7 +142,7 @@ func TestProcessSuccessfulClaimLock(t *testing.T) {
 	require.Equal(t, receiverCoins, sdk.NewCoins())
 
 	claimType := types.ClaimType_CLAIM_TYPE_LOCK
-	claimContent := types.NewOracleClaimContent(cosmosReceivers[0], amount, symbol, tokenContractAddress, claimType)
+	claimContent := types.NewOracleClaimContent(ethereumChainID, cosmosReceivers[0], amount, symbol, tokenContractAddress, claimType)
 
 	claimBytes, err := json.Marshal(claimContent)
 	require.NoError(t, err)
@@ -168,7 +169,7 @@ func TestProcessSuccessfulClaimBurn(t *testing.T) {
 	require.Equal(t, receiverCoins, sdk.NewCoins())
 
 	claimType := types.ClaimType_CLAIM_TYPE_BURN
-	claimContent := types.NewOracleClaimContent(cosmosReceivers[0], amount, symbol, tokenContractAddress, claimType)
+	claimContent := types.NewOracleClaimContent(ethereumChainID, cosmosReceivers[0], amount, symbol, tokenContractAddress, claimType)
 
 	claimBytes, err := json.Marshal(claimContent)
 	require.NoError(t, err)
@@ -191,7 +192,7 @@ func TestProcessSuccessfulClaimBurn(t *testing.T) {
 func TestProcessBurn(t *testing.T) {
 	ctx, keeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
 
-	msg := types.NewMsgBurn(1, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
+	msg := types.NewMsgBurn(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
 	coins := sdk.NewCoins(sdk.NewCoin("stake", amount), sdk.NewCoin(types.CethSymbol, amount))
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
@@ -206,7 +207,7 @@ func TestProcessBurn(t *testing.T) {
 func TestProcessBurnCeth(t *testing.T) {
 	ctx, keeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
 
-	msg := types.NewMsgBurn(1, cosmosReceivers[0], ethereumSender, amount, types.CethSymbol, amount)
+	msg := types.NewMsgBurn(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, types.CethSymbol, amount)
 	coins := sdk.NewCoins(sdk.NewCoin(types.CethSymbol, doubleAmount))
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
@@ -224,7 +225,7 @@ func TestProcessLock(t *testing.T) {
 	receiverCoins := bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
 	require.Equal(t, receiverCoins, sdk.NewCoins())
 
-	msg := types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
+	msg := types.NewMsgLock(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
 
 	err := keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
 	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
@@ -247,7 +248,7 @@ func TestProcessBurnWithReceiver(t *testing.T) {
 	require.NoError(t, err)
 	oracleKeeper.SetAdminAccount(ctx, cosmosSender)
 
-	msg := types.NewMsgBurn(1, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
+	msg := types.NewMsgBurn(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
 	coins := sdk.NewCoins(sdk.NewCoin("stake", amount), sdk.NewCoin(types.CethSymbol, amount))
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
@@ -265,7 +266,7 @@ func TestProcessBurnCethWithReceiver(t *testing.T) {
 	require.NoError(t, err)
 	oracleKeeper.SetAdminAccount(ctx, cosmosSender)
 
-	msg := types.NewMsgBurn(1, cosmosReceivers[0], ethereumSender, amount, types.CethSymbol, amount)
+	msg := types.NewMsgBurn(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, types.CethSymbol, amount)
 	coins := sdk.NewCoins(sdk.NewCoin(types.CethSymbol, doubleAmount))
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
@@ -286,7 +287,7 @@ func TestProcessLockWithReceiver(t *testing.T) {
 	receiverCoins := bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
 	require.Equal(t, receiverCoins, sdk.NewCoins())
 
-	msg := types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
+	msg := types.NewMsgLock(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
 
 	err = keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
 	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
@@ -326,7 +327,7 @@ func TestProcessRescueCeth(t *testing.T) {
 	err = bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.CethSymbol, cethAmount)))
 	require.NoError(t, err)
 
-	msg := types.NewMsgRescueCeth(cosmosSender, cosmosSender, cethAmount)
+	msg := types.NewMsgRescueCeth(ethereumChainID, cosmosSender, cosmosSender, cethAmount)
 
 	err = keeper.ProcessRescueCeth(ctx, &msg)
 	require.Equal(t, err.Error(), "only admin account can call rescue ceth")
@@ -335,4 +336,18 @@ func TestProcessRescueCeth(t *testing.T) {
 
 	err = keeper.ProcessRescueCeth(ctx, &msg)
 	require.NoError(t, err)
+	require.Equal(t, cethAmount, bankKeeper.GetBalance(ctx, cosmosSender, types.CethSymbol).Amount)
+
+	// The fees of other networks are rescued in their native token
+	err = bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(bscNetwork.NativeToken, cethAmount)))
+	require.NoError(t, err)
+	msg = types.NewMsgRescueCeth(bscNetwork.EthereumChainId, cosmosSender, cosmosSender, cethAmount)
+	err = keeper.ProcessRescueCeth(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrUnregisteredNetwork)
+
+	keeper.SetNetwork(ctx, bscNetwork)
+	err = keeper.ProcessRescueCeth(ctx, &msg)
+	require.NoError(t, err)
+	require.Equal(t, cethAmount, bankKeeper.GetBalance(ctx, cosmosSender, bscNetwork.NativeToken).Amount)
+	require.Equal(t, cethAmount, bankKeeper.GetBalance(ctx, cosmosSender, types.CethSymbol).Amount)
 }
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator migrates the ethbridge store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a migrator of the ethbridge store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateToVer2 seeds the bridge supplies checked by the invariants of the module
+func (m Migrator) MigrateToVer2(ctx sdk.Context) error {
+	m.keeper.SeedBridgeSupplies(ctx)
+	return nil
+}
@@ -34,6 +34,10 @@ func (srv msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.Msg
 		return nil, errors.Errorf("Pegged token %s can't be lock.", msg.Symbol)
 	}
 	fmt.Println("GO | Exists Peggy Token")
+	if err := srv.Keeper.CheckOutboundPause(ctx, msg.Symbol); err != nil {
+		logger.Error("bridge is paused.", errorMessageKey, err.Error())
+		return nil, err
+	}
 	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
 	if err != nil {
 		return nil, err
@@ -49,11 +53,20 @@ func (srv msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.Msg
 		logger.Error("bridge keeper failed to process lock.", errorMessageKey, err.Error())
 		return nil, err
 	}
+
+	sequence := srv.Keeper.NextOutboundSequence(ctx, cosmosSender, account.GetSequence())
+	transfer := types.NewOutboundTransfer(cosmosSender, sequence, msg.EthereumChainId,
+		types.NewEthereumAddress(msg.EthereumReceiver), msg.Symbol, msg.Amount, msg.CethAmount,
+		types.ClaimType_CLAIM_TYPE_LOCK, ctx.BlockHeight())
+	if err := srv.Keeper.RecordOutboundTransfer(ctx, transfer); err != nil {
+		logger.Error("bridge keeper failed to record outbound transfer.", errorMessageKey, err.Error())
+		return nil, err
+	}
 	fmt.Println("GO |===== Process lock event")
 	logger.Info("sifnode emit lock event.",
 		"EthereumChainID", strconv.FormatInt(msg.EthereumChainId, 10),
 		"CosmosSender", msg.CosmosSender,
-		"CosmosSenderSequence", strconv.FormatUint(account.GetSequence(), 10),
+		"CosmosSenderSequence", strconv.FormatUint(sequence, 10),
 		"EthereumReceiver", msg.EthereumReceiver,
 		"Amount", msg.Amount.String(),
 		"Symbol", msg.Symbol,
@@ -69,15 +82,30 @@ func (srv msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.Msg
 			types.EventTypeLock,
 			sdk.NewAttribute(types.AttributeKeyEthereumChainID, strconv.FormatInt(msg.EthereumChainId, 10)),
 			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.CosmosSender),
-			sdk.NewAttribute(types.AttributeKeyCosmosSenderSequence, strconv.FormatUint(account.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyCosmosSenderSequence, strconv.FormatUint(sequence, 10)),
 			sdk.NewAttribute(types.AttributeKeyEthereumReceiver, msg.EthereumReceiver),
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyCethAmount, msg.CethAmount.String()),
 		),
 	})
+	prophecyID := types.GetOutboundTransferProphecyID(msg.CosmosSender, sequence)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLock{
+		EthereumChainId:      msg.EthereumChainId,
+		CosmosSender:         msg.CosmosSender,
+		CosmosSenderSequence: sequence,
+		EthereumReceiver:     msg.EthereumReceiver,
+		Symbol:               msg.Symbol,
+		Amount:               msg.Amount,
+		CethAmount:           msg.CethAmount,
+		ProphecyId:           prophecyID,
+		Height:               ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &types.MsgLockResponse{}, nil
+	return &types.MsgLockResponse{CosmosSenderSequence: sequence, ProphecyId: prophecyID}, nil
 }
 
 func (srv msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
@@ -90,6 +118,11 @@ func (srv msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.Msg
 		return nil, errors.Errorf("Native token %s can't be burn.", msg.Symbol)
 	}
 
+	if err := srv.Keeper.CheckOutboundPause(ctx, msg.Symbol); err != nil {
+		logger.Error("bridge is paused.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
 	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
 	if err != nil {
 		return nil, err
@@ -106,10 +139,19 @@ func (srv msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.Msg
 		return nil, err
 	}
 
+	sequence := srv.Keeper.NextOutboundSequence(ctx, cosmosSender, account.GetSequence())
+	transfer := types.NewOutboundTransfer(cosmosSender, sequence, msg.EthereumChainId,
+		types.NewEthereumAddress(msg.EthereumReceiver), msg.Symbol, msg.Amount, msg.CethAmount,
+		types.ClaimType_CLAIM_TYPE_BURN, ctx.BlockHeight())
+	if err := srv.Keeper.RecordOutboundTransfer(ctx, transfer); err != nil {
+		logger.Error("bridge keeper failed to record outbound transfer.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
 	logger.Info("sifnode emit burn event.",
 		"EthereumChainID", strconv.FormatInt(msg.EthereumChainId, 10),
 		"CosmosSender", msg.CosmosSender,
-		"CosmosSenderSequence", strconv.FormatUint(account.GetSequence(), 10),
+		"CosmosSenderSequence", strconv.FormatUint(sequence, 10),
 		"EthereumReceiver", msg.EthereumReceiver,
 		"Amount", msg.Amount.String(),
 		"Symbol", msg.Symbol,
@@ -125,15 +167,30 @@ func (srv msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.Msg
 			types.EventTypeBurn,
 			sdk.NewAttribute(types.AttributeKeyEthereumChainID, strconv.FormatInt(msg.EthereumChainId, 10)),
 			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.CosmosSender),
-			sdk.NewAttribute(types.AttributeKeyCosmosSenderSequence, strconv.FormatUint(account.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyCosmosSenderSequence, strconv.FormatUint(sequence, 10)),
 			sdk.NewAttribute(types.AttributeKeyEthereumReceiver, msg.EthereumReceiver),
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyCethAmount, msg.CethAmount.String()),
 		),
 	})
+	prophecyID := types.GetOutboundTransferProphecyID(msg.CosmosSender, sequence)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		EthereumChainId:      msg.EthereumChainId,
+		CosmosSender:         msg.CosmosSender,
+		CosmosSenderSequence: sequence,
+		EthereumReceiver:     msg.EthereumReceiver,
+		Symbol:               msg.Symbol,
+		Amount:               msg.Amount,
+		CethAmount:           msg.CethAmount,
+		ProphecyId:           prophecyID,
+		Height:               ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &types.MsgBurnResponse{}, nil
+	return &types.MsgBurnResponse{CosmosSenderSequence: sequence, ProphecyId: prophecyID}, nil
 
 }
 func (srv msgServer) CreateEthBridgeClaim(goCtx context.Context, msg *types.MsgCreateEthBridgeClaim) (*types.MsgCreateEthBridgeClaimResponse, error) {
@@ -141,6 +198,22 @@ func (srv msgServer) CreateEthBridgeClaim(goCtx context.Context, msg *types.MsgC
 
 	logger := srv.Keeper.Logger(ctx)
 
+	denom, err := srv.Keeper.GetClaimDenom(ctx, msg.EthBridgeClaim)
+	if err != nil {
+		logger.Error("failed to get the claim denom.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	if err := srv.Keeper.CheckInboundPause(ctx, denom); err != nil {
+		logger.Error("bridge is paused.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	if srv.Keeper.IsBlacklisted(ctx, msg.EthBridgeClaim.EthereumSender) {
+		logger.Error("ethereum sender is blacklisted.", "EthereumSender", msg.EthBridgeClaim.EthereumSender)
+		return nil, sdkerrors.Wrap(types.ErrBlacklisted, msg.EthBridgeClaim.EthereumSender)
+	}
+
 	status, err := srv.Keeper.ProcessClaim(ctx, msg.EthBridgeClaim)
 	if err != nil {
 		logger.Error("bridge keeper failed to process claim.",
@@ -186,8 +259,18 @@ func (srv msgServer) CreateEthBridgeClaim(goCtx context.Context, msg *types.MsgC
 			sdk.NewAttribute(types.AttributeKeyStatus, status.Text.String()),
 		),
 	})
+	prophecyID := types.GetClaimProphecyID(msg.EthBridgeClaim)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateClaim{
+		Claim:      msg.EthBridgeClaim,
+		ProphecyId: prophecyID,
+		Status:     status.Text,
+		Height:     ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &types.MsgCreateEthBridgeClaimResponse{}, nil
+	return &types.MsgCreateEthBridgeClaimResponse{ProphecyId: prophecyID, Status: status.Text}, nil
 }
 
 func (srv msgServer) UpdateWhiteListValidator(goCtx context.Context,
@@ -233,12 +316,21 @@ func (srv msgServer) UpdateWhiteListValidator(goCtx context.Context,
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
 		),
 		sdk.NewEvent(
-			types.EventTypeLock,
+			types.EventTypeUpdateWhiteListValidator,
 			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.CosmosSender),
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator),
 			sdk.NewAttribute(types.AttributeKeyOperationType, msg.OperationType),
 		),
 	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdateWhiteListValidator{
+		CosmosSender:  msg.CosmosSender,
+		Validator:     msg.Validator,
+		OperationType: msg.OperationType,
+		Height:        ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateWhiteListValidatorResponse{}, nil
 }
@@ -285,11 +377,19 @@ func (srv msgServer) UpdateCethReceiverAccount(goCtx context.Context,
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
 		),
 		sdk.NewEvent(
-			types.EventTypeLock,
+			types.EventTypeUpdateCethReceiver,
 			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.CosmosSender),
 			sdk.NewAttribute(types.AttributeKeyCethReceiverAccount, msg.CethReceiverAccount),
 		),
 	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdateCethReceiverAccount{
+		CosmosSender:        msg.CosmosSender,
+		CethReceiverAccount: msg.CethReceiverAccount,
+		Height:              ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateCethReceiverAccountResponse{}, nil
 }
@@ -313,20 +413,509 @@ func (srv msgServer) RescueCeth(goCtx context.Context, msg *types.MsgRescueCeth)
 		logger.Error("keeper failed to process rescue ceth message.", errorMessageKey, err.Error())
 		return nil, err
 	}
+	network, err := srv.Keeper.GetRegisteredNetwork(ctx, msg.EthereumChainId)
+	if err != nil {
+		return nil, err
+	}
 	logger.Info("sifnode emit rescue ceth event.",
+		"EthereumChainID", strconv.FormatInt(msg.EthereumChainId, 10),
 		"CosmosSender", msg.CosmosSender,
 		"CosmosSenderSequence", strconv.FormatUint(account.GetSequence(), 10),
 		"CosmosReceiver", msg.CosmosReceiver,
-		"CethAmount", msg.CethAmount)
+		"CethAmount", msg.CethAmount,
+		"Denom", network.NativeToken)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+		),
+		sdk.NewEvent(
+			types.EventTypeRescueCeth,
+			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.CosmosSender),
+			sdk.NewAttribute(types.AttributeKeyCosmosReceiver, msg.CosmosReceiver),
+			sdk.NewAttribute(types.AttributeKeyCethAmount, msg.CethAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyEthereumChainID, strconv.FormatInt(msg.EthereumChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, network.NativeToken),
+		),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRescueCeth{
+		CosmosSender:    msg.CosmosSender,
+		CosmosReceiver:  msg.CosmosReceiver,
+		CethAmount:      msg.CethAmount,
+		Height:          ctx.BlockHeight(),
+		EthereumChainId: msg.EthereumChainId,
+		Denom:           network.NativeToken,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgRescueCethResponse{}, nil
 }
 
 func (srv msgServer) SetBlacklist(goCtx context.Context, msg *types.MsgSetBlacklist) (*types.MsgSetBlacklistResponse, error) {
-	err := srv.Keeper.SetBlacklist(sdk.UnwrapSDKContext(goCtx), msg)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := srv.Keeper.SetBlacklist(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+	))
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetBlacklist{
+		CosmosSender: msg.From,
+		Addresses:    msg.Addresses,
+		Height:       ctx.BlockHeight(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSetBlacklistResponse{}, nil
 }
+
+func (srv msgServer) SetNetwork(goCtx context.Context, msg *types.MsgSetNetwork) (*types.MsgSetNetworkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	if err := srv.Keeper.ProcessSetNetwork(ctx, msg); err != nil {
+		logger.Error("keeper failed to process set network.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetNetwork,
+			sdk.NewAttribute(types.AttributeKeyEthereumChainID, strconv.FormatInt(msg.Network.EthereumChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBridgeContract, msg.Network.BridgeContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDenomPrefix, msg.Network.DenomPrefix),
+			sdk.NewAttribute(types.AttributeKeyNativeToken, msg.Network.NativeToken),
+		),
+	})
+	err := ctx.EventManager().EmitTypedEvent(&types.EventSetNetwork{
+		CosmosSender: msg.CosmosSender,
+		Network:      msg.Network,
+		Height:       ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetNetworkResponse{}, nil
+}
+
+func (srv msgServer) SetPause(goCtx context.Context, msg *types.MsgSetPause) (*types.MsgSetPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	if err := srv.Keeper.ProcessSetPause(ctx, msg); err != nil {
+		logger.Error("keeper failed to process set pause.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetPause,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Pause.Symbol),
+			sdk.NewAttribute(types.AttributeKeyOutbound, strconv.FormatBool(msg.Pause.Outbound)),
+			sdk.NewAttribute(types.AttributeKeyInbound, strconv.FormatBool(msg.Pause.Inbound)),
+		),
+	})
+	err := ctx.EventManager().EmitTypedEvent(&types.EventSetPause{
+		CosmosSender: msg.CosmosSender,
+		Pause:        msg.Pause,
+		Height:       ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPauseResponse{}, nil
+}
+
+func (srv msgServer) ReportOutboundTransfer(goCtx context.Context, msg *types.MsgReportOutboundTransfer) (*types.MsgReportOutboundTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	transfer, err := srv.Keeper.ProcessReportOutboundTransfer(ctx, msg)
+	if err != nil {
+		logger.Error("keeper failed to process report outbound transfer.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeReportOutboundTransfer,
+			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.CosmosSender),
+			sdk.NewAttribute(types.AttributeKeyCosmosSenderSequence, strconv.FormatUint(msg.CosmosSenderSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyEthereumTxHash, msg.EthereumTxHash),
+			sdk.NewAttribute(types.AttributeKeyStatus, transfer.Status.String()),
+		),
+	})
+	prophecyID := types.GetOutboundTransferProphecyID(msg.CosmosSender, msg.CosmosSenderSequence)
+	status := oracletypes.StatusText_STATUS_TEXT_PENDING
+	if transfer.Status == types.OutboundStatus_OUTBOUND_STATUS_COMPLETED {
+		status = oracletypes.StatusText_STATUS_TEXT_SUCCESS
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventReportOutboundTransfer{
+		ValidatorAddress: msg.ValidatorAddress,
+		Transfer:         transfer,
+		ProphecyId:       prophecyID,
+		Status:           status,
+		Height:           ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportOutboundTransferResponse{ProphecyId: prophecyID, Status: status}, nil
+}
+
+func (srv msgServer) RefundOutboundTransfer(goCtx context.Context, msg *types.MsgRefundOutboundTransfer) (*types.MsgRefundOutboundTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	transfer, err := srv.Keeper.ProcessRefundOutboundTransfer(ctx, msg)
+	if err != nil {
+		logger.Error("keeper failed to process refund outbound transfer.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+		),
+		sdk.NewEvent(
+			types.EventTypeRefundOutboundTransfer,
+			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.TransferSender),
+			sdk.NewAttribute(types.AttributeKeyCosmosSenderSequence, strconv.FormatUint(msg.TransferSenderSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, transfer.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, transfer.Symbol),
+		),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRefundOutboundTransfer{
+		CosmosSender: msg.CosmosSender,
+		Transfer:     transfer,
+		Height:       ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundOutboundTransferResponse{}, nil
+}
+
+func (srv msgServer) RedirectUnclaimedInbound(goCtx context.Context, msg *types.MsgRedirectUnclaimedInbound) (*types.MsgRedirectUnclaimedInboundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	unclaimed, err := srv.Keeper.ProcessRedirectUnclaimedInbound(ctx, msg)
+	if err != nil {
+		logger.Error("keeper failed to process redirect unclaimed inbound.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+		),
+		sdk.NewEvent(
+			types.EventTypeRedirectUnclaimedInbound,
+			sdk.NewAttribute(types.AttributeKeyUnclaimedID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCosmosReceiver, unclaimed.CosmosReceiver),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, unclaimed.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, unclaimed.Symbol),
+		),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedirectUnclaimedInbound{
+		CosmosSender: msg.CosmosSender,
+		Unclaimed:    unclaimed,
+		Recipient:    msg.Recipient,
+		Height:       ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedirectUnclaimedInboundResponse{}, nil
+}
+
+func (srv msgServer) AddToBlacklist(goCtx context.Context, msg *types.MsgAddToBlacklist) (*types.MsgAddToBlacklistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	if err := srv.Keeper.ProcessAddToBlacklist(ctx, msg); err != nil {
+		logger.Error("keeper failed to process add to blacklist.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+	))
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAddToBlacklist{
+		CosmosSender: msg.CosmosSender,
+		Addresses:    msg.Addresses,
+		Reason:       msg.Reason,
+		ExpiryHeight: msg.ExpiryHeight,
+		Height:       ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToBlacklistResponse{}, nil
+}
+
+func (srv msgServer) RemoveFromBlacklist(goCtx context.Context, msg *types.MsgRemoveFromBlacklist) (*types.MsgRemoveFromBlacklistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	if err := srv.Keeper.ProcessRemoveFromBlacklist(ctx, msg); err != nil {
+		logger.Error("keeper failed to process remove from blacklist.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+	))
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRemoveFromBlacklist{
+		CosmosSender: msg.CosmosSender,
+		Addresses:    msg.Addresses,
+		Reason:       msg.Reason,
+		Height:       ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFromBlacklistResponse{}, nil
+}
+
+func (srv msgServer) ReportGasPrice(goCtx context.Context, msg *types.MsgReportGasPrice) (*types.MsgReportGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	if err := srv.Keeper.ProcessReportGasPrice(ctx, msg); err != nil {
+		logger.Error("keeper failed to process report gas price.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeReportGasPrice,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEthereumChainID, strconv.FormatInt(msg.EthereumChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyGasPrice, msg.GasPrice.String()),
+		),
+	})
+	err := ctx.EventManager().EmitTypedEvent(&types.EventReportGasPrice{
+		ValidatorAddress: msg.ValidatorAddress,
+		EthereumChainId:  msg.EthereumChainId,
+		GasPrice:         msg.GasPrice,
+		Height:           ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportGasPriceResponse{}, nil
+}
+
+func (srv msgServer) CreateEthBridgeNftClaim(goCtx context.Context,
+	msg *types.MsgCreateEthBridgeNftClaim) (*types.MsgCreateEthBridgeNftClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+	claim := msg.EthBridgeNftClaim
+	classID := types.NftClassID(claim.EthereumChainId, types.NewEthereumAddress(claim.TokenContractAddress))
+
+	if err := srv.Keeper.CheckInboundPause(ctx, classID); err != nil {
+		logger.Error("bridge is paused.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	if srv.Keeper.IsBlacklisted(ctx, claim.EthereumSender) {
+		logger.Error("ethereum sender is blacklisted.", "EthereumSender", claim.EthereumSender)
+		return nil, sdkerrors.Wrap(types.ErrBlacklisted, claim.EthereumSender)
+	}
+
+	// NFTs cannot be escrowed like coins, so claims to a blacklisted receiver never reach consensus
+	if srv.Keeper.IsBlacklisted(ctx, claim.CosmosReceiver) {
+		logger.Error("cosmos receiver is blacklisted.", "CosmosReceiver", claim.CosmosReceiver)
+		return nil, sdkerrors.Wrap(types.ErrBlacklisted, claim.CosmosReceiver)
+	}
+
+	status, err := srv.Keeper.ProcessNftClaim(ctx, claim)
+	if err != nil {
+		logger.Error("bridge keeper failed to process nft claim.",
+			errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, claim.ValidatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateNftClaim,
+			sdk.NewAttribute(types.AttributeKeyCosmosSender, claim.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEthereumSender, claim.EthereumSender),
+			sdk.NewAttribute(types.AttributeKeyEthereumSenderNonce, strconv.FormatInt(claim.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyCosmosReceiver, claim.CosmosReceiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyTokenID, claim.TokenId),
+			sdk.NewAttribute(types.AttributeKeyClaimType, types.ClaimType_CLAIM_TYPE_NFT_LOCK.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeProphecyStatus,
+			sdk.NewAttribute(types.AttributeKeyStatus, status.Text.String()),
+		),
+	}
+
+	var minted *types.Nft
+	if status.Text == oracletypes.StatusText_STATUS_TEXT_SUCCESS {
+		nft, err := srv.Keeper.ProcessSuccessfulNftClaim(ctx, status.FinalClaim)
+		if err != nil {
+			logger.Error("bridge keeper failed to process successful nft claim.",
+				errorMessageKey, err.Error())
+			return nil, err
+		}
+		events = append(events, sdk.NewEvent(
+			types.EventTypeMintNft,
+			sdk.NewAttribute(types.AttributeKeyClassID, nft.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenID, nft.TokenId),
+			sdk.NewAttribute(types.AttributeKeyTokenURI, nft.Uri),
+			sdk.NewAttribute(types.AttributeKeyCosmosReceiver, nft.Owner),
+		))
+		minted = &nft
+	}
+
+	logger.Info("sifnode emit create nft claim event.",
+		"CosmosSender", claim.ValidatorAddress,
+		"EthereumSender", claim.EthereumSender,
+		"EthereumSenderNonce", strconv.FormatInt(claim.Nonce, 10),
+		"CosmosReceiver", claim.CosmosReceiver,
+		"ClassID", classID,
+		"TokenID", claim.TokenId)
+
+	ctx.EventManager().EmitEvents(events)
+	prophecyID := types.GetNftClaimProphecyID(claim)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateNftClaim{
+		Claim:      claim,
+		ProphecyId: prophecyID,
+		Status:     status.Text,
+		Minted:     minted,
+		Height:     ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEthBridgeNftClaimResponse{ProphecyId: prophecyID, Status: status.Text}, nil
+}
+
+func (srv msgServer) BurnNft(goCtx context.Context, msg *types.MsgBurnNft) (*types.MsgBurnNftResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+
+	if err := srv.Keeper.CheckOutboundPause(ctx, msg.ClassId); err != nil {
+		logger.Error("bridge is paused.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		return nil, err
+	}
+
+	account := srv.Keeper.accountKeeper.GetAccount(ctx, cosmosSender)
+	if account == nil {
+		logger.Error("account is nil.", "CosmosSender", msg.CosmosSender)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	class, err := srv.Keeper.ProcessBurnNft(ctx, cosmosSender, msg)
+	if err != nil {
+		logger.Error("bridge keeper failed to process nft burn.", errorMessageKey, err.Error())
+		return nil, err
+	}
+
+	logger.Info("sifnode emit burn nft event.",
+		"EthereumChainID", strconv.FormatInt(class.EthereumChainId, 10),
+		"CosmosSender", msg.CosmosSender,
+		"CosmosSenderSequence", strconv.FormatUint(account.GetSequence(), 10),
+		"EthereumReceiver", msg.EthereumReceiver,
+		"ClassID", msg.ClassId,
+		"TokenID", msg.TokenId,
+		"CethAmount", msg.CethAmount.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.CosmosSender),
+		),
+		sdk.NewEvent(
+			types.EventTypeBurnNft,
+			sdk.NewAttribute(types.AttributeKeyEthereumChainID, strconv.FormatInt(class.EthereumChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCosmosSender, msg.CosmosSender),
+			sdk.NewAttribute(types.AttributeKeyCosmosSenderSequence, strconv.FormatUint(account.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyEthereumReceiver, msg.EthereumReceiver),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, class.TokenContractAddress),
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenID, msg.TokenId),
+			sdk.NewAttribute(types.AttributeKeyCethAmount, msg.CethAmount.String()),
+		),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBurnNft{
+		EthereumChainId:      class.EthereumChainId,
+		CosmosSender:         msg.CosmosSender,
+		CosmosSenderSequence: account.GetSequence(),
+		EthereumReceiver:     msg.EthereumReceiver,
+		TokenContractAddress: class.TokenContractAddress,
+		ClassId:              msg.ClassId,
+		TokenId:              msg.TokenId,
+		CethAmount:           msg.CethAmount,
+		Height:               ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnNftResponse{CosmosSenderSequence: account.GetSequence()}, nil
+}
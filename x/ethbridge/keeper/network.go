@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protobuftypes "github.com/gogo/protobuf/types"
+)
+
+// SetNetwork registers a network or replaces its registration
+func (k Keeper) SetNetwork(ctx sdk.Context, network types.Network) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNetworkKey(network.EthereumChainId), k.cdc.MustMarshal(&network))
+}
+
+// GetNetwork returns the network registered for a chain id
+func (k Keeper) GetNetwork(ctx sdk.Context, ethereumChainID int64) (types.Network, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNetworkKey(ethereumChainID))
+	if bz == nil {
+		return types.Network{}, false
+	}
+	var network types.Network
+	k.cdc.MustUnmarshal(bz, &network)
+	return network, true
+}
+
+// GetRegisteredNetwork returns the network registered for a chain id, or an error if there is none. Claims, locks and
+// burns of unregistered chain ids are refused once any network has been registered, until then every chain id is
+// processed with the legacy network.
+func (k Keeper) GetRegisteredNetwork(ctx sdk.Context, ethereumChainID int64) (types.Network, error) {
+	network, ok := k.GetNetwork(ctx, ethereumChainID)
+	if ok {
+		return network, nil
+	}
+	if !k.hasNetworks(ctx) {
+		return types.LegacyNetwork(ethereumChainID), nil
+	}
+	return types.Network{}, sdkerrors.Wrapf(types.ErrUnregisteredNetwork, "chain id %d", ethereumChainID)
+}
+
+// hasNetworks returns whether any network has been registered
+func (k Keeper) hasNetworks(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.NetworkPrefix)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// GetNetworks returns the registered networks
+func (k Keeper) GetNetworks(ctx sdk.Context) []types.Network {
+	var networks []types.Network
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.NetworkPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var network types.Network
+		k.cdc.MustUnmarshal(iter.Value(), &network)
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// ProcessSetNetwork registers a network from the fee manager. Two registered networks cannot share a denom prefix.
+func (k Keeper) ProcessSetNetwork(ctx sdk.Context, msg *types.MsgSetNetwork) error {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		return err
+	}
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_FEE, cosmosSender) {
+		return oracletypes.ErrNotAdminAccount
+	}
+	for _, network := range k.GetNetworks(ctx) {
+		if network.EthereumChainId != msg.Network.EthereumChainId && network.DenomPrefix == msg.Network.DenomPrefix {
+			return sdkerrors.Wrapf(types.ErrInvalidNetwork, "denom prefix %s is used by chain id %d",
+				network.DenomPrefix, network.EthereumChainId)
+		}
+	}
+	k.SetNetwork(ctx, msg.Network)
+	return nil
+}
+
+// AddNetworkPeggyToken adds a token into the peggy token list and records the network it was minted for
+func (k Keeper) AddNetworkPeggyToken(ctx sdk.Context, ethereumChainID int64, token string) {
+	k.AddPeggyToken(ctx, token)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNetworkPeggyTokenKey(token), k.cdc.MustMarshal(&protobuftypes.Int64Value{Value: ethereumChainID}))
+}
+
+// GetPeggyTokenNetwork returns the chain id of the network a peggy token was minted for, tokens added before networks
+// were tracked have none
+func (k Keeper) GetPeggyTokenNetwork(ctx sdk.Context, token string) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNetworkPeggyTokenKey(token))
+	if bz == nil {
+		return 0, false
+	}
+	var chainID protobuftypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &chainID)
+	return chainID.Value, true
+}
+
+// GetNetworkPeggyTokens returns the peggy tokens minted for a network, or for all networks if ethereumChainID is 0
+func (k Keeper) GetNetworkPeggyTokens(ctx sdk.Context, ethereumChainID int64) []types.NetworkPeggyToken {
+	var tokens []types.NetworkPeggyToken
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.NetworkPeggyTokenPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var chainID protobuftypes.Int64Value
+		k.cdc.MustUnmarshal(iter.Value(), &chainID)
+		if ethereumChainID != 0 && chainID.Value != ethereumChainID {
+			continue
+		}
+		tokens = append(tokens, types.NetworkPeggyToken{
+			EthereumChainId: chainID.Value,
+			Denom:           string(iter.Key()[len(types.NetworkPeggyTokenPrefix):]),
+		})
+	}
+	return tokens
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var bscNetwork = types.NewNetwork(56, "bsc", types.NewEthereumAddress("0x782D10cC8c352D0524a1639eD261d29F47023922"), "cbsc", "cbscbnb")
+
+func TestSetNetwork(t *testing.T) {
+	ctx, keeper, _, _, oracleKeeper, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	adminAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgSetNetwork(adminAddress, bscNetwork)
+	err = keeper.ProcessSetNetwork(ctx, &msg)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+
+	oracleKeeper.SetAdminAccount(ctx, adminAddress)
+	err = keeper.ProcessSetNetwork(ctx, &msg)
+	require.NoError(t, err)
+	network, ok := keeper.GetNetwork(ctx, 56)
+	require.True(t, ok)
+	require.Equal(t, bscNetwork, network)
+	require.Len(t, keeper.GetNetworks(ctx), 2)
+
+	// The prefix of the ethereum network is taken too
+	bsc := bscNetwork
+	bsc.DenomPrefix = types.PeggedCoinPrefix
+	msg = types.NewMsgSetNetwork(adminAddress, bsc)
+	require.ErrorIs(t, keeper.ProcessSetNetwork(ctx, &msg), types.ErrInvalidNetwork)
+
+	// Two networks cannot share a denom prefix
+	polygon := types.NewNetwork(137, "polygon", ethBridgeAddress, "cbsc", "cpolymatic")
+	msg = types.NewMsgSetNetwork(adminAddress, polygon)
+	err = keeper.ProcessSetNetwork(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalidNetwork)
+	polygon.DenomPrefix = "cpoly"
+	msg = types.NewMsgSetNetwork(adminAddress, polygon)
+	err = keeper.ProcessSetNetwork(ctx, &msg)
+	require.NoError(t, err)
+	require.Len(t, keeper.GetNetworks(ctx), 3)
+}
+
+func TestNetworkClaimsAndBurns(t *testing.T) {
+	ctx, keeper, bankKeeper, _, _, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3}, "")
+	keeper.SetNetwork(ctx, bscNetwork)
+
+	// Claims of a registered network must come from its bridge contract
+	claim := types.NewEthBridgeClaim(56, ethBridgeAddress, 1, "usdt", tokenContractAddress, ethereumSender,
+		cosmosReceivers[0], validatorAddresses[0], amount, types.ClaimType_CLAIM_TYPE_LOCK)
+	_, err := keeper.ProcessClaim(ctx, claim)
+	require.ErrorIs(t, err, types.ErrInvalidBridgeContract)
+
+	// Claims, locks and burns of unregistered chain ids are refused
+	claim = types.NewEthBridgeClaim(137, ethBridgeAddress, 1, "usdt", tokenContractAddress, ethereumSender,
+		cosmosReceivers[0], validatorAddresses[0], amount, types.ClaimType_CLAIM_TYPE_LOCK)
+	_, err = keeper.ProcessClaim(ctx, claim)
+	require.ErrorIs(t, err, types.ErrUnregisteredNetwork)
+	lock := types.NewMsgLock(137, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
+	require.ErrorIs(t, keeper.ProcessLock(ctx, cosmosReceivers[0], &lock), types.ErrUnregisteredNetwork)
+	burn := types.NewMsgBurn(137, cosmosReceivers[0], ethereumSender, amount, "cusdt", amount)
+	require.ErrorIs(t, keeper.ProcessBurn(ctx, cosmosReceivers[0], &burn), types.ErrUnregisteredNetwork)
+
+	// Locked tokens get the denom prefix of their network
+	for _, chainID := range []int64{ethereumChainID, 56} {
+		network, ok := keeper.GetNetwork(ctx, chainID)
+		require.True(t, ok)
+		claim = types.NewEthBridgeClaim(chainID, types.NewEthereumAddress(network.BridgeContractAddress), 1, "usdt", tokenContractAddress, ethereumSender,
+			cosmosReceivers[0], validatorAddresses[0], amount, types.ClaimType_CLAIM_TYPE_LOCK)
+		status, err := keeper.ProcessClaim(ctx, claim)
+		require.NoError(t, err)
+		require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+		err = keeper.ProcessSuccessfulClaim(ctx, status.FinalClaim)
+		require.NoError(t, err)
+	}
+	require.Equal(t, "10cbscusdt,10cusdt", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	chainID, ok := keeper.GetPeggyTokenNetwork(ctx, "cbscusdt")
+	require.True(t, ok)
+	require.Equal(t, int64(56), chainID)
+	require.Equal(t, []types.NetworkPeggyToken{{EthereumChainId: 56, Denom: "cbscusdt"}}, keeper.GetNetworkPeggyTokens(ctx, 56))
+
+	// Pegged tokens are burned back to their network, paying the fee in its native token
+	fee := sdk.NewCoins(sdk.NewCoin("cbscbnb", amount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, fee))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], fee))
+	msg := types.NewMsgBurn(ethereumChainID, cosmosReceivers[0], ethereumSender, amount, "cbscusdt", amount)
+	err = keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
+	require.ErrorIs(t, err, types.ErrWrongNetwork)
+	msg = types.NewMsgBurn(56, cosmosReceivers[0], ethereumSender, amount, "cbscusdt", amount)
+	err = keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
+	require.NoError(t, err)
+	require.Equal(t, "10cusdt", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+}
+
+func TestLegacyNetwork(t *testing.T) {
+	ctx, keeper := test.CreateTestAppEthBridge(false)
+
+	// Until a network is registered every chain id is processed as before networks were registered
+	network, err := keeper.GetRegisteredNetwork(ctx, 137)
+	require.NoError(t, err)
+	require.Equal(t, types.LegacyNetwork(137), network)
+	require.Equal(t, "cusdt", network.Denom("usdt"))
+	require.Equal(t, types.CethSymbol, network.NativeToken)
+	require.True(t, network.IsBridgeContract(ethBridgeAddress.String()))
+	_, ok := keeper.GetNetwork(ctx, 137)
+	require.False(t, ok)
+
+	// Once one is, unregistered chain ids are refused
+	keeper.SetNetwork(ctx, bscNetwork)
+	_, err = keeper.GetRegisteredNetwork(ctx, 137)
+	require.ErrorIs(t, err, types.ErrUnregisteredNetwork)
+	network, err = keeper.GetRegisteredNetwork(ctx, 56)
+	require.NoError(t, err)
+	require.Equal(t, bscNetwork, network)
+	require.False(t, network.IsBridgeContract(ethBridgeAddress.String()))
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// SetNftClass stores an NFT class
+func (k Keeper) SetNftClass(ctx sdk.Context, class types.NftClass) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNftClassKey(class.Id), k.cdc.MustMarshal(&class))
+}
+
+// GetNftClass returns an NFT class and whether it exists
+func (k Keeper) GetNftClass(ctx sdk.Context, classID string) (types.NftClass, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNftClassKey(classID))
+	if bz == nil {
+		return types.NftClass{}, false
+	}
+	var class types.NftClass
+	k.cdc.MustUnmarshal(bz, &class)
+	return class, true
+}
+
+// GetNftClasses returns all NFT classes
+func (k Keeper) GetNftClasses(ctx sdk.Context) []types.NftClass {
+	var classes []types.NftClass
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.NftClassPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var class types.NftClass
+		k.cdc.MustUnmarshal(iter.Value(), &class)
+		classes = append(classes, class)
+	}
+	return classes
+}
+
+// GetNftClassesPaginated returns a page of the NFT classes
+func (k Keeper) GetNftClassesPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.NftClass, *query.PageResponse, error) {
+	var classes []types.NftClass
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NftClassPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		var class types.NftClass
+		if err := k.cdc.Unmarshal(value, &class); err != nil {
+			return err
+		}
+		classes = append(classes, class)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return classes, pageRes, nil
+}
+
+// SetNft stores an NFT, its token id must be valid
+func (k Keeper) SetNft(ctx sdk.Context, nft types.Nft) {
+	tokenID, err := types.ParseNftTokenID(nft.TokenId)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNftKey(nft.ClassId, tokenID), k.cdc.MustMarshal(&nft))
+}
+
+// GetNft returns an NFT and whether it exists
+func (k Keeper) GetNft(ctx sdk.Context, classID string, tokenID string) (types.Nft, bool) {
+	id, err := types.ParseNftTokenID(tokenID)
+	if err != nil {
+		return types.Nft{}, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNftKey(classID, id))
+	if bz == nil {
+		return types.Nft{}, false
+	}
+	var nft types.Nft
+	k.cdc.MustUnmarshal(bz, &nft)
+	return nft, true
+}
+
+// DeleteNft removes an NFT, its token id must be valid
+func (k Keeper) DeleteNft(ctx sdk.Context, classID string, tokenID string) {
+	id, err := types.ParseNftTokenID(tokenID)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNftKey(classID, id))
+}
+
+// GetNfts returns the NFTs of all classes
+func (k Keeper) GetNfts(ctx sdk.Context) []types.Nft {
+	var nfts []types.Nft
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.NftPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var nft types.Nft
+		k.cdc.MustUnmarshal(iter.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+	return nfts
+}
+
+// GetNftsPaginated returns a page of the NFTs of a class, of its NFTs held by the owner when it is set
+func (k Keeper) GetNftsPaginated(ctx sdk.Context, classID string, owner string,
+	pagination *query.PageRequest) ([]types.Nft, *query.PageResponse, error) {
+	var nfts []types.Nft
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetNftsKey(classID))
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var nft types.Nft
+		if err := k.cdc.Unmarshal(value, &nft); err != nil {
+			return false, err
+		}
+		if owner != "" && nft.Owner != owner {
+			return false, nil
+		}
+		if accumulate {
+			nfts = append(nfts, nft)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return nfts, pageRes, nil
+}
+
+// ProcessNftClaim processes a new NFT claim
+func (k Keeper) ProcessNftClaim(ctx sdk.Context, claim *types.EthBridgeNftClaim) (oracletypes.Status, error) {
+	network, err := k.GetRegisteredNetwork(ctx, claim.EthereumChainId)
+	if err != nil {
+		return oracletypes.Status{}, err
+	}
+	if !network.IsBridgeContract(claim.BridgeContractAddress) {
+		return oracletypes.Status{}, sdkerrors.Wrapf(types.ErrInvalidBridgeContract, "%s on chain id %d",
+			claim.BridgeContractAddress, claim.EthereumChainId)
+	}
+	oracleClaim, err := types.CreateOracleClaimFromEthNftClaim(claim)
+	if err != nil {
+		k.Logger(ctx).Error("failed to create oracle claim from eth nft claim.",
+			errorMessageKey, err.Error())
+		return oracletypes.Status{}, err
+	}
+	return k.oracleKeeper.ProcessClaim(ctx, oracleClaim)
+}
+
+// ProcessSuccessfulNftClaim mints the NFT of an NFT claim that has just completed successfully with consensus, under
+// the class of its contract which is created by the first claim of one of its tokens
+func (k Keeper) ProcessSuccessfulNftClaim(ctx sdk.Context, claim string) (types.Nft, error) {
+	content, err := types.CreateNftOracleClaimFromOracleString(claim)
+	if err != nil {
+		k.Logger(ctx).Error("failed to create nft oracle claim from oracle string.",
+			errorMessageKey, err.Error())
+		return types.Nft{}, err
+	}
+	class := types.NewNftClass(content.EthereumChainID, content.TokenContractAddress, content.Symbol)
+	if _, ok := k.GetNftClass(ctx, class.Id); !ok {
+		k.SetNftClass(ctx, class)
+	}
+	if _, ok := k.GetNft(ctx, class.Id, content.TokenID); ok {
+		return types.Nft{}, sdkerrors.Wrapf(types.ErrNftExists, "%s %s", class.Id, content.TokenID)
+	}
+	nft := types.NewNft(class.Id, content.TokenID, content.CosmosReceiver, content.TokenURI)
+	k.SetNft(ctx, nft)
+	return nft, nil
+}
+
+// ProcessBurnNft burns an NFT held by the sender and collects the cross-chain fee of unlocking its token
+func (k Keeper) ProcessBurnNft(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *types.MsgBurnNft) (types.NftClass, error) {
+	logger := k.Logger(ctx)
+
+	if k.IsBlacklisted(ctx, msg.EthereumReceiver) {
+		return types.NftClass{}, types.ErrInvalidEthAddress
+	}
+
+	class, ok := k.GetNftClass(ctx, msg.ClassId)
+	if !ok {
+		return types.NftClass{}, sdkerrors.Wrapf(types.ErrNftNotFound, "class %s", msg.ClassId)
+	}
+	nft, ok := k.GetNft(ctx, msg.ClassId, msg.TokenId)
+	if !ok {
+		return types.NftClass{}, sdkerrors.Wrapf(types.ErrNftNotFound, "%s %s", msg.ClassId, msg.TokenId)
+	}
+	if nft.Owner != cosmosSender.String() {
+		return types.NftClass{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of %s %s",
+			msg.CosmosSender, msg.ClassId, msg.TokenId)
+	}
+
+	if err := k.CheckCethAmount(ctx, class.EthereumChainId, msg.CethAmount); err != nil {
+		return types.NftClass{}, err
+	}
+
+	network, err := k.GetRegisteredNetwork(ctx, class.EthereumChainId)
+	if err != nil {
+		return types.NftClass{}, err
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(network.NativeToken, msg.CethAmount))
+	if k.IsCethReceiverAccountSet(ctx) {
+		err = k.bankKeeper.SendCoins(ctx, cosmosSender, k.GetCethReceiverAccount(ctx), fee)
+	} else {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosSender, types.ModuleName, fee)
+	}
+	if err != nil {
+		logger.Error("failed to collect the cross-chain fee of the nft burn.",
+			errorMessageKey, err.Error())
+		return types.NftClass{}, err
+	}
+
+	k.DeleteNft(ctx, msg.ClassId, msg.TokenId)
+	return class, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func TestNftClaimAndBurn(t *testing.T) {
+	ctx, keeper, bankKeeper, _, _, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	keeper.SetNetwork(ctx, bscNetwork)
+	bridgeContract := types.NewEthereumAddress(bscNetwork.BridgeContractAddress)
+	classID := types.NftClassID(56, tokenContractAddress)
+	claim := func(validator sdk.ValAddress, nonce int64, tokenID string) (oracletypes.Status, error) {
+		return keeper.ProcessNftClaim(ctx, types.NewEthBridgeNftClaim(56, bridgeContract, nonce, "PUNK",
+			tokenContractAddress, ethereumSender, cosmosReceivers[0], validator, tokenID, "ipfs://punk/"+tokenID))
+	}
+
+	// The NFT is minted once the claims of the relayers reach consensus
+	status, err := claim(validatorAddresses[0], 1, "7")
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, status.Text)
+	status, err = claim(validatorAddresses[1], 1, "7")
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+	nft, err := keeper.ProcessSuccessfulNftClaim(ctx, status.FinalClaim)
+	require.NoError(t, err)
+	require.Equal(t, types.NewNft(classID, "7", cosmosReceivers[0], "ipfs://punk/7"), nft)
+
+	class, ok := keeper.GetNftClass(ctx, classID)
+	require.True(t, ok)
+	require.Equal(t, types.NewNftClass(56, tokenContractAddress, "PUNK"), class)
+	stored, ok := keeper.GetNft(ctx, classID, "7")
+	require.True(t, ok)
+	require.Equal(t, nft, stored)
+
+	// NFT claims do not share prophecies with claims of coins from the same sender and nonce
+	_, err = keeper.ProcessClaim(ctx, types.NewEthBridgeClaim(56, bridgeContract, 1, symbol,
+		tokenContractAddress, ethereumSender, cosmosReceivers[0], validatorAddresses[0], amount,
+		types.ClaimType_CLAIM_TYPE_LOCK))
+	require.NoError(t, err)
+
+	// A token cannot be minted twice
+	_, err = keeper.ProcessSuccessfulNftClaim(ctx, status.FinalClaim)
+	require.ErrorIs(t, err, types.ErrNftExists)
+
+	// Claims must come from the bridge contract of the network
+	_, err = keeper.ProcessNftClaim(ctx, types.NewEthBridgeNftClaim(56, ethBridgeAddress, 2, "PUNK",
+		tokenContractAddress, ethereumSender, cosmosReceivers[0], validatorAddresses[0], "8", ""))
+	require.ErrorIs(t, err, types.ErrInvalidBridgeContract)
+
+	keeper.SetNft(ctx, types.NewNft(classID, "8", sdk.AccAddress(validatorAddresses[0]), ""))
+	nfts, _, err := keeper.GetNftsPaginated(ctx, classID, "", &query.PageRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, nfts, 2)
+	nfts, _, err = keeper.GetNftsPaginated(ctx, classID, cosmosReceivers[0].String(), &query.PageRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []types.Nft{nft}, nfts)
+	classes, _, err := keeper.GetNftClassesPaginated(ctx, &query.PageRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []types.NftClass{class}, classes)
+
+	// Only the owner burns an NFT, paying the cross-chain fee
+	fee := sdk.NewCoins(sdk.NewCoin(bscNetwork.NativeToken, sdk.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, fee))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], fee))
+	burn := types.NewMsgBurnNft(cosmosReceivers[0], classID, "8", ethereumSender, sdk.NewInt(100))
+	_, err = keeper.ProcessBurnNft(ctx, cosmosReceivers[0], &burn)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	burn.TokenId = "7"
+	burnedClass, err := keeper.ProcessBurnNft(ctx, cosmosReceivers[0], &burn)
+	require.NoError(t, err)
+	require.Equal(t, class, burnedClass)
+	_, ok = keeper.GetNft(ctx, classID, "7")
+	require.False(t, ok)
+	require.True(t, bankKeeper.GetBalance(ctx, cosmosReceivers[0], bscNetwork.NativeToken).IsZero())
+
+	_, err = keeper.ProcessBurnNft(ctx, cosmosReceivers[0], &burn)
+	require.ErrorIs(t, err, types.ErrNftNotFound)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// SetOutboundTransfer stores an outbound transfer
+func (k Keeper) SetOutboundTransfer(ctx sdk.Context, transfer types.OutboundTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	cosmosSender, err := sdk.AccAddressFromBech32(transfer.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetOutboundTransferKey(cosmosSender, transfer.CosmosSenderSequence), k.cdc.MustMarshal(&transfer))
+}
+
+// GetOutboundTransfer returns the outbound transfer of a cosmos sender sequence
+func (k Keeper) GetOutboundTransfer(ctx sdk.Context, cosmosSender sdk.AccAddress, sequence uint64) (types.OutboundTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutboundTransferKey(cosmosSender, sequence))
+	if bz == nil {
+		return types.OutboundTransfer{}, false
+	}
+	var transfer types.OutboundTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// GetOutboundTransfers returns all outbound transfers
+func (k Keeper) GetOutboundTransfers(ctx sdk.Context) []types.OutboundTransfer {
+	var transfers []types.OutboundTransfer
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OutboundTransferPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.OutboundTransfer
+		k.cdc.MustUnmarshal(iter.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+// GetOutboundTransfersForSenderPaginated returns the outbound transfers of a cosmos sender in sequence order
+func (k Keeper) GetOutboundTransfersForSenderPaginated(ctx sdk.Context, cosmosSender sdk.AccAddress,
+	pagination *query.PageRequest) ([]types.OutboundTransfer, *query.PageResponse, error) {
+	var transfers []types.OutboundTransfer
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutboundTransfersKey(cosmosSender))
+	pageRes, err := query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		var transfer types.OutboundTransfer
+		if err := k.cdc.Unmarshal(value, &transfer); err != nil {
+			return err
+		}
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return transfers, pageRes, nil
+}
+
+// NextOutboundSequence returns the sequence the next outbound transfer of a cosmos sender is recorded under. It is the
+// account sequence of the transaction, or one more than the last recorded transfer of the sender when the transaction
+// already recorded one, so that every lock and burn of a transaction gets its own sequence.
+func (k Keeper) NextOutboundSequence(ctx sdk.Context, cosmosSender sdk.AccAddress, accountSequence uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutboundTransfersKey(cosmosSender))
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return accountSequence
+	}
+	if last := sdk.BigEndianToUint64(iter.Key()); last >= accountSequence {
+		return last + 1
+	}
+	return accountSequence
+}
+
+// RecordOutboundTransfer stores a new pending outbound transfer, a sender sequence can only be recorded once
+func (k Keeper) RecordOutboundTransfer(ctx sdk.Context, transfer types.OutboundTransfer) error {
+	cosmosSender, err := sdk.AccAddressFromBech32(transfer.CosmosSender)
+	if err != nil {
+		return err
+	}
+	if k.Exists(ctx, types.GetOutboundTransferKey(cosmosSender, transfer.CosmosSenderSequence)) {
+		return sdkerrors.Wrapf(types.ErrOutboundExists, "%s sequence %d", transfer.CosmosSender, transfer.CosmosSenderSequence)
+	}
+	k.SetOutboundTransfer(ctx, transfer)
+	return nil
+}
+
+// ProcessReportOutboundTransfer counts the report of a relayer towards the consensus on the ethereum transaction of
+// an outbound transfer. The transfer is relayed once reported and completed once the relayers reach consensus.
+func (k Keeper) ProcessReportOutboundTransfer(ctx sdk.Context, msg *types.MsgReportOutboundTransfer) (types.OutboundTransfer, error) {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		return types.OutboundTransfer{}, err
+	}
+	transfer, ok := k.GetOutboundTransfer(ctx, cosmosSender, msg.CosmosSenderSequence)
+	if !ok {
+		return types.OutboundTransfer{}, sdkerrors.Wrapf(types.ErrOutboundNotFound, "%s sequence %d",
+			msg.CosmosSender, msg.CosmosSenderSequence)
+	}
+	if !transfer.IsOpen() {
+		return types.OutboundTransfer{}, sdkerrors.Wrapf(types.ErrOutboundStatus, "transfer is %s", transfer.Status)
+	}
+	status, err := k.oracleKeeper.ProcessClaim(ctx, oracletypes.Claim{
+		Id:               types.GetOutboundTransferProphecyID(msg.CosmosSender, msg.CosmosSenderSequence),
+		ValidatorAddress: msg.ValidatorAddress,
+		Content:          msg.EthereumTxHash,
+	})
+	if err != nil {
+		return types.OutboundTransfer{}, err
+	}
+	if status.Text == oracletypes.StatusText_STATUS_TEXT_SUCCESS {
+		transfer.Status = types.OutboundStatus_OUTBOUND_STATUS_COMPLETED
+		transfer.EthereumTxHash = status.FinalClaim
+	} else {
+		transfer.Status = types.OutboundStatus_OUTBOUND_STATUS_RELAYED
+	}
+	k.SetOutboundTransfer(ctx, transfer)
+	return transfer, nil
+}
+
+// ProcessRefundOutboundTransfer mints the amount of an outbound transfer that was not completed within the refund
+// timeout back to its sender. The amount of a refunded burn is counted as minted and the amount of a refunded lock is
+// no longer counted as locked. Only the rescuer can refund, the cross-chain fee is not refunded.
+func (k Keeper) ProcessRefundOutboundTransfer(ctx sdk.Context, msg *types.MsgRefundOutboundTransfer) (types.OutboundTransfer, error) {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		return types.OutboundTransfer{}, err
+	}
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_RESCUER, cosmosSender) {
+		return types.OutboundTransfer{}, oracletypes.ErrNotAdminAccount
+	}
+	transferSender, err := sdk.AccAddressFromBech32(msg.TransferSender)
+	if err != nil {
+		return types.OutboundTransfer{}, err
+	}
+	transfer, ok := k.GetOutboundTransfer(ctx, transferSender, msg.TransferSenderSequence)
+	if !ok {
+		return types.OutboundTransfer{}, sdkerrors.Wrapf(types.ErrOutboundNotFound, "%s sequence %d",
+			msg.TransferSender, msg.TransferSenderSequence)
+	}
+	if !transfer.IsOpen() {
+		return types.OutboundTransfer{}, sdkerrors.Wrapf(types.ErrOutboundStatus, "transfer is %s", transfer.Status)
+	}
+	if ctx.BlockHeight()-transfer.CreatedHeight < types.OutboundRefundTimeout {
+		return types.OutboundTransfer{}, sdkerrors.Wrapf(types.ErrOutboundNotTimedOut, "created at height %d, timeout is %d blocks",
+			transfer.CreatedHeight, types.OutboundRefundTimeout)
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(transfer.Symbol, transfer.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return types.OutboundTransfer{}, err
+	}
+	if transfer.ClaimType == types.ClaimType_CLAIM_TYPE_BURN {
+		k.AddBridgeMinted(ctx, transfer.Symbol, transfer.Amount)
+	} else {
+		k.SubBridgeLocked(ctx, transfer.Symbol, transfer.Amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, transferSender, coins); err != nil {
+		return types.OutboundTransfer{}, err
+	}
+	transfer.Status = types.OutboundStatus_OUTBOUND_STATUS_REFUNDED
+	k.SetOutboundTransfer(ctx, transfer)
+	return transfer, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetPause stores the pause of a symbol, a pause that is not paused in any direction is removed
+func (k Keeper) SetPause(ctx sdk.Context, pause types.Pause) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPauseKey(pause.Symbol)
+	if !pause.IsPaused() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&pause))
+}
+
+// GetPause returns the pause of a symbol, the empty symbol returns the global pause
+func (k Keeper) GetPause(ctx sdk.Context, symbol string) types.Pause {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPauseKey(symbol))
+	if bz == nil {
+		return types.NewPause(symbol, false, false)
+	}
+	var pause types.Pause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause
+}
+
+// GetPauses returns the global pause and the pauses of symbols
+func (k Keeper) GetPauses(ctx sdk.Context) []types.Pause {
+	var pauses []types.Pause
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PausePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pause types.Pause
+		k.cdc.MustUnmarshal(iter.Value(), &pause)
+		pauses = append(pauses, pause)
+	}
+	return pauses
+}
+
+// CheckOutboundPause returns an error if locks and burns of a symbol are paused globally or for the symbol
+func (k Keeper) CheckOutboundPause(ctx sdk.Context, symbol string) error {
+	if k.GetPause(ctx, "").Outbound || k.GetPause(ctx, symbol).Outbound {
+		return sdkerrors.Wrapf(types.ErrBridgePaused, "outbound transfers of %s", symbol)
+	}
+	return nil
+}
+
+// CheckInboundPause returns an error if claims of a symbol are paused globally or for the symbol
+func (k Keeper) CheckInboundPause(ctx sdk.Context, symbol string) error {
+	if k.GetPause(ctx, "").Inbound || k.GetPause(ctx, symbol).Inbound {
+		return sdkerrors.Wrapf(types.ErrBridgePaused, "inbound transfers of %s", symbol)
+	}
+	return nil
+}
+
+// GetClaimDenom returns the denom a claim mints, the pegged denom of its network for locks
+func (k Keeper) GetClaimDenom(ctx sdk.Context, claim *types.EthBridgeClaim) (string, error) {
+	if claim.ClaimType == types.ClaimType_CLAIM_TYPE_LOCK {
+		network, err := k.GetRegisteredNetwork(ctx, claim.EthereumChainId)
+		if err != nil {
+			return "", err
+		}
+		return network.Denom(claim.Symbol), nil
+	}
+	return claim.Symbol, nil
+}
+
+// ProcessSetPause pauses or resumes bridge transfers from the compliance role
+func (k Keeper) ProcessSetPause(ctx sdk.Context, msg *types.MsgSetPause) error {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		return err
+	}
+	if !k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_COMPLIANCE, cosmosSender) {
+		return oracletypes.ErrNotAdminAccount
+	}
+	k.SetPause(ctx, msg.Pause)
+	return nil
+}
@@ -23,6 +23,28 @@ func NewLegacyQuerier(keeper Keeper, cdc *codec.LegacyAmino) sdk.Querier { //nol
 			return legacyQueryEthProphecy(ctx, cdc, req, keeper)
 		case types.QueryBlacklist:
 			return legacyQueryBlacklist(ctx, cdc, req, keeper)
+		case types.QueryNetworks:
+			return legacyQueryNetworks(ctx, cdc, keeper)
+		case types.QueryTransferUsage:
+			return legacyQueryTransferUsage(ctx, cdc, req, keeper)
+		case types.QueryPauses:
+			return legacyQueryPauses(ctx, cdc, keeper)
+		case types.QueryOutbound:
+			return legacyQueryOutboundTransfers(ctx, cdc, req, keeper)
+		case types.QueryUnclaimed:
+			return legacyQueryUnclaimedInbounds(ctx, cdc, req, keeper)
+		case types.QueryBlacklistEntries:
+			return legacyQueryBlacklistEntries(ctx, cdc, req, keeper)
+		case types.QueryCrossChainFee:
+			return legacyQueryCrossChainFee(ctx, cdc, req, keeper)
+		case types.QueryNftClasses:
+			return legacyQueryNftClasses(ctx, cdc, req, keeper)
+		case types.QueryNfts:
+			return legacyQueryNfts(ctx, cdc, req, keeper)
+		case types.QueryAdminRoleHolders:
+			return legacyQueryAdminRoleHolders(ctx, cdc, req, keeper)
+		case types.QueryClaimRecords:
+			return legacyQueryClaimRecords(ctx, cdc, req, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown ethbridge query endpoint")
 		}
@@ -60,3 +82,167 @@ func legacyQueryBlacklist(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.Re
 
 	return cdc.MarshalJSONIndent(response, "", "  ")
 }
+
+func legacyQueryNetworks(ctx sdk.Context, cdc *codec.LegacyAmino, keeper Keeper) ([]byte, error) { //nolint
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetNetworks(sdk.WrapSDKContext(ctx), &types.QueryNetworksRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryTransferUsage(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryTransferUsageRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetTransferUsage(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryPauses(ctx sdk.Context, cdc *codec.LegacyAmino, keeper Keeper) ([]byte, error) { //nolint
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetPauses(sdk.WrapSDKContext(ctx), &types.QueryPausesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryOutboundTransfers(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryOutboundTransfersRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetOutboundTransfers(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryUnclaimedInbounds(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryUnclaimedInboundsRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetUnclaimedInbounds(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryBlacklistEntries(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryBlacklistEntriesRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetBlacklistEntries(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryCrossChainFee(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryCrossChainFeeRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetCrossChainFee(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryNftClasses(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryNftClassesRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetNftClasses(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryNfts(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryNftsRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetNfts(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryAdminRoleHolders(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryAdminRoleHoldersRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetAdminRoleHolders(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
+
+func legacyQueryClaimRecords(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryClaimRecordsRequest
+
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetClaimRecords(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
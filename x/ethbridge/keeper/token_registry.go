@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterProvisionalToken adds a quarantined token registry entry for the pegged denom of a locked token that is not
+// registered yet. The entry has no permissions until the token registry admin approves it.
+func (k Keeper) RegisterProvisionalToken(ctx sdk.Context, claim types.OracleClaimContent, denom string) {
+	if _, err := k.tokenRegistry.GetEntry(k.tokenRegistry.GetRegistry(ctx), denom); err == nil {
+		return
+	}
+	network := strconv.FormatInt(claim.EthereumChainID, 10)
+	if registered, ok := k.GetNetwork(ctx, claim.EthereumChainID); ok && registered.Name != "" {
+		network = registered.Name
+	}
+	entry := tokenregistrytypes.RegistryEntry{
+		Decimals:       claim.Decimals,
+		Denom:          denom,
+		BaseDenom:      denom,
+		UnitDenom:      denom,
+		Network:        network,
+		Address:        claim.TokenContractAddress.String(),
+		ExternalSymbol: claim.Symbol,
+		Quarantined:    true,
+	}
+	k.tokenRegistry.SetToken(ctx, &entry)
+
+	k.Logger(ctx).Info("registered provisional token.",
+		"denom", denom,
+		"network", network,
+		"TokenContractAddress", entry.Address,
+		"decimals", claim.Decimals)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProvisionalToken,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyEthereumChainID, strconv.FormatInt(claim.EthereumChainID, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokenContract, entry.Address),
+		sdk.NewAttribute(types.AttributeKeySymbol, claim.Symbol),
+		sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatInt(claim.Decimals, 10)),
+	))
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterProvisionalToken(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, tokenRegistryKeeper := test.CreateTestKeepersWithTokenRegistry(t, 0.7, []int64{3}, "")
+	processLock := func(symbol string, decimals int64) {
+		claimContent := types.NewOracleClaimContent(ethereumChainID, cosmosReceivers[0], amount, symbol,
+			tokenContractAddress, types.ClaimType_CLAIM_TYPE_LOCK)
+		claimContent.Decimals = decimals
+		claimBytes, err := json.Marshal(claimContent)
+		require.NoError(t, err)
+		require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+	}
+
+	// The first lock of a token registers it in quarantine without permissions
+	processLock("usdc", 6)
+	entry, err := tokenRegistryKeeper.GetEntry(tokenRegistryKeeper.GetRegistry(ctx), "cusdc")
+	require.NoError(t, err)
+	require.Equal(t, &tokenregistrytypes.RegistryEntry{
+		Decimals:       6,
+		Denom:          "cusdc",
+		BaseDenom:      "cusdc",
+		UnitDenom:      "cusdc",
+		Network:        "ethereum",
+		Address:        tokenContractAddress.String(),
+		ExternalSymbol: "usdc",
+		Quarantined:    true,
+	}, entry)
+	require.False(t, tokenRegistryKeeper.CheckEntryPermissions(entry, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}))
+
+	// Later locks keep the entry, as do locks of registered tokens
+	entry.Quarantined = false
+	entry.Permissions = []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}
+	tokenRegistryKeeper.SetToken(ctx, entry)
+	processLock("usdc", 8)
+	entry, err = tokenRegistryKeeper.GetEntry(tokenRegistryKeeper.GetRegistry(ctx), "cusdc")
+	require.NoError(t, err)
+	require.Equal(t, int64(6), entry.Decimals)
+	require.False(t, entry.Quarantined)
+	require.Len(t, tokenRegistryKeeper.GetRegistry(ctx).Entries, 1)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetTransferUsage stores the bridge transfer usage of a denom
+func (k Keeper) SetTransferUsage(ctx sdk.Context, usage types.TransferUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTransferUsageKey(usage.Denom), k.cdc.MustMarshal(&usage))
+}
+
+// GetTransferUsage returns the bridge transfer usage of a denom, or an empty usage if nothing has been transferred
+func (k Keeper) GetTransferUsage(ctx sdk.Context, denom string) types.TransferUsage {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTransferUsageKey(denom))
+	if bz == nil {
+		return types.NewTransferUsage(denom)
+	}
+	var usage types.TransferUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// GetTransferUsages returns the bridge transfer usages of all denoms
+func (k Keeper) GetTransferUsages(ctx sdk.Context) []types.TransferUsage {
+	var usages []types.TransferUsage
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TransferUsagePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var usage types.TransferUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// GetRollingTransferAmount returns the volume of a denom counted against its window transfer limit at the current
+// height, or the usage of the current window if the token has no transfer window
+func (k Keeper) GetRollingTransferAmount(ctx sdk.Context, denom string) sdk.Int {
+	usage := k.GetTransferUsage(ctx, denom)
+	entry, err := k.tokenRegistry.GetEntry(k.tokenRegistry.GetRegistry(ctx), denom)
+	if err != nil || entry.TransferWindow <= 0 {
+		return usage.Amount
+	}
+	return usage.RollingAmount(ctx.BlockHeight(), entry.TransferWindow)
+}
+
+// CheckTransferLimit checks a transfer of a denom out of Sifchain across the bridge against the per transfer and
+// rolling window limits of its registry entry and records it in the usage of the denom. Denoms without a registry
+// entry are not limited.
+func (k Keeper) CheckTransferLimit(ctx sdk.Context, denom string, amount sdk.Int) error {
+	entry, err := k.tokenRegistry.GetEntry(k.tokenRegistry.GetRegistry(ctx), denom)
+	if err != nil {
+		return nil
+	}
+	transferLimit, windowLimit, err := parseTransferLimits(entry)
+	if err != nil {
+		return err
+	}
+	if transferLimit != nil && amount.GT(*transferLimit) {
+		return sdkerrors.Wrapf(types.ErrTransferLimitExceeded, "%s%s is over the limit of %s", amount, denom, transferLimit)
+	}
+	if windowLimit == nil {
+		return nil
+	}
+	rolling := k.GetTransferUsage(ctx, denom).RollingAmount(ctx.BlockHeight(), entry.TransferWindow).Add(amount)
+	if rolling.GT(*windowLimit) {
+		return sdkerrors.Wrapf(types.ErrWindowLimitExceeded, "%s%s transferred in the last %d blocks is over the limit of %s",
+			rolling, denom, entry.TransferWindow, windowLimit)
+	}
+	k.AddTransferUsage(ctx, denom, amount)
+	return nil
+}
+
+// CheckInboundTransferLimit checks a transfer of a denom into Sifchain against the limits of its registry entry like
+// CheckTransferLimit. The claim of an inbound transfer already reached consensus and can not be refused, a transfer
+// over the limits is held instead. It returns the error of the limit check and the height the held coins are released
+// at, which is the start of the next transfer window when only the window limit is exceeded. It is zero for transfers
+// over the per transfer limit, which no window makes room for, and for denoms without a window, whose coins wait for
+// the rescuer.
+func (k Keeper) CheckInboundTransferLimit(ctx sdk.Context, denom string, amount sdk.Int) (int64, error) {
+	err := k.CheckTransferLimit(ctx, denom, amount)
+	if err == nil {
+		return 0, nil
+	}
+	if !errors.Is(err, types.ErrWindowLimitExceeded) {
+		return 0, err
+	}
+	entry, entryErr := k.tokenRegistry.GetEntry(k.tokenRegistry.GetRegistry(ctx), denom)
+	if entryErr != nil || entry.TransferWindow <= 0 {
+		return 0, err
+	}
+	height := ctx.BlockHeight()
+	return height - height%entry.TransferWindow + entry.TransferWindow, err
+}
+
+// AddTransferUsage counts a transfer of a denom in its usage, in the window of the current height. The usage of denoms
+// without a transfer window is not recorded.
+func (k Keeper) AddTransferUsage(ctx sdk.Context, denom string, amount sdk.Int) {
+	entry, err := k.tokenRegistry.GetEntry(k.tokenRegistry.GetRegistry(ctx), denom)
+	if err != nil || entry.TransferWindow <= 0 {
+		return
+	}
+	usage := k.GetTransferUsage(ctx, denom).Advance(ctx.BlockHeight(), entry.TransferWindow)
+	usage.Amount = usage.Amount.Add(amount)
+	k.SetTransferUsage(ctx, usage)
+}
+
+// parseTransferLimits returns the per transfer and window limits of a registry entry, nil when there is none
+func parseTransferLimits(entry *tokenregistrytypes.RegistryEntry) (*sdk.Int, *sdk.Int, error) {
+	var transferLimit, windowLimit *sdk.Int
+	if entry.TransferLimit != "" {
+		limit, ok := sdk.NewIntFromString(entry.TransferLimit)
+		if !ok || limit.IsNegative() {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidTransferLimit, "transfer limit %q of %s",
+				entry.TransferLimit, entry.Denom)
+		}
+		transferLimit = &limit
+	}
+	if entry.WindowTransferLimit != "" {
+		limit, ok := sdk.NewIntFromString(entry.WindowTransferLimit)
+		if !ok || limit.IsNegative() || entry.TransferWindow <= 0 {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidTransferLimit, "window transfer limit %q over %d blocks of %s",
+				entry.WindowTransferLimit, entry.TransferWindow, entry.Denom)
+		}
+		windowLimit = &limit
+	}
+	return transferLimit, windowLimit, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferLimits(t *testing.T) {
+	ctx, keeper, bankKeeper, _, _, _, validatorAddresses, tokenRegistryKeeper := test.CreateTestKeepersWithTokenRegistry(t, 0.7, []int64{3}, "")
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)), sdk.NewCoin(types.CethSymbol, sdk.NewInt(1000)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins))
+	lock := func(ctx sdk.Context, amount int64) error {
+		msg := types.NewMsgLock(ethereumChainID, cosmosReceivers[0], ethereumSender, sdk.NewInt(amount), "stake", sdk.NewInt(1))
+		return keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
+	}
+
+	// Tokens without a registry entry are not limited
+	require.NoError(t, lock(ctx, 100))
+	require.Empty(t, keeper.GetTransferUsages(ctx))
+
+	tokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:               "stake",
+		Decimals:            18,
+		TransferLimit:       "10",
+		WindowTransferLimit: "25",
+		TransferWindow:      100,
+	})
+	require.ErrorIs(t, lock(ctx, 11), types.ErrTransferLimitExceeded)
+
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, lock(ctx, 10))
+	require.NoError(t, lock(ctx, 10))
+	require.ErrorIs(t, lock(ctx, 10), types.ErrWindowLimitExceeded)
+	require.ErrorIs(t, lock(ctx.WithBlockHeight(150), 10), types.ErrWindowLimitExceeded)
+
+	// Half of the previous window is still inside the rolling window
+	ctx = ctx.WithBlockHeight(250)
+	require.Equal(t, sdk.NewInt(10), keeper.GetRollingTransferAmount(ctx, "stake"))
+	require.NoError(t, lock(ctx, 10))
+	require.ErrorIs(t, lock(ctx, 10), types.ErrWindowLimitExceeded)
+	require.Equal(t, types.TransferUsage{
+		Denom:          "stake",
+		WindowStart:    200,
+		Amount:         sdk.NewInt(10),
+		PreviousAmount: sdk.NewInt(20),
+	}, keeper.GetTransferUsage(ctx, "stake"))
+
+	// Incoming transfers over the limits are held after consensus until the next window starts
+	claim := types.NewEthBridgeClaim(ethereumChainID, ethBridgeAddress, 1, "stake", tokenContractAddress, ethereumSender,
+		cosmosReceivers[0], validatorAddresses[0], sdk.NewInt(10), types.ClaimType_CLAIM_TYPE_BURN)
+	status, err := keeper.ProcessClaim(ctx, claim)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+	balance := bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake")
+	require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, status.FinalClaim))
+	require.Equal(t, balance, bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake"))
+	held, ok := keeper.GetUnclaimedInbound(ctx, 0)
+	require.True(t, ok)
+	require.True(t, held.Held)
+	require.Equal(t, int64(300), held.ReleaseHeight)
+	redirect := types.NewMsgRedirectUnclaimedInbound(cosmosReceivers[0], held.Id, cosmosReceivers[0])
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+
+	keeper.ReleaseHeldInbounds(ctx.WithBlockHeight(299))
+	require.Equal(t, balance, bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake"))
+	ctx = ctx.WithBlockHeight(300)
+	keeper.ReleaseHeldInbounds(ctx)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(10)), bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake"))
+	require.Empty(t, keeper.GetUnclaimedInbounds(ctx))
+	require.Equal(t, sdk.NewInt(10), keeper.GetTransferUsage(ctx, "stake").Amount)
+
+	// Incoming transfers within the limits are counted in the usage
+	ctx = ctx.WithBlockHeight(350)
+	require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, status.FinalClaim))
+	require.Equal(t, balance.AddAmount(sdk.NewInt(20)), bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake"))
+	require.Equal(t, sdk.NewInt(20), keeper.GetTransferUsage(ctx, "stake").Amount)
+
+	// Held transfers are checked again when they are released and held until the next window if still over the limit
+	processClaim := func(ctx sdk.Context, nonce int64, amount int64) {
+		claim := types.NewEthBridgeClaim(ethereumChainID, ethBridgeAddress, nonce, "stake", tokenContractAddress,
+			ethereumSender, cosmosReceivers[0], validatorAddresses[0], sdk.NewInt(amount), types.ClaimType_CLAIM_TYPE_BURN)
+		status, err := keeper.ProcessClaim(ctx, claim)
+		require.NoError(t, err)
+		require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+		require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, status.FinalClaim))
+	}
+	processClaim(ctx, 2, 10)
+	held, ok = keeper.GetUnclaimedInbound(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, int64(400), held.ReleaseHeight)
+	ctx = ctx.WithBlockHeight(400)
+	keeper.ReleaseHeldInbounds(ctx)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(20)), bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake"))
+	held, ok = keeper.GetUnclaimedInbound(ctx, 1)
+	require.True(t, ok)
+	require.True(t, held.Held)
+	require.Equal(t, int64(500), held.ReleaseHeight)
+	require.Contains(t, held.Reason, types.ErrWindowLimitExceeded.Error())
+	ctx = ctx.WithBlockHeight(500)
+	keeper.ReleaseHeldInbounds(ctx)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(30)), bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake"))
+	require.Empty(t, keeper.GetUnclaimedInbounds(ctx))
+
+	// Incoming transfers over the per transfer limit are held for the rescuer, the window never makes room for them
+	processClaim(ctx, 3, 11)
+	held, ok = keeper.GetUnclaimedInbound(ctx, 2)
+	require.True(t, ok)
+	require.True(t, held.Held)
+	require.Equal(t, int64(0), held.ReleaseHeight)
+	require.Contains(t, held.Reason, types.ErrTransferLimitExceeded.Error())
+	keeper.ReleaseHeldInbounds(ctx.WithBlockHeight(1000))
+	require.Equal(t, balance.AddAmount(sdk.NewInt(30)), bankKeeper.GetBalance(ctx, cosmosReceivers[0], "stake"))
+
+	tokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:         "stake",
+		Decimals:      18,
+		TransferLimit: "ten",
+	})
+	require.ErrorIs(t, lock(ctx, 1), types.ErrInvalidTransferLimit)
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// GetNextUnclaimedInboundID returns the id the next unclaimed inbound record is assigned
+func (k Keeper) GetNextUnclaimedInboundID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextUnclaimedInboundIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextUnclaimedInboundID sets the id the next unclaimed inbound record is assigned
+func (k Keeper) SetNextUnclaimedInboundID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextUnclaimedInboundIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetUnclaimedInbound stores an unclaimed inbound record, indexing it by release height while it is held
+func (k Keeper) SetUnclaimedInbound(ctx sdk.Context, unclaimed types.UnclaimedInbound) {
+	store := ctx.KVStore(k.storeKey)
+	if old, ok := k.GetUnclaimedInbound(ctx, unclaimed.Id); ok && old.ReleaseHeight > 0 {
+		store.Delete(types.GetHeldInboundKey(old.ReleaseHeight, old.Id))
+	}
+	store.Set(types.GetUnclaimedInboundKey(unclaimed.Id), k.cdc.MustMarshal(&unclaimed))
+	if unclaimed.Held && unclaimed.ReleaseHeight > 0 {
+		store.Set(types.GetHeldInboundKey(unclaimed.ReleaseHeight, unclaimed.Id), sdk.Uint64ToBigEndian(unclaimed.Id))
+	}
+}
+
+// DeleteUnclaimedInbound removes an unclaimed inbound record and its release height index
+func (k Keeper) DeleteUnclaimedInbound(ctx sdk.Context, unclaimed types.UnclaimedInbound) {
+	store := ctx.KVStore(k.storeKey)
+	if unclaimed.ReleaseHeight > 0 {
+		store.Delete(types.GetHeldInboundKey(unclaimed.ReleaseHeight, unclaimed.Id))
+	}
+	store.Delete(types.GetUnclaimedInboundKey(unclaimed.Id))
+}
+
+// GetUnclaimedInbound returns an unclaimed inbound record by id
+func (k Keeper) GetUnclaimedInbound(ctx sdk.Context, id uint64) (types.UnclaimedInbound, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnclaimedInboundKey(id))
+	if bz == nil {
+		return types.UnclaimedInbound{}, false
+	}
+	var unclaimed types.UnclaimedInbound
+	k.cdc.MustUnmarshal(bz, &unclaimed)
+	return unclaimed, true
+}
+
+// GetUnclaimedInbounds returns all unclaimed inbound records
+func (k Keeper) GetUnclaimedInbounds(ctx sdk.Context) []types.UnclaimedInbound {
+	var unclaimedInbounds []types.UnclaimedInbound
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.UnclaimedInboundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var unclaimed types.UnclaimedInbound
+		k.cdc.MustUnmarshal(iter.Value(), &unclaimed)
+		unclaimedInbounds = append(unclaimedInbounds, unclaimed)
+	}
+	return unclaimedInbounds
+}
+
+// GetUnclaimedInboundsPaginated returns the unclaimed inbound records in id order, only those of a cosmos receiver
+// when it is not empty
+func (k Keeper) GetUnclaimedInboundsPaginated(ctx sdk.Context, cosmosReceiver string,
+	pagination *query.PageRequest) ([]types.UnclaimedInbound, *query.PageResponse, error) {
+	var unclaimedInbounds []types.UnclaimedInbound
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnclaimedInboundPrefix)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var unclaimed types.UnclaimedInbound
+		if err := k.cdc.Unmarshal(value, &unclaimed); err != nil {
+			return false, err
+		}
+		if cosmosReceiver != "" && unclaimed.CosmosReceiver != cosmosReceiver {
+			return false, nil
+		}
+		if accumulate {
+			unclaimedInbounds = append(unclaimedInbounds, unclaimed)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return unclaimedInbounds, pageRes, nil
+}
+
+// EscrowUnclaimedInbound keeps the minted coins of a successful claim in the module account under a new unclaimed
+// inbound record, when they cannot be sent to the receiver of the claim
+func (k Keeper) EscrowUnclaimedInbound(ctx sdk.Context, claim types.OracleClaimContent, symbol string, reason string) types.UnclaimedInbound {
+	unclaimed := types.NewUnclaimedInbound(k.GetNextUnclaimedInboundID(ctx), claim, symbol, reason, ctx.BlockHeight())
+	k.escrowUnclaimedInbound(ctx, unclaimed)
+	return unclaimed
+}
+
+// HoldUnclaimedInbound keeps the minted coins of a successful claim over the transfer limits of their denom in the
+// module account under a new held unclaimed inbound record. The coins are sent to the receiver at the release height,
+// or wait for the rescuer when it is zero.
+func (k Keeper) HoldUnclaimedInbound(ctx sdk.Context, claim types.OracleClaimContent, symbol string, reason string, releaseHeight int64) types.UnclaimedInbound {
+	unclaimed := types.NewUnclaimedInbound(k.GetNextUnclaimedInboundID(ctx), claim, symbol, reason, ctx.BlockHeight())
+	unclaimed.Held = true
+	unclaimed.ReleaseHeight = releaseHeight
+	k.escrowUnclaimedInbound(ctx, unclaimed)
+	return unclaimed
+}
+
+func (k Keeper) escrowUnclaimedInbound(ctx sdk.Context, unclaimed types.UnclaimedInbound) {
+	k.SetUnclaimedInbound(ctx, unclaimed)
+	k.SetNextUnclaimedInboundID(ctx, unclaimed.Id+1)
+
+	k.Logger(ctx).Info("escrowed unclaimed inbound coins.",
+		"id", unclaimed.Id,
+		"CosmosReceiver", unclaimed.CosmosReceiver,
+		"Amount", unclaimed.Amount.String(),
+		"Symbol", unclaimed.Symbol,
+		"reason", unclaimed.Reason,
+		"ReleaseHeight", unclaimed.ReleaseHeight)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnclaimedInbound,
+		sdk.NewAttribute(types.AttributeKeyUnclaimedID, strconv.FormatUint(unclaimed.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCosmosReceiver, unclaimed.CosmosReceiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, unclaimed.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeySymbol, unclaimed.Symbol),
+		sdk.NewAttribute(types.AttributeKeyReason, unclaimed.Reason),
+		sdk.NewAttribute(types.AttributeKeyReleaseHeight, strconv.FormatInt(unclaimed.ReleaseHeight, 10)),
+	))
+}
+
+// ReleaseHeldInbounds sends the held unclaimed inbound coins whose release height has been reached to their receivers,
+// checking them again against the transfer limits of their denom and counting them in its usage. Coins still over the
+// window limit are held until the next window starts, and coins over the per transfer limit wait for the rescuer.
+// Coins which can not be sent stay escrowed, no longer held.
+func (k Keeper) ReleaseHeldInbounds(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.HeldInboundPrefix, types.GetHeldInboundsKey(ctx.BlockHeight()+1))
+	var ids []uint64
+	for ; iter.Valid(); iter.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iter.Value()))
+	}
+	iter.Close()
+	for _, id := range ids {
+		unclaimed, ok := k.GetUnclaimedInbound(ctx, id)
+		if !ok {
+			continue
+		}
+		// The usage is only counted if the coins are sent
+		cacheCtx, writeCache := ctx.CacheContext()
+		if releaseHeight, err := k.CheckInboundTransferLimit(cacheCtx, unclaimed.Symbol, unclaimed.Amount); err != nil {
+			unclaimed.ReleaseHeight = releaseHeight
+			unclaimed.Reason = err.Error()
+			k.SetUnclaimedInbound(ctx, unclaimed)
+			continue
+		}
+		err := k.releaseHeldInbound(cacheCtx, unclaimed)
+		if err != nil {
+			k.Logger(ctx).Error("failed to release held inbound coins.", "id", id, errorMessageKey, err.Error())
+			unclaimed.Held = false
+			unclaimed.ReleaseHeight = 0
+			unclaimed.Reason = err.Error()
+			k.SetUnclaimedInbound(ctx, unclaimed)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeReleaseUnclaimedInbound,
+			sdk.NewAttribute(types.AttributeKeyUnclaimedID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCosmosReceiver, unclaimed.CosmosReceiver),
+			sdk.NewAttribute(types.AttributeKeyAmount, unclaimed.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, unclaimed.Symbol),
+		))
+	}
+}
+
+// releaseHeldInbound sends held coins to their receiver and removes their record
+func (k Keeper) releaseHeldInbound(ctx sdk.Context, unclaimed types.UnclaimedInbound) error {
+	if k.IsBlacklisted(ctx, unclaimed.CosmosReceiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted", unclaimed.CosmosReceiver)
+	}
+	receiver, err := sdk.AccAddressFromBech32(unclaimed.CosmosReceiver)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, unclaimed.Coins()); err != nil {
+		return err
+	}
+	k.DeleteUnclaimedInbound(ctx, unclaimed)
+	return nil
+}
+
+// ProcessRedirectUnclaimedInbound sends the escrowed coins of an unclaimed inbound record to a recipient and removes
+// the record. Only the intended receiver of the coins or the rescuer can redirect them, and only the rescuer while
+// they are held or the receiver is blacklisted.
+func (k Keeper) ProcessRedirectUnclaimedInbound(ctx sdk.Context, msg *types.MsgRedirectUnclaimedInbound) (types.UnclaimedInbound, error) {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		return types.UnclaimedInbound{}, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return types.UnclaimedInbound{}, err
+	}
+	unclaimed, ok := k.GetUnclaimedInbound(ctx, msg.Id)
+	if !ok {
+		return types.UnclaimedInbound{}, sdkerrors.Wrapf(types.ErrUnclaimedNotFound, "id %d", msg.Id)
+	}
+	if (unclaimed.Held || unclaimed.CosmosReceiver != msg.CosmosSender || k.IsBlacklisted(ctx, unclaimed.CosmosReceiver)) &&
+		!k.HasAdminRole(ctx, types.AdminRole_ADMIN_ROLE_RESCUER, cosmosSender) {
+		return types.UnclaimedInbound{}, oracletypes.ErrNotAdminAccount
+	}
+	if k.IsBlacklisted(ctx, msg.Recipient) {
+		return types.UnclaimedInbound{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted", msg.Recipient)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, unclaimed.Coins()); err != nil {
+		return types.UnclaimedInbound{}, err
+	}
+	k.DeleteUnclaimedInbound(ctx, unclaimed)
+	return unclaimed, nil
+}
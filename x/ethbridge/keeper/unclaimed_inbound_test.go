@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnclaimedInbounds(t *testing.T) {
+	ctx, keeper, bankKeeper, accountKeeper, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3}, "")
+	addresses, _ := test.CreateTestAddrs(2)
+	receiver, admin := addresses[0], addresses[1]
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	moduleAddress := accountKeeper.GetModuleAddress(types.ModuleName)
+	claim := func(nonce int64, cosmosReceiver sdk.AccAddress) {
+		claim := types.NewEthBridgeClaim(ethereumChainID, ethBridgeAddress, nonce, "stake", tokenContractAddress,
+			ethereumSender, cosmosReceiver, validatorAddresses[0], amount, types.ClaimType_CLAIM_TYPE_BURN)
+		status, err := keeper.ProcessClaim(ctx, claim)
+		require.NoError(t, err)
+		require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+		require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, status.FinalClaim))
+	}
+
+	// Coins sent to a blocked module account are escrowed instead of halting the chain
+	claim(1, feeCollector)
+	unclaimed, ok := keeper.GetUnclaimedInbound(ctx, 0)
+	require.True(t, ok)
+	require.Equal(t, feeCollector.String(), unclaimed.CosmosReceiver)
+	require.Equal(t, amount, bankKeeper.GetBalance(ctx, moduleAddress, "stake").Amount)
+
+	// Coins sent to a blacklisted receiver are escrowed
+	oracleKeeper.SetAdminAccount(ctx, admin)
+	require.NoError(t, keeper.SetBlacklist(ctx, &types.MsgSetBlacklist{From: admin.String(), Addresses: []string{receiver.String()}}))
+	claim(2, receiver)
+	unclaimed, ok = keeper.GetUnclaimedInbound(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, types.UnclaimedInbound{
+		Id:              1,
+		CosmosReceiver:  receiver.String(),
+		EthereumChainId: ethereumChainID,
+		Symbol:          "stake",
+		Amount:          amount,
+		Reason:          "receiver is blacklisted",
+		CreatedHeight:   ctx.BlockHeight(),
+	}, unclaimed)
+	require.True(t, bankKeeper.GetBalance(ctx, receiver, "stake").IsZero())
+
+	unclaimedInbounds, _, err := keeper.GetUnclaimedInboundsPaginated(ctx, receiver.String(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.UnclaimedInbound{unclaimed}, unclaimedInbounds)
+
+	// Only the intended receiver or the admin can redirect the coins, only the admin when the receiver is
+	// blacklisted, and not to a blacklisted recipient
+	redirect := types.NewMsgRedirectUnclaimedInbound(admin, 0, receiver)
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.Error(t, err)
+	redirect = types.NewMsgRedirectUnclaimedInbound(receiver, 0, admin)
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+	redirect = types.NewMsgRedirectUnclaimedInbound(receiver, 1, admin)
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.ErrorIs(t, err, oracletypes.ErrNotAdminAccount)
+	redirect = types.NewMsgRedirectUnclaimedInbound(admin, 1, admin)
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.NoError(t, err)
+	require.Equal(t, amount, bankKeeper.GetBalance(ctx, admin, "stake").Amount)
+	redirect = types.NewMsgRedirectUnclaimedInbound(admin, 0, admin)
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.NoError(t, err)
+	require.Equal(t, doubleAmount, bankKeeper.GetBalance(ctx, admin, "stake").Amount)
+
+	require.Empty(t, keeper.GetUnclaimedInbounds(ctx))
+	_, err = keeper.ProcessRedirectUnclaimedInbound(ctx, &redirect)
+	require.ErrorIs(t, err, types.ErrUnclaimedNotFound)
+	require.Equal(t, uint64(2), keeper.GetNextUnclaimedInboundID(ctx))
+}
@@ -3,8 +3,8 @@ package ethbridge
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/gorilla/mux"
@@ -17,15 +17,18 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/client"
 	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
+	"github.com/Sifchain/sifnode/x/ethbridge/simulation"
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleSimulation = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the ethbridge module.
@@ -84,13 +87,9 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 //____________________________________________________________________________
 
-// AppModuleSimulation defines the module simulation functions used by the ethbridge module.
-type AppModuleSimulation struct{}
-
 // AppModule implements an application module for the ethbridge module.
 type AppModule struct {
 	AppModuleBasic
-	AppModuleSimulation
 	OracleKeeper  types.OracleKeeper
 	BankKeeper    types.BankKeeper
 	AccountKeeper types.AccountKeeper
@@ -101,6 +100,11 @@ type AppModule struct {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.BridgeKeeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.BridgeKeeper))
+	m := keeper.NewMigrator(am.BridgeKeeper)
+	err := cfg.RegisterMigration(types.ModuleName, 1, m.MigrateToVer2)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // NewAppModule creates a new AppModule object
@@ -109,13 +113,12 @@ func NewAppModule(
 	accountKeeper types.AccountKeeper, bridgeKeeper Keeper,
 	cdc *codec.Codec) AppModule {
 	return AppModule{
-		AppModuleBasic:      AppModuleBasic{},
-		AppModuleSimulation: AppModuleSimulation{},
-		OracleKeeper:        oracleKeeper,
-		BankKeeper:          bankKeeper,
-		AccountKeeper:       accountKeeper,
-		BridgeKeeper:        bridgeKeeper,
-		Codec:               cdc,
+		AppModuleBasic: AppModuleBasic{},
+		OracleKeeper:   oracleKeeper,
+		BankKeeper:     bankKeeper,
+		AccountKeeper:  accountKeeper,
+		BridgeKeeper:   bridgeKeeper,
+		Codec:          cdc,
 	}
 }
 
@@ -126,6 +129,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants registers the ethbridge module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.BridgeKeeper)
 }
 
 // Route returns the message routing key for the ethbridge module.
@@ -151,8 +155,9 @@ func (am AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 // InitGenesis performs genesis initialization for the ethbridge module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	bridgeAccount := authtypes.NewEmptyModuleAccount(ModuleName, authtypes.Burner, authtypes.Minter)
-	am.AccountKeeper.SetModuleAccount(ctx, bridgeAccount)
+	// creates the module account with the next account number, if it does not
+	// exist yet
+	am.AccountKeeper.GetModuleAccount(ctx, ModuleName)
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(data, &genesisState)
 	return InitGenesis(ctx, am.BridgeKeeper, genesisState)
@@ -167,10 +172,39 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) js
 // BeginBlock returns the begin blocker for the ethbridge module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the ethbridge module. It returns no validator
-// updates.
+// EndBlock returns the end blocker for the ethbridge module. It releases the held inbound coins and returns no
+// validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.BridgeKeeper.ReleaseHeldInbounds(ctx)
 	return nil
 }
 
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+//____________________________________________________________________________
+
+// GenerateGenesisState creates a randomized GenState of the ethbridge module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalContents doesn't return any content functions for governance proposals.
+func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams returns nil, the ethbridge module has no params.
+func (AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder registers a decoder for ethbridge module's types.
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(*am.Codec)
+}
+
+// WeightedOperations returns the all the ethbridge module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc,
+		am.AccountKeeper, am.BankKeeper, am.OracleKeeper, am.BridgeKeeper)
+}
@@ -0,0 +1,107 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding ethbridge type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.PeggyTokenKeyPrefix):
+			var tokensA, tokensB types.PeggyTokens
+			cdc.MustUnmarshal(kvA.Value, &tokensA)
+			cdc.MustUnmarshal(kvB.Value, &tokensB)
+			return fmt.Sprintf("%v\n%v", tokensA, tokensB)
+		case bytes.Equal(kvA.Key[:1], types.CethReceiverAccountPrefix):
+			var accountA, accountB gogotypes.StringValue
+			cdc.MustUnmarshal(kvA.Value, &accountA)
+			cdc.MustUnmarshal(kvB.Value, &accountB)
+			return fmt.Sprintf("%v\n%v", accountA.Value, accountB.Value)
+		case bytes.Equal(kvA.Key[:1], types.BlacklistPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Key[1:], kvB.Key[1:])
+		case bytes.Equal(kvA.Key[:1], types.NetworkPrefix):
+			var networkA, networkB types.Network
+			cdc.MustUnmarshal(kvA.Value, &networkA)
+			cdc.MustUnmarshal(kvB.Value, &networkB)
+			return fmt.Sprintf("%v\n%v", networkA, networkB)
+		case bytes.Equal(kvA.Key[:1], types.NetworkPeggyTokenPrefix):
+			var chainIDA, chainIDB gogotypes.Int64Value
+			cdc.MustUnmarshal(kvA.Value, &chainIDA)
+			cdc.MustUnmarshal(kvB.Value, &chainIDB)
+			return fmt.Sprintf("%s: %d\n%s: %d", kvA.Key[1:], chainIDA.Value, kvB.Key[1:], chainIDB.Value)
+		case bytes.Equal(kvA.Key[:1], types.TransferUsagePrefix):
+			var usageA, usageB types.TransferUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+		case bytes.Equal(kvA.Key[:1], types.PausePrefix):
+			var pauseA, pauseB types.Pause
+			cdc.MustUnmarshal(kvA.Value, &pauseA)
+			cdc.MustUnmarshal(kvB.Value, &pauseB)
+			return fmt.Sprintf("%v\n%v", pauseA, pauseB)
+		case bytes.Equal(kvA.Key[:1], types.OutboundTransferPrefix):
+			var transferA, transferB types.OutboundTransfer
+			cdc.MustUnmarshal(kvA.Value, &transferA)
+			cdc.MustUnmarshal(kvB.Value, &transferB)
+			return fmt.Sprintf("%v\n%v", transferA, transferB)
+		case bytes.Equal(kvA.Key[:1], types.UnclaimedInboundPrefix):
+			var unclaimedA, unclaimedB types.UnclaimedInbound
+			cdc.MustUnmarshal(kvA.Value, &unclaimedA)
+			cdc.MustUnmarshal(kvB.Value, &unclaimedB)
+			return fmt.Sprintf("%v\n%v", unclaimedA, unclaimedB)
+		case bytes.Equal(kvA.Key[:1], types.NextUnclaimedInboundIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.GasPriceReportPrefix):
+			var reportA, reportB types.GasPriceReport
+			cdc.MustUnmarshal(kvA.Value, &reportA)
+			cdc.MustUnmarshal(kvB.Value, &reportB)
+			return fmt.Sprintf("%v\n%v", reportA, reportB)
+		case bytes.Equal(kvA.Key[:1], types.NftClassPrefix):
+			var classA, classB types.NftClass
+			cdc.MustUnmarshal(kvA.Value, &classA)
+			cdc.MustUnmarshal(kvB.Value, &classB)
+			return fmt.Sprintf("%v\n%v", classA, classB)
+		case bytes.Equal(kvA.Key[:1], types.NftPrefix):
+			var nftA, nftB types.Nft
+			cdc.MustUnmarshal(kvA.Value, &nftA)
+			cdc.MustUnmarshal(kvB.Value, &nftB)
+			return fmt.Sprintf("%v\n%v", nftA, nftB)
+		case bytes.Equal(kvA.Key[:1], types.BridgeSupplyPrefix):
+			var supplyA, supplyB types.BridgeSupply
+			cdc.MustUnmarshal(kvA.Value, &supplyA)
+			cdc.MustUnmarshal(kvB.Value, &supplyB)
+			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], types.AdminRolePrefix):
+			var grantA, grantB types.AdminRoleGrant
+			cdc.MustUnmarshal(kvA.Value, &grantA)
+			cdc.MustUnmarshal(kvB.Value, &grantB)
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], types.ClaimRecordPrefix):
+			var recordA, recordB types.ClaimRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.ClaimRecordIDPrefix),
+			bytes.Equal(kvA.Key[:1], types.ClaimsByReceiverPrefix),
+			bytes.Equal(kvA.Key[:1], types.ClaimsBySenderPrefix),
+			bytes.Equal(kvA.Key[:1], types.ClaimsByStatusPrefix),
+			bytes.Equal(kvA.Key[:1], types.NextClaimRecordIDKey),
+			bytes.Equal(kvA.Key[:1], types.HeldInboundPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.ConfiguredAdminRolePrefix):
+			return fmt.Sprintf("%s\n%s", types.AdminRole(kvA.Key[1]), types.AdminRole(kvB.Key[1]))
+		default:
+			panic(fmt.Sprintf("invalid ethbridge key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+// Simulation parameter constants
+const (
+	CethReceiveAccount = "ceth_receive_account"
+)
+
+// GenCethReceiveAccount picks the account collecting the ceth paid for the
+// outgoing transfers, or none at all half of the time.
+func GenCethReceiveAccount(r *rand.Rand, accs []simtypes.Account) string {
+	if r.Intn(2) == 0 {
+		return ""
+	}
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
+
+// RandomizedGenState generates a random GenesisState for ethbridge. Every
+// pegged denom of the bank genesis supply is registered as a peggy token, so
+// it must run after the modules handing out the pegged tokens. The simulated
+// ethereum network is registered for the claims, locks and burns.
+func RandomizedGenState(simState *module.SimulationState) {
+	var cethReceiveAccount string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CethReceiveAccount, &cethReceiveAccount, simState.Rand,
+		func(r *rand.Rand) { cethReceiveAccount = GenCethReceiveAccount(r, simState.Accounts) },
+	)
+
+	var peggyTokens []string
+	bankGenesis := banktypes.GetGenesisStateFromAppState(simState.Cdc, simState.GenState)
+	for _, coin := range bankGenesis.Supply {
+		if strings.HasPrefix(coin.Denom, types.PeggedCoinPrefix) {
+			peggyTokens = append(peggyTokens, coin.Denom)
+		}
+	}
+
+	ethbridgeGenesis := types.GenesisState{
+		CethReceiveAccount: cethReceiveAccount,
+		PeggyTokens:        peggyTokens,
+		Networks:           []types.Network{types.NewEthereumNetwork(ethereumChainID, types.NewEthereumAddress(bridgeContract))},
+	}
+	bz, err := json.MarshalIndent(&ethbridgeGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated ethbridge genesis:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&ethbridgeGenesis)
+}
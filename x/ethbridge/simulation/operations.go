@@ -0,0 +1,240 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgLock                 = "op_weight_msg_lock"
+	OpWeightMsgBurn                 = "op_weight_msg_burn"
+	OpWeightMsgCreateEthBridgeClaim = "op_weight_msg_create_eth_bridge_claim"
+
+	DefaultWeightMsgLock                 = 20
+	DefaultWeightMsgBurn                 = 20
+	DefaultWeightMsgCreateEthBridgeClaim = 100
+)
+
+const (
+	ethereumChainID = 1
+	// bridgeContract is the bridge contract of the simulated ethereum network
+	bridgeContract = "0x30753E4A8aad7F8597332E813735Def5dD395028"
+	// gasCost is the minimum ceth amount paid by locks and burns
+	gasCost = 60000000000 * 393000
+	// claimNonceWindow is how many blocks the validators keep claiming the
+	// same prophecy, long enough for most of them to reach consensus.
+	claimNonceWindow = 10
+	// txGas is the gas limit of the simulated transactions. Every claim
+	// rewrites the prophecy with the claims of all the validators so far, which
+	// needs more than the default gas of the simulator.
+	txGas = 10 * helpers.DefaultGenTxGas
+)
+
+// claimSymbols are the ethereum tokens locked on the ethereum side of the
+// simulated bridge.
+var claimSymbols = []string{"eth", "usdc", "usdt", "dai", "link", "wbtc"}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, ok types.OracleKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgLock                 int
+		weightMsgBurn                 int
+		weightMsgCreateEthBridgeClaim int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgLock, &weightMsgLock, nil,
+		func(_ *rand.Rand) { weightMsgLock = DefaultWeightMsgLock },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) { weightMsgBurn = DefaultWeightMsgBurn },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateEthBridgeClaim, &weightMsgCreateEthBridgeClaim, nil,
+		func(_ *rand.Rand) { weightMsgCreateEthBridgeClaim = DefaultWeightMsgCreateEthBridgeClaim },
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgLock, SimulateMsgLock(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgBurn, SimulateMsgBurn(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgCreateEthBridgeClaim, SimulateMsgCreateEthBridgeClaim(ak, ok, k)),
+	}
+}
+
+// SimulateMsgLock generates a MsgLock of a random native token held by a
+// random account.
+func SimulateMsgLock(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgLock{}.Type()
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		var candidates sdk.Coins
+		for _, coin := range spendable {
+			if !k.ExistsPeggyToken(ctx, coin.Denom) {
+				candidates = append(candidates, coin)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no native token to lock"), nil, nil
+		}
+		coin := candidates[r.Intn(len(candidates))]
+		amount := simtypes.RandomAmount(r, coin.Amount)
+		cethAmount, ok := randomCethAmount(r, spendable.AmountOf(types.CethSymbol))
+		if amount.IsZero() || !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough funds to lock"), nil, nil
+		}
+		msg := types.NewMsgLock(ethereumChainID, simAccount.Address, randomEthereumAddress(r), amount, coin.Denom, cethAmount)
+		return deliver(app, ctx, chainID, ak, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.Lock(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn of a random pegged token held by a
+// random account.
+func SimulateMsgBurn(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgBurn{}.Type()
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		var candidates sdk.Coins
+		for _, coin := range spendable {
+			if k.ExistsPeggyToken(ctx, coin.Denom) {
+				candidates = append(candidates, coin)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pegged token to burn"), nil, nil
+		}
+		coin := candidates[r.Intn(len(candidates))]
+		cethAmount, ok := randomCethAmount(r, spendable.AmountOf(types.CethSymbol))
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough ceth to pay the gas"), nil, nil
+		}
+		balance := coin.Amount
+		if coin.Denom == types.CethSymbol {
+			balance = balance.Sub(cethAmount)
+		}
+		amount := simtypes.RandomAmount(r, balance)
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough funds to burn"), nil, nil
+		}
+		msg := types.NewMsgBurn(ethereumChainID, simAccount.Address, randomEthereumAddress(r), amount, coin.Denom, cethAmount)
+		return deliver(app, ctx, chainID, ak, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgCreateEthBridgeClaim generates a MsgCreateEthBridgeClaim from a
+// random whitelisted validator. The claimed ethereum event only depends on the
+// nonce, and the nonces are taken from the last few blocks, so the validators
+// agree on the claims and prophecies regularly reach consensus.
+func SimulateMsgCreateEthBridgeClaim(ak types.AccountKeeper, ok types.OracleKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgCreateEthBridgeClaim{}.Type()
+		var validators []simtypes.Account
+		for _, valAddress := range ok.GetOracleWhiteList(ctx) {
+			if acc, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddress)); found {
+				validators = append(validators, acc)
+			}
+		}
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no whitelisted validator"), nil, nil
+		}
+		simAccount := validators[r.Intn(len(validators))]
+		nonce := ctx.BlockHeight() - int64(r.Intn(claimNonceWindow))
+		if nonce < 0 {
+			nonce = 0
+		}
+		claim := ethereumEvent(nonce, accs)
+		claim.ValidatorAddress = sdk.ValAddress(simAccount.Address).String()
+		msg := types.NewMsgCreateEthBridgeClaim(claim)
+		return deliver(app, ctx, chainID, ak, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.CreateEthBridgeClaim(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// ethereumEvent deterministically builds the claim of the ethereum event with
+// the given nonce, without validator.
+func ethereumEvent(nonce int64, accs []simtypes.Account) *types.EthBridgeClaim {
+	r := rand.New(rand.NewSource(nonce))
+	receiver, _ := simtypes.RandomAcc(r, accs)
+	amount := simtypes.RandomAmount(r, sdk.NewIntWithDecimal(1, 21)).AddRaw(1)
+	claimType := types.ClaimType_CLAIM_TYPE_LOCK
+	symbol := claimSymbols[r.Intn(len(claimSymbols))]
+	tokenContract := randomEthereumAddress(r)
+	if symbol == "eth" {
+		tokenContract = types.NewEthereumAddress("0x0000000000000000000000000000000000000000")
+	}
+	if r.Intn(4) == 0 {
+		claimType = types.ClaimType_CLAIM_TYPE_BURN
+		symbol = "rowan"
+	}
+	return types.NewEthBridgeClaim(ethereumChainID, types.NewEthereumAddress(bridgeContract), nonce, symbol, tokenContract,
+		randomEthereumAddress(r), receiver.Address, nil, amount, claimType)
+}
+
+func randomEthereumAddress(r *rand.Rand) types.EthereumAddress {
+	bz := make([]byte, gethCommon.AddressLength)
+	r.Read(bz)
+	return types.EthereumAddress(gethCommon.BytesToAddress(bz))
+}
+
+// randomCethAmount returns a ceth amount covering the gas cost of a transfer,
+// if the balance allows it.
+func randomCethAmount(r *rand.Rand, balance sdk.Int) (sdk.Int, bool) {
+	min := sdk.NewInt(gasCost)
+	if balance.LT(min) {
+		return sdk.Int{}, false
+	}
+	return min.Add(simtypes.RandomAmount(r, sdk.MinInt(balance.Sub(min), min.MulRaw(10)))), true
+}
+
+// deliver runs the msg against a cached copy of the state first and only
+// delivers transactions the msg server accepts. Rejected msgs are reported as
+// no-ops.
+func deliver(app *baseapp.BaseApp, ctx sdk.Context, chainID string, ak types.AccountKeeper, k keeper.Keeper, simAccount simtypes.Account, msg legacytx.LegacyMsg, check func(types.MsgServer, sdk.Context) error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := check(keeper.NewMsgServerImpl(k), cacheCtx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+	}
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	account := ak.GetAccount(ctx, simAccount.Address)
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		sdk.NewCoins(),
+		txGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+	if _, _, err = app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
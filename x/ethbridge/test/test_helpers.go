@@ -37,6 +37,8 @@ import (
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 	oraclekeeper "github.com/Sifchain/sifnode/x/oracle/keeper"
 	oracleTypes "github.com/Sifchain/sifnode/x/oracle/types"
+	tokenregistrykeeper "github.com/Sifchain/sifnode/x/tokenregistry/keeper"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 )
 
 const (
@@ -50,6 +52,12 @@ const (
 
 // CreateTestKeepers greates an Mock App, OracleKeeper, bankKeeper and ValidatorAddresses to be used for test input
 func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts []int64, extraMaccPerm string) (sdk.Context, keeper.Keeper, bankkeeper.Keeper, authkeeper.AccountKeeper, oraclekeeper.Keeper, simappparams.EncodingConfig, []sdk.ValAddress) {
+	ctx, ethbridgeKeeper, bankKeeper, accountKeeper, oracleKeeper, encCfg, valAddrs, _ := CreateTestKeepersWithTokenRegistry(t, consensusNeeded, validatorAmounts, extraMaccPerm)
+	return ctx, ethbridgeKeeper, bankKeeper, accountKeeper, oracleKeeper, encCfg, valAddrs
+}
+
+// CreateTestKeepersWithTokenRegistry is CreateTestKeepers that also returns the token registry keeper
+func CreateTestKeepersWithTokenRegistry(t *testing.T, consensusNeeded float64, validatorAmounts []int64, extraMaccPerm string) (sdk.Context, keeper.Keeper, bankkeeper.Keeper, authkeeper.AccountKeeper, oraclekeeper.Keeper, simappparams.EncodingConfig, []sdk.ValAddress, tokenregistrytypes.Keeper) {
 	PKs := CreateTestPubKeys(500)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	// TODO: staking.TStoreKey removed in favor of?
@@ -60,6 +68,7 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	keyBank := sdk.NewKVStoreKey(banktypes.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracleTypes.StoreKey)
 	keyEthBridge := sdk.NewKVStoreKey(types.StoreKey)
+	keyTokenRegistry := sdk.NewKVStoreKey(tokenregistrytypes.StoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, nil)
@@ -70,6 +79,7 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyEthBridge, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTokenRegistry, sdk.StoreTypeIAVL, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{ChainID: "foochainid"}, false, nil)
@@ -120,7 +130,8 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	// bankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
 	stakingKeeper := stakingkeeper.NewKeeper(encCfg.Marshaler, keyStaking, accountKeeper, bankKeeper, paramsKeeper.Subspace(stakingtypes.ModuleName))
 	stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
-	oracleKeeper := oraclekeeper.NewKeeper(encCfg.Marshaler, keyOracle, stakingKeeper, consensusNeeded)
+	oracleKeeper := oraclekeeper.NewKeeper(encCfg.Marshaler, keyOracle, stakingKeeper, paramsKeeper.Subspace(oracleTypes.ModuleName))
+	oracleKeeper.SetParams(ctx, oracleTypes.NewParams(sdk.MustNewDecFromStr(strconv.FormatFloat(consensusNeeded, 'f', -1, 64))))
 	// set module accounts
 	accountKeeper.SetModuleAccount(ctx, bridgeAccount)
 	accountKeeper.SetModuleAccount(ctx, feeCollectorAcc)
@@ -130,7 +141,8 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	require.NoError(t, err)
 	err = bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.NotBondedPoolName, totalSupply)
 	require.NoError(t, err)
-	ethbridgeKeeper := keeper.NewKeeper(encCfg.Marshaler, bankKeeper, oracleKeeper, accountKeeper, keyEthBridge)
+	tokenRegistryKeeper := tokenregistrykeeper.NewKeeper(encCfg.Marshaler, keyTokenRegistry)
+	ethbridgeKeeper := keeper.NewKeeper(encCfg.Marshaler, bankKeeper, oracleKeeper, accountKeeper, tokenRegistryKeeper, keyEthBridge)
 	cethReceiverAccount, _ := sdk.AccAddressFromBech32(types.TestAddress)
 	ethbridgeKeeper.SetCethReceiverAccount(ctx, cethReceiverAccount)
 	// Setup validators
@@ -152,7 +164,9 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 		}
 	}
 	oracleKeeper.SetOracleWhiteList(ctx, valAddrs)
-	return ctx, ethbridgeKeeper, bankKeeper, accountKeeper, oracleKeeper, encCfg, valAddrs
+	ethbridgeKeeper.SetNetwork(ctx, types.NewEthereumNetwork(types.TestEthereumChainID,
+		types.NewEthereumAddress(types.TestBridgeContractAddress)))
+	return ctx, ethbridgeKeeper, bankKeeper, accountKeeper, oracleKeeper, encCfg, valAddrs, tokenRegistryKeeper
 }
 
 // nolint: unparam
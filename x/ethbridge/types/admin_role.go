@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAdminRoleGrant returns the grant of an admin role to an account
+func NewAdminRoleGrant(role AdminRole, address sdk.AccAddress) AdminRoleGrant {
+	return AdminRoleGrant{
+		Role:    role,
+		Address: address.String(),
+	}
+}
+
+// ValidateAdminRole checks that a role is one of the admin roles
+func ValidateAdminRole(role AdminRole) error {
+	if _, ok := AdminRole_name[int32(role)]; !ok || role == AdminRole_ADMIN_ROLE_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalidAdminRole, "%d", role)
+	}
+	return nil
+}
+
+// ParseAdminRole parses an admin role from its name, such as "ADMIN_ROLE_FEE" or "fee"
+func ParseAdminRole(name string) (AdminRole, error) {
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	if !strings.HasPrefix(name, "ADMIN_ROLE_") {
+		name = "ADMIN_ROLE_" + name
+	}
+	role := AdminRole(AdminRole_value[name])
+	if err := ValidateAdminRole(role); err != nil {
+		return role, sdkerrors.Wrap(ErrInvalidAdminRole, name)
+	}
+	return role, nil
+}
+
+// Validate checks the role and the address of an admin role grant
+func (g AdminRoleGrant) Validate() error {
+	if err := ValidateAdminRole(g.Role); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, g.Address)
+	}
+	return nil
+}
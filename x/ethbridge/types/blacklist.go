@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// NormalizeBlacklistAddress returns the checksummed form of ethereum addresses and the canonical bech32 form of cosmos
+// account addresses, so blacklist lookups do not depend on the case an address was written in. Other addresses are
+// returned unchanged.
+func NormalizeBlacklistAddress(address string) string {
+	if gethCommon.IsHexAddress(address) {
+		return gethCommon.HexToAddress(address).Hex()
+	}
+	if accAddress, err := sdk.AccAddressFromBech32(address); err == nil {
+		return accAddress.String()
+	}
+	return address
+}
+
+// ValidateBlacklistAddress checks that an address is an ethereum address or a cosmos account address, the two forms
+// bridge transfers are checked against the blacklist with
+func ValidateBlacklistAddress(address string) error {
+	if gethCommon.IsHexAddress(address) {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBlacklist, "%q is neither an ethereum nor a cosmos address", address)
+	}
+	return nil
+}
+
+// NewBlacklistEntry is a constructor function for BlacklistEntry. The address must be an ethereum or a cosmos account
+// address.
+func NewBlacklistEntry(address string, reason string, addedBy sdk.AccAddress, addedHeight, expiryHeight int64) (BlacklistEntry, error) {
+	if err := ValidateBlacklistAddress(address); err != nil {
+		return BlacklistEntry{}, err
+	}
+	return BlacklistEntry{
+		Address:      NormalizeBlacklistAddress(address),
+		Reason:       reason,
+		AddedBy:      addedBy.String(),
+		AddedHeight:  addedHeight,
+		ExpiryHeight: expiryHeight,
+	}, nil
+}
+
+// IsActive returns whether the entry still applies at a height
+func (e BlacklistEntry) IsActive(height int64) bool {
+	return e.ExpiryHeight == 0 || height < e.ExpiryHeight
+}
+
+// Validate checks the fields of a blacklist entry
+func (e BlacklistEntry) Validate() error {
+	if err := ValidateBlacklistAddress(e.Address); err != nil {
+		return err
+	}
+	if e.ExpiryHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidBlacklist, "negative expiry height %d", e.ExpiryHeight)
+	}
+	return nil
+}
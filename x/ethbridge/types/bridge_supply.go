@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewBridgeSupply returns the empty bridge supply of a denom
+func NewBridgeSupply(denom string) BridgeSupply {
+	return BridgeSupply{
+		Denom:  denom,
+		Minted: sdk.ZeroInt(),
+		Burned: sdk.ZeroInt(),
+		Locked: sdk.ZeroInt(),
+	}
+}
+
+// Validate checks the denom and amounts of a bridge supply
+func (s BridgeSupply) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	if s.Minted.IsNil() || s.Minted.IsNegative() || s.Burned.IsNil() || s.Burned.IsNegative() ||
+		s.Locked.IsNil() || s.Locked.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "bridge supply of %s", s.Denom)
+	}
+	return nil
+}
+
+// Outstanding returns the amount minted by the bridge that has not been burned back
+func (s BridgeSupply) Outstanding() sdk.Int {
+	return s.Minted.Sub(s.Burned)
+}
@@ -39,18 +39,22 @@ func NewEthBridgeClaim(
 
 // OracleClaimContent is the details of how the content of the claim for each validator will be stored in the oracle
 type OracleClaimContent struct {
+	EthereumChainID      int64           `json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
 	CosmosReceiver       sdk.AccAddress  `json:"cosmos_receiver" yaml:"cosmos_receiver"`
 	Amount               sdk.Int         `json:"amount" yaml:"amount"`
 	Symbol               string          `json:"symbol" yaml:"symbol"`
 	TokenContractAddress EthereumAddress `json:"token_contract_address" yaml:"token_contract_address"`
 	ClaimType            ClaimType       `json:"claim_type" yaml:"claim_type"`
+	Decimals             int64           `json:"decimals,omitempty" yaml:"decimals"`
 }
 
 // NewOracleClaimContent is a constructor function for OracleClaim
 func NewOracleClaimContent(
-	cosmosReceiver sdk.AccAddress, amount sdk.Int, symbol string, tokenContractAddress EthereumAddress, claimType ClaimType,
+	ethereumChainID int64, cosmosReceiver sdk.AccAddress, amount sdk.Int, symbol string,
+	tokenContractAddress EthereumAddress, claimType ClaimType,
 ) OracleClaimContent {
 	return OracleClaimContent{
+		EthereumChainID:      ethereumChainID,
 		CosmosReceiver:       cosmosReceiver,
 		Amount:               amount,
 		Symbol:               symbol,
@@ -59,22 +63,28 @@ func NewOracleClaimContent(
 	}
 }
 
+// GetClaimProphecyID returns the id of the prophecy of an ethereum bridge claim
+func GetClaimProphecyID(ethClaim *EthBridgeClaim) string {
+	return strconv.FormatInt(ethClaim.EthereumChainId, 10) + strconv.FormatInt(ethClaim.Nonce, 10) +
+		ethClaim.EthereumSender
+}
+
 // CreateOracleClaimFromEthClaim converts a specific ethereum bridge claim to a general oracle claim to be used by
 // the oracle module. The oracle module expects every claim for a particular prophecy to have the same id, so this id
 // must be created in a deterministic way that all validators can follow.
 // For this, we use the Nonce an Ethereum Sender provided,
 // as all validators will see this same data from the smart contract.
 func CreateOracleClaimFromEthClaim(ethClaim *EthBridgeClaim) (oracletypes.Claim, error) {
-	oracleID := strconv.FormatInt(ethClaim.EthereumChainId, 10) + strconv.FormatInt(ethClaim.Nonce, 10) +
-		ethClaim.EthereumSender
+	oracleID := GetClaimProphecyID(ethClaim)
 
 	cosmosReceiver, err := sdk.AccAddressFromBech32(ethClaim.CosmosReceiver)
 	if err != nil {
 		return oracletypes.Claim{}, err
 	}
 
-	claimContent := NewOracleClaimContent(cosmosReceiver, ethClaim.Amount,
+	claimContent := NewOracleClaimContent(ethClaim.EthereumChainId, cosmosReceiver, ethClaim.Amount,
 		ethClaim.Symbol, NewEthereumAddress(ethClaim.TokenContractAddress), ethClaim.ClaimType)
+	claimContent.Decimals = ethClaim.Decimals
 	claimBytes, err := json.Marshal(claimContent)
 	if err != nil {
 		return oracletypes.Claim{}, err
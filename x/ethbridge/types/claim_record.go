@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// NewClaimRecord returns the pending claim record of the prophecy of a claim
+func NewClaimRecord(id uint64, prophecyID string, claim *EthBridgeClaim, height int64) ClaimRecord {
+	return ClaimRecord{
+		Id:                   id,
+		ProphecyId:           prophecyID,
+		EthereumChainId:      claim.EthereumChainId,
+		Nonce:                claim.Nonce,
+		EthereumSender:       claim.EthereumSender,
+		CosmosReceiver:       claim.CosmosReceiver,
+		Symbol:               claim.Symbol,
+		TokenContractAddress: claim.TokenContractAddress,
+		Amount:               claim.Amount,
+		ClaimType:            claim.ClaimType,
+		Status:               oracletypes.StatusText_STATUS_TEXT_PENDING,
+		CreatedHeight:        height,
+	}
+}
+
+// UpdateStatus sets the status of the prophecy of a claim record. The fields of the claim that won consensus replace
+// the ones of the first claim when the prophecy succeeds.
+func (r *ClaimRecord) UpdateStatus(status oracletypes.Status, height int64) error {
+	r.Status = status.Text
+	if status.Text == oracletypes.StatusText_STATUS_TEXT_PENDING {
+		return nil
+	}
+	r.CompletedHeight = height
+	if status.Text != oracletypes.StatusText_STATUS_TEXT_SUCCESS {
+		return nil
+	}
+	content, err := CreateOracleClaimFromOracleString(status.FinalClaim)
+	if err != nil {
+		return err
+	}
+	r.CosmosReceiver = content.CosmosReceiver.String()
+	r.Symbol = content.Symbol
+	r.TokenContractAddress = content.TokenContractAddress.String()
+	r.Amount = content.Amount
+	r.ClaimType = content.ClaimType
+	return nil
+}
+
+// ParseClaimStatus returns the prophecy status of a name, either its enum name or its short form like "pending"
+func ParseClaimStatus(name string) (oracletypes.StatusText, error) {
+	return oracletypes.ParseStatusText(name)
+}
+
+// Validate checks the fields of a claim record
+func (r ClaimRecord) Validate() error {
+	if r.ProphecyId == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "claim record %d has no prophecy id", r.Id)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, r.CosmosReceiver)
+	}
+	if !gethCommon.IsHexAddress(r.EthereumSender) {
+		return sdkerrors.Wrap(ErrInvalidEthAddress, r.EthereumSender)
+	}
+	if r.Amount.IsNil() || r.Amount.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "claim record %d", r.Id)
+	}
+	if _, ok := oracletypes.StatusText_name[int32(r.Status)]; !ok ||
+		r.Status == oracletypes.StatusText_STATUS_TEXT_UNSPECIFIED {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "claim record %d has status %s", r.Id, r.Status)
+	}
+	return nil
+}
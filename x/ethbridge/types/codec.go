@@ -14,6 +14,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint
 	cdc.RegisterConcrete(&MsgUpdateCethReceiverAccount{}, "ethbridge/MsgUpdateCethReceiverAccount", nil)
 	cdc.RegisterConcrete(&MsgRescueCeth{}, "ethbridge/MsgRescueCeth", nil)
 	cdc.RegisterConcrete(&MsgSetBlacklist{}, "ethbridge/MsgSetBlacklist", nil)
+	cdc.RegisterConcrete(&MsgSetNetwork{}, "ethbridge/MsgSetNetwork", nil)
+	cdc.RegisterConcrete(&MsgSetPause{}, "ethbridge/MsgSetPause", nil)
+	cdc.RegisterConcrete(&MsgReportOutboundTransfer{}, "ethbridge/MsgReportOutboundTransfer", nil)
+	cdc.RegisterConcrete(&MsgRefundOutboundTransfer{}, "ethbridge/MsgRefundOutboundTransfer", nil)
+	cdc.RegisterConcrete(&MsgRedirectUnclaimedInbound{}, "ethbridge/MsgRedirectUnclaimedInbound", nil)
+	cdc.RegisterConcrete(&MsgAddToBlacklist{}, "ethbridge/MsgAddToBlacklist", nil)
+	cdc.RegisterConcrete(&MsgRemoveFromBlacklist{}, "ethbridge/MsgRemoveFromBlacklist", nil)
+	cdc.RegisterConcrete(&MsgReportGasPrice{}, "ethbridge/MsgReportGasPrice", nil)
+	cdc.RegisterConcrete(&MsgCreateEthBridgeNftClaim{}, "ethbridge/MsgCreateEthBridgeNftClaim", nil)
+	cdc.RegisterConcrete(&MsgBurnNft{}, "ethbridge/MsgBurnNft", nil)
 }
 
 var (
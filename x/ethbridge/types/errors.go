@@ -19,5 +19,29 @@ var (
 	ErrInvalidSymbol          = sdkerrors.Register(ModuleName, 8, "symbol must be 1 character or more")
 	ErrInvalidBurnSymbol      = sdkerrors.Register(ModuleName, 9,
 		fmt.Sprintf("symbol of token to burn must be in the form %v{ethereumSymbol}", PeggedCoinPrefix))
-	ErrCethAmount             = sdkerrors.Register(ModuleName, 10, "not enough ceth provided")
+	ErrCethAmount            = sdkerrors.Register(ModuleName, 10, "not enough ceth provided")
+	ErrInvalidNetwork        = sdkerrors.Register(ModuleName, 11, "invalid network")
+	ErrInvalidBridgeContract = sdkerrors.Register(ModuleName, 12, "claim is not from the bridge contract of its network")
+	ErrWrongNetwork          = sdkerrors.Register(ModuleName, 13, "token is pegged to another network")
+	ErrInvalidTransferLimit  = sdkerrors.Register(ModuleName, 14, "invalid transfer limit in token registry")
+	ErrTransferLimitExceeded = sdkerrors.Register(ModuleName, 15, "transfer exceeds the limit of the token")
+	ErrWindowLimitExceeded   = sdkerrors.Register(ModuleName, 16, "transfer exceeds the rolling window limit of the token")
+	ErrBridgePaused          = sdkerrors.Register(ModuleName, 17, "bridge is paused")
+	ErrOutboundNotFound      = sdkerrors.Register(ModuleName, 18, "outbound transfer not found")
+	ErrOutboundExists        = sdkerrors.Register(ModuleName, 19, "outbound transfer already recorded for the sender sequence")
+	ErrOutboundStatus        = sdkerrors.Register(ModuleName, 20, "invalid outbound transfer status")
+	ErrOutboundNotTimedOut   = sdkerrors.Register(ModuleName, 21, "outbound transfer has not reached the refund timeout")
+	ErrInvalidEthTxHash      = sdkerrors.Register(ModuleName, 22, "invalid ethereum transaction hash")
+	ErrUnclaimedNotFound     = sdkerrors.Register(ModuleName, 23, "unclaimed inbound record not found")
+	ErrInvalidBlacklist      = sdkerrors.Register(ModuleName, 24, "invalid blacklist entry")
+	ErrBlacklisted           = sdkerrors.Register(ModuleName, 25, "address is blacklisted")
+	ErrInvalidDecimals       = sdkerrors.Register(ModuleName, 26, "invalid token decimals")
+	ErrInvalidGasPrice       = sdkerrors.Register(ModuleName, 27, "invalid gas price")
+	ErrInsufficientCethFee   = sdkerrors.Register(ModuleName, 28, "cross-chain fee is below the minimum")
+	ErrInvalidNft            = sdkerrors.Register(ModuleName, 29, "invalid nft")
+	ErrNftNotFound           = sdkerrors.Register(ModuleName, 30, "nft not found")
+	ErrNftExists             = sdkerrors.Register(ModuleName, 31, "nft already minted")
+	ErrInvalidAdminRole      = sdkerrors.Register(ModuleName, 32, "invalid admin role")
+	ErrUnregisteredNetwork   = sdkerrors.Register(ModuleName, 33, "network is not registered")
+	ErrGasPriceQuorum        = sdkerrors.Register(ModuleName, 34, "not enough relayers reported the gas price of the network")
 )
@@ -7,6 +7,24 @@ var (
 	EventTypeBurn                     = "burn"
 	EventTypeLock                     = "lock"
 	EventTypeUpdateWhiteListValidator = "update_whitelist_validator"
+	EventTypeUpdateCethReceiver       = "update_ceth_receiver_account"
+	EventTypeRescueCeth               = "rescue_ceth"
+	EventTypeSetNetwork               = "set_network"
+	EventTypeSetPause                 = "set_pause"
+	EventTypeReportOutboundTransfer   = "report_outbound_transfer"
+	EventTypeRefundOutboundTransfer   = "refund_outbound_transfer"
+	EventTypeUnclaimedInbound         = "unclaimed_inbound"
+	EventTypeRedirectUnclaimedInbound = "redirect_unclaimed_inbound"
+	EventTypeReleaseUnclaimedInbound  = "release_unclaimed_inbound"
+	EventTypeAddToBlacklist           = "add_to_blacklist"
+	EventTypeRemoveFromBlacklist      = "remove_from_blacklist"
+	EventTypeProvisionalToken         = "provisional_token"
+	EventTypeReportGasPrice           = "report_gas_price"
+	EventTypeCreateNftClaim           = "create_nft_claim"
+	EventTypeMintNft                  = "mint_nft"
+	EventTypeBurnNft                  = "burn_nft"
+	EventTypeGrantAdminRole           = "grant_admin_role"
+	EventTypeRevokeAdminRole          = "revoke_admin_role"
 
 	AttributeKeyEthereumSender      = "ethereum_sender"
 	AttributeKeyEthereumSenderNonce = "ethereum_sender_nonce"
@@ -26,6 +44,25 @@ var (
 	AttributeKeyCosmosSender         = "cosmos_sender"
 	AttributeKeyCosmosSenderSequence = "cosmos_sender_sequence"
 	AttributeKeyEthereumReceiver     = "ethereum_receiver"
+	AttributeKeyBridgeContract       = "bridge_contract_address"
+	AttributeKeyDenomPrefix          = "denom_prefix"
+	AttributeKeyNativeToken          = "native_token"
+	AttributeKeyOutbound             = "outbound"
+	AttributeKeyInbound              = "inbound"
+	AttributeKeyEthereumTxHash       = "ethereum_tx_hash"
+	AttributeKeyUnclaimedID          = "unclaimed_id"
+	AttributeKeyReason               = "reason"
+	AttributeKeyRecipient            = "recipient"
+	AttributeKeyAddress              = "address"
+	AttributeKeyExpiryHeight         = "expiry_height"
+	AttributeKeyDenom                = "denom"
+	AttributeKeyDecimals             = "decimals"
+	AttributeKeyGasPrice             = "gas_price"
+	AttributeKeyClassID              = "class_id"
+	AttributeKeyTokenID              = "token_id"
+	AttributeKeyTokenURI             = "token_uri"
+	AttributeKeyRole                 = "role"
+	AttributeKeyReleaseHeight        = "release_height"
 
 	AttributeValueCategory = ModuleName
 )
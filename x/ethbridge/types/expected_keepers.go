@@ -2,6 +2,7 @@ package types
 
 import (
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -10,6 +11,7 @@ import (
 type AccountKeeper interface {
 	GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI
 	SetModuleAccount(sdk.Context, authtypes.ModuleAccountI)
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
 }
 
 // BankKeeper defines the expected supply keeper
@@ -19,14 +21,28 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
 
 // OracleKeeper defines the expected oracle keeper
 type OracleKeeper interface {
 	ProcessClaim(ctx sdk.Context, claim oracletypes.Claim) (oracletypes.Status, error)
 	GetProphecy(ctx sdk.Context, id string) (oracletypes.Prophecy, bool)
-	ProcessUpdateWhiteListValidator(ctx sdk.Context, cosmosSender sdk.AccAddress, validator sdk.ValAddress, operationtype string) error
+	AddOracleWhiteList(ctx sdk.Context, validator sdk.ValAddress)
+	RemoveOracleWhiteList(ctx sdk.Context, validator sdk.ValAddress)
 	IsAdminAccount(ctx sdk.Context, cosmosSender sdk.AccAddress) bool
 	GetAdminAccount(ctx sdk.Context) sdk.AccAddress
 	SetAdminAccount(ctx sdk.Context, cosmosSender sdk.AccAddress)
+	GetOracleWhiteList(ctx sdk.Context) []sdk.ValAddress
+	ValidateAddress(ctx sdk.Context, address sdk.ValAddress) bool
+	EnsureAddressIsInWhitelist(ctx sdk.Context, validatorAddress string) error
+	GetConsensusNeeded(ctx sdk.Context) sdk.Dec
+}
+
+// TokenRegistryKeeper defines the expected token registry keeper
+type TokenRegistryKeeper interface {
+	GetRegistry(ctx sdk.Context) tokenregistrytypes.Registry
+	GetEntry(registry tokenregistrytypes.Registry, denom string) (*tokenregistrytypes.RegistryEntry, error)
+	SetToken(ctx sdk.Context, entry *tokenregistrytypes.RegistryEntry)
 }
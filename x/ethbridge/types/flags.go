@@ -5,4 +5,14 @@ const (
 	FlagEthereumChainID string = "ethereum-chain-id"
 	// FlagTokenContractAddr flag for passing the token contract address field
 	FlagTokenContractAddr string = "token-contract-address"
+	// FlagExpiryHeight flag for passing the height blacklist entries expire at
+	FlagExpiryHeight string = "expiry-height"
+	// FlagOutboundGas flag for passing the gas of delivering an outbound transfer to a network
+	FlagOutboundGas string = "outbound-gas"
+	// FlagCosmosReceiver flag for passing the cosmos receiver field
+	FlagCosmosReceiver string = "cosmos-receiver"
+	// FlagEthereumSender flag for passing the ethereum sender field
+	FlagEthereumSender string = "ethereum-sender"
+	// FlagStatus flag for passing the status of a prophecy
+	FlagStatus string = "status"
 )
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGasPriceReport returns the gas price report of a relayer for a network
+func NewGasPriceReport(validatorAddress sdk.ValAddress, ethereumChainID int64, gasPrice sdk.Int, height int64) GasPriceReport {
+	return GasPriceReport{
+		ValidatorAddress: validatorAddress.String(),
+		EthereumChainId:  ethereumChainID,
+		GasPrice:         gasPrice,
+		Height:           height,
+	}
+}
+
+// IsRecent returns whether the report is recent enough to count towards the median gas price at a height
+func (r GasPriceReport) IsRecent(height int64) bool {
+	return height-r.Height < GasPriceReportTimeout
+}
+
+// Validate checks the fields of a gas price report
+func (r GasPriceReport) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, r.ValidatorAddress)
+	}
+	if r.EthereumChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEthereumChainID, "%d", r.EthereumChainId)
+	}
+	if r.GasPrice.IsNil() || !r.GasPrice.IsPositive() {
+		return ErrInvalidGasPrice
+	}
+	return nil
+}
+
+// MedianGasPrice returns the median gas price of the reports, the mean of the two middle prices for an even number of
+// reports and zero without reports
+func MedianGasPrice(reports []GasPriceReport) sdk.Int {
+	if len(reports) == 0 {
+		return sdk.ZeroInt()
+	}
+	prices := make([]sdk.Int, len(reports))
+	for i, report := range reports {
+		prices[i] = report.GasPrice
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+	middle := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[middle]
+	}
+	return prices[middle-1].Add(prices[middle]).QuoRaw(2)
+}
@@ -1,5 +1,15 @@
 package types
 
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+)
+
 const (
 	// ModuleName is the name of the ethereum bridge module
 	ModuleName = "ethbridge"
@@ -18,10 +28,163 @@ const (
 
 	// ceth symbol
 	CethSymbol = "ceth"
+
+	// OutboundRefundTimeout is the number of blocks after which an outbound transfer that was not delivered can be
+	// refunded
+	OutboundRefundTimeout = int64(14400)
+
+	// MaxTokenDecimals is the largest number of decimals of an ERC20 token, which stores them in a uint8
+	MaxTokenDecimals = int64(255)
+
+	// GasPriceReportTimeout is the number of blocks after which a gas price report is left out of the median gas price
+	GasPriceReportTimeout = int64(600)
+
+	// MaxNftURILength is the longest metadata URI of an NFT that can be claimed
+	MaxNftURILength = 512
 )
 
 var (
 	PeggyTokenKeyPrefix       = []byte{0x00}
 	CethReceiverAccountPrefix = []byte{0x01}
 	BlacklistPrefix           = []byte{0x02}
+	NetworkPrefix             = []byte{0x03}
+	NetworkPeggyTokenPrefix   = []byte{0x04}
+	TransferUsagePrefix       = []byte{0x05}
+	PausePrefix               = []byte{0x06}
+	OutboundTransferPrefix    = []byte{0x07}
+	UnclaimedInboundPrefix    = []byte{0x08}
+	NextUnclaimedInboundIDKey = []byte{0x09}
+	GasPriceReportPrefix      = []byte{0x0A}
+	NftClassPrefix            = []byte{0x0B}
+	NftPrefix                 = []byte{0x0C}
+	BridgeSupplyPrefix        = []byte{0x0D}
+	AdminRolePrefix           = []byte{0x0E}
+	ClaimRecordPrefix         = []byte{0x0F}
+	ClaimRecordIDPrefix       = []byte{0x10}
+	ClaimsByReceiverPrefix    = []byte{0x11}
+	ClaimsBySenderPrefix      = []byte{0x12}
+	ClaimsByStatusPrefix      = []byte{0x13}
+	NextClaimRecordIDKey      = []byte{0x14}
+	HeldInboundPrefix         = []byte{0x15}
+	ConfiguredAdminRolePrefix = []byte{0x16}
 )
+
+// GetNetworkKey returns the key of the network of a chain id
+func GetNetworkKey(ethereumChainID int64) []byte {
+	return append(NetworkPrefix, sdk.Uint64ToBigEndian(uint64(ethereumChainID))...)
+}
+
+// GetNetworkPeggyTokenKey returns the key recording the network a pegged denom was minted for
+func GetNetworkPeggyTokenKey(denom string) []byte {
+	return append(NetworkPeggyTokenPrefix, []byte(denom)...)
+}
+
+// GetTransferUsageKey returns the key of the bridge transfer usage of a denom
+func GetTransferUsageKey(denom string) []byte {
+	return append(TransferUsagePrefix, []byte(denom)...)
+}
+
+// GetBridgeSupplyKey returns the key of the bridge supply of a denom
+func GetBridgeSupplyKey(denom string) []byte {
+	return append(BridgeSupplyPrefix, []byte(denom)...)
+}
+
+// GetAdminRoleHoldersKey returns the key prefix of the holders of an admin role
+func GetAdminRoleHoldersKey(role AdminRole) []byte {
+	return append(AdminRolePrefix, byte(role))
+}
+
+// GetAdminRoleKey returns the key of the grant of an admin role to an account
+func GetAdminRoleKey(role AdminRole, address sdk.AccAddress) []byte {
+	return append(GetAdminRoleHoldersKey(role), address.Bytes()...)
+}
+
+// GetConfiguredAdminRoleKey returns the key recording that an admin role has been granted
+func GetConfiguredAdminRoleKey(role AdminRole) []byte {
+	return append(ConfiguredAdminRolePrefix, byte(role))
+}
+
+// GetPauseKey returns the key of the pause of a symbol, the empty symbol pauses all symbols
+func GetPauseKey(symbol string) []byte {
+	return append(PausePrefix, []byte(symbol)...)
+}
+
+// GetOutboundTransfersKey returns the key prefix of the outbound transfers of a cosmos sender
+func GetOutboundTransfersKey(cosmosSender sdk.AccAddress) []byte {
+	return append(OutboundTransferPrefix, address.MustLengthPrefix(cosmosSender)...)
+}
+
+// GetOutboundTransferKey returns the key of an outbound transfer
+func GetOutboundTransferKey(cosmosSender sdk.AccAddress, sequence uint64) []byte {
+	return append(GetOutboundTransfersKey(cosmosSender), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetOutboundTransferProphecyID returns the oracle prophecy id of the relayer reports of an outbound transfer
+func GetOutboundTransferProphecyID(cosmosSender string, sequence uint64) string {
+	return fmt.Sprintf("outbound/%s/%d", cosmosSender, sequence)
+}
+
+// GetUnclaimedInboundKey returns the key of an unclaimed inbound record
+func GetUnclaimedInboundKey(id uint64) []byte {
+	return append(UnclaimedInboundPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetHeldInboundsKey returns the key prefix of the held unclaimed inbound records released at a height
+func GetHeldInboundsKey(releaseHeight int64) []byte {
+	return append(HeldInboundPrefix, sdk.Uint64ToBigEndian(uint64(releaseHeight))...)
+}
+
+// GetHeldInboundKey returns the key indexing a held unclaimed inbound record by its release height
+func GetHeldInboundKey(releaseHeight int64, id uint64) []byte {
+	return append(GetHeldInboundsKey(releaseHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetGasPriceReportsKey returns the key prefix of the gas price reports of a network
+func GetGasPriceReportsKey(ethereumChainID int64) []byte {
+	return append(GasPriceReportPrefix, sdk.Uint64ToBigEndian(uint64(ethereumChainID))...)
+}
+
+// GetGasPriceReportKey returns the key of the gas price report of a relayer for a network
+func GetGasPriceReportKey(ethereumChainID int64, validator sdk.ValAddress) []byte {
+	return append(GetGasPriceReportsKey(ethereumChainID), address.MustLengthPrefix(validator)...)
+}
+
+// GetNftClassKey returns the key of an NFT class
+func GetNftClassKey(classID string) []byte {
+	return append(NftClassPrefix, []byte(classID)...)
+}
+
+// GetNftsKey returns the key prefix of the NFTs of a class
+func GetNftsKey(classID string) []byte {
+	return append(NftPrefix, address.MustLengthPrefix([]byte(classID))...)
+}
+
+// GetNftKey returns the key of an NFT, its token id is a valid uint256
+func GetNftKey(classID string, tokenID *big.Int) []byte {
+	return append(GetNftsKey(classID), tokenID.FillBytes(make([]byte, 32))...)
+}
+
+// GetClaimRecordKey returns the key of a claim record
+func GetClaimRecordKey(id uint64) []byte {
+	return append(ClaimRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetClaimRecordIDKey returns the key of the id of the claim record of a prophecy
+func GetClaimRecordIDKey(prophecyID string) []byte {
+	return append(ClaimRecordIDPrefix, []byte(prophecyID)...)
+}
+
+// GetClaimsByReceiverKey returns the key prefix of the index of the claim records of a cosmos receiver
+func GetClaimsByReceiverKey(cosmosReceiver sdk.AccAddress) []byte {
+	return append(ClaimsByReceiverPrefix, address.MustLengthPrefix(cosmosReceiver)...)
+}
+
+// GetClaimsBySenderKey returns the key prefix of the index of the claim records of an ethereum sender
+func GetClaimsBySenderKey(ethereumSender EthereumAddress) []byte {
+	return append(ClaimsBySenderPrefix, ethereumSender[:]...)
+}
+
+// GetClaimsByStatusKey returns the key prefix of the index of the claim records with a status
+func GetClaimsByStatusKey(status oracletypes.StatusText) []byte {
+	return append(ClaimsByStatusPrefix, byte(status))
+}
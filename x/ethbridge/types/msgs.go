@@ -209,6 +209,10 @@ func (msg MsgCreateEthBridgeClaim) ValidateBasic() error {
 		return ErrInvalidEthSymbol
 	}
 
+	if msg.EthBridgeClaim.Decimals < 0 || msg.EthBridgeClaim.Decimals > MaxTokenDecimals {
+		return sdkerrors.Wrapf(ErrInvalidDecimals, "%d", msg.EthBridgeClaim.Decimals)
+	}
+
 	return nil
 }
 
@@ -279,11 +283,12 @@ func (msg MsgUpdateCethReceiverAccount) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgRescueCeth is a constructor function for NewMsgRescueCeth
-func NewMsgRescueCeth(cosmosSender sdk.AccAddress, cosmosReceiver sdk.AccAddress, cethAmount sdk.Int) MsgRescueCeth {
+func NewMsgRescueCeth(ethereumChainID int64, cosmosSender sdk.AccAddress, cosmosReceiver sdk.AccAddress, cethAmount sdk.Int) MsgRescueCeth {
 	return MsgRescueCeth{
-		CosmosSender:   cosmosSender.String(),
-		CosmosReceiver: cosmosReceiver.String(),
-		CethAmount:     cethAmount,
+		EthereumChainId: ethereumChainID,
+		CosmosSender:    cosmosSender.String(),
+		CosmosReceiver:  cosmosReceiver.String(),
+		CethAmount:      cethAmount,
 	}
 }
 
@@ -295,6 +300,10 @@ func (msg MsgRescueCeth) Type() string { return "rescue_ceth" }
 
 // ValidateBasic runs stateless checks on the message
 func (msg MsgRescueCeth) ValidateBasic() error {
+	if msg.EthereumChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEthereumChainID, "%d", msg.EthereumChainId)
+	}
+
 	if msg.CosmosSender == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
 	}
@@ -420,6 +429,11 @@ func (msg MsgSetBlacklist) GetSignBytes() []byte {
 }
 
 func (msg *MsgSetBlacklist) ValidateBasic() error {
+	for _, address := range msg.Addresses {
+		if err := ValidateBlacklistAddress(address); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -431,3 +445,520 @@ func (msg *MsgSetBlacklist) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgSetNetwork is a constructor function for MsgSetNetwork
+func NewMsgSetNetwork(cosmosSender sdk.AccAddress, network Network) MsgSetNetwork {
+	return MsgSetNetwork{
+		CosmosSender: cosmosSender.String(),
+		Network:      network,
+	}
+}
+
+var _ sdk.Msg = &MsgSetNetwork{}
+
+// Route should return the name of the module
+func (msg MsgSetNetwork) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetNetwork) Type() string { return "set_network" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetNetwork) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	return msg.Network.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetNetwork) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetNetwork) GetSigners() []sdk.AccAddress {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{cosmosSender}
+}
+
+// NewMsgSetPause is a constructor function for MsgSetPause
+func NewMsgSetPause(cosmosSender sdk.AccAddress, pause Pause) MsgSetPause {
+	return MsgSetPause{
+		CosmosSender: cosmosSender.String(),
+		Pause:        pause,
+	}
+}
+
+var _ sdk.Msg = &MsgSetPause{}
+
+// Route should return the name of the module
+func (msg MsgSetPause) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetPause) Type() string { return "set_pause" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	return msg.Pause.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetPause) GetSigners() []sdk.AccAddress {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{cosmosSender}
+}
+
+// NewMsgReportOutboundTransfer is a constructor function for MsgReportOutboundTransfer
+func NewMsgReportOutboundTransfer(validatorAddress sdk.ValAddress, cosmosSender string, sequence uint64,
+	ethereumTxHash string) MsgReportOutboundTransfer {
+	return MsgReportOutboundTransfer{
+		ValidatorAddress:     validatorAddress.String(),
+		CosmosSender:         cosmosSender,
+		CosmosSenderSequence: sequence,
+		EthereumTxHash:       ethereumTxHash,
+	}
+}
+
+var _ sdk.Msg = &MsgReportOutboundTransfer{}
+
+// Route should return the name of the module
+func (msg MsgReportOutboundTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgReportOutboundTransfer) Type() string { return "report_outbound_transfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgReportOutboundTransfer) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	if !IsValidEthTxHash(msg.EthereumTxHash) {
+		return sdkerrors.Wrap(ErrInvalidEthTxHash, msg.EthereumTxHash)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgReportOutboundTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgReportOutboundTransfer) GetSigners() []sdk.AccAddress {
+	validatorAddress, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(validatorAddress)}
+}
+
+// NewMsgRefundOutboundTransfer is a constructor function for MsgRefundOutboundTransfer
+func NewMsgRefundOutboundTransfer(cosmosSender sdk.AccAddress, transferSender string, sequence uint64) MsgRefundOutboundTransfer {
+	return MsgRefundOutboundTransfer{
+		CosmosSender:           cosmosSender.String(),
+		TransferSender:         transferSender,
+		TransferSenderSequence: sequence,
+	}
+}
+
+var _ sdk.Msg = &MsgRefundOutboundTransfer{}
+
+// Route should return the name of the module
+func (msg MsgRefundOutboundTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRefundOutboundTransfer) Type() string { return "refund_outbound_transfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRefundOutboundTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.TransferSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.TransferSender)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRefundOutboundTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRefundOutboundTransfer) GetSigners() []sdk.AccAddress {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{cosmosSender}
+}
+
+// NewMsgRedirectUnclaimedInbound is a constructor function for MsgRedirectUnclaimedInbound
+func NewMsgRedirectUnclaimedInbound(cosmosSender sdk.AccAddress, id uint64, recipient sdk.AccAddress) MsgRedirectUnclaimedInbound {
+	return MsgRedirectUnclaimedInbound{
+		CosmosSender: cosmosSender.String(),
+		Id:           id,
+		Recipient:    recipient.String(),
+	}
+}
+
+var _ sdk.Msg = &MsgRedirectUnclaimedInbound{}
+
+// Route should return the name of the module
+func (msg MsgRedirectUnclaimedInbound) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRedirectUnclaimedInbound) Type() string { return "redirect_unclaimed_inbound" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRedirectUnclaimedInbound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Recipient)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRedirectUnclaimedInbound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRedirectUnclaimedInbound) GetSigners() []sdk.AccAddress {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{cosmosSender}
+}
+
+// NewMsgAddToBlacklist is a constructor function for MsgAddToBlacklist
+func NewMsgAddToBlacklist(cosmosSender sdk.AccAddress, addresses []string, reason string, expiryHeight int64) MsgAddToBlacklist {
+	return MsgAddToBlacklist{
+		CosmosSender: cosmosSender.String(),
+		Addresses:    addresses,
+		Reason:       reason,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+var _ sdk.Msg = &MsgAddToBlacklist{}
+
+// Route should return the name of the module
+func (msg MsgAddToBlacklist) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAddToBlacklist) Type() string { return "add_to_blacklist" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAddToBlacklist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	if err := validateBlacklistChange(msg.Addresses, msg.Reason); err != nil {
+		return err
+	}
+
+	for _, address := range msg.Addresses {
+		if err := ValidateBlacklistAddress(address); err != nil {
+			return err
+		}
+	}
+
+	if msg.ExpiryHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidBlacklist, "negative expiry height %d", msg.ExpiryHeight)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddToBlacklist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAddToBlacklist) GetSigners() []sdk.AccAddress {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{cosmosSender}
+}
+
+// NewMsgRemoveFromBlacklist is a constructor function for MsgRemoveFromBlacklist
+func NewMsgRemoveFromBlacklist(cosmosSender sdk.AccAddress, addresses []string, reason string) MsgRemoveFromBlacklist {
+	return MsgRemoveFromBlacklist{
+		CosmosSender: cosmosSender.String(),
+		Addresses:    addresses,
+		Reason:       reason,
+	}
+}
+
+var _ sdk.Msg = &MsgRemoveFromBlacklist{}
+
+// Route should return the name of the module
+func (msg MsgRemoveFromBlacklist) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRemoveFromBlacklist) Type() string { return "remove_from_blacklist" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRemoveFromBlacklist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	return validateBlacklistChange(msg.Addresses, msg.Reason)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveFromBlacklist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRemoveFromBlacklist) GetSigners() []sdk.AccAddress {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{cosmosSender}
+}
+
+// validateBlacklistChange checks that a blacklist change has addresses and a reason for the audit trail
+func validateBlacklistChange(addresses []string, reason string) error {
+	if len(addresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBlacklist, "no addresses")
+	}
+
+	for _, address := range addresses {
+		if address == "" {
+			return sdkerrors.Wrap(ErrInvalidBlacklist, "empty address")
+		}
+	}
+
+	if reason == "" {
+		return sdkerrors.Wrap(ErrInvalidBlacklist, "empty reason")
+	}
+
+	return nil
+}
+
+// NewMsgReportGasPrice is a constructor function for MsgReportGasPrice
+func NewMsgReportGasPrice(validatorAddress sdk.ValAddress, ethereumChainID int64, gasPrice sdk.Int) MsgReportGasPrice {
+	return MsgReportGasPrice{
+		ValidatorAddress: validatorAddress.String(),
+		EthereumChainId:  ethereumChainID,
+		GasPrice:         gasPrice,
+	}
+}
+
+var _ sdk.Msg = &MsgReportGasPrice{}
+
+// Route should return the name of the module
+func (msg MsgReportGasPrice) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgReportGasPrice) Type() string { return "report_gas_price" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgReportGasPrice) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+
+	if msg.EthereumChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEthereumChainID, "%d", msg.EthereumChainId)
+	}
+
+	if msg.GasPrice.IsNil() || !msg.GasPrice.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidGasPrice, msg.GasPrice.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgReportGasPrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgReportGasPrice) GetSigners() []sdk.AccAddress {
+	validatorAddress, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(validatorAddress)}
+}
+
+// NewMsgCreateEthBridgeNftClaim is a constructor function for MsgCreateEthBridgeNftClaim
+func NewMsgCreateEthBridgeNftClaim(nftClaim *EthBridgeNftClaim) MsgCreateEthBridgeNftClaim {
+	return MsgCreateEthBridgeNftClaim{
+		EthBridgeNftClaim: nftClaim,
+	}
+}
+
+var _ sdk.Msg = &MsgCreateEthBridgeNftClaim{}
+
+// Route should return the name of the module
+func (msg MsgCreateEthBridgeNftClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateEthBridgeNftClaim) Type() string { return "create_bridge_nft_claim" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateEthBridgeNftClaim) ValidateBasic() error {
+	claim := msg.EthBridgeNftClaim
+	if claim == nil {
+		return sdkerrors.Wrap(ErrInvalidNft, "empty claim")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(claim.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, claim.CosmosReceiver)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(claim.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, claim.ValidatorAddress)
+	}
+
+	if claim.EthereumChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEthereumChainID, "%d", claim.EthereumChainId)
+	}
+
+	if claim.Nonce < 0 {
+		return ErrInvalidEthNonce
+	}
+
+	if !gethCommon.IsHexAddress(claim.EthereumSender) ||
+		!gethCommon.IsHexAddress(claim.BridgeContractAddress) ||
+		!gethCommon.IsHexAddress(claim.TokenContractAddress) {
+		return ErrInvalidEthAddress
+	}
+
+	if _, err := ParseNftTokenID(claim.TokenId); err != nil {
+		return err
+	}
+
+	if len(claim.TokenUri) > MaxNftURILength {
+		return sdkerrors.Wrap(ErrInvalidNft, "token uri too long")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateEthBridgeNftClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateEthBridgeNftClaim) GetSigners() []sdk.AccAddress {
+	validatorAddress, err := sdk.ValAddressFromBech32(msg.EthBridgeNftClaim.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(validatorAddress)}
+}
+
+// NewMsgBurnNft is a constructor function for MsgBurnNft
+func NewMsgBurnNft(cosmosSender sdk.AccAddress, classID string, tokenID string,
+	ethereumReceiver EthereumAddress, cethAmount sdk.Int) MsgBurnNft {
+	return MsgBurnNft{
+		CosmosSender:     cosmosSender.String(),
+		ClassId:          classID,
+		TokenId:          tokenID,
+		EthereumReceiver: ethereumReceiver.String(),
+		CethAmount:       cethAmount,
+	}
+}
+
+var _ sdk.Msg = &MsgBurnNft{}
+
+// Route should return the name of the module
+func (msg MsgBurnNft) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBurnNft) Type() string { return "burn_nft" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBurnNft) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
+	}
+
+	if msg.ClassId == "" {
+		return sdkerrors.Wrap(ErrInvalidNft, "empty class id")
+	}
+
+	if _, err := ParseNftTokenID(msg.TokenId); err != nil {
+		return err
+	}
+
+	if !gethCommon.IsHexAddress(msg.EthereumReceiver) {
+		return ErrInvalidEthAddress
+	}
+
+	// check that enough ceth is sent to cover the gas cost.
+	if msg.CethAmount.IsNil() || msg.CethAmount.LT(sdk.NewInt(burnGasCost)) {
+		return ErrCethAmount
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBurnNft) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBurnNft) GetSigners() []sdk.AccAddress {
+	cosmosSender, err := sdk.AccAddressFromBech32(msg.CosmosSender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{cosmosSender}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +50,69 @@ func TestNewMsgCreateEthBridgeClaim(t *testing.T) {
 	err := msg.ValidateBasic()
 	assert.Error(t, err)
 }
+
+func TestNewMsgCreateEthBridgeNftClaim(t *testing.T) {
+	validator := sdk.ValAddress(cosmosReceivers[0])
+	claim := types.NewEthBridgeNftClaim(1, ethBridgeAddress, 1, "PUNK", tokenContractAddress, ethereumSender,
+		cosmosReceivers[0], validator, "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+		"ipfs://punk/1")
+	msg := types.NewMsgCreateEthBridgeNftClaim(claim)
+	assert.NoError(t, msg.ValidateBasic())
+	assert.Equal(t, msg.GetSigners()[0], cosmosReceivers[0])
+
+	for _, tokenID := range []string{"", "-1", "01", "0x1", "115792089237316195423570985008687907853269984665640564039457584007913129639936"} {
+		claim.TokenId = tokenID
+		assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidNft, tokenID)
+	}
+	claim.TokenId = "1"
+	claim.TokenUri = strings.Repeat("a", types.MaxNftURILength+1)
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidNft)
+}
+
+func TestNewMsgBurnNft(t *testing.T) {
+	classID := types.NftClassID(1, tokenContractAddress)
+	msg := types.NewMsgBurnNft(cosmosReceivers[0], classID, "1", ethereumSender, amount)
+	assert.NoError(t, msg.ValidateBasic())
+	assert.Equal(t, msg.GetSigners()[0], cosmosReceivers[0])
+	msg = types.NewMsgBurnNft(cosmosReceivers[0], "", "1", ethereumSender, amount)
+	assert.Error(t, msg.ValidateBasic())
+	msg = types.NewMsgBurnNft(cosmosReceivers[0], classID, "1", ethereumSender, sdk.NewInt(1))
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrCethAmount)
+}
+
+func TestNewSetAdminRoleProposal(t *testing.T) {
+	proposal := types.NewSetAdminRoleProposal("rescuer", "grant the rescuer role",
+		types.NewAdminRoleGrant(types.AdminRole_ADMIN_ROLE_RESCUER, cosmosReceivers[0]), false)
+	assert.NoError(t, proposal.ValidateBasic())
+	proposal.Grant.Role = types.AdminRole_ADMIN_ROLE_UNSPECIFIED
+	assert.ErrorIs(t, proposal.ValidateBasic(), types.ErrInvalidAdminRole)
+	proposal.Grant.Role = types.AdminRole(9)
+	proposal.Revoke = true
+	assert.ErrorIs(t, proposal.ValidateBasic(), types.ErrInvalidAdminRole)
+}
+
+func TestParseAdminRole(t *testing.T) {
+	role, err := types.ParseAdminRole("validator-set")
+	assert.NoError(t, err)
+	assert.Equal(t, types.AdminRole_ADMIN_ROLE_VALIDATOR_SET, role)
+	role, err = types.ParseAdminRole("ADMIN_ROLE_FEE")
+	assert.NoError(t, err)
+	assert.Equal(t, types.AdminRole_ADMIN_ROLE_FEE, role)
+	_, err = types.ParseAdminRole("unspecified")
+	assert.ErrorIs(t, err, types.ErrInvalidAdminRole)
+	_, err = types.ParseAdminRole("owner")
+	assert.ErrorIs(t, err, types.ErrInvalidAdminRole)
+}
+
+func TestParseClaimStatus(t *testing.T) {
+	status, err := types.ParseClaimStatus("pending")
+	assert.NoError(t, err)
+	assert.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, status)
+	status, err = types.ParseClaimStatus("STATUS_TEXT_FAILED")
+	assert.NoError(t, err)
+	assert.Equal(t, oracletypes.StatusText_STATUS_TEXT_FAILED, status)
+	_, err = types.ParseClaimStatus("unspecified")
+	assert.Error(t, err)
+	_, err = types.ParseClaimStatus("done")
+	assert.Error(t, err)
+}
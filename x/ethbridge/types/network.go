@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// NewNetwork is a constructor function for Network
+func NewNetwork(ethereumChainID int64, name string, bridgeContract EthereumAddress, denomPrefix, nativeToken string) Network {
+	return Network{
+		EthereumChainId:       ethereumChainID,
+		Name:                  name,
+		BridgeContractAddress: bridgeContract.String(),
+		DenomPrefix:           denomPrefix,
+		NativeToken:           nativeToken,
+	}
+}
+
+// NewEthereumNetwork returns the network of an ethereum chain bridged through a bridge contract: tokens get the pegged
+// coin prefix and the fee is paid in ceth
+func NewEthereumNetwork(ethereumChainID int64, bridgeContract EthereumAddress) Network {
+	return NewNetwork(ethereumChainID, "ethereum", bridgeContract, PeggedCoinPrefix, CethSymbol)
+}
+
+// LegacyNetwork returns the network claims, locks and burns of a chain id are processed with while no network has
+// been registered, as they were before networks were registered: tokens get the pegged coin prefix, the fee is paid in
+// ceth and claims may come from any bridge contract
+func LegacyNetwork(ethereumChainID int64) Network {
+	return Network{
+		EthereumChainId: ethereumChainID,
+		DenomPrefix:     PeggedCoinPrefix,
+		NativeToken:     CethSymbol,
+	}
+}
+
+// Denom returns the pegged denom of a token locked on the network
+func (n Network) Denom(symbol string) string {
+	return n.DenomPrefix + symbol
+}
+
+// IsBridgeContract returns true if claims of the network may come from the bridge contract
+func (n Network) IsBridgeContract(bridgeContract string) bool {
+	if n.BridgeContractAddress == "" {
+		return true
+	}
+	return NewEthereumAddress(n.BridgeContractAddress) == NewEthereumAddress(bridgeContract)
+}
+
+// Validate runs stateless checks on the network
+func (n Network) Validate() error {
+	if n.EthereumChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEthereumChainID, "%d", n.EthereumChainId)
+	}
+	if !gethCommon.IsHexAddress(n.BridgeContractAddress) {
+		return sdkerrors.Wrap(ErrInvalidEthAddress, n.BridgeContractAddress)
+	}
+	if !strings.HasPrefix(n.DenomPrefix, PeggedCoinPrefix) {
+		return sdkerrors.Wrapf(ErrInvalidNetwork, "denom prefix must start with %s: %s", PeggedCoinPrefix, n.DenomPrefix)
+	}
+	if err := sdk.ValidateDenom(n.Denom("eth")); err != nil {
+		return sdkerrors.Wrap(ErrInvalidNetwork, err.Error())
+	}
+	if err := sdk.ValidateDenom(n.NativeToken); err != nil {
+		return sdkerrors.Wrap(ErrInvalidNetwork, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NftClassID returns the id of the NFT class mirroring an ERC-721 contract of a network
+func NftClassID(ethereumChainID int64, tokenContract EthereumAddress) string {
+	return fmt.Sprintf("nft/%d/%s", ethereumChainID, tokenContract.String())
+}
+
+// ParseNftTokenID parses the decimal id of an ERC-721 token, which must be a uint256 without leading zeros so that
+// every token has a single id
+func ParseNftTokenID(tokenID string) (*big.Int, error) {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok || id.Sign() < 0 || id.BitLen() > 256 || id.String() != tokenID {
+		return nil, sdkerrors.Wrapf(ErrInvalidNft, "token id %q", tokenID)
+	}
+	return id, nil
+}
+
+// NewEthBridgeNftClaim is a constructor function for EthBridgeNftClaim
+func NewEthBridgeNftClaim(
+	ethereumChainID int64,
+	bridgeContract EthereumAddress,
+	nonce int64,
+	symbol string,
+	tokenContract EthereumAddress,
+	ethereumSender EthereumAddress,
+	cosmosReceiver sdk.AccAddress,
+	validator sdk.ValAddress,
+	tokenID string,
+	tokenURI string,
+) *EthBridgeNftClaim {
+	return &EthBridgeNftClaim{
+		EthereumChainId:       ethereumChainID,
+		BridgeContractAddress: bridgeContract.String(),
+		Nonce:                 nonce,
+		Symbol:                symbol,
+		TokenContractAddress:  tokenContract.String(),
+		EthereumSender:        ethereumSender.String(),
+		CosmosReceiver:        cosmosReceiver.String(),
+		ValidatorAddress:      validator.String(),
+		TokenId:               tokenID,
+		TokenUri:              tokenURI,
+	}
+}
+
+// NewNftClass returns the NFT class mirroring an ERC-721 contract of a network
+func NewNftClass(ethereumChainID int64, tokenContract EthereumAddress, symbol string) NftClass {
+	return NftClass{
+		Id:                   NftClassID(ethereumChainID, tokenContract),
+		EthereumChainId:      ethereumChainID,
+		TokenContractAddress: tokenContract.String(),
+		Symbol:               symbol,
+	}
+}
+
+// Validate checks that the id of the class is the one of its contract
+func (c NftClass) Validate() error {
+	if c.EthereumChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidEthereumChainID, "%d", c.EthereumChainId)
+	}
+	if c.Id != NftClassID(c.EthereumChainId, NewEthereumAddress(c.TokenContractAddress)) {
+		return sdkerrors.Wrapf(ErrInvalidNft, "class id %s", c.Id)
+	}
+	return nil
+}
+
+// NewNft returns an NFT of a class held by the owner
+func NewNft(classID string, tokenID string, owner sdk.AccAddress, uri string) Nft {
+	return Nft{
+		ClassId: classID,
+		TokenId: tokenID,
+		Owner:   owner.String(),
+		Uri:     uri,
+	}
+}
+
+// Validate checks the fields of an NFT
+func (n Nft) Validate() error {
+	if n.ClassId == "" {
+		return sdkerrors.Wrap(ErrInvalidNft, "empty class id")
+	}
+	if _, err := ParseNftTokenID(n.TokenId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(n.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, n.Owner)
+	}
+	if len(n.Uri) > MaxNftURILength {
+		return sdkerrors.Wrap(ErrInvalidNft, "uri too long")
+	}
+	return nil
+}
+
+// NftOracleClaimContent is the content of an NFT claim each validator stores in the oracle
+type NftOracleClaimContent struct {
+	EthereumChainID      int64           `json:"ethereum_chain_id" yaml:"ethereum_chain_id"`
+	CosmosReceiver       sdk.AccAddress  `json:"cosmos_receiver" yaml:"cosmos_receiver"`
+	Symbol               string          `json:"symbol" yaml:"symbol"`
+	TokenContractAddress EthereumAddress `json:"token_contract_address" yaml:"token_contract_address"`
+	TokenID              string          `json:"token_id" yaml:"token_id"`
+	TokenURI             string          `json:"token_uri" yaml:"token_uri"`
+	ClaimType            ClaimType       `json:"claim_type" yaml:"claim_type"`
+}
+
+// GetNftClaimProphecyID returns the id of the prophecy of an NFT claim. It is prefixed so that it cannot collide with
+// the id of a claim of fungible tokens from the same sender and nonce.
+func GetNftClaimProphecyID(nftClaim *EthBridgeNftClaim) string {
+	return "nft" + strconv.FormatInt(nftClaim.EthereumChainId, 10) + strconv.FormatInt(nftClaim.Nonce, 10) +
+		nftClaim.EthereumSender
+}
+
+// CreateOracleClaimFromEthNftClaim converts an NFT claim to an oracle claim
+func CreateOracleClaimFromEthNftClaim(nftClaim *EthBridgeNftClaim) (oracletypes.Claim, error) {
+	oracleID := GetNftClaimProphecyID(nftClaim)
+
+	cosmosReceiver, err := sdk.AccAddressFromBech32(nftClaim.CosmosReceiver)
+	if err != nil {
+		return oracletypes.Claim{}, err
+	}
+
+	claimBytes, err := json.Marshal(NftOracleClaimContent{
+		EthereumChainID:      nftClaim.EthereumChainId,
+		CosmosReceiver:       cosmosReceiver,
+		Symbol:               nftClaim.Symbol,
+		TokenContractAddress: NewEthereumAddress(nftClaim.TokenContractAddress),
+		TokenID:              nftClaim.TokenId,
+		TokenURI:             nftClaim.TokenUri,
+		ClaimType:            ClaimType_CLAIM_TYPE_NFT_LOCK,
+	})
+	if err != nil {
+		return oracletypes.Claim{}, err
+	}
+	return oracletypes.NewClaim(oracleID, nftClaim.ValidatorAddress, string(claimBytes)), nil
+}
+
+// CreateNftOracleClaimFromOracleString converts the JSON content of an NFT claim stored in the oracle
+func CreateNftOracleClaimFromOracleString(oracleClaimString string) (NftOracleClaimContent, error) {
+	var content NftOracleClaimContent
+	if err := json.Unmarshal([]byte(oracleClaimString), &content); err != nil {
+		return NftOracleClaimContent{}, sdkerrors.Wrap(ErrJSONMarshalling, fmt.Sprintf("failed to parse claim: %s", err.Error()))
+	}
+	if content.ClaimType != ClaimType_CLAIM_TYPE_NFT_LOCK {
+		return NftOracleClaimContent{}, ErrInvalidClaimType
+	}
+	return content, nil
+}
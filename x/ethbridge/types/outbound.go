@@ -0,0 +1,69 @@
+package types
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+var ethTxHashRegex = regexp.MustCompile("^0x[0-9a-fA-F]{64}$")
+
+// NewOutboundTransfer returns the pending record of a lock or burn
+func NewOutboundTransfer(cosmosSender sdk.AccAddress, sequence uint64, ethereumChainID int64,
+	ethereumReceiver EthereumAddress, symbol string, amount, cethAmount sdk.Int, claimType ClaimType, height int64) OutboundTransfer {
+	return OutboundTransfer{
+		CosmosSender:         cosmosSender.String(),
+		CosmosSenderSequence: sequence,
+		EthereumChainId:      ethereumChainID,
+		EthereumReceiver:     ethereumReceiver.String(),
+		Symbol:               symbol,
+		Amount:               amount,
+		CethAmount:           cethAmount,
+		ClaimType:            claimType,
+		Status:               OutboundStatus_OUTBOUND_STATUS_PENDING,
+		CreatedHeight:        height,
+	}
+}
+
+// IsOpen returns whether the transfer can still be reported or refunded
+func (t OutboundTransfer) IsOpen() bool {
+	return t.Status == OutboundStatus_OUTBOUND_STATUS_PENDING || t.Status == OutboundStatus_OUTBOUND_STATUS_RELAYED
+}
+
+// IsLocked returns whether the transfer is a lock whose coins are still counted as locked, refunded locks are not
+func (t OutboundTransfer) IsLocked() bool {
+	return t.ClaimType == ClaimType_CLAIM_TYPE_LOCK && t.Status != OutboundStatus_OUTBOUND_STATUS_REFUNDED
+}
+
+// Validate checks the fields of an outbound transfer
+func (t OutboundTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.CosmosSender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, t.CosmosSender)
+	}
+	if !gethCommon.IsHexAddress(t.EthereumReceiver) {
+		return ErrInvalidEthAddress
+	}
+	if err := sdk.ValidateDenom(t.Symbol); err != nil {
+		return err
+	}
+	if t.Amount.IsNil() || !t.Amount.IsPositive() || t.CethAmount.IsNil() || t.CethAmount.IsNegative() {
+		return ErrInvalidAmount
+	}
+	if t.ClaimType != ClaimType_CLAIM_TYPE_LOCK && t.ClaimType != ClaimType_CLAIM_TYPE_BURN {
+		return ErrInvalidClaimType
+	}
+	if _, ok := OutboundStatus_name[int32(t.Status)]; !ok || t.Status == OutboundStatus_OUTBOUND_STATUS_UNSPECIFIED {
+		return ErrOutboundStatus
+	}
+	if t.EthereumTxHash != "" && !IsValidEthTxHash(t.EthereumTxHash) {
+		return ErrInvalidEthTxHash
+	}
+	return nil
+}
+
+// IsValidEthTxHash returns whether a string is a 0x prefixed ethereum transaction hash
+func IsValidEthTxHash(hash string) bool {
+	return ethTxHashRegex.MatchString(hash)
+}
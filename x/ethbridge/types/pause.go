@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPause is a constructor function for Pause
+func NewPause(symbol string, outbound, inbound bool) Pause {
+	return Pause{
+		Symbol:   symbol,
+		Outbound: outbound,
+		Inbound:  inbound,
+	}
+}
+
+// IsGlobal returns whether the pause applies to all symbols
+func (p Pause) IsGlobal() bool {
+	return p.Symbol == ""
+}
+
+// IsPaused returns whether the pause stops transfers in any direction
+func (p Pause) IsPaused() bool {
+	return p.Outbound || p.Inbound
+}
+
+// Validate checks the symbol of a pause
+func (p Pause) Validate() error {
+	if p.IsGlobal() {
+		return nil
+	}
+	return sdk.ValidateDenom(p.Symbol)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetPause defines the type for a SetPauseProposal
+	ProposalTypeSetPause = "SetPause"
+	// ProposalTypeSetAdminRole defines the type for a SetAdminRoleProposal
+	ProposalTypeSetAdminRole = "SetAdminRole"
+)
+
+var _ govtypes.Content = &SetPauseProposal{}
+var _ govtypes.Content = &SetAdminRoleProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPause)
+	govtypes.RegisterProposalTypeCodec(&SetPauseProposal{}, "ethbridge/SetPauseProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetAdminRole)
+	govtypes.RegisterProposalTypeCodec(&SetAdminRoleProposal{}, "ethbridge/SetAdminRoleProposal")
+}
+
+// NewSetPauseProposal creates a new proposal pausing or resuming bridge transfers
+func NewSetPauseProposal(title, description string, pause Pause) *SetPauseProposal {
+	return &SetPauseProposal{Title: title, Description: description, Pause: pause}
+}
+
+func (p *SetPauseProposal) GetTitle() string { return p.Title }
+
+func (p *SetPauseProposal) GetDescription() string { return p.Description }
+
+func (p *SetPauseProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPauseProposal) ProposalType() string { return ProposalTypeSetPause }
+
+func (p *SetPauseProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return p.Pause.Validate()
+}
+
+func (p SetPauseProposal) String() string {
+	return fmt.Sprintf(`Set Bridge Pause Proposal:
+  Title:       %s
+  Description: %s
+  Symbol:      %s
+  Outbound:    %t
+  Inbound:     %t
+`, p.Title, p.Description, p.Pause.Symbol, p.Pause.Outbound, p.Pause.Inbound)
+}
+
+// NewSetAdminRoleProposal creates a new proposal granting or revoking an admin role
+func NewSetAdminRoleProposal(title, description string, grant AdminRoleGrant, revoke bool) *SetAdminRoleProposal {
+	return &SetAdminRoleProposal{Title: title, Description: description, Grant: grant, Revoke: revoke}
+}
+
+func (p *SetAdminRoleProposal) GetTitle() string { return p.Title }
+
+func (p *SetAdminRoleProposal) GetDescription() string { return p.Description }
+
+func (p *SetAdminRoleProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetAdminRoleProposal) ProposalType() string { return ProposalTypeSetAdminRole }
+
+func (p *SetAdminRoleProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return p.Grant.Validate()
+}
+
+func (p SetAdminRoleProposal) String() string {
+	return fmt.Sprintf(`Set Admin Role Proposal:
+  Title:       %s
+  Description: %s
+  Role:        %s
+  Address:     %s
+  Revoke:      %t
+`, p.Title, p.Description, p.Grant.Role, p.Grant.Address, p.Revoke)
+}
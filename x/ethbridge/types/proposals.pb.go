@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/ethbridge/v1/proposals.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetPauseProposal pauses or resumes bridge transfers through governance.
+type SetPauseProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Pause       Pause  `protobuf:"bytes,3,opt,name=pause,proto3" json:"pause" yaml:"pause"`
+}
+
+func (m *SetPauseProposal) Reset()      { *m = SetPauseProposal{} }
+func (*SetPauseProposal) ProtoMessage() {}
+func (*SetPauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5474ed35a5c6727, []int{0}
+}
+func (m *SetPauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPauseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPauseProposal.Merge(m, src)
+}
+func (m *SetPauseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPauseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPauseProposal proto.InternalMessageInfo
+
+// SetAdminRoleProposal grants or revokes an admin role through governance.
+type SetAdminRoleProposal struct {
+	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Grant       AdminRoleGrant `protobuf:"bytes,3,opt,name=grant,proto3" json:"grant" yaml:"grant"`
+	// revoke revokes the role instead of granting it
+	Revoke bool `protobuf:"varint,4,opt,name=revoke,proto3" json:"revoke,omitempty" yaml:"revoke"`
+}
+
+func (m *SetAdminRoleProposal) Reset()      { *m = SetAdminRoleProposal{} }
+func (*SetAdminRoleProposal) ProtoMessage() {}
+func (*SetAdminRoleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5474ed35a5c6727, []int{1}
+}
+func (m *SetAdminRoleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAdminRoleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAdminRoleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAdminRoleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAdminRoleProposal.Merge(m, src)
+}
+func (m *SetAdminRoleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAdminRoleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAdminRoleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAdminRoleProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetPauseProposal)(nil), "sifnode.ethbridge.v1.SetPauseProposal")
+	proto.RegisterType((*SetAdminRoleProposal)(nil), "sifnode.ethbridge.v1.SetAdminRoleProposal")
+}
+
+func init() {
+	proto.RegisterFile("sifnode/ethbridge/v1/proposals.proto", fileDescriptor_f5474ed35a5c6727)
+}
+
+var fileDescriptor_f5474ed35a5c6727 = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xb1, 0x4f, 0xc2, 0x40,
+	0x14, 0xc6, 0x7b, 0x28, 0x44, 0x0f, 0x4c, 0xb0, 0x69, 0x4c, 0x83, 0x49, 0xaf, 0xb9, 0x10, 0x83,
+	0x83, 0x6d, 0xd0, 0xc5, 0xb0, 0xd9, 0x85, 0x95, 0x94, 0xcd, 0xad, 0xd0, 0xa3, 0x5c, 0x2c, 0xbd,
+	0xa6, 0x3d, 0x88, 0xcc, 0x2e, 0x8e, 0x8e, 0x8e, 0xfc, 0x39, 0x24, 0x2e, 0x8c, 0x4e, 0x8d, 0x81,
+	0xc5, 0x99, 0xbf, 0xc0, 0x70, 0x57, 0x11, 0x0d, 0xb3, 0xdb, 0xe5, 0xbd, 0xdf, 0xfb, 0xde, 0xfb,
+	0x2e, 0x1f, 0xac, 0xa7, 0x74, 0x10, 0x31, 0x9f, 0xd8, 0x84, 0x0f, 0x7b, 0x09, 0xf5, 0x03, 0x62,
+	0x4f, 0x9a, 0x76, 0x9c, 0xb0, 0x98, 0xa5, 0x5e, 0x98, 0x5a, 0x71, 0xc2, 0x38, 0x53, 0xb5, 0x9c,
+	0xb2, 0xb6, 0x94, 0x35, 0x69, 0xd6, 0xb4, 0x80, 0x05, 0x4c, 0x00, 0xf6, 0xe6, 0x25, 0xd9, 0x9a,
+	0xb9, 0x57, 0x91, 0x4f, 0x63, 0x92, 0xab, 0xe1, 0x37, 0x00, 0xab, 0x5d, 0xc2, 0x3b, 0xde, 0x38,
+	0x25, 0x9d, 0x7c, 0x93, 0x7a, 0x01, 0x8b, 0x9c, 0xf2, 0x90, 0xe8, 0xc0, 0x04, 0x8d, 0x63, 0xa7,
+	0xba, 0xce, 0x50, 0x65, 0xea, 0x8d, 0xc2, 0x16, 0x16, 0x65, 0xec, 0xca, 0xb6, 0x7a, 0x0b, 0xcb,
+	0x3e, 0x49, 0xfb, 0x09, 0x8d, 0x39, 0x65, 0x91, 0x5e, 0x10, 0xf4, 0xd9, 0x3a, 0x43, 0xaa, 0xa4,
+	0x77, 0x9a, 0xd8, 0xdd, 0x45, 0xd5, 0x36, 0x2c, 0xc6, 0x9b, 0x95, 0xfa, 0x81, 0x09, 0x1a, 0xe5,
+	0xeb, 0x73, 0x6b, 0x9f, 0x29, 0x4b, 0x5c, 0xe5, 0x68, 0xf3, 0x0c, 0x29, 0x3f, 0x27, 0x88, 0x39,
+	0xec, 0xca, 0xf9, 0x56, 0xe5, 0x79, 0x86, 0x94, 0xd7, 0x19, 0x52, 0x3e, 0x67, 0x48, 0xc1, 0x4f,
+	0x05, 0xa8, 0x75, 0x09, 0xbf, 0xf3, 0x47, 0x34, 0x72, 0x59, 0xf8, 0x9f, 0x8e, 0x3a, 0xb0, 0x18,
+	0x24, 0x5e, 0xc4, 0x73, 0x47, 0xf5, 0xfd, 0x8e, 0xb6, 0x97, 0xb5, 0x37, 0xec, 0x5f, 0x6b, 0x42,
+	0x00, 0xbb, 0x52, 0x48, 0xbd, 0x84, 0xa5, 0x84, 0x4c, 0xd8, 0x03, 0xd1, 0x0f, 0x4d, 0xd0, 0x38,
+	0x72, 0x4e, 0xd7, 0x19, 0x3a, 0x91, 0xa0, 0xac, 0x63, 0x37, 0x07, 0x7e, 0xff, 0x82, 0xd3, 0x9e,
+	0x2f, 0x0d, 0xb0, 0x58, 0x1a, 0xe0, 0x63, 0x69, 0x80, 0x97, 0x95, 0xa1, 0x2c, 0x56, 0x86, 0xf2,
+	0xbe, 0x32, 0x94, 0xfb, 0xab, 0x80, 0xf2, 0xe1, 0xb8, 0x67, 0xf5, 0xd9, 0xc8, 0xee, 0xd2, 0x41,
+	0x7f, 0xe8, 0xd1, 0xc8, 0xfe, 0xce, 0xc8, 0xe3, 0x4e, 0x4a, 0x44, 0x44, 0x7a, 0x25, 0x91, 0x91,
+	0x9b, 0xaf, 0x01, 0x00, 0xf8, 0x03, 0x23, 0x5c, 0x99, 0x02, 0x00, 0x00,
+}
+
+func (m *SetPauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAdminRoleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAdminRoleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAdminRoleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoke {
+		i--
+		if m.Revoke {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetPauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *SetAdminRoleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	if m.Revoke {
+		n += 2
+	}
+	return n
+}
+
+func sovProposals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposals(x uint64) (n int) {
+	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetPauseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPauseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAdminRoleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAdminRoleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAdminRoleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoke = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposals(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposals
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposals
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposals
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposals        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposals          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposals = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,8 +6,19 @@ import (
 
 // query endpoints supported by the oracle Querier
 const (
-	QueryEthProphecy = "prophecies"
-	QueryBlacklist   = "blacklist"
+	QueryEthProphecy      = "prophecies"
+	QueryBlacklist        = "blacklist"
+	QueryNetworks         = "networks"
+	QueryTransferUsage    = "transferUsage"
+	QueryPauses           = "pauses"
+	QueryOutbound         = "outboundTransfers"
+	QueryUnclaimed        = "unclaimedInbounds"
+	QueryBlacklistEntries = "blacklistEntries"
+	QueryCrossChainFee    = "crossChainFee"
+	QueryNftClasses       = "nftClasses"
+	QueryNfts             = "nfts"
+	QueryAdminRoleHolders = "adminRoleHolders"
+	QueryClaimRecords     = "claimRecords"
 )
 
 // NewQueryEthProphecyRequest creates a new QueryEthProphecyParams
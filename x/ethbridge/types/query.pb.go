@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/Sifchain/sifnode/x/oracle/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -258,438 +260,6278 @@ func (m *QueryBlacklistResponse) GetAddresses() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryEthProphecyRequest)(nil), "sifnode.ethbridge.v1.QueryEthProphecyRequest")
-	proto.RegisterType((*QueryEthProphecyResponse)(nil), "sifnode.ethbridge.v1.QueryEthProphecyResponse")
-	proto.RegisterType((*QueryBlacklistRequest)(nil), "sifnode.ethbridge.v1.QueryBlacklistRequest")
-	proto.RegisterType((*QueryBlacklistResponse)(nil), "sifnode.ethbridge.v1.QueryBlacklistResponse")
+type QueryNetworksRequest struct {
 }
 
-func init() { proto.RegisterFile("sifnode/ethbridge/v1/query.proto", fileDescriptor_7077edcf9f792b78) }
-
-var fileDescriptor_7077edcf9f792b78 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x8e, 0x12, 0x4d,
-	0x10, 0xc7, 0x19, 0xf8, 0x20, 0xa1, 0xf9, 0xb2, 0xc6, 0x0e, 0x0b, 0x23, 0xd1, 0x91, 0x4c, 0x4c,
-	0x96, 0xb8, 0x6e, 0x4f, 0x40, 0xe3, 0xc1, 0x78, 0x91, 0xcd, 0x66, 0xe3, 0x4d, 0x87, 0x9b, 0x17,
-	0x32, 0xf4, 0xd4, 0x32, 0x1d, 0x86, 0x69, 0xb6, 0xbb, 0x21, 0xce, 0x5b, 0x78, 0xf7, 0x41, 0x7c,
-	0x05, 0x8f, 0x7b, 0xf4, 0x64, 0x0c, 0xf8, 0x04, 0x3e, 0x81, 0x99, 0x9e, 0x1e, 0xdc, 0x00, 0x9a,
-	0xbd, 0x75, 0x57, 0xfd, 0xaa, 0xeb, 0x5f, 0x55, 0x5d, 0xa8, 0x2b, 0xd9, 0x55, 0xc2, 0x43, 0xf0,
-	0x40, 0x45, 0x13, 0xc1, 0xc2, 0x29, 0x78, 0xab, 0xbe, 0x77, 0xbd, 0x04, 0x91, 0x92, 0x85, 0xe0,
-	0x8a, 0xe3, 0xa6, 0x21, 0xc8, 0x96, 0x20, 0xab, 0x7e, 0xa7, 0x39, 0xe5, 0x53, 0xae, 0x01, 0x2f,
-	0x3b, 0xe5, 0x6c, 0xe7, 0xf0, 0x6b, 0x2a, 0x5d, 0x80, 0x34, 0xc4, 0xa3, 0x82, 0xe0, 0x22, 0xa0,
-	0xf1, 0xae, 0xdb, 0xfd, 0x52, 0x46, 0xed, 0xf7, 0x59, 0xf2, 0x0b, 0x15, 0xbd, 0x13, 0x7c, 0x11,
-	0x01, 0x4d, 0x7d, 0xb8, 0x5e, 0x82, 0x54, 0xf8, 0x29, 0xba, 0x0f, 0x2a, 0x02, 0x01, 0xcb, 0xf9,
-	0x98, 0x46, 0x01, 0x4b, 0xc6, 0x2c, 0xb4, 0xad, 0xae, 0xd5, 0xab, 0xf8, 0xf7, 0x0a, 0xc7, 0x79,
-	0x66, 0x7f, 0x1b, 0x62, 0x8a, 0xda, 0x79, 0xfe, 0x31, 0xe5, 0x89, 0x12, 0x01, 0x55, 0xe3, 0x20,
-	0x0c, 0x05, 0x48, 0x69, 0x97, 0xbb, 0x56, 0xaf, 0x3e, 0x3c, 0xfd, 0xf5, 0xfd, 0xf1, 0x49, 0x1a,
-	0xcc, 0xe3, 0x57, 0xae, 0x01, 0x05, 0x4c, 0x99, 0x54, 0x22, 0xdd, 0x8b, 0x70, 0xfd, 0xe3, 0x1c,
-	0x39, 0x37, 0x8e, 0x37, 0xb9, 0x1d, 0x37, 0x51, 0x35, 0xe1, 0x09, 0x05, 0xbb, 0xa2, 0x45, 0xe4,
-	0x17, 0xdc, 0x42, 0x35, 0x99, 0xce, 0x27, 0x3c, 0xb6, 0xff, 0xcb, 0x32, 0xf9, 0xe6, 0x86, 0x5f,
-	0xa0, 0x96, 0xe2, 0x33, 0x48, 0xf6, 0x15, 0x55, 0x35, 0xd7, 0xd4, 0xde, 0xdd, 0x1c, 0x27, 0x68,
-	0x5b, 0xdb, 0x58, 0x42, 0x12, 0x82, 0xb0, 0x6b, 0x1a, 0x3f, 0x2a, 0xcc, 0x23, 0x6d, 0x75, 0x3f,
-	0x5b, 0xc8, 0xde, 0xef, 0x9c, 0x5c, 0xf0, 0x44, 0x02, 0x3e, 0x42, 0x65, 0xd3, 0xab, 0xba, 0x5f,
-	0x66, 0x21, 0xee, 0xa3, 0x9a, 0x54, 0x81, 0x5a, 0xe6, 0xdd, 0x68, 0x0c, 0x1e, 0x90, 0x62, 0xc8,
-	0xf9, 0x58, 0xc8, 0xaa, 0x4f, 0x46, 0x1a, 0xf0, 0x0d, 0x88, 0x5f, 0xa3, 0x1a, 0x8d, 0x03, 0x36,
-	0x97, 0x76, 0xa5, 0x5b, 0xe9, 0x35, 0x06, 0x4f, 0xc8, 0xa1, 0x7f, 0x41, 0x2e, 0x54, 0x34, 0xcc,
-	0x9b, 0x95, 0xc1, 0xbe, 0x89, 0x71, 0xdb, 0xe8, 0x58, 0x8b, 0x1b, 0xc6, 0x01, 0x9d, 0xc5, 0x4c,
-	0x2a, 0x33, 0x54, 0xf7, 0x25, 0x6a, 0xed, 0x3a, 0x8c, 0xe6, 0x87, 0xa8, 0x6e, 0x1a, 0x04, 0xd2,
-	0xb6, 0xba, 0x95, 0x5e, 0xdd, 0xff, 0x63, 0x18, 0xfc, 0xb4, 0x50, 0x55, 0x07, 0xe2, 0x04, 0x35,
-	0x6e, 0x95, 0x8c, 0xcf, 0x0e, 0xeb, 0xfa, 0xcb, 0xa7, 0xea, 0x90, 0xbb, 0xe2, 0xb9, 0x2a, 0xb7,
-	0x84, 0x67, 0xe8, 0xff, 0x4b, 0x50, 0x5b, 0xbd, 0xf8, 0xf4, 0x1f, 0x2f, 0xec, 0x96, 0xdb, 0x79,
-	0x76, 0x37, 0xb8, 0x48, 0x36, 0xbc, 0xfc, 0xba, 0x76, 0xac, 0x9b, 0xb5, 0x63, 0xfd, 0x58, 0x3b,
-	0xd6, 0xa7, 0x8d, 0x53, 0xba, 0xd9, 0x38, 0xa5, 0x6f, 0x1b, 0xa7, 0xf4, 0xe1, 0x6c, 0xca, 0x54,
-	0xb4, 0x9c, 0x10, 0xca, 0xe7, 0xde, 0x88, 0x5d, 0xe9, 0x85, 0xf0, 0x8a, 0xe5, 0xfa, 0x78, 0x6b,
-	0x01, 0xf5, 0x7a, 0x4d, 0x6a, 0x7a, 0xbf, 0x9e, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x78, 0x60,
-	0x1c, 0x22, 0xf0, 0x03, 0x00, 0x00,
+func (m *QueryNetworksRequest) Reset()         { *m = QueryNetworksRequest{} }
+func (m *QueryNetworksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworksRequest) ProtoMessage()    {}
+func (*QueryNetworksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{4}
+}
+func (m *QueryNetworksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworksRequest.Merge(m, src)
+}
+func (m *QueryNetworksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworksRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryNetworksRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// EthProphecy queries an EthProphecy
-	EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error)
-	GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error)
+type QueryNetworksResponse struct {
+	Networks []Network `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryNetworksResponse) Reset()         { *m = QueryNetworksResponse{} }
+func (m *QueryNetworksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworksResponse) ProtoMessage()    {}
+func (*QueryNetworksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryNetworksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error) {
-	out := new(QueryEthProphecyResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/EthProphecy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNetworksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNetworksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworksResponse.Merge(m, src)
+}
+func (m *QueryNetworksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworksResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error) {
-	out := new(QueryBlacklistResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetBlacklist", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNetworksResponse proto.InternalMessageInfo
+
+func (m *QueryNetworksResponse) GetNetworks() []Network {
+	if m != nil {
+		return m.Networks
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// EthProphecy queries an EthProphecy
-	EthProphecy(context.Context, *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error)
-	GetBlacklist(context.Context, *QueryBlacklistRequest) (*QueryBlacklistResponse, error)
+type QueryNetworkPeggyTokensRequest struct {
+	EthereumChainId int64 `protobuf:"varint,1,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryNetworkPeggyTokensRequest) Reset()         { *m = QueryNetworkPeggyTokensRequest{} }
+func (m *QueryNetworkPeggyTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkPeggyTokensRequest) ProtoMessage()    {}
+func (*QueryNetworkPeggyTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{6}
 }
-
-func (*UnimplementedQueryServer) EthProphecy(ctx context.Context, req *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthProphecy not implemented")
+func (m *QueryNetworkPeggyTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetBlacklist(ctx context.Context, req *QueryBlacklistRequest) (*QueryBlacklistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlacklist not implemented")
+func (m *QueryNetworkPeggyTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkPeggyTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryNetworkPeggyTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkPeggyTokensRequest.Merge(m, src)
+}
+func (m *QueryNetworkPeggyTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkPeggyTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkPeggyTokensRequest.DiscardUnknown(m)
 }
 
-func _Query_EthProphecy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEthProphecyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EthProphecy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Query/EthProphecy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EthProphecy(ctx, req.(*QueryEthProphecyRequest))
+var xxx_messageInfo_QueryNetworkPeggyTokensRequest proto.InternalMessageInfo
+
+func (m *QueryNetworkPeggyTokensRequest) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlacklistRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBlacklist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Query/GetBlacklist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBlacklist(ctx, req.(*QueryBlacklistRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryNetworkPeggyTokensResponse struct {
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sifnode.ethbridge.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EthProphecy",
-			Handler:    _Query_EthProphecy_Handler,
-		},
-		{
-			MethodName: "GetBlacklist",
-			Handler:    _Query_GetBlacklist_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sifnode/ethbridge/v1/query.proto",
+func (m *QueryNetworkPeggyTokensResponse) Reset()         { *m = QueryNetworkPeggyTokensResponse{} }
+func (m *QueryNetworkPeggyTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkPeggyTokensResponse) ProtoMessage()    {}
+func (*QueryNetworkPeggyTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{7}
+}
+func (m *QueryNetworkPeggyTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkPeggyTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkPeggyTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkPeggyTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkPeggyTokensResponse.Merge(m, src)
+}
+func (m *QueryNetworkPeggyTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkPeggyTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkPeggyTokensResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEthProphecyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNetworkPeggyTokensResponse proto.InternalMessageInfo
+
+func (m *QueryNetworkPeggyTokensResponse) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEthProphecyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTransferUsageRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryEthProphecyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumSender) > 0 {
-		i -= len(m.EthereumSender)
-		copy(dAtA[i:], m.EthereumSender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSender)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TokenContractAddress) > 0 {
-		i -= len(m.TokenContractAddress)
-		copy(dAtA[i:], m.TokenContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContractAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BridgeContractAddress) > 0 {
-		i -= len(m.BridgeContractAddress)
-		copy(dAtA[i:], m.BridgeContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EthereumChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EthereumChainId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryTransferUsageRequest) Reset()         { *m = QueryTransferUsageRequest{} }
+func (m *QueryTransferUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferUsageRequest) ProtoMessage()    {}
+func (*QueryTransferUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{8}
+}
+func (m *QueryTransferUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTransferUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferUsageRequest.Merge(m, src)
+}
+func (m *QueryTransferUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferUsageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEthProphecyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferUsageRequest proto.InternalMessageInfo
+
+func (m *QueryTransferUsageRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEthProphecyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTransferUsageResponse struct {
+	Usage TransferUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+	// rolling_amount is the volume counted against the window transfer limit at
+	// the queried height
+	RollingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=rolling_amount,json=rollingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rolling_amount"`
+	Height        int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryEthProphecyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Claims) > 0 {
-		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryTransferUsageResponse) Reset()         { *m = QueryTransferUsageResponse{} }
+func (m *QueryTransferUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferUsageResponse) ProtoMessage()    {}
+func (*QueryTransferUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{9}
+}
+func (m *QueryTransferUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryTransferUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferUsageResponse.Merge(m, src)
+}
+func (m *QueryTransferUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferUsageResponse proto.InternalMessageInfo
+
+func (m *QueryTransferUsageResponse) GetUsage() TransferUsage {
+	if m != nil {
+		return m.Usage
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return TransferUsage{}
+}
+
+func (m *QueryTransferUsageResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBlacklistRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type QueryPausesRequest struct {
+}
+
+func (m *QueryPausesRequest) Reset()         { *m = QueryPausesRequest{} }
+func (m *QueryPausesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausesRequest) ProtoMessage()    {}
+func (*QueryPausesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{10}
+}
+func (m *QueryPausesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPausesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausesRequest.Merge(m, src)
+}
+func (m *QueryPausesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlacklistRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPausesRequest proto.InternalMessageInfo
+
+type QueryPausesResponse struct {
+	Pauses []Pause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses"`
 }
 
-func (m *QueryBlacklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPausesResponse) Reset()         { *m = QueryPausesResponse{} }
+func (m *QueryPausesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausesResponse) ProtoMessage()    {}
+func (*QueryPausesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{11}
+}
+func (m *QueryPausesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausesResponse.Merge(m, src)
+}
+func (m *QueryPausesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlacklistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPausesResponse proto.InternalMessageInfo
+
+func (m *QueryPausesResponse) GetPauses() []Pause {
+	if m != nil {
+		return m.Pauses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOutboundTransfersRequest struct {
+	CosmosSender string             `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOutboundTransfersRequest) Reset()         { *m = QueryOutboundTransfersRequest{} }
+func (m *QueryOutboundTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransfersRequest) ProtoMessage()    {}
+func (*QueryOutboundTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{12}
+}
+func (m *QueryOutboundTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOutboundTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransfersRequest.Merge(m, src)
+}
+func (m *QueryOutboundTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransfersRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryOutboundTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryOutboundTransfersRequest) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryEthProphecyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryOutboundTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.EthereumChainId != 0 {
-		n += 1 + sovQuery(uint64(m.EthereumChainId))
+	return nil
+}
+
+type QueryOutboundTransfersResponse struct {
+	Transfers  []OutboundTransfer  `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutboundTransfersResponse) Reset()         { *m = QueryOutboundTransfersResponse{} }
+func (m *QueryOutboundTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransfersResponse) ProtoMessage()    {}
+func (*QueryOutboundTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{13}
+}
+func (m *QueryOutboundTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.BridgeContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryOutboundTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransfersResponse.Merge(m, src)
+}
+func (m *QueryOutboundTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutboundTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryOutboundTransfersResponse) GetTransfers() []OutboundTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	return nil
+}
+
+func (m *QueryOutboundTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryOutboundTransferRequest struct {
+	CosmosSender         string `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	CosmosSenderSequence uint64 `protobuf:"varint,2,opt,name=cosmos_sender_sequence,json=cosmosSenderSequence,proto3" json:"cosmos_sender_sequence,omitempty"`
+}
+
+func (m *QueryOutboundTransferRequest) Reset()         { *m = QueryOutboundTransferRequest{} }
+func (m *QueryOutboundTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransferRequest) ProtoMessage()    {}
+func (*QueryOutboundTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{14}
+}
+func (m *QueryOutboundTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.TokenContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryOutboundTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransferRequest.Merge(m, src)
+}
+func (m *QueryOutboundTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutboundTransferRequest proto.InternalMessageInfo
+
+func (m *QueryOutboundTransferRequest) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	l = len(m.EthereumSender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryOutboundTransferRequest) GetCosmosSenderSequence() uint64 {
+	if m != nil {
+		return m.CosmosSenderSequence
 	}
-	return n
+	return 0
 }
 
-func (m *QueryEthProphecyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryOutboundTransferResponse struct {
+	Transfer OutboundTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
+}
+
+func (m *QueryOutboundTransferResponse) Reset()         { *m = QueryOutboundTransferResponse{} }
+func (m *QueryOutboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransferResponse) ProtoMessage()    {}
+func (*QueryOutboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{15}
+}
+func (m *QueryOutboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryOutboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransferResponse.Merge(m, src)
+}
+func (m *QueryOutboundTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutboundTransferResponse proto.InternalMessageInfo
+
+func (m *QueryOutboundTransferResponse) GetTransfer() OutboundTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return OutboundTransfer{}
+}
+
+type QueryUnclaimedInboundsRequest struct {
+	// cosmos_receiver filters the records by receiver when set
+	CosmosReceiver string             `protobuf:"bytes,1,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnclaimedInboundsRequest) Reset()         { *m = QueryUnclaimedInboundsRequest{} }
+func (m *QueryUnclaimedInboundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnclaimedInboundsRequest) ProtoMessage()    {}
+func (*QueryUnclaimedInboundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{16}
+}
+func (m *QueryUnclaimedInboundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnclaimedInboundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnclaimedInboundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnclaimedInboundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnclaimedInboundsRequest.Merge(m, src)
+}
+func (m *QueryUnclaimedInboundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnclaimedInboundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnclaimedInboundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnclaimedInboundsRequest proto.InternalMessageInfo
+
+func (m *QueryUnclaimedInboundsRequest) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *QueryUnclaimedInboundsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryUnclaimedInboundsResponse struct {
+	UnclaimedInbounds []UnclaimedInbound  `protobuf:"bytes,1,rep,name=unclaimed_inbounds,json=unclaimedInbounds,proto3" json:"unclaimed_inbounds"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnclaimedInboundsResponse) Reset()         { *m = QueryUnclaimedInboundsResponse{} }
+func (m *QueryUnclaimedInboundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnclaimedInboundsResponse) ProtoMessage()    {}
+func (*QueryUnclaimedInboundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{17}
+}
+func (m *QueryUnclaimedInboundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnclaimedInboundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnclaimedInboundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnclaimedInboundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnclaimedInboundsResponse.Merge(m, src)
+}
+func (m *QueryUnclaimedInboundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnclaimedInboundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnclaimedInboundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnclaimedInboundsResponse proto.InternalMessageInfo
+
+func (m *QueryUnclaimedInboundsResponse) GetUnclaimedInbounds() []UnclaimedInbound {
+	if m != nil {
+		return m.UnclaimedInbounds
+	}
+	return nil
+}
+
+func (m *QueryUnclaimedInboundsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBlacklistEntriesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlacklistEntriesRequest) Reset()         { *m = QueryBlacklistEntriesRequest{} }
+func (m *QueryBlacklistEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlacklistEntriesRequest) ProtoMessage()    {}
+func (*QueryBlacklistEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{18}
+}
+func (m *QueryBlacklistEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlacklistEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlacklistEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlacklistEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlacklistEntriesRequest.Merge(m, src)
+}
+func (m *QueryBlacklistEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlacklistEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlacklistEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlacklistEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryBlacklistEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBlacklistEntriesResponse struct {
+	Entries    []BlacklistEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlacklistEntriesResponse) Reset()         { *m = QueryBlacklistEntriesResponse{} }
+func (m *QueryBlacklistEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlacklistEntriesResponse) ProtoMessage()    {}
+func (*QueryBlacklistEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{19}
+}
+func (m *QueryBlacklistEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlacklistEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlacklistEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlacklistEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlacklistEntriesResponse.Merge(m, src)
+}
+func (m *QueryBlacklistEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlacklistEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlacklistEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlacklistEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryBlacklistEntriesResponse) GetEntries() []BlacklistEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryBlacklistEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCrossChainFeeRequest struct {
+	EthereumChainId int64 `protobuf:"varint,1,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty"`
+}
+
+func (m *QueryCrossChainFeeRequest) Reset()         { *m = QueryCrossChainFeeRequest{} }
+func (m *QueryCrossChainFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainFeeRequest) ProtoMessage()    {}
+func (*QueryCrossChainFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{20}
+}
+func (m *QueryCrossChainFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossChainFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossChainFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainFeeRequest.Merge(m, src)
+}
+func (m *QueryCrossChainFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossChainFeeRequest proto.InternalMessageInfo
+
+func (m *QueryCrossChainFeeRequest) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
+type QueryCrossChainFeeResponse struct {
+	NativeToken string `protobuf:"bytes,1,opt,name=native_token,json=nativeToken,proto3" json:"native_token,omitempty"`
+	// gas_price is the median of the recent gas price reports
+	GasPrice    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price"`
+	OutboundGas uint64                                 `protobuf:"varint,3,opt,name=outbound_gas,json=outboundGas,proto3" json:"outbound_gas,omitempty"`
+	// min_ceth_amount is the smallest ceth_amount of a lock or burn to the
+	// network, in the native token
+	MinCethAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_ceth_amount,json=minCethAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_ceth_amount"`
+	// reports is the number of recent gas price reports the median is taken of
+	Reports uint32 `protobuf:"varint,5,opt,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (m *QueryCrossChainFeeResponse) Reset()         { *m = QueryCrossChainFeeResponse{} }
+func (m *QueryCrossChainFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainFeeResponse) ProtoMessage()    {}
+func (*QueryCrossChainFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{21}
+}
+func (m *QueryCrossChainFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCrossChainFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCrossChainFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCrossChainFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainFeeResponse.Merge(m, src)
+}
+func (m *QueryCrossChainFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCrossChainFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCrossChainFeeResponse proto.InternalMessageInfo
+
+func (m *QueryCrossChainFeeResponse) GetNativeToken() string {
+	if m != nil {
+		return m.NativeToken
+	}
+	return ""
+}
+
+func (m *QueryCrossChainFeeResponse) GetOutboundGas() uint64 {
+	if m != nil {
+		return m.OutboundGas
+	}
+	return 0
+}
+
+func (m *QueryCrossChainFeeResponse) GetReports() uint32 {
+	if m != nil {
+		return m.Reports
+	}
+	return 0
+}
+
+type QueryNftClassesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNftClassesRequest) Reset()         { *m = QueryNftClassesRequest{} }
+func (m *QueryNftClassesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNftClassesRequest) ProtoMessage()    {}
+func (*QueryNftClassesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{22}
+}
+func (m *QueryNftClassesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNftClassesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNftClassesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNftClassesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNftClassesRequest.Merge(m, src)
+}
+func (m *QueryNftClassesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNftClassesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNftClassesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNftClassesRequest proto.InternalMessageInfo
+
+func (m *QueryNftClassesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryNftClassesResponse struct {
+	Classes    []NftClass          `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNftClassesResponse) Reset()         { *m = QueryNftClassesResponse{} }
+func (m *QueryNftClassesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNftClassesResponse) ProtoMessage()    {}
+func (*QueryNftClassesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{23}
+}
+func (m *QueryNftClassesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNftClassesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNftClassesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNftClassesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNftClassesResponse.Merge(m, src)
+}
+func (m *QueryNftClassesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNftClassesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNftClassesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNftClassesResponse proto.InternalMessageInfo
+
+func (m *QueryNftClassesResponse) GetClasses() []NftClass {
+	if m != nil {
+		return m.Classes
+	}
+	return nil
+}
+
+func (m *QueryNftClassesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryNftsRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// owner filters the NFTs by owner when set
+	Owner      string             `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNftsRequest) Reset()         { *m = QueryNftsRequest{} }
+func (m *QueryNftsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNftsRequest) ProtoMessage()    {}
+func (*QueryNftsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{24}
+}
+func (m *QueryNftsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNftsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNftsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNftsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNftsRequest.Merge(m, src)
+}
+func (m *QueryNftsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNftsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNftsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNftsRequest proto.InternalMessageInfo
+
+func (m *QueryNftsRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryNftsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryNftsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryNftsResponse struct {
+	Nfts       []Nft               `protobuf:"bytes,1,rep,name=nfts,proto3" json:"nfts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNftsResponse) Reset()         { *m = QueryNftsResponse{} }
+func (m *QueryNftsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNftsResponse) ProtoMessage()    {}
+func (*QueryNftsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{25}
+}
+func (m *QueryNftsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNftsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNftsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNftsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNftsResponse.Merge(m, src)
+}
+func (m *QueryNftsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNftsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNftsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNftsResponse proto.InternalMessageInfo
+
+func (m *QueryNftsResponse) GetNfts() []Nft {
+	if m != nil {
+		return m.Nfts
+	}
+	return nil
+}
+
+func (m *QueryNftsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAdminRoleHoldersRequest struct {
+	// role is the admin role to list the holders of, unspecified for all roles
+	Role       AdminRole          `protobuf:"varint,1,opt,name=role,proto3,enum=sifnode.ethbridge.v1.AdminRole" json:"role,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAdminRoleHoldersRequest) Reset()         { *m = QueryAdminRoleHoldersRequest{} }
+func (m *QueryAdminRoleHoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminRoleHoldersRequest) ProtoMessage()    {}
+func (*QueryAdminRoleHoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{26}
+}
+func (m *QueryAdminRoleHoldersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminRoleHoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminRoleHoldersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminRoleHoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminRoleHoldersRequest.Merge(m, src)
+}
+func (m *QueryAdminRoleHoldersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminRoleHoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminRoleHoldersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminRoleHoldersRequest proto.InternalMessageInfo
+
+func (m *QueryAdminRoleHoldersRequest) GetRole() AdminRole {
+	if m != nil {
+		return m.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+func (m *QueryAdminRoleHoldersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAdminRoleHoldersResponse struct {
+	Grants     []AdminRoleGrant    `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAdminRoleHoldersResponse) Reset()         { *m = QueryAdminRoleHoldersResponse{} }
+func (m *QueryAdminRoleHoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminRoleHoldersResponse) ProtoMessage()    {}
+func (*QueryAdminRoleHoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{27}
+}
+func (m *QueryAdminRoleHoldersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminRoleHoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminRoleHoldersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminRoleHoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminRoleHoldersResponse.Merge(m, src)
+}
+func (m *QueryAdminRoleHoldersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminRoleHoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminRoleHoldersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminRoleHoldersResponse proto.InternalMessageInfo
+
+func (m *QueryAdminRoleHoldersResponse) GetGrants() []AdminRoleGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryAdminRoleHoldersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryClaimRecordsRequest struct {
+	// cosmos_receiver is an sdk.AccAddress, empty for all receivers
+	CosmosReceiver string `protobuf:"bytes,1,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	// ethereum_sender is an EthereumAddress, empty for all senders
+	EthereumSender string `protobuf:"bytes,2,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	// status is unspecified for all statuses
+	Status     types.StatusText   `protobuf:"varint,3,opt,name=status,proto3,enum=sifnode.oracle.v1.StatusText" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClaimRecordsRequest) Reset()         { *m = QueryClaimRecordsRequest{} }
+func (m *QueryClaimRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRecordsRequest) ProtoMessage()    {}
+func (*QueryClaimRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{28}
+}
+func (m *QueryClaimRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRecordsRequest.Merge(m, src)
+}
+func (m *QueryClaimRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimRecordsRequest) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *QueryClaimRecordsRequest) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *QueryClaimRecordsRequest) GetStatus() types.StatusText {
+	if m != nil {
+		return m.Status
+	}
+	return types.StatusText_STATUS_TEXT_UNSPECIFIED
+}
+
+func (m *QueryClaimRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryClaimRecordsResponse struct {
+	Records    []ClaimRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClaimRecordsResponse) Reset()         { *m = QueryClaimRecordsResponse{} }
+func (m *QueryClaimRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRecordsResponse) ProtoMessage()    {}
+func (*QueryClaimRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{29}
+}
+func (m *QueryClaimRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRecordsResponse.Merge(m, src)
+}
+func (m *QueryClaimRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimRecordsResponse) GetRecords() []ClaimRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryClaimRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryEthProphecyRequest)(nil), "sifnode.ethbridge.v1.QueryEthProphecyRequest")
+	proto.RegisterType((*QueryEthProphecyResponse)(nil), "sifnode.ethbridge.v1.QueryEthProphecyResponse")
+	proto.RegisterType((*QueryBlacklistRequest)(nil), "sifnode.ethbridge.v1.QueryBlacklistRequest")
+	proto.RegisterType((*QueryBlacklistResponse)(nil), "sifnode.ethbridge.v1.QueryBlacklistResponse")
+	proto.RegisterType((*QueryNetworksRequest)(nil), "sifnode.ethbridge.v1.QueryNetworksRequest")
+	proto.RegisterType((*QueryNetworksResponse)(nil), "sifnode.ethbridge.v1.QueryNetworksResponse")
+	proto.RegisterType((*QueryNetworkPeggyTokensRequest)(nil), "sifnode.ethbridge.v1.QueryNetworkPeggyTokensRequest")
+	proto.RegisterType((*QueryNetworkPeggyTokensResponse)(nil), "sifnode.ethbridge.v1.QueryNetworkPeggyTokensResponse")
+	proto.RegisterType((*QueryTransferUsageRequest)(nil), "sifnode.ethbridge.v1.QueryTransferUsageRequest")
+	proto.RegisterType((*QueryTransferUsageResponse)(nil), "sifnode.ethbridge.v1.QueryTransferUsageResponse")
+	proto.RegisterType((*QueryPausesRequest)(nil), "sifnode.ethbridge.v1.QueryPausesRequest")
+	proto.RegisterType((*QueryPausesResponse)(nil), "sifnode.ethbridge.v1.QueryPausesResponse")
+	proto.RegisterType((*QueryOutboundTransfersRequest)(nil), "sifnode.ethbridge.v1.QueryOutboundTransfersRequest")
+	proto.RegisterType((*QueryOutboundTransfersResponse)(nil), "sifnode.ethbridge.v1.QueryOutboundTransfersResponse")
+	proto.RegisterType((*QueryOutboundTransferRequest)(nil), "sifnode.ethbridge.v1.QueryOutboundTransferRequest")
+	proto.RegisterType((*QueryOutboundTransferResponse)(nil), "sifnode.ethbridge.v1.QueryOutboundTransferResponse")
+	proto.RegisterType((*QueryUnclaimedInboundsRequest)(nil), "sifnode.ethbridge.v1.QueryUnclaimedInboundsRequest")
+	proto.RegisterType((*QueryUnclaimedInboundsResponse)(nil), "sifnode.ethbridge.v1.QueryUnclaimedInboundsResponse")
+	proto.RegisterType((*QueryBlacklistEntriesRequest)(nil), "sifnode.ethbridge.v1.QueryBlacklistEntriesRequest")
+	proto.RegisterType((*QueryBlacklistEntriesResponse)(nil), "sifnode.ethbridge.v1.QueryBlacklistEntriesResponse")
+	proto.RegisterType((*QueryCrossChainFeeRequest)(nil), "sifnode.ethbridge.v1.QueryCrossChainFeeRequest")
+	proto.RegisterType((*QueryCrossChainFeeResponse)(nil), "sifnode.ethbridge.v1.QueryCrossChainFeeResponse")
+	proto.RegisterType((*QueryNftClassesRequest)(nil), "sifnode.ethbridge.v1.QueryNftClassesRequest")
+	proto.RegisterType((*QueryNftClassesResponse)(nil), "sifnode.ethbridge.v1.QueryNftClassesResponse")
+	proto.RegisterType((*QueryNftsRequest)(nil), "sifnode.ethbridge.v1.QueryNftsRequest")
+	proto.RegisterType((*QueryNftsResponse)(nil), "sifnode.ethbridge.v1.QueryNftsResponse")
+	proto.RegisterType((*QueryAdminRoleHoldersRequest)(nil), "sifnode.ethbridge.v1.QueryAdminRoleHoldersRequest")
+	proto.RegisterType((*QueryAdminRoleHoldersResponse)(nil), "sifnode.ethbridge.v1.QueryAdminRoleHoldersResponse")
+	proto.RegisterType((*QueryClaimRecordsRequest)(nil), "sifnode.ethbridge.v1.QueryClaimRecordsRequest")
+	proto.RegisterType((*QueryClaimRecordsResponse)(nil), "sifnode.ethbridge.v1.QueryClaimRecordsResponse")
+}
+
+func init() { proto.RegisterFile("sifnode/ethbridge/v1/query.proto", fileDescriptor_7077edcf9f792b78) }
+
+var fileDescriptor_7077edcf9f792b78 = []byte{
+	// 1619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0x24, 0x8e, 0x93, 0x1c, 0x37, 0x49, 0x3b, 0x75, 0x12, 0x67, 0x5e, 0xe3, 0xa4, 0xd3,
+	0xa7, 0x24, 0xaf, 0x7d, 0xb1, 0x9b, 0x3f, 0x7d, 0x52, 0x9f, 0x10, 0x28, 0x09, 0xad, 0x1b, 0x40,
+	0x25, 0x4c, 0x5a, 0x84, 0x00, 0xc9, 0x8c, 0xc7, 0x37, 0xf6, 0x28, 0xf6, 0x8c, 0x3b, 0xf7, 0x3a,
+	0xad, 0x77, 0x48, 0x48, 0xdd, 0xc0, 0xa2, 0xac, 0xbb, 0x82, 0x0d, 0x1b, 0xc4, 0x0e, 0xf1, 0x0d,
+	0x50, 0x17, 0x2c, 0xba, 0x44, 0x2c, 0x2a, 0xd4, 0x2e, 0xd9, 0xf1, 0x09, 0xd0, 0xdc, 0x7b, 0xee,
+	0xc4, 0x1e, 0x8f, 0x1d, 0xdb, 0xf2, 0x2a, 0x99, 0x33, 0xe7, 0x77, 0xfe, 0xfc, 0xce, 0x99, 0x7b,
+	0xcf, 0x31, 0xac, 0x50, 0xfb, 0xd8, 0x71, 0x8b, 0x24, 0x4b, 0x58, 0xb9, 0xe0, 0xd9, 0xc5, 0x12,
+	0xc9, 0x9e, 0x6e, 0x66, 0x1f, 0xd5, 0x89, 0xd7, 0xc8, 0xd4, 0x3c, 0x97, 0xb9, 0x6a, 0x12, 0x35,
+	0x32, 0x81, 0x46, 0xe6, 0x74, 0x53, 0x4b, 0x96, 0xdc, 0x92, 0xcb, 0x15, 0xb2, 0xfe, 0x7f, 0x42,
+	0x57, 0xbb, 0x6e, 0xb9, 0xb4, 0xea, 0xd2, 0x6c, 0xc1, 0xa4, 0x44, 0x18, 0xc9, 0x9e, 0x6e, 0x16,
+	0x08, 0x33, 0x37, 0xb3, 0x35, 0xb3, 0x64, 0x3b, 0x26, 0xb3, 0x5d, 0x07, 0x75, 0xa3, 0x3d, 0xb3,
+	0x46, 0x8d, 0x50, 0xd4, 0x58, 0x92, 0x1a, 0xae, 0x67, 0x5a, 0x95, 0xf0, 0x6b, 0xfd, 0x97, 0x51,
+	0x58, 0xf8, 0xc8, 0xf7, 0x71, 0x87, 0x95, 0x0f, 0x3d, 0xb7, 0x56, 0x26, 0x56, 0xc3, 0x20, 0x8f,
+	0xea, 0x84, 0x32, 0xf5, 0x3a, 0x5c, 0x22, 0xac, 0x4c, 0x3c, 0x52, 0xaf, 0xe6, 0xad, 0xb2, 0x69,
+	0x3b, 0x79, 0xbb, 0x98, 0x52, 0x56, 0x94, 0xf5, 0x31, 0x63, 0x56, 0xbe, 0xd8, 0xf7, 0xe5, 0x07,
+	0x45, 0xd5, 0x82, 0x05, 0xe1, 0x3f, 0x6f, 0xb9, 0x0e, 0xf3, 0x4c, 0x8b, 0xe5, 0xcd, 0x62, 0xd1,
+	0x23, 0x94, 0xa6, 0x46, 0x57, 0x94, 0xf5, 0xa9, 0xbd, 0x1b, 0x7f, 0xbf, 0x5a, 0x5e, 0x6b, 0x98,
+	0xd5, 0xca, 0xff, 0x75, 0x54, 0xf4, 0x48, 0xc9, 0xa6, 0xcc, 0x6b, 0xb4, 0x21, 0x74, 0x63, 0x4e,
+	0xa8, 0xec, 0xe3, 0x8b, 0x5d, 0x21, 0x57, 0x93, 0x30, 0xee, 0xb8, 0x8e, 0x45, 0x52, 0x63, 0x3c,
+	0x08, 0xf1, 0xa0, 0xce, 0x43, 0x9c, 0x36, 0xaa, 0x05, 0xb7, 0x92, 0x8a, 0xf9, 0x9e, 0x0c, 0x7c,
+	0x52, 0x77, 0x60, 0x9e, 0xb9, 0x27, 0xc4, 0x69, 0x8f, 0x68, 0x9c, 0xeb, 0x25, 0xf9, 0xdb, 0xb0,
+	0x8f, 0x35, 0x08, 0x72, 0xcb, 0x53, 0xe2, 0x14, 0x89, 0x97, 0x8a, 0x73, 0xf5, 0x19, 0x29, 0x3e,
+	0xe2, 0x52, 0xfd, 0xb9, 0x02, 0xa9, 0x76, 0xe6, 0x68, 0xcd, 0x75, 0x28, 0x51, 0x67, 0x60, 0x14,
+	0xb9, 0x9a, 0x32, 0x46, 0xed, 0xa2, 0xba, 0x09, 0x71, 0xca, 0x4c, 0x56, 0x17, 0x6c, 0x24, 0xb6,
+	0x16, 0x33, 0xb2, 0x21, 0x44, 0x59, 0x32, 0xa7, 0x9b, 0x99, 0x23, 0xae, 0x60, 0xa0, 0xa2, 0xfa,
+	0x16, 0xc4, 0xad, 0x8a, 0x69, 0x57, 0x69, 0x6a, 0x6c, 0x65, 0x6c, 0x3d, 0xb1, 0xf5, 0xef, 0x4c,
+	0x54, 0x0f, 0x65, 0xee, 0xb0, 0xf2, 0x9e, 0x20, 0xcb, 0x57, 0x36, 0x10, 0xa3, 0x2f, 0xc0, 0x1c,
+	0x0f, 0x6e, 0xaf, 0x62, 0x5a, 0x27, 0x15, 0x9b, 0x32, 0x2c, 0xaa, 0xfe, 0x3f, 0x98, 0x0f, 0xbf,
+	0xc0, 0x98, 0xaf, 0xc0, 0x14, 0x12, 0x44, 0x68, 0x4a, 0x59, 0x19, 0x5b, 0x9f, 0x32, 0xce, 0x04,
+	0xfa, 0x3c, 0x24, 0x39, 0xee, 0x3e, 0x61, 0x8f, 0x5d, 0xef, 0x84, 0x4a, 0x7b, 0x9f, 0xc0, 0x5c,
+	0x48, 0x8e, 0xe6, 0xde, 0x81, 0x49, 0x07, 0x65, 0xdc, 0x5a, 0x62, 0x6b, 0x29, 0x3a, 0x03, 0x44,
+	0xee, 0xc5, 0x5e, 0xbc, 0x5a, 0x1e, 0x31, 0x02, 0x90, 0xfe, 0x01, 0xa4, 0x9b, 0x2d, 0x1f, 0x92,
+	0x52, 0xa9, 0xf1, 0xc0, 0x2f, 0x19, 0x1d, 0xa0, 0x41, 0xf5, 0xdb, 0xb0, 0xdc, 0xd1, 0x1a, 0x46,
+	0x3c, 0x0f, 0x71, 0xde, 0x12, 0x32, 0x7b, 0x7c, 0xd2, 0x37, 0x61, 0x91, 0x43, 0x1f, 0x78, 0xa6,
+	0x43, 0x8f, 0x89, 0xf7, 0x90, 0x9a, 0x25, 0x22, 0x63, 0x48, 0xc2, 0x78, 0x91, 0x38, 0x6e, 0x15,
+	0x8b, 0x2d, 0x1e, 0xf4, 0xdf, 0x14, 0xd0, 0xa2, 0x30, 0x01, 0x37, 0xe3, 0x75, 0x5f, 0xc0, 0x41,
+	0x89, 0xad, 0x6b, 0xd1, 0xc4, 0xb4, 0x60, 0x91, 0x1e, 0x81, 0x53, 0x1f, 0xc2, 0x8c, 0xe7, 0x56,
+	0x2a, 0xb6, 0x53, 0xca, 0x9b, 0x55, 0xb7, 0xee, 0x30, 0xfc, 0xca, 0x32, 0xbe, 0xd2, 0x1f, 0xaf,
+	0x96, 0x57, 0x4b, 0x36, 0x2b, 0xd7, 0x0b, 0x19, 0xcb, 0xad, 0x66, 0xf1, 0x38, 0x11, 0x7f, 0x36,
+	0x68, 0xf1, 0x04, 0x0f, 0x80, 0x03, 0x87, 0x19, 0xd3, 0x68, 0x65, 0x97, 0x1b, 0xf1, 0x19, 0x28,
+	0x13, 0xbb, 0x54, 0x66, 0xf8, 0x85, 0xe1, 0x93, 0x9e, 0x04, 0x95, 0x67, 0x73, 0x68, 0xd6, 0x29,
+	0x09, 0x4a, 0x7f, 0x08, 0x97, 0x5b, 0xa4, 0x98, 0xdc, 0x6d, 0x88, 0xd7, 0xb8, 0x04, 0xcb, 0xfe,
+	0xaf, 0xe8, 0xec, 0x38, 0x0a, 0xb3, 0x42, 0x80, 0xfe, 0x8d, 0x02, 0x4b, 0xdc, 0xe4, 0x87, 0x75,
+	0x56, 0x70, 0xeb, 0x4e, 0x51, 0x52, 0x10, 0x94, 0xfc, 0x1a, 0x4c, 0x8b, 0x44, 0xe4, 0xc7, 0x29,
+	0x68, 0xbf, 0x20, 0x84, 0xe2, 0xd3, 0x54, 0xef, 0x02, 0x9c, 0x9d, 0x94, 0xf8, 0xc5, 0xad, 0x66,
+	0x84, 0x4a, 0xc6, 0x3f, 0x56, 0x33, 0xe2, 0x6c, 0xc6, 0x63, 0x35, 0x73, 0x78, 0x56, 0x4f, 0xa3,
+	0x09, 0xa9, 0xff, 0xac, 0x40, 0xba, 0x53, 0x38, 0x98, 0xec, 0x7b, 0x30, 0xc5, 0xa4, 0x10, 0xf3,
+	0x5d, 0x8d, 0xce, 0x37, 0x6c, 0x03, 0x53, 0x3f, 0x83, 0xab, 0xb9, 0x88, 0xb0, 0xd7, 0xce, 0x0d,
+	0x5b, 0x04, 0xd2, 0x12, 0x77, 0x03, 0xae, 0x44, 0x86, 0xdd, 0x17, 0x89, 0x3b, 0x30, 0xdf, 0xa2,
+	0x94, 0xa7, 0x3e, 0xda, 0x3f, 0x7d, 0xfd, 0xc8, 0x62, 0x46, 0xb2, 0x59, 0xfb, 0x08, 0xdf, 0xe9,
+	0x76, 0x87, 0x02, 0x06, 0x84, 0xdd, 0x83, 0x49, 0x99, 0x31, 0x76, 0x7f, 0x7f, 0x7c, 0x05, 0x68,
+	0xfd, 0x99, 0x6c, 0x96, 0x87, 0x0e, 0x3f, 0xf4, 0x48, 0xf1, 0xc0, 0xe1, 0x88, 0xa0, 0x59, 0xd6,
+	0x60, 0x16, 0x53, 0xf0, 0x88, 0x45, 0xec, 0xd3, 0x20, 0xd3, 0x19, 0x21, 0x36, 0x50, 0x3a, 0xb4,
+	0x86, 0xf9, 0x55, 0x36, 0x4c, 0x44, 0x48, 0x98, 0xff, 0x67, 0xa0, 0xd6, 0xe5, 0xcb, 0xbc, 0x8d,
+	0x6f, 0xbb, 0x77, 0x4e, 0xd8, 0x18, 0x32, 0x71, 0xa9, 0x1e, 0x76, 0x32, 0xbc, 0x0e, 0x3a, 0x86,
+	0x2b, 0xad, 0xb7, 0xc4, 0x1d, 0x87, 0x79, 0x76, 0xf0, 0xe9, 0x87, 0x08, 0x53, 0x06, 0x26, 0xec,
+	0x27, 0x59, 0xc3, 0x76, 0x47, 0xc8, 0xd7, 0xbb, 0x30, 0x41, 0x84, 0x28, 0xa5, 0x74, 0xbb, 0x07,
+	0x5b, 0x0c, 0x34, 0x90, 0x22, 0x09, 0x1d, 0x1e, 0x31, 0x39, 0xbc, 0x0b, 0xf6, 0x3d, 0x97, 0x52,
+	0x7e, 0xb7, 0xdc, 0x25, 0x64, 0x90, 0xfb, 0xe8, 0xbb, 0x51, 0xd0, 0xa2, 0x2c, 0x61, 0xda, 0x57,
+	0xe1, 0x82, 0xef, 0xf1, 0x94, 0xe4, 0xf9, 0x25, 0x84, 0x7d, 0x9b, 0x10, 0x32, 0x7e, 0x6f, 0xa9,
+	0xef, 0xc3, 0x54, 0xc9, 0xa4, 0xf9, 0x9a, 0x67, 0x5b, 0x64, 0xc0, 0xe3, 0x7f, 0xb2, 0x64, 0xd2,
+	0x43, 0x1f, 0xef, 0xfb, 0x73, 0xf1, 0x83, 0xcb, 0x97, 0x4c, 0xca, 0xcf, 0xff, 0x98, 0x91, 0x90,
+	0xb2, 0x9c, 0x49, 0xd5, 0x8f, 0x61, 0xb6, 0x6a, 0x3b, 0x79, 0x8b, 0xb0, 0xb2, 0xbc, 0x74, 0x62,
+	0x83, 0x5d, 0x3a, 0x55, 0xdb, 0xd9, 0x27, 0xac, 0x8c, 0x97, 0x4e, 0x0a, 0x26, 0x3c, 0x52, 0x73,
+	0x3d, 0x26, 0x06, 0xb3, 0x69, 0x43, 0x3e, 0xea, 0x5f, 0xe0, 0xac, 0x72, 0xff, 0x98, 0xed, 0x57,
+	0x4c, 0x4a, 0x87, 0xdf, 0x7f, 0xdf, 0x2b, 0xb0, 0xd0, 0xe6, 0x02, 0x4b, 0xf0, 0x36, 0x4c, 0x58,
+	0x42, 0x84, 0x9d, 0x97, 0xee, 0x30, 0xbf, 0x20, 0x54, 0xf6, 0x1c, 0x82, 0x86, 0xd7, 0x73, 0x5f,
+	0x2b, 0x70, 0x51, 0x06, 0x19, 0x30, 0xb0, 0x08, 0x93, 0xdc, 0x51, 0x3e, 0x98, 0x33, 0x85, 0xe3,
+	0x83, 0xa2, 0x3f, 0x92, 0xb8, 0x8f, 0x1d, 0xe2, 0x89, 0xa6, 0x30, 0xc4, 0x43, 0x88, 0xb2, 0xb1,
+	0x81, 0x29, 0xfb, 0x56, 0x81, 0x4b, 0x4d, 0xd1, 0x20, 0x59, 0xdb, 0x10, 0x73, 0x8e, 0x99, 0x64,
+	0x6a, 0xb1, 0x23, 0x53, 0x48, 0x12, 0x57, 0x1e, 0x1e, 0x43, 0xcf, 0x15, 0x3c, 0xaf, 0x76, 0x8b,
+	0x55, 0xdb, 0x31, 0xdc, 0x0a, 0xb9, 0xe7, 0x56, 0x8a, 0x4d, 0x63, 0xc3, 0x36, 0xc4, 0x3c, 0xb7,
+	0x22, 0xe6, 0xad, 0x99, 0xad, 0xe5, 0xe8, 0xf0, 0x02, 0xb0, 0xc1, 0x95, 0x87, 0x76, 0x2b, 0xfc,
+	0x28, 0x0f, 0xb9, 0xf6, 0xe8, 0x90, 0xbd, 0x3d, 0x88, 0x97, 0x3c, 0xd3, 0x61, 0xe7, 0x9c, 0x71,
+	0x01, 0x3e, 0xe7, 0x2b, 0xcb, 0xd9, 0x49, 0x20, 0x87, 0x47, 0xe6, 0x5f, 0x72, 0xb1, 0x11, 0x1b,
+	0x05, 0xb1, 0x5c, 0x6f, 0x80, 0x2b, 0x35, 0x62, 0x8f, 0x1a, 0x8d, 0xda, 0xa3, 0xd4, 0x5b, 0xc1,
+	0x6a, 0x34, 0xc6, 0x8b, 0xb3, 0xd4, 0x71, 0x35, 0x7a, 0x40, 0x9e, 0xb0, 0x60, 0x3d, 0x6a, 0x2d,
+	0x4e, 0x6c, 0xe0, 0xe2, 0xfc, 0xa0, 0xc0, 0x62, 0x44, 0xb6, 0x58, 0x98, 0x5d, 0xff, 0x6c, 0xe2,
+	0x22, 0xac, 0xcc, 0xd5, 0xe8, 0xca, 0x34, 0x81, 0xe5, 0x31, 0x80, 0xb8, 0xa1, 0xd5, 0x65, 0xeb,
+	0xe9, 0x0c, 0x8c, 0xf3, 0x48, 0x55, 0x07, 0x12, 0x4d, 0x4b, 0xa7, 0xba, 0x11, 0x1d, 0x53, 0x87,
+	0xb5, 0x5e, 0xcb, 0xf4, 0xaa, 0x2e, 0x62, 0xd0, 0x47, 0xd4, 0x13, 0xb8, 0x90, 0x23, 0x2c, 0xb8,
+	0x61, 0xd5, 0x1b, 0x5d, 0x2c, 0x84, 0x17, 0x4e, 0xed, 0xbf, 0xbd, 0x29, 0x07, 0xce, 0xca, 0x90,
+	0xc8, 0x11, 0x26, 0xd7, 0x49, 0xf5, 0x7a, 0x17, 0x78, 0x68, 0x17, 0xd5, 0x6e, 0xf4, 0xa4, 0x1b,
+	0x78, 0x7a, 0xaa, 0xc0, 0xdc, 0x99, 0xab, 0xa6, 0x8d, 0x50, 0xdd, 0x39, 0xdf, 0x50, 0xfb, 0x3a,
+	0xaa, 0xdd, 0xea, 0x13, 0x15, 0x04, 0xf2, 0x18, 0x2e, 0xe6, 0x08, 0x6b, 0x59, 0xf7, 0xd4, 0x6c,
+	0x17, 0x63, 0x51, 0x8b, 0xa8, 0x76, 0xb3, 0x77, 0x40, 0xe0, 0xb8, 0x00, 0x53, 0x39, 0xc2, 0xc4,
+	0xfe, 0xa6, 0xae, 0x77, 0x31, 0xd0, 0xb2, 0xf8, 0x69, 0xff, 0xe9, 0x41, 0x33, 0xf0, 0xf1, 0x95,
+	0x02, 0xc9, 0x1c, 0x61, 0x6d, 0x2b, 0x94, 0xba, 0xdd, 0xc5, 0x4a, 0xa7, 0xfd, 0x4f, 0xdb, 0xe9,
+	0x0f, 0x14, 0x44, 0xf1, 0xa5, 0x02, 0x97, 0x23, 0xa2, 0x50, 0xb7, 0xfa, 0xb0, 0x27, 0x63, 0xd8,
+	0xee, 0x0b, 0x13, 0x26, 0xa2, 0x6d, 0x35, 0xe8, 0x4a, 0x44, 0xa7, 0xdd, 0x46, 0xdb, 0xe9, 0x0f,
+	0x14, 0x26, 0x22, 0x3c, 0x6f, 0x77, 0x25, 0xa2, 0xc3, 0x16, 0xa0, 0x6d, 0xf7, 0x85, 0x09, 0xb5,
+	0x7b, 0xcb, 0xdc, 0xdb, 0xb5, 0xdd, 0xa3, 0x66, 0x6d, 0xed, 0x66, 0xef, 0x80, 0xc0, 0xb1, 0x03,
+	0xd3, 0xfe, 0xf7, 0x1e, 0x8c, 0x7a, 0x6a, 0xb7, 0xb3, 0xa9, 0x6d, 0xe8, 0xd4, 0x36, 0x7a, 0xd4,
+	0x0e, 0xfc, 0x7d, 0x0e, 0x13, 0xc2, 0x1f, 0x55, 0x57, 0xbb, 0x63, 0x03, 0x1f, 0x6b, 0xe7, 0xea,
+	0x85, 0x2b, 0x19, 0x1e, 0x2a, 0xba, 0x56, 0xb2, 0xc3, 0x7c, 0xa4, 0x6d, 0xf7, 0x85, 0x09, 0x42,
+	0x60, 0x30, 0xeb, 0x57, 0xb2, 0xe9, 0xe6, 0x54, 0xbb, 0xdd, 0x2e, 0x11, 0x03, 0x85, 0x96, 0xed,
+	0x59, 0x5f, 0x7a, 0xdd, 0xcb, 0xbd, 0x78, 0x9d, 0x56, 0x5e, 0xbe, 0x4e, 0x2b, 0x7f, 0xbe, 0x4e,
+	0x2b, 0xcf, 0xde, 0xa4, 0x47, 0x5e, 0xbe, 0x49, 0x8f, 0xfc, 0xfe, 0x26, 0x3d, 0xf2, 0xe9, 0x46,
+	0xd3, 0x06, 0x72, 0x64, 0x1f, 0xf3, 0x1d, 0x2c, 0x2b, 0x7f, 0x00, 0x7f, 0xd2, 0xf4, 0x23, 0x39,
+	0x5f, 0x46, 0x0a, 0x71, 0xfe, 0x1b, 0xf8, 0xf6, 0x3f, 0x03, 0x00, 0xc8, 0x18, 0xea, 0x1f, 0xc0,
+	0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// EthProphecy queries an EthProphecy
+	EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error)
+	GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error)
+	// GetNetworks queries the registered EVM networks
+	GetNetworks(ctx context.Context, in *QueryNetworksRequest, opts ...grpc.CallOption) (*QueryNetworksResponse, error)
+	// GetNetworkPeggyTokens queries the pegged denoms minted for tokens locked
+	// on a network
+	GetNetworkPeggyTokens(ctx context.Context, in *QueryNetworkPeggyTokensRequest, opts ...grpc.CallOption) (*QueryNetworkPeggyTokensResponse, error)
+	// GetTransferUsage queries the bridge volume of a denom counted against its
+	// rolling transfer limit
+	GetTransferUsage(ctx context.Context, in *QueryTransferUsageRequest, opts ...grpc.CallOption) (*QueryTransferUsageResponse, error)
+	// GetPauses queries the paused bridge transfers
+	GetPauses(ctx context.Context, in *QueryPausesRequest, opts ...grpc.CallOption) (*QueryPausesResponse, error)
+	// GetOutboundTransfers queries the outbound transfers of a cosmos sender
+	GetOutboundTransfers(ctx context.Context, in *QueryOutboundTransfersRequest, opts ...grpc.CallOption) (*QueryOutboundTransfersResponse, error)
+	// GetOutboundTransfer queries an outbound transfer by its cosmos sender and
+	// sequence
+	GetOutboundTransfer(ctx context.Context, in *QueryOutboundTransferRequest, opts ...grpc.CallOption) (*QueryOutboundTransferResponse, error)
+	// GetUnclaimedInbounds queries the escrowed coins of claims that could not
+	// be sent to their receiver
+	GetUnclaimedInbounds(ctx context.Context, in *QueryUnclaimedInboundsRequest, opts ...grpc.CallOption) (*QueryUnclaimedInboundsResponse, error)
+	// GetBlacklistEntries queries the blacklisted addresses with their reason and
+	// expiry
+	GetBlacklistEntries(ctx context.Context, in *QueryBlacklistEntriesRequest, opts ...grpc.CallOption) (*QueryBlacklistEntriesResponse, error)
+	// GetCrossChainFee queries the minimum cross-chain fee of locks and burns to
+	// a network
+	GetCrossChainFee(ctx context.Context, in *QueryCrossChainFeeRequest, opts ...grpc.CallOption) (*QueryCrossChainFeeResponse, error)
+	// GetNftClasses queries the ERC-721 contracts mirrored on sifchain
+	GetNftClasses(ctx context.Context, in *QueryNftClassesRequest, opts ...grpc.CallOption) (*QueryNftClassesResponse, error)
+	// GetNfts queries the NFTs of a class
+	GetNfts(ctx context.Context, in *QueryNftsRequest, opts ...grpc.CallOption) (*QueryNftsResponse, error)
+	// GetAdminRoleHolders queries the accounts holding an admin role, or all
+	// admin roles
+	GetAdminRoleHolders(ctx context.Context, in *QueryAdminRoleHoldersRequest, opts ...grpc.CallOption) (*QueryAdminRoleHoldersResponse, error)
+	// GetClaimRecords queries the history of the prophecies of claims, oldest
+	// first, filtered by cosmos receiver, ethereum sender and status
+	GetClaimRecords(ctx context.Context, in *QueryClaimRecordsRequest, opts ...grpc.CallOption) (*QueryClaimRecordsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error) {
+	out := new(QueryEthProphecyResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/EthProphecy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error) {
+	out := new(QueryBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNetworks(ctx context.Context, in *QueryNetworksRequest, opts ...grpc.CallOption) (*QueryNetworksResponse, error) {
+	out := new(QueryNetworksResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNetworkPeggyTokens(ctx context.Context, in *QueryNetworkPeggyTokensRequest, opts ...grpc.CallOption) (*QueryNetworkPeggyTokensResponse, error) {
+	out := new(QueryNetworkPeggyTokensResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetNetworkPeggyTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTransferUsage(ctx context.Context, in *QueryTransferUsageRequest, opts ...grpc.CallOption) (*QueryTransferUsageResponse, error) {
+	out := new(QueryTransferUsageResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetTransferUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPauses(ctx context.Context, in *QueryPausesRequest, opts ...grpc.CallOption) (*QueryPausesResponse, error) {
+	out := new(QueryPausesResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetPauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOutboundTransfers(ctx context.Context, in *QueryOutboundTransfersRequest, opts ...grpc.CallOption) (*QueryOutboundTransfersResponse, error) {
+	out := new(QueryOutboundTransfersResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetOutboundTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetOutboundTransfer(ctx context.Context, in *QueryOutboundTransferRequest, opts ...grpc.CallOption) (*QueryOutboundTransferResponse, error) {
+	out := new(QueryOutboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetOutboundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetUnclaimedInbounds(ctx context.Context, in *QueryUnclaimedInboundsRequest, opts ...grpc.CallOption) (*QueryUnclaimedInboundsResponse, error) {
+	out := new(QueryUnclaimedInboundsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetUnclaimedInbounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBlacklistEntries(ctx context.Context, in *QueryBlacklistEntriesRequest, opts ...grpc.CallOption) (*QueryBlacklistEntriesResponse, error) {
+	out := new(QueryBlacklistEntriesResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetBlacklistEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCrossChainFee(ctx context.Context, in *QueryCrossChainFeeRequest, opts ...grpc.CallOption) (*QueryCrossChainFeeResponse, error) {
+	out := new(QueryCrossChainFeeResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetCrossChainFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNftClasses(ctx context.Context, in *QueryNftClassesRequest, opts ...grpc.CallOption) (*QueryNftClassesResponse, error) {
+	out := new(QueryNftClassesResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetNftClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNfts(ctx context.Context, in *QueryNftsRequest, opts ...grpc.CallOption) (*QueryNftsResponse, error) {
+	out := new(QueryNftsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetNfts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAdminRoleHolders(ctx context.Context, in *QueryAdminRoleHoldersRequest, opts ...grpc.CallOption) (*QueryAdminRoleHoldersResponse, error) {
+	out := new(QueryAdminRoleHoldersResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetAdminRoleHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetClaimRecords(ctx context.Context, in *QueryClaimRecordsRequest, opts ...grpc.CallOption) (*QueryClaimRecordsResponse, error) {
+	out := new(QueryClaimRecordsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetClaimRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// EthProphecy queries an EthProphecy
+	EthProphecy(context.Context, *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error)
+	GetBlacklist(context.Context, *QueryBlacklistRequest) (*QueryBlacklistResponse, error)
+	// GetNetworks queries the registered EVM networks
+	GetNetworks(context.Context, *QueryNetworksRequest) (*QueryNetworksResponse, error)
+	// GetNetworkPeggyTokens queries the pegged denoms minted for tokens locked
+	// on a network
+	GetNetworkPeggyTokens(context.Context, *QueryNetworkPeggyTokensRequest) (*QueryNetworkPeggyTokensResponse, error)
+	// GetTransferUsage queries the bridge volume of a denom counted against its
+	// rolling transfer limit
+	GetTransferUsage(context.Context, *QueryTransferUsageRequest) (*QueryTransferUsageResponse, error)
+	// GetPauses queries the paused bridge transfers
+	GetPauses(context.Context, *QueryPausesRequest) (*QueryPausesResponse, error)
+	// GetOutboundTransfers queries the outbound transfers of a cosmos sender
+	GetOutboundTransfers(context.Context, *QueryOutboundTransfersRequest) (*QueryOutboundTransfersResponse, error)
+	// GetOutboundTransfer queries an outbound transfer by its cosmos sender and
+	// sequence
+	GetOutboundTransfer(context.Context, *QueryOutboundTransferRequest) (*QueryOutboundTransferResponse, error)
+	// GetUnclaimedInbounds queries the escrowed coins of claims that could not
+	// be sent to their receiver
+	GetUnclaimedInbounds(context.Context, *QueryUnclaimedInboundsRequest) (*QueryUnclaimedInboundsResponse, error)
+	// GetBlacklistEntries queries the blacklisted addresses with their reason and
+	// expiry
+	GetBlacklistEntries(context.Context, *QueryBlacklistEntriesRequest) (*QueryBlacklistEntriesResponse, error)
+	// GetCrossChainFee queries the minimum cross-chain fee of locks and burns to
+	// a network
+	GetCrossChainFee(context.Context, *QueryCrossChainFeeRequest) (*QueryCrossChainFeeResponse, error)
+	// GetNftClasses queries the ERC-721 contracts mirrored on sifchain
+	GetNftClasses(context.Context, *QueryNftClassesRequest) (*QueryNftClassesResponse, error)
+	// GetNfts queries the NFTs of a class
+	GetNfts(context.Context, *QueryNftsRequest) (*QueryNftsResponse, error)
+	// GetAdminRoleHolders queries the accounts holding an admin role, or all
+	// admin roles
+	GetAdminRoleHolders(context.Context, *QueryAdminRoleHoldersRequest) (*QueryAdminRoleHoldersResponse, error)
+	// GetClaimRecords queries the history of the prophecies of claims, oldest
+	// first, filtered by cosmos receiver, ethereum sender and status
+	GetClaimRecords(context.Context, *QueryClaimRecordsRequest) (*QueryClaimRecordsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) EthProphecy(ctx context.Context, req *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthProphecy not implemented")
+}
+func (*UnimplementedQueryServer) GetBlacklist(ctx context.Context, req *QueryBlacklistRequest) (*QueryBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlacklist not implemented")
+}
+func (*UnimplementedQueryServer) GetNetworks(ctx context.Context, req *QueryNetworksRequest) (*QueryNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworks not implemented")
+}
+func (*UnimplementedQueryServer) GetNetworkPeggyTokens(ctx context.Context, req *QueryNetworkPeggyTokensRequest) (*QueryNetworkPeggyTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkPeggyTokens not implemented")
+}
+func (*UnimplementedQueryServer) GetTransferUsage(ctx context.Context, req *QueryTransferUsageRequest) (*QueryTransferUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferUsage not implemented")
+}
+func (*UnimplementedQueryServer) GetPauses(ctx context.Context, req *QueryPausesRequest) (*QueryPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPauses not implemented")
+}
+func (*UnimplementedQueryServer) GetOutboundTransfers(ctx context.Context, req *QueryOutboundTransfersRequest) (*QueryOutboundTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboundTransfers not implemented")
+}
+func (*UnimplementedQueryServer) GetOutboundTransfer(ctx context.Context, req *QueryOutboundTransferRequest) (*QueryOutboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboundTransfer not implemented")
+}
+func (*UnimplementedQueryServer) GetUnclaimedInbounds(ctx context.Context, req *QueryUnclaimedInboundsRequest) (*QueryUnclaimedInboundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnclaimedInbounds not implemented")
+}
+func (*UnimplementedQueryServer) GetBlacklistEntries(ctx context.Context, req *QueryBlacklistEntriesRequest) (*QueryBlacklistEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlacklistEntries not implemented")
+}
+func (*UnimplementedQueryServer) GetCrossChainFee(ctx context.Context, req *QueryCrossChainFeeRequest) (*QueryCrossChainFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossChainFee not implemented")
+}
+func (*UnimplementedQueryServer) GetNftClasses(ctx context.Context, req *QueryNftClassesRequest) (*QueryNftClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNftClasses not implemented")
+}
+func (*UnimplementedQueryServer) GetNfts(ctx context.Context, req *QueryNftsRequest) (*QueryNftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNfts not implemented")
+}
+func (*UnimplementedQueryServer) GetAdminRoleHolders(ctx context.Context, req *QueryAdminRoleHoldersRequest) (*QueryAdminRoleHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminRoleHolders not implemented")
+}
+func (*UnimplementedQueryServer) GetClaimRecords(ctx context.Context, req *QueryClaimRecordsRequest) (*QueryClaimRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimRecords not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_EthProphecy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEthProphecyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthProphecy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/EthProphecy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthProphecy(ctx, req.(*QueryEthProphecyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlacklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlacklist(ctx, req.(*QueryBlacklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetNetworks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworks(ctx, req.(*QueryNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNetworkPeggyTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkPeggyTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkPeggyTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetNetworkPeggyTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkPeggyTokens(ctx, req.(*QueryNetworkPeggyTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTransferUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTransferUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetTransferUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTransferUsage(ctx, req.(*QueryTransferUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetPauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPauses(ctx, req.(*QueryPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOutboundTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutboundTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOutboundTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetOutboundTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOutboundTransfers(ctx, req.(*QueryOutboundTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOutboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutboundTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOutboundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetOutboundTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOutboundTransfer(ctx, req.(*QueryOutboundTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetUnclaimedInbounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnclaimedInboundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUnclaimedInbounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetUnclaimedInbounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUnclaimedInbounds(ctx, req.(*QueryUnclaimedInboundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBlacklistEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlacklistEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlacklistEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetBlacklistEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlacklistEntries(ctx, req.(*QueryBlacklistEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCrossChainFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossChainFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCrossChainFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetCrossChainFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCrossChainFee(ctx, req.(*QueryCrossChainFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNftClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNftClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNftClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetNftClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNftClasses(ctx, req.(*QueryNftClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNfts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNfts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetNfts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNfts(ctx, req.(*QueryNftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAdminRoleHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminRoleHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAdminRoleHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetAdminRoleHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAdminRoleHolders(ctx, req.(*QueryAdminRoleHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetClaimRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetClaimRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetClaimRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClaimRecords(ctx, req.(*QueryClaimRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.ethbridge.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthProphecy",
+			Handler:    _Query_EthProphecy_Handler,
+		},
+		{
+			MethodName: "GetBlacklist",
+			Handler:    _Query_GetBlacklist_Handler,
+		},
+		{
+			MethodName: "GetNetworks",
+			Handler:    _Query_GetNetworks_Handler,
+		},
+		{
+			MethodName: "GetNetworkPeggyTokens",
+			Handler:    _Query_GetNetworkPeggyTokens_Handler,
+		},
+		{
+			MethodName: "GetTransferUsage",
+			Handler:    _Query_GetTransferUsage_Handler,
+		},
+		{
+			MethodName: "GetPauses",
+			Handler:    _Query_GetPauses_Handler,
+		},
+		{
+			MethodName: "GetOutboundTransfers",
+			Handler:    _Query_GetOutboundTransfers_Handler,
+		},
+		{
+			MethodName: "GetOutboundTransfer",
+			Handler:    _Query_GetOutboundTransfer_Handler,
+		},
+		{
+			MethodName: "GetUnclaimedInbounds",
+			Handler:    _Query_GetUnclaimedInbounds_Handler,
+		},
+		{
+			MethodName: "GetBlacklistEntries",
+			Handler:    _Query_GetBlacklistEntries_Handler,
+		},
+		{
+			MethodName: "GetCrossChainFee",
+			Handler:    _Query_GetCrossChainFee_Handler,
+		},
+		{
+			MethodName: "GetNftClasses",
+			Handler:    _Query_GetNftClasses_Handler,
+		},
+		{
+			MethodName: "GetNfts",
+			Handler:    _Query_GetNfts_Handler,
+		},
+		{
+			MethodName: "GetAdminRoleHolders",
+			Handler:    _Query_GetAdminRoleHolders_Handler,
+		},
+		{
+			MethodName: "GetClaimRecords",
+			Handler:    _Query_GetClaimRecords_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/ethbridge/v1/query.proto",
+}
+
+func (m *QueryEthProphecyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthProphecyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthProphecyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenContractAddress) > 0 {
+		i -= len(m.TokenContractAddress)
+		copy(dAtA[i:], m.TokenContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BridgeContractAddress) > 0 {
+		i -= len(m.BridgeContractAddress)
+		copy(dAtA[i:], m.BridgeContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEthProphecyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthProphecyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthProphecyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Networks) > 0 {
+		for iNdEx := len(m.Networks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Networks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkPeggyTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkPeggyTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkPeggyTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkPeggyTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkPeggyTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkPeggyTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokens[iNdEx])
+			copy(dAtA[i:], m.Tokens[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Tokens[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RollingAmount.Size()
+		i -= size
+		if _, err := m.RollingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosSenderSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CosmosSenderSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnclaimedInboundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnclaimedInboundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnclaimedInboundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnclaimedInboundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnclaimedInboundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnclaimedInboundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnclaimedInbounds) > 0 {
+		for iNdEx := len(m.UnclaimedInbounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnclaimedInbounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reports != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reports))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinCethAmount.Size()
+		i -= size
+		if _, err := m.MinCethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.OutboundGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutboundGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.NativeToken) > 0 {
+		i -= len(m.NativeToken)
+		copy(dAtA[i:], m.NativeToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativeToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNftClassesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNftClassesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNftClassesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNftClassesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNftClassesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNftClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Classes) > 0 {
+		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNftsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNftsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNftsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNftsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNftsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNftsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminRoleHoldersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminRoleHoldersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminRoleHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminRoleHoldersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminRoleHoldersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminRoleHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEthProphecyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovQuery(uint64(m.EthereumChainId))
+	}
+	l = len(m.BridgeContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEthProphecyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlacklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNetworksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetworksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNetworkPeggyTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovQuery(uint64(m.EthereumChainId))
+	}
+	return n
+}
+
+func (m *QueryNetworkPeggyTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, s := range m.Tokens {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTransferUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RollingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryPausesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOutboundTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutboundTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutboundTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CosmosSenderSequence != 0 {
+		n += 1 + sovQuery(uint64(m.CosmosSenderSequence))
+	}
+	return n
+}
+
+func (m *QueryOutboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnclaimedInboundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnclaimedInboundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnclaimedInbounds) > 0 {
+		for _, e := range m.UnclaimedInbounds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlacklistEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlacklistEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrossChainFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovQuery(uint64(m.EthereumChainId))
+	}
+	return n
+}
+
+func (m *QueryCrossChainFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OutboundGas != 0 {
+		n += 1 + sovQuery(uint64(m.OutboundGas))
+	}
+	l = m.MinCethAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Reports != 0 {
+		n += 1 + sovQuery(uint64(m.Reports))
+	}
+	return n
+}
+
+func (m *QueryNftClassesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNftClassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNftsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNftsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminRoleHoldersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminRoleHoldersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthProphecyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthProphecyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthProphecyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthProphecyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &types.Status{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, &EthBridgeClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Networks = append(m.Networks, Network{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkPeggyTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkPeggyTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkPeggyTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkPeggyTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkPeggyTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkPeggyTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RollingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, OutboundTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSenderSequence", wireType)
+			}
+			m.CosmosSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnclaimedInboundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnclaimedInboundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnclaimedInboundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnclaimedInboundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnclaimedInboundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnclaimedInboundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedInbounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnclaimedInbounds = append(m.UnclaimedInbounds, UnclaimedInbound{})
+			if err := m.UnclaimedInbounds[len(m.UnclaimedInbounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BlacklistEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundGas", wireType)
+			}
+			m.OutboundGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			m.Reports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reports |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Claims) > 0 {
-		for _, e := range m.Claims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryNftClassesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNftClassesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNftClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNftClassesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNftClassesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNftClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, NftClass{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryBlacklistRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBlacklistResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNftsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -712,17 +6554,17 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthProphecyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNftsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthProphecyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNftsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			m.EthereumChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -732,14 +6574,27 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -764,16 +6619,222 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNftsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNftsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNftsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, Nft{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminRoleHoldersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminRoleHoldersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminRoleHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.Nonce = 0
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -783,16 +6844,16 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				m.Role |= AdminRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -802,29 +6863,83 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminRoleHoldersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminRoleHoldersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminRoleHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -834,29 +6949,31 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Grants = append(m.Grants, AdminRoleGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -866,23 +6983,27 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -905,7 +7026,7 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -928,15 +7049,15 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthProphecyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthProphecyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -964,13 +7085,13 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -980,31 +7101,46 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &types.Status{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.StatusText(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1031,8 +7167,10 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claims = append(m.Claims, &EthBridgeClaim{})
-			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1057,7 +7195,7 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1080,67 +7218,51 @@ func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlacklistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlacklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Records = append(m.Records, ClaimRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlacklistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1150,23 +7272,27 @@ func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -93,12 +93,12 @@ func CreateTestUpdateCethReceiverAccountMsg(t *testing.T, testCosmosSender strin
 	return msgUpdateCethReceiverAccount
 }
 
-func CreateTestRescueCethMsg(t *testing.T, testCosmosSender string, testCethReceiverAccount string, cethAmount sdk.Int) MsgRescueCeth {
+func CreateTestRescueCethMsg(t *testing.T, testEthereumChainID int64, testCosmosSender string, testCethReceiverAccount string, cethAmount sdk.Int) MsgRescueCeth {
 	accAddress1, err := sdk.AccAddressFromBech32(testCosmosSender)
 	require.NoError(t, err)
 	accAddress2, err := sdk.AccAddressFromBech32(testCethReceiverAccount)
 	require.NoError(t, err)
-	MsgRescueCeth := NewMsgRescueCeth(accAddress1, accAddress2, cethAmount)
+	MsgRescueCeth := NewMsgRescueCeth(testEthereumChainID, accAddress1, accAddress2, cethAmount)
 	return MsgRescueCeth
 }
 
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTransferUsage returns an empty transfer usage of a denom
+func NewTransferUsage(denom string) TransferUsage {
+	return TransferUsage{
+		Denom:          denom,
+		Amount:         sdk.ZeroInt(),
+		PreviousAmount: sdk.ZeroInt(),
+	}
+}
+
+// Validate checks the denom and amounts of a transfer usage
+func (u TransferUsage) Validate() error {
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return err
+	}
+	if u.WindowStart < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative window start %d for %s", u.WindowStart, u.Denom)
+	}
+	if u.Amount.IsNil() || u.Amount.IsNegative() || u.PreviousAmount.IsNil() || u.PreviousAmount.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "transfer usage of %s", u.Denom)
+	}
+	return nil
+}
+
+// Advance moves the usage to the window of the given height. Windows are aligned to multiples of the window length,
+// the amount of the window directly before the current one is kept as the previous amount.
+func (u TransferUsage) Advance(height, window int64) TransferUsage {
+	start := height - height%window
+	switch u.WindowStart {
+	case start:
+		return u
+	case start - window:
+		u.PreviousAmount = u.Amount
+	default:
+		u.PreviousAmount = sdk.ZeroInt()
+	}
+	u.WindowStart = start
+	u.Amount = sdk.ZeroInt()
+	return u
+}
+
+// RollingAmount estimates the volume transferred in the last window blocks before the given height by weighting the
+// previous window with the part of it still inside the rolling window
+func (u TransferUsage) RollingAmount(height, window int64) sdk.Int {
+	u = u.Advance(height, window)
+	remaining := window - (height - u.WindowStart)
+	return u.PreviousAmount.MulRaw(remaining).QuoRaw(window).Add(u.Amount)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/Sifchain/sifnode/x/oracle/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -101,6 +102,10 @@ func (m *MsgLock) GetEthereumReceiver() string {
 }
 
 type MsgLockResponse struct {
+	CosmosSenderSequence uint64 `protobuf:"varint,1,opt,name=cosmos_sender_sequence,json=cosmosSenderSequence,proto3" json:"cosmos_sender_sequence,omitempty"`
+	// prophecy_id is the id of the prophecy of the relayer reports of the
+	// transfer
+	ProphecyId string `protobuf:"bytes,2,opt,name=prophecy_id,json=prophecyId,proto3" json:"prophecy_id,omitempty"`
 }
 
 func (m *MsgLockResponse) Reset()         { *m = MsgLockResponse{} }
@@ -136,6 +141,20 @@ func (m *MsgLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLockResponse proto.InternalMessageInfo
 
+func (m *MsgLockResponse) GetCosmosSenderSequence() uint64 {
+	if m != nil {
+		return m.CosmosSenderSequence
+	}
+	return 0
+}
+
+func (m *MsgLockResponse) GetProphecyId() string {
+	if m != nil {
+		return m.ProphecyId
+	}
+	return ""
+}
+
 // MsgBurn defines a message for burning coins and triggering a related event
 type MsgBurn struct {
 	CosmosSender     string                                 `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty" yaml:"cosmos_sender"`
@@ -208,6 +227,10 @@ func (m *MsgBurn) GetEthereumReceiver() string {
 }
 
 type MsgBurnResponse struct {
+	CosmosSenderSequence uint64 `protobuf:"varint,1,opt,name=cosmos_sender_sequence,json=cosmosSenderSequence,proto3" json:"cosmos_sender_sequence,omitempty"`
+	// prophecy_id is the id of the prophecy of the relayer reports of the
+	// transfer
+	ProphecyId string `protobuf:"bytes,2,opt,name=prophecy_id,json=prophecyId,proto3" json:"prophecy_id,omitempty"`
 }
 
 func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
@@ -243,6 +266,20 @@ func (m *MsgBurnResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
 
+func (m *MsgBurnResponse) GetCosmosSenderSequence() uint64 {
+	if m != nil {
+		return m.CosmosSenderSequence
+	}
+	return 0
+}
+
+func (m *MsgBurnResponse) GetProphecyId() string {
+	if m != nil {
+		return m.ProphecyId
+	}
+	return ""
+}
+
 type MsgCreateEthBridgeClaim struct {
 	EthBridgeClaim *EthBridgeClaim `protobuf:"bytes,1,opt,name=eth_bridge_claim,json=ethBridgeClaim,proto3" json:"eth_bridge_claim,omitempty" yaml:"eth_bridge_claim"`
 }
@@ -288,6 +325,8 @@ func (m *MsgCreateEthBridgeClaim) GetEthBridgeClaim() *EthBridgeClaim {
 }
 
 type MsgCreateEthBridgeClaimResponse struct {
+	ProphecyId string           `protobuf:"bytes,1,opt,name=prophecy_id,json=prophecyId,proto3" json:"prophecy_id,omitempty"`
+	Status     types.StatusText `protobuf:"varint,2,opt,name=status,proto3,enum=sifnode.oracle.v1.StatusText" json:"status,omitempty"`
 }
 
 func (m *MsgCreateEthBridgeClaimResponse) Reset()         { *m = MsgCreateEthBridgeClaimResponse{} }
@@ -323,6 +362,20 @@ func (m *MsgCreateEthBridgeClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateEthBridgeClaimResponse proto.InternalMessageInfo
 
+func (m *MsgCreateEthBridgeClaimResponse) GetProphecyId() string {
+	if m != nil {
+		return m.ProphecyId
+	}
+	return ""
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) GetStatus() types.StatusText {
+	if m != nil {
+		return m.Status
+	}
+	return types.StatusText_STATUS_TEXT_UNSPECIFIED
+}
+
 // MsgUpdateWhiteListValidator add or remove validator from whitelist
 type MsgUpdateWhiteListValidator struct {
 	CosmosSender  string `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty" yaml:"cosmos_sender"`
@@ -508,10 +561,13 @@ func (m *MsgUpdateCethReceiverAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCethReceiverAccountResponse proto.InternalMessageInfo
 
+// MsgRescueCeth sends cross-chain fees held by the module to an account, the
+// fees are rescued in the native token of the network of ethereum_chain_id
 type MsgRescueCeth struct {
-	CosmosSender   string                                 `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
-	CosmosReceiver string                                 `protobuf:"bytes,2,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
-	CethAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=ceth_amount,json=cethAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ceth_amount"`
+	CosmosSender    string                                 `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	CosmosReceiver  string                                 `protobuf:"bytes,2,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	CethAmount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=ceth_amount,json=cethAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ceth_amount"`
+	EthereumChainId int64                                  `protobuf:"varint,4,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty"`
 }
 
 func (m *MsgRescueCeth) Reset()         { *m = MsgRescueCeth{} }
@@ -561,6 +617,13 @@ func (m *MsgRescueCeth) GetCosmosReceiver() string {
 	return ""
 }
 
+func (m *MsgRescueCeth) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
 type MsgRescueCethResponse struct {
 }
 
@@ -685,1045 +748,3577 @@ func (m *MsgSetBlacklistResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBlacklistResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgLock)(nil), "sifnode.ethbridge.v1.MsgLock")
-	proto.RegisterType((*MsgLockResponse)(nil), "sifnode.ethbridge.v1.MsgLockResponse")
-	proto.RegisterType((*MsgBurn)(nil), "sifnode.ethbridge.v1.MsgBurn")
-	proto.RegisterType((*MsgBurnResponse)(nil), "sifnode.ethbridge.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgCreateEthBridgeClaim)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaim")
-	proto.RegisterType((*MsgCreateEthBridgeClaimResponse)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaimResponse")
-	proto.RegisterType((*MsgUpdateWhiteListValidator)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidator")
-	proto.RegisterType((*MsgUpdateWhiteListValidatorResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidatorResponse")
-	proto.RegisterType((*MsgUpdateCethReceiverAccount)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccount")
-	proto.RegisterType((*MsgUpdateCethReceiverAccountResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccountResponse")
-	proto.RegisterType((*MsgRescueCeth)(nil), "sifnode.ethbridge.v1.MsgRescueCeth")
-	proto.RegisterType((*MsgRescueCethResponse)(nil), "sifnode.ethbridge.v1.MsgRescueCethResponse")
-	proto.RegisterType((*MsgSetBlacklist)(nil), "sifnode.ethbridge.v1.MsgSetBlacklist")
-	proto.RegisterType((*MsgSetBlacklistResponse)(nil), "sifnode.ethbridge.v1.MsgSetBlacklistResponse")
+// MsgSetNetwork registers an EVM network or updates its bridge contract, denom
+// prefix and native token
+type MsgSetNetwork struct {
+	CosmosSender string  `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	Network      Network `protobuf:"bytes,2,opt,name=network,proto3" json:"network"`
 }
 
-func init() { proto.RegisterFile("sifnode/ethbridge/v1/tx.proto", fileDescriptor_44d60f3dabe1980f) }
-
-var fileDescriptor_44d60f3dabe1980f = []byte{
-	// 846 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xe4, 0x44,
-	0x10, 0x8e, 0x77, 0xb2, 0x41, 0xa9, 0xdd, 0x24, 0x9b, 0x66, 0xa2, 0x38, 0xde, 0xec, 0x38, 0x78,
-	0x7f, 0x08, 0x42, 0x99, 0x51, 0x06, 0x71, 0x60, 0x25, 0x04, 0xeb, 0x01, 0x41, 0xa4, 0x8c, 0x90,
-	0x1c, 0x60, 0x25, 0x0e, 0x58, 0x8e, 0xdd, 0xb1, 0xad, 0x8c, 0xdd, 0x23, 0x77, 0x4f, 0xd8, 0x48,
-	0x9c, 0x11, 0x12, 0x17, 0x9e, 0x64, 0x9f, 0x23, 0xc7, 0x15, 0x27, 0xc4, 0xc1, 0x42, 0xc9, 0x1b,
-	0xcc, 0x03, 0x20, 0xe4, 0x6e, 0xbb, 0x63, 0x2b, 0x76, 0x98, 0x11, 0x17, 0x0e, 0x9c, 0x66, 0x5c,
-	0xf5, 0xd5, 0x57, 0xd5, 0x5d, 0xf5, 0x95, 0x0d, 0x8f, 0x68, 0x78, 0x12, 0x13, 0x0f, 0xf7, 0x30,
-	0x0b, 0x8e, 0x93, 0xd0, 0xf3, 0x71, 0xef, 0x6c, 0xbf, 0xc7, 0x5e, 0x75, 0xc7, 0x09, 0x61, 0x04,
-	0xb5, 0x73, 0x77, 0x57, 0xba, 0xbb, 0x67, 0xfb, 0x5a, 0xdb, 0x27, 0x3e, 0xe1, 0x80, 0x5e, 0xf6,
-	0x4f, 0x60, 0xb5, 0x9d, 0x7a, 0xaa, 0xf3, 0x31, 0xa6, 0x02, 0x61, 0xbc, 0x6e, 0xc1, 0x5b, 0x43,
-	0xea, 0x1f, 0x12, 0xf7, 0x14, 0x3d, 0x86, 0x15, 0x97, 0xd0, 0x88, 0x50, 0x9b, 0xe2, 0xd8, 0xc3,
-	0x89, 0xaa, 0xec, 0x28, 0xbb, 0xcb, 0xd6, 0x7d, 0x61, 0x3c, 0xe2, 0x36, 0xf4, 0x12, 0x96, 0x9c,
-	0x88, 0x4c, 0x62, 0xa6, 0xde, 0xc9, 0xbc, 0xe6, 0x27, 0x17, 0xa9, 0xbe, 0xf0, 0x47, 0xaa, 0x3f,
-	0xf3, 0x43, 0x16, 0x4c, 0x8e, 0xbb, 0x2e, 0x89, 0x7a, 0x22, 0x20, 0xff, 0xd9, 0xa3, 0xde, 0x69,
-	0x9e, 0xf2, 0x20, 0x66, 0xd3, 0x54, 0x5f, 0x39, 0x77, 0xa2, 0xd1, 0x73, 0x43, 0xb0, 0x18, 0x56,
-	0x4e, 0x87, 0xde, 0x83, 0x25, 0x7a, 0x1e, 0x1d, 0x93, 0x91, 0xda, 0xe2, 0xc4, 0xeb, 0xd7, 0x50,
-	0x61, 0x37, 0xac, 0x1c, 0x80, 0xbe, 0x84, 0x75, 0xcc, 0x02, 0x9c, 0xe0, 0x49, 0x64, 0xbb, 0x81,
-	0x13, 0xc6, 0x76, 0xe8, 0xa9, 0x8b, 0x3b, 0xca, 0x6e, 0xcb, 0xdc, 0x9e, 0xa6, 0xba, 0x2a, 0xa2,
-	0x6e, 0x40, 0x0c, 0x6b, 0xad, 0xb0, 0x0d, 0x32, 0xd3, 0x81, 0x87, 0x0e, 0x4a, 0x4c, 0x09, 0x76,
-	0x71, 0x78, 0x86, 0x13, 0xf5, 0x2e, 0xcf, 0x5f, 0xc7, 0x54, 0x40, 0x0c, 0xeb, 0x41, 0x61, 0xb3,
-	0x72, 0x13, 0xc2, 0x70, 0xcf, 0xc5, 0x2c, 0xb0, 0xf3, 0xdb, 0x59, 0xe2, 0x24, 0x9f, 0xcd, 0x7d,
-	0x3b, 0x48, 0xa4, 0x2c, 0x51, 0x19, 0x16, 0x64, 0x4f, 0x2f, 0xc4, 0xc3, 0x3a, 0xac, 0xe5, 0xfd,
-	0xb2, 0x30, 0x1d, 0x93, 0x98, 0x62, 0xe3, 0x42, 0xf4, 0xd0, 0x9c, 0x24, 0x31, 0xfa, 0xb8, 0xb6,
-	0x87, 0xa6, 0x3a, 0x4d, 0xf5, 0x76, 0xce, 0x5c, 0x76, 0x1b, 0xff, 0x77, 0xf7, 0x3f, 0xd8, 0xdd,
-	0xac, 0x93, 0xb2, 0xbb, 0x3f, 0x29, 0xb0, 0x39, 0xa4, 0xfe, 0x20, 0xc1, 0x0e, 0xc3, 0x9f, 0xb3,
-	0xc0, 0xe4, 0x3a, 0x1e, 0x8c, 0x9c, 0x30, 0x42, 0xa7, 0x90, 0x55, 0x6a, 0x0b, 0x69, 0xdb, 0x6e,
-	0x66, 0xe3, 0x0d, 0xbf, 0xd7, 0x7f, 0xd2, 0xad, 0x5b, 0x13, 0xdd, 0x6a, 0xbc, 0xf9, 0x70, 0x9a,
-	0xea, 0x9b, 0xf2, 0x16, 0x2a, 0x3c, 0x86, 0xb5, 0x8a, 0x2b, 0x60, 0xe3, 0x1d, 0xd0, 0x1b, 0xea,
-	0x90, 0xb5, 0xfe, 0xa6, 0xc0, 0xc3, 0x21, 0xf5, 0xbf, 0x19, 0x7b, 0x0e, 0xc3, 0x2f, 0x83, 0x90,
-	0xe1, 0xc3, 0x90, 0xb2, 0x6f, 0x9d, 0x51, 0xe8, 0x39, 0x8c, 0x24, 0xff, 0x76, 0x3a, 0xfb, 0xb0,
-	0x7c, 0x56, 0x70, 0xe5, 0x03, 0xda, 0x9e, 0xa6, 0xfa, 0x03, 0x11, 0x2a, 0x5d, 0x86, 0x75, 0x0d,
-	0x43, 0x9f, 0xc2, 0x2a, 0x19, 0xe3, 0xc4, 0x61, 0x21, 0x89, 0xed, 0xac, 0x15, 0xf9, 0x00, 0x6e,
-	0x4d, 0x53, 0x7d, 0x43, 0x04, 0x56, 0xfd, 0x86, 0xb5, 0x22, 0x0d, 0x5f, 0x67, 0xcf, 0x4f, 0xe1,
-	0xf1, 0x2d, 0x67, 0x92, 0x67, 0xff, 0x01, 0xb6, 0x25, 0x6c, 0x80, 0x59, 0x50, 0x8c, 0xce, 0x0b,
-	0xd7, 0xe5, 0x0a, 0x98, 0x69, 0xbb, 0xf6, 0x61, 0x83, 0xcf, 0x46, 0x31, 0x8a, 0xb6, 0x23, 0xa2,
-	0xc5, 0x69, 0xad, 0xb7, 0xdd, 0x9b, 0xc4, 0xc6, 0x33, 0x78, 0x72, 0x5b, 0x62, 0x59, 0xe0, 0x6b,
-	0x05, 0x56, 0x86, 0xd4, 0xb7, 0x30, 0x75, 0x27, 0x1c, 0x38, 0x5b, 0x49, 0xef, 0xc2, 0x5a, 0x0e,
-	0x92, 0x12, 0x12, 0xc5, 0xac, 0x0a, 0xb3, 0x94, 0xc8, 0x57, 0x55, 0x89, 0x88, 0x6b, 0xee, 0xce,
-	0x27, 0x91, 0x8a, 0x18, 0x36, 0x61, 0xa3, 0x52, 0xaf, 0x3c, 0xc9, 0x80, 0xab, 0xe4, 0x08, 0x33,
-	0x73, 0xe4, 0xb8, 0xa7, 0xa3, 0x90, 0x32, 0x84, 0x60, 0xf1, 0x24, 0x21, 0x51, 0x7e, 0x02, 0xfe,
-	0x1f, 0x6d, 0xc3, 0xb2, 0xe3, 0x79, 0x09, 0xa6, 0x14, 0x53, 0xf5, 0xce, 0x4e, 0x6b, 0x77, 0xd9,
-	0xba, 0x36, 0x18, 0x5b, 0x5c, 0x56, 0x65, 0x92, 0x82, 0xbf, 0xff, 0xd7, 0x5d, 0x68, 0x0d, 0xa9,
-	0x8f, 0x0e, 0x61, 0x91, 0xbf, 0x18, 0x1f, 0xd5, 0x8b, 0x29, 0xdf, 0xc3, 0xda, 0xd3, 0x5b, 0xdd,
-	0x05, 0x6b, 0xc6, 0xc6, 0x57, 0x74, 0x33, 0x5b, 0xe6, 0xbe, 0x85, 0xad, 0xbc, 0x16, 0xd0, 0x8f,
-	0xd0, 0xae, 0x5d, 0x09, 0x7b, 0x8d, 0xe1, 0x75, 0x70, 0xed, 0xc3, 0xb9, 0xe0, 0x32, 0xfb, 0xcf,
-	0x0a, 0xa8, 0x8d, 0x2a, 0xdf, 0x6f, 0xe4, 0x6c, 0x0a, 0xd1, 0x3e, 0x9a, 0x3b, 0x44, 0x96, 0xf2,
-	0x8b, 0x02, 0x5b, 0xcd, 0xaa, 0xeb, 0xff, 0x03, 0x71, 0x4d, 0x8c, 0xf6, 0x7c, 0xfe, 0x18, 0x59,
-	0xcd, 0xf7, 0x00, 0x65, 0x81, 0x35, 0x32, 0x5d, 0x83, 0xb4, 0xf7, 0x67, 0x00, 0x49, 0x7e, 0x0f,
-	0xee, 0x57, 0xe6, 0xbe, 0x79, 0x5a, 0xca, 0x30, 0x6d, 0x6f, 0x26, 0x58, 0x91, 0xc5, 0xfc, 0xe2,
-	0xe2, 0xb2, 0xa3, 0xbc, 0xb9, 0xec, 0x28, 0x7f, 0x5e, 0x76, 0x94, 0x5f, 0xaf, 0x3a, 0x0b, 0x6f,
-	0xae, 0x3a, 0x0b, 0xbf, 0x5f, 0x75, 0x16, 0xbe, 0xdb, 0x2b, 0xe9, 0xf8, 0x28, 0x3c, 0xe1, 0x2f,
-	0xdf, 0x5e, 0xf1, 0x95, 0xf9, 0xaa, 0xf4, 0x9d, 0xc9, 0x25, 0x7d, 0xbc, 0xc4, 0xbf, 0x32, 0x3f,
-	0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x39, 0x15, 0x76, 0x97, 0xd4, 0x0a, 0x00, 0x00,
+func (m *MsgSetNetwork) Reset()         { *m = MsgSetNetwork{} }
+func (m *MsgSetNetwork) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetwork) ProtoMessage()    {}
+func (*MsgSetNetwork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{14}
+}
+func (m *MsgSetNetwork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetwork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetwork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetNetwork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetwork.Merge(m, src)
+}
+func (m *MsgSetNetwork) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetwork) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetwork.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetNetwork proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
-	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error)
-	UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error)
-	UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error)
-	RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error)
-	SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error)
+func (m *MsgSetNetwork) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetNetwork) GetNetwork() Network {
+	if m != nil {
+		return m.Network
+	}
+	return Network{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgSetNetworkResponse struct {
 }
 
-func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
-	out := new(MsgLockResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Lock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetNetworkResponse) Reset()         { *m = MsgSetNetworkResponse{} }
+func (m *MsgSetNetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNetworkResponse) ProtoMessage()    {}
+func (*MsgSetNetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{15}
+}
+func (m *MsgSetNetworkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetNetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNetworkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetNetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNetworkResponse.Merge(m, src)
+}
+func (m *MsgSetNetworkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNetworkResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetNetworkResponse proto.InternalMessageInfo
+
+// MsgSetPause pauses or resumes bridge transfers, an unpaused direction of the
+// pause resumes it
+type MsgSetPause struct {
+	CosmosSender string `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	Pause        Pause  `protobuf:"bytes,2,opt,name=pause,proto3" json:"pause"`
 }
 
-func (c *msgClient) CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error) {
-	out := new(MsgCreateEthBridgeClaimResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPause) Reset()         { *m = MsgSetPause{} }
+func (m *MsgSetPause) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPause) ProtoMessage()    {}
+func (*MsgSetPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{16}
+}
+func (m *MsgSetPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPause.Merge(m, src)
+}
+func (m *MsgSetPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPause.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error) {
-	out := new(MsgUpdateWhiteListValidatorResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPause proto.InternalMessageInfo
+
+func (m *MsgSetPause) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error) {
-	out := new(MsgUpdateCethReceiverAccountResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPause) GetPause() Pause {
+	if m != nil {
+		return m.Pause
 	}
-	return out, nil
+	return Pause{}
 }
 
-func (c *msgClient) RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error) {
-	out := new(MsgRescueCethResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/RescueCeth", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetPauseResponse struct {
 }
 
-func (c *msgClient) SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error) {
-	out := new(MsgSetBlacklistResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetBlacklist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPauseResponse) Reset()         { *m = MsgSetPauseResponse{} }
+func (m *MsgSetPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPauseResponse) ProtoMessage()    {}
+func (*MsgSetPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{17}
+}
+func (m *MsgSetPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
-	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
-	CreateEthBridgeClaim(context.Context, *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error)
-	UpdateWhiteListValidator(context.Context, *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error)
-	UpdateCethReceiverAccount(context.Context, *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error)
-	RescueCeth(context.Context, *MsgRescueCeth) (*MsgRescueCethResponse, error)
-	SetBlacklist(context.Context, *MsgSetBlacklist) (*MsgSetBlacklistResponse, error)
+func (m *MsgSetPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPauseResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPauseResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) Lock(ctx context.Context, req *MsgLock) (*MsgLockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+var xxx_messageInfo_MsgSetPauseResponse proto.InternalMessageInfo
+
+// MsgReportOutboundTransfer is sent by a relayer once an outbound transfer has
+// been delivered to its EVM network
+type MsgReportOutboundTransfer struct {
+	ValidatorAddress     string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	CosmosSender         string `protobuf:"bytes,2,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	CosmosSenderSequence uint64 `protobuf:"varint,3,opt,name=cosmos_sender_sequence,json=cosmosSenderSequence,proto3" json:"cosmos_sender_sequence,omitempty"`
+	EthereumTxHash       string `protobuf:"bytes,4,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3" json:"ethereum_tx_hash,omitempty"`
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+
+func (m *MsgReportOutboundTransfer) Reset()         { *m = MsgReportOutboundTransfer{} }
+func (m *MsgReportOutboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgReportOutboundTransfer) ProtoMessage()    {}
+func (*MsgReportOutboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{18}
 }
-func (*UnimplementedMsgServer) CreateEthBridgeClaim(ctx context.Context, req *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEthBridgeClaim not implemented")
+func (m *MsgReportOutboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateWhiteListValidator(ctx context.Context, req *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWhiteListValidator not implemented")
+func (m *MsgReportOutboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportOutboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateCethReceiverAccount(ctx context.Context, req *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCethReceiverAccount not implemented")
+func (m *MsgReportOutboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportOutboundTransfer.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RescueCeth(ctx context.Context, req *MsgRescueCeth) (*MsgRescueCethResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RescueCeth not implemented")
+func (m *MsgReportOutboundTransfer) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetBlacklist(ctx context.Context, req *MsgSetBlacklist) (*MsgSetBlacklistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBlacklist not implemented")
+func (m *MsgReportOutboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportOutboundTransfer.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgReportOutboundTransfer proto.InternalMessageInfo
 
-func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Lock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/Lock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Lock(ctx, req.(*MsgLock))
+func (m *MsgReportOutboundTransfer) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurn)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgReportOutboundTransfer) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateEthBridgeClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateEthBridgeClaim)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateEthBridgeClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateEthBridgeClaim(ctx, req.(*MsgCreateEthBridgeClaim))
+func (m *MsgReportOutboundTransfer) GetCosmosSenderSequence() uint64 {
+	if m != nil {
+		return m.CosmosSenderSequence
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateWhiteListValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateWhiteListValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateWhiteListValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateWhiteListValidator(ctx, req.(*MsgUpdateWhiteListValidator))
+func (m *MsgReportOutboundTransfer) GetEthereumTxHash() string {
+	if m != nil {
+		return m.EthereumTxHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateCethReceiverAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCethReceiverAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, req.(*MsgUpdateCethReceiverAccount))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgReportOutboundTransferResponse struct {
+	ProphecyId string           `protobuf:"bytes,1,opt,name=prophecy_id,json=prophecyId,proto3" json:"prophecy_id,omitempty"`
+	Status     types.StatusText `protobuf:"varint,2,opt,name=status,proto3,enum=sifnode.oracle.v1.StatusText" json:"status,omitempty"`
 }
 
-func _Msg_RescueCeth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRescueCeth)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RescueCeth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/RescueCeth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RescueCeth(ctx, req.(*MsgRescueCeth))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgReportOutboundTransferResponse) Reset()         { *m = MsgReportOutboundTransferResponse{} }
+func (m *MsgReportOutboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportOutboundTransferResponse) ProtoMessage()    {}
+func (*MsgReportOutboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{19}
 }
-
-func _Msg_SetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBlacklist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBlacklist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/SetBlacklist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBlacklist(ctx, req.(*MsgSetBlacklist))
+func (m *MsgReportOutboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportOutboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportOutboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReportOutboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportOutboundTransferResponse.Merge(m, src)
+}
+func (m *MsgReportOutboundTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportOutboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportOutboundTransferResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sifnode.ethbridge.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Lock",
-			Handler:    _Msg_Lock_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "CreateEthBridgeClaim",
-			Handler:    _Msg_CreateEthBridgeClaim_Handler,
-		},
-		{
-			MethodName: "UpdateWhiteListValidator",
-			Handler:    _Msg_UpdateWhiteListValidator_Handler,
-		},
-		{
-			MethodName: "UpdateCethReceiverAccount",
-			Handler:    _Msg_UpdateCethReceiverAccount_Handler,
-		},
-		{
-			MethodName: "RescueCeth",
-			Handler:    _Msg_RescueCeth_Handler,
-		},
-		{
-			MethodName: "SetBlacklist",
-			Handler:    _Msg_SetBlacklist_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sifnode/ethbridge/v1/tx.proto",
+var xxx_messageInfo_MsgReportOutboundTransferResponse proto.InternalMessageInfo
+
+func (m *MsgReportOutboundTransferResponse) GetProphecyId() string {
+	if m != nil {
+		return m.ProphecyId
+	}
+	return ""
 }
 
-func (m *MsgLock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportOutboundTransferResponse) GetStatus() types.StatusText {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return types.StatusText_STATUS_TEXT_UNSPECIFIED
 }
 
-func (m *MsgLock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRefundOutboundTransfer returns an outbound transfer that was not
+// delivered within the refund timeout to its sender
+type MsgRefundOutboundTransfer struct {
+	CosmosSender           string `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	TransferSender         string `protobuf:"bytes,2,opt,name=transfer_sender,json=transferSender,proto3" json:"transfer_sender,omitempty"`
+	TransferSenderSequence uint64 `protobuf:"varint,3,opt,name=transfer_sender_sequence,json=transferSenderSequence,proto3" json:"transfer_sender_sequence,omitempty"`
 }
 
-func (m *MsgLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CethAmount.Size()
-		i -= size
-		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.EthereumReceiver) > 0 {
-		i -= len(m.EthereumReceiver)
-		copy(dAtA[i:], m.EthereumReceiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.EthereumChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRefundOutboundTransfer) Reset()         { *m = MsgRefundOutboundTransfer{} }
+func (m *MsgRefundOutboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundOutboundTransfer) ProtoMessage()    {}
+func (*MsgRefundOutboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{20}
+}
+func (m *MsgRefundOutboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundOutboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundOutboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRefundOutboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundOutboundTransfer.Merge(m, src)
+}
+func (m *MsgRefundOutboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundOutboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundOutboundTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgLockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRefundOutboundTransfer proto.InternalMessageInfo
+
+func (m *MsgRefundOutboundTransfer) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgLockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundOutboundTransfer) GetTransferSender() string {
+	if m != nil {
+		return m.TransferSender
+	}
+	return ""
 }
 
-func (m *MsgLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRefundOutboundTransfer) GetTransferSenderSequence() uint64 {
+	if m != nil {
+		return m.TransferSenderSequence
+	}
+	return 0
 }
 
-func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgRefundOutboundTransferResponse struct {
 }
 
-func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundOutboundTransferResponse) Reset()         { *m = MsgRefundOutboundTransferResponse{} }
+func (m *MsgRefundOutboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundOutboundTransferResponse) ProtoMessage()    {}
+func (*MsgRefundOutboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{21}
 }
-
-func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CethAmount.Size()
-		i -= size
-		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.EthereumReceiver) > 0 {
-		i -= len(m.EthereumReceiver)
-		copy(dAtA[i:], m.EthereumReceiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.EthereumChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRefundOutboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundOutboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundOutboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRefundOutboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundOutboundTransferResponse.Merge(m, src)
 }
-
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundOutboundTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundOutboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundOutboundTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRefundOutboundTransferResponse proto.InternalMessageInfo
+
+// MsgRedirectUnclaimedInbound sends escrowed inbound coins to a recipient, it
+// is signed by their intended receiver or the admin
+type MsgRedirectUnclaimedInbound struct {
+	CosmosSender string `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	Id           uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient    string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgCreateEthBridgeClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedirectUnclaimedInbound) Reset()         { *m = MsgRedirectUnclaimedInbound{} }
+func (m *MsgRedirectUnclaimedInbound) String() string { return proto.CompactTextString(m) }
+func (*MsgRedirectUnclaimedInbound) ProtoMessage()    {}
+func (*MsgRedirectUnclaimedInbound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{22}
+}
+func (m *MsgRedirectUnclaimedInbound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedirectUnclaimedInbound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedirectUnclaimedInbound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateEthBridgeClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRedirectUnclaimedInbound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedirectUnclaimedInbound.Merge(m, src)
+}
+func (m *MsgRedirectUnclaimedInbound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedirectUnclaimedInbound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedirectUnclaimedInbound.DiscardUnknown(m)
 }
 
-func (m *MsgCreateEthBridgeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthBridgeClaim != nil {
-		{
-			size, err := m.EthBridgeClaim.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRedirectUnclaimedInbound proto.InternalMessageInfo
+
+func (m *MsgRedirectUnclaimedInbound) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateEthBridgeClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedirectUnclaimedInbound) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateEthBridgeClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRedirectUnclaimedInbound) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *MsgCreateEthBridgeClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRedirectUnclaimedInboundResponse struct {
 }
 
-func (m *MsgUpdateWhiteListValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedirectUnclaimedInboundResponse) Reset()         { *m = MsgRedirectUnclaimedInboundResponse{} }
+func (m *MsgRedirectUnclaimedInboundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedirectUnclaimedInboundResponse) ProtoMessage()    {}
+func (*MsgRedirectUnclaimedInboundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{23}
+}
+func (m *MsgRedirectUnclaimedInboundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedirectUnclaimedInboundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedirectUnclaimedInboundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRedirectUnclaimedInboundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedirectUnclaimedInboundResponse.Merge(m, src)
+}
+func (m *MsgRedirectUnclaimedInboundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedirectUnclaimedInboundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedirectUnclaimedInboundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateWhiteListValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRedirectUnclaimedInboundResponse proto.InternalMessageInfo
+
+// MsgAddToBlacklist adds addresses to the blacklist, or updates their entries
+type MsgAddToBlacklist struct {
+	CosmosSender string   `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	Addresses    []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Reason       string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expiry_height is the height the entries stop applying at, zero for never
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
-func (m *MsgUpdateWhiteListValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperationType) > 0 {
-		i -= len(m.OperationType)
-		copy(dAtA[i:], m.OperationType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperationType)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAddToBlacklist) Reset()         { *m = MsgAddToBlacklist{} }
+func (m *MsgAddToBlacklist) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToBlacklist) ProtoMessage()    {}
+func (*MsgAddToBlacklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{24}
+}
+func (m *MsgAddToBlacklist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToBlacklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToBlacklist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAddToBlacklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToBlacklist.Merge(m, src)
+}
+func (m *MsgAddToBlacklist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToBlacklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToBlacklist.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddToBlacklist proto.InternalMessageInfo
+
+func (m *MsgAddToBlacklist) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddToBlacklist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAddToBlacklist) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgUpdateCethReceiverAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddToBlacklist) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateCethReceiverAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAddToBlacklistResponse struct {
 }
 
-func (m *MsgUpdateCethReceiverAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CethReceiverAccount) > 0 {
-		i -= len(m.CethReceiverAccount)
-		copy(dAtA[i:], m.CethReceiverAccount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CethReceiverAccount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAddToBlacklistResponse) Reset()         { *m = MsgAddToBlacklistResponse{} }
+func (m *MsgAddToBlacklistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToBlacklistResponse) ProtoMessage()    {}
+func (*MsgAddToBlacklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{25}
+}
+func (m *MsgAddToBlacklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToBlacklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToBlacklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAddToBlacklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToBlacklistResponse.Merge(m, src)
+}
+func (m *MsgAddToBlacklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToBlacklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToBlacklistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddToBlacklistResponse proto.InternalMessageInfo
+
+// MsgRemoveFromBlacklist removes addresses from the blacklist
+type MsgRemoveFromBlacklist struct {
+	CosmosSender string   `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	Addresses    []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Reason       string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRemoveFromBlacklist) Reset()         { *m = MsgRemoveFromBlacklist{} }
+func (m *MsgRemoveFromBlacklist) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromBlacklist) ProtoMessage()    {}
+func (*MsgRemoveFromBlacklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{26}
+}
+func (m *MsgRemoveFromBlacklist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromBlacklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromBlacklist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveFromBlacklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromBlacklist.Merge(m, src)
+}
+func (m *MsgRemoveFromBlacklist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromBlacklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromBlacklist.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveFromBlacklist proto.InternalMessageInfo
+
+func (m *MsgRemoveFromBlacklist) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
+	}
+	return ""
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveFromBlacklist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-func (m *MsgRescueCeth) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFromBlacklist) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRescueCeth) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRemoveFromBlacklistResponse struct {
 }
 
-func (m *MsgRescueCeth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CethAmount.Size()
-		i -= size
-		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRemoveFromBlacklistResponse) Reset()         { *m = MsgRemoveFromBlacklistResponse{} }
+func (m *MsgRemoveFromBlacklistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromBlacklistResponse) ProtoMessage()    {}
+func (*MsgRemoveFromBlacklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{27}
+}
+func (m *MsgRemoveFromBlacklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromBlacklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromBlacklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosReceiver)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRemoveFromBlacklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromBlacklistResponse.Merge(m, src)
+}
+func (m *MsgRemoveFromBlacklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromBlacklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromBlacklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFromBlacklistResponse proto.InternalMessageInfo
+
+// MsgReportGasPrice is sent by a whitelisted relayer to report the gas price of
+// a network
+type MsgReportGasPrice struct {
+	ValidatorAddress string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EthereumChainId  int64                                  `protobuf:"varint,2,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty"`
+	GasPrice         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price"`
+}
+
+func (m *MsgReportGasPrice) Reset()         { *m = MsgReportGasPrice{} }
+func (m *MsgReportGasPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgReportGasPrice) ProtoMessage()    {}
+func (*MsgReportGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{28}
+}
+func (m *MsgReportGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReportGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportGasPrice.Merge(m, src)
+}
+func (m *MsgReportGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportGasPrice.DiscardUnknown(m)
 }
 
-func (m *MsgRescueCethResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReportGasPrice proto.InternalMessageInfo
+
+func (m *MsgReportGasPrice) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRescueCethResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportGasPrice) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
 }
 
-func (m *MsgRescueCethResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgReportGasPriceResponse struct {
 }
 
-func (m *MsgSetBlacklist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportGasPriceResponse) Reset()         { *m = MsgReportGasPriceResponse{} }
+func (m *MsgReportGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportGasPriceResponse) ProtoMessage()    {}
+func (*MsgReportGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{29}
+}
+func (m *MsgReportGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReportGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportGasPriceResponse.Merge(m, src)
+}
+func (m *MsgReportGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportGasPriceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBlacklist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReportGasPriceResponse proto.InternalMessageInfo
+
+// MsgCreateEthBridgeNftClaim is sent by a whitelisted relayer to claim an
+// ERC-721 token locked on an EVM network
+type MsgCreateEthBridgeNftClaim struct {
+	EthBridgeNftClaim *EthBridgeNftClaim `protobuf:"bytes,1,opt,name=eth_bridge_nft_claim,json=ethBridgeNftClaim,proto3" json:"eth_bridge_nft_claim,omitempty" yaml:"eth_bridge_nft_claim"`
 }
 
-func (m *MsgSetBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgCreateEthBridgeNftClaim) Reset()         { *m = MsgCreateEthBridgeNftClaim{} }
+func (m *MsgCreateEthBridgeNftClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEthBridgeNftClaim) ProtoMessage()    {}
+func (*MsgCreateEthBridgeNftClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{30}
+}
+func (m *MsgCreateEthBridgeNftClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEthBridgeNftClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEthBridgeNftClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateEthBridgeNftClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEthBridgeNftClaim.Merge(m, src)
+}
+func (m *MsgCreateEthBridgeNftClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEthBridgeNftClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEthBridgeNftClaim.DiscardUnknown(m)
 }
 
-func (m *MsgSetBlacklistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateEthBridgeNftClaim proto.InternalMessageInfo
+
+func (m *MsgCreateEthBridgeNftClaim) GetEthBridgeNftClaim() *EthBridgeNftClaim {
+	if m != nil {
+		return m.EthBridgeNftClaim
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateEthBridgeNftClaimResponse struct {
+	ProphecyId string           `protobuf:"bytes,1,opt,name=prophecy_id,json=prophecyId,proto3" json:"prophecy_id,omitempty"`
+	Status     types.StatusText `protobuf:"varint,2,opt,name=status,proto3,enum=sifnode.oracle.v1.StatusText" json:"status,omitempty"`
 }
 
-func (m *MsgSetBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateEthBridgeNftClaimResponse) Reset()         { *m = MsgCreateEthBridgeNftClaimResponse{} }
+func (m *MsgCreateEthBridgeNftClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEthBridgeNftClaimResponse) ProtoMessage()    {}
+func (*MsgCreateEthBridgeNftClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{31}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgCreateEthBridgeNftClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgLock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EthereumChainId != 0 {
-		n += 1 + sovTx(uint64(m.EthereumChainId))
-	}
-	l = len(m.EthereumReceiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateEthBridgeNftClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEthBridgeNftClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.CethAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgCreateEthBridgeNftClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEthBridgeNftClaimResponse.Merge(m, src)
+}
+func (m *MsgCreateEthBridgeNftClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEthBridgeNftClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEthBridgeNftClaimResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCreateEthBridgeNftClaimResponse proto.InternalMessageInfo
+
+func (m *MsgCreateEthBridgeNftClaimResponse) GetProphecyId() string {
+	if m != nil {
+		return m.ProphecyId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgBurn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EthereumChainId != 0 {
-		n += 1 + sovTx(uint64(m.EthereumChainId))
-	}
-	l = len(m.EthereumReceiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateEthBridgeNftClaimResponse) GetStatus() types.StatusText {
+	if m != nil {
+		return m.Status
 	}
-	l = m.CethAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return types.StatusText_STATUS_TEXT_UNSPECIFIED
 }
 
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgBurnNft burns an NFT minted by a claim so that the relayers unlock its
+// ERC-721 token on its network
+type MsgBurnNft struct {
+	CosmosSender     string                                 `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty" yaml:"cosmos_sender"`
+	ClassId          string                                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty" yaml:"class_id"`
+	TokenId          string                                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty" yaml:"token_id"`
+	EthereumReceiver string                                 `protobuf:"bytes,4,opt,name=ethereum_receiver,json=ethereumReceiver,proto3" json:"ethereum_receiver,omitempty" yaml:"ethereum_receiver"`
+	CethAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=ceth_amount,json=cethAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ceth_amount" yaml:"ceth_amount"`
 }
 
-func (m *MsgCreateEthBridgeClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EthBridgeClaim != nil {
-		l = m.EthBridgeClaim.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgBurnNft) Reset()         { *m = MsgBurnNft{} }
+func (m *MsgBurnNft) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnNft) ProtoMessage()    {}
+func (*MsgBurnNft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{32}
+}
+func (m *MsgBurnNft) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnNft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnNft.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgBurnNft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnNft.Merge(m, src)
+}
+func (m *MsgBurnNft) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnNft) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnNft.DiscardUnknown(m)
 }
 
-func (m *MsgCreateEthBridgeClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgBurnNft proto.InternalMessageInfo
+
+func (m *MsgBurnNft) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateWhiteListValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperationType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgBurnNft) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBurnNft) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateCethReceiverAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgBurnNft) GetEthereumReceiver() string {
+	if m != nil {
+		return m.EthereumReceiver
 	}
-	l = len(m.CethReceiverAccount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgBurnNftResponse struct {
+	CosmosSenderSequence uint64 `protobuf:"varint,1,opt,name=cosmos_sender_sequence,json=cosmosSenderSequence,proto3" json:"cosmos_sender_sequence,omitempty"`
+}
+
+func (m *MsgBurnNftResponse) Reset()         { *m = MsgBurnNftResponse{} }
+func (m *MsgBurnNftResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnNftResponse) ProtoMessage()    {}
+func (*MsgBurnNftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{33}
+}
+func (m *MsgBurnNftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnNftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnNftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgBurnNftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnNftResponse.Merge(m, src)
+}
+func (m *MsgBurnNftResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnNftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnNftResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgBurnNftResponse proto.InternalMessageInfo
+
+func (m *MsgBurnNftResponse) GetCosmosSenderSequence() uint64 {
+	if m != nil {
+		return m.CosmosSenderSequence
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgRescueCeth) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*MsgLock)(nil), "sifnode.ethbridge.v1.MsgLock")
+	proto.RegisterType((*MsgLockResponse)(nil), "sifnode.ethbridge.v1.MsgLockResponse")
+	proto.RegisterType((*MsgBurn)(nil), "sifnode.ethbridge.v1.MsgBurn")
+	proto.RegisterType((*MsgBurnResponse)(nil), "sifnode.ethbridge.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgCreateEthBridgeClaim)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaim")
+	proto.RegisterType((*MsgCreateEthBridgeClaimResponse)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaimResponse")
+	proto.RegisterType((*MsgUpdateWhiteListValidator)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidator")
+	proto.RegisterType((*MsgUpdateWhiteListValidatorResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidatorResponse")
+	proto.RegisterType((*MsgUpdateCethReceiverAccount)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccount")
+	proto.RegisterType((*MsgUpdateCethReceiverAccountResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccountResponse")
+	proto.RegisterType((*MsgRescueCeth)(nil), "sifnode.ethbridge.v1.MsgRescueCeth")
+	proto.RegisterType((*MsgRescueCethResponse)(nil), "sifnode.ethbridge.v1.MsgRescueCethResponse")
+	proto.RegisterType((*MsgSetBlacklist)(nil), "sifnode.ethbridge.v1.MsgSetBlacklist")
+	proto.RegisterType((*MsgSetBlacklistResponse)(nil), "sifnode.ethbridge.v1.MsgSetBlacklistResponse")
+	proto.RegisterType((*MsgSetNetwork)(nil), "sifnode.ethbridge.v1.MsgSetNetwork")
+	proto.RegisterType((*MsgSetNetworkResponse)(nil), "sifnode.ethbridge.v1.MsgSetNetworkResponse")
+	proto.RegisterType((*MsgSetPause)(nil), "sifnode.ethbridge.v1.MsgSetPause")
+	proto.RegisterType((*MsgSetPauseResponse)(nil), "sifnode.ethbridge.v1.MsgSetPauseResponse")
+	proto.RegisterType((*MsgReportOutboundTransfer)(nil), "sifnode.ethbridge.v1.MsgReportOutboundTransfer")
+	proto.RegisterType((*MsgReportOutboundTransferResponse)(nil), "sifnode.ethbridge.v1.MsgReportOutboundTransferResponse")
+	proto.RegisterType((*MsgRefundOutboundTransfer)(nil), "sifnode.ethbridge.v1.MsgRefundOutboundTransfer")
+	proto.RegisterType((*MsgRefundOutboundTransferResponse)(nil), "sifnode.ethbridge.v1.MsgRefundOutboundTransferResponse")
+	proto.RegisterType((*MsgRedirectUnclaimedInbound)(nil), "sifnode.ethbridge.v1.MsgRedirectUnclaimedInbound")
+	proto.RegisterType((*MsgRedirectUnclaimedInboundResponse)(nil), "sifnode.ethbridge.v1.MsgRedirectUnclaimedInboundResponse")
+	proto.RegisterType((*MsgAddToBlacklist)(nil), "sifnode.ethbridge.v1.MsgAddToBlacklist")
+	proto.RegisterType((*MsgAddToBlacklistResponse)(nil), "sifnode.ethbridge.v1.MsgAddToBlacklistResponse")
+	proto.RegisterType((*MsgRemoveFromBlacklist)(nil), "sifnode.ethbridge.v1.MsgRemoveFromBlacklist")
+	proto.RegisterType((*MsgRemoveFromBlacklistResponse)(nil), "sifnode.ethbridge.v1.MsgRemoveFromBlacklistResponse")
+	proto.RegisterType((*MsgReportGasPrice)(nil), "sifnode.ethbridge.v1.MsgReportGasPrice")
+	proto.RegisterType((*MsgReportGasPriceResponse)(nil), "sifnode.ethbridge.v1.MsgReportGasPriceResponse")
+	proto.RegisterType((*MsgCreateEthBridgeNftClaim)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeNftClaim")
+	proto.RegisterType((*MsgCreateEthBridgeNftClaimResponse)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeNftClaimResponse")
+	proto.RegisterType((*MsgBurnNft)(nil), "sifnode.ethbridge.v1.MsgBurnNft")
+	proto.RegisterType((*MsgBurnNftResponse)(nil), "sifnode.ethbridge.v1.MsgBurnNftResponse")
+}
+
+func init() { proto.RegisterFile("sifnode/ethbridge/v1/tx.proto", fileDescriptor_44d60f3dabe1980f) }
+
+var fileDescriptor_44d60f3dabe1980f = []byte{
+	// 1595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x13, 0x37, 0x6d, 0xc5, 0x8e, 0xc7, 0xb1, 0x1c, 0xd3, 0x5f, 0x32, 0x9d, 0x58, 0x0e, 0xf3, 0x61,
+	0xe7, 0x9f, 0xbf, 0xa5, 0x5a, 0x4d, 0x90, 0x0f, 0x20, 0x68, 0x2d, 0xb7, 0x4d, 0xdc, 0xda, 0x4e,
+	0x40, 0x3b, 0x4d, 0xd1, 0x43, 0x05, 0x9a, 0x5c, 0x89, 0xac, 0x25, 0x52, 0xe5, 0xae, 0x1c, 0x0b,
+	0xcd, 0xa9, 0x40, 0x8b, 0x02, 0xbd, 0x14, 0xe8, 0xa1, 0x0f, 0xd0, 0x7b, 0xef, 0x7d, 0x83, 0x5c,
+	0x0a, 0x04, 0x3d, 0x15, 0x01, 0x2a, 0x14, 0xc9, 0x1b, 0xe8, 0x05, 0x5a, 0x70, 0xb9, 0x5c, 0x91,
+	0x12, 0x29, 0x4b, 0x4d, 0x5d, 0xf4, 0xd0, 0x93, 0xc9, 0x99, 0xdf, 0xcc, 0xfe, 0x76, 0x38, 0x33,
+	0x3b, 0x6b, 0xc1, 0x79, 0x6c, 0x16, 0x2d, 0x5b, 0x47, 0x59, 0x44, 0x8c, 0x7d, 0xc7, 0xd4, 0x4b,
+	0x28, 0x7b, 0xb8, 0x96, 0x25, 0x47, 0x99, 0xaa, 0x63, 0x13, 0x5b, 0x9c, 0x66, 0xea, 0x0c, 0x57,
+	0x67, 0x0e, 0xd7, 0xa4, 0xe9, 0x92, 0x5d, 0xb2, 0x29, 0x20, 0xeb, 0x3e, 0x79, 0x58, 0x69, 0x29,
+	0xda, 0x55, 0xbd, 0x8a, 0x30, 0x43, 0xf0, 0xc5, 0x6c, 0x47, 0xd5, 0xca, 0xed, 0x6a, 0xf9, 0xc7,
+	0x21, 0x18, 0xd9, 0xc6, 0xa5, 0x2d, 0x5b, 0x3b, 0x10, 0x2f, 0xc2, 0xb8, 0x66, 0xe3, 0x8a, 0x8d,
+	0x0b, 0x18, 0x59, 0x3a, 0x72, 0x52, 0xc2, 0x92, 0xb0, 0x32, 0xaa, 0x9c, 0xf1, 0x84, 0xbb, 0x54,
+	0x26, 0x3e, 0x86, 0x61, 0xb5, 0x62, 0xd7, 0x2c, 0x92, 0x1a, 0x74, 0xb5, 0xf9, 0xb7, 0x9e, 0x35,
+	0xd2, 0x03, 0x2f, 0x1a, 0xe9, 0x2b, 0x25, 0x93, 0x18, 0xb5, 0xfd, 0x8c, 0x66, 0x57, 0xb2, 0x9e,
+	0x01, 0xfb, 0xb3, 0x8a, 0xf5, 0x03, 0xb6, 0xe4, 0xa6, 0x45, 0x9a, 0x8d, 0xf4, 0x78, 0x5d, 0xad,
+	0x94, 0xef, 0xc8, 0x9e, 0x17, 0x59, 0x61, 0xee, 0xc4, 0xab, 0x30, 0x8c, 0xeb, 0x95, 0x7d, 0xbb,
+	0x9c, 0x1a, 0xa2, 0x8e, 0x27, 0x5b, 0x50, 0x4f, 0x2e, 0x2b, 0x0c, 0x20, 0xde, 0x87, 0x49, 0x44,
+	0x0c, 0xe4, 0xa0, 0x5a, 0xa5, 0xa0, 0x19, 0xaa, 0x69, 0x15, 0x4c, 0x3d, 0x95, 0x58, 0x12, 0x56,
+	0x86, 0xf2, 0xe7, 0x9a, 0x8d, 0x74, 0xca, 0xb3, 0xea, 0x80, 0xc8, 0xca, 0x84, 0x2f, 0xdb, 0x70,
+	0x45, 0x9b, 0xba, 0xb8, 0x19, 0xf0, 0xe4, 0x20, 0x0d, 0x99, 0x87, 0xc8, 0x49, 0x9d, 0xa2, 0xeb,
+	0x47, 0x79, 0xf2, 0x21, 0xb2, 0x72, 0xd6, 0x97, 0x29, 0x4c, 0x24, 0x22, 0x18, 0xd3, 0x10, 0x31,
+	0x0a, 0x2c, 0x3a, 0xc3, 0xd4, 0xc9, 0x3b, 0x7d, 0x47, 0x47, 0xf4, 0x96, 0x0c, 0xb8, 0x92, 0x15,
+	0x70, 0xdf, 0xd6, 0xbd, 0x17, 0x03, 0x26, 0xd8, 0xf7, 0x52, 0x10, 0xae, 0xda, 0x16, 0x46, 0xe2,
+	0x75, 0x98, 0x0d, 0x7d, 0xb7, 0x02, 0x46, 0x9f, 0xd5, 0x90, 0xa5, 0x21, 0xfa, 0x01, 0x13, 0xca,
+	0x74, 0xf0, 0x03, 0xee, 0x32, 0x9d, 0x98, 0x86, 0xb1, 0xaa, 0x63, 0x57, 0x0d, 0xa4, 0xd5, 0xdd,
+	0xf0, 0xd1, 0xaf, 0xa9, 0x80, 0x2f, 0xda, 0xd4, 0xe5, 0x67, 0x5e, 0x6a, 0xe4, 0x6b, 0x8e, 0x25,
+	0xde, 0x8d, 0x4c, 0x8d, 0x7c, 0xaa, 0xd9, 0x48, 0x4f, 0x33, 0xc2, 0x41, 0xb5, 0xfc, 0x5f, 0xd2,
+	0xfc, 0x0b, 0x93, 0xc6, 0xfd, 0x92, 0x27, 0x9d, 0x34, 0x5f, 0x09, 0x30, 0xb7, 0x8d, 0x4b, 0x1b,
+	0x0e, 0x52, 0x09, 0x7a, 0x97, 0x18, 0x79, 0xda, 0x94, 0x36, 0xca, 0xaa, 0x59, 0x11, 0x0f, 0xc0,
+	0x0d, 0x40, 0xc1, 0xeb, 0x53, 0x05, 0xcd, 0x95, 0xd1, 0xc5, 0xc6, 0x72, 0x97, 0x32, 0x51, 0x3d,
+	0x2f, 0x13, 0xb6, 0xcf, 0x2f, 0x34, 0x1b, 0xe9, 0x39, 0x1e, 0xdc, 0x90, 0x1f, 0x59, 0x49, 0xa2,
+	0x10, 0x58, 0xae, 0x43, 0x3a, 0x86, 0x07, 0x0f, 0x41, 0xdb, 0x66, 0x84, 0xf6, 0xcd, 0x88, 0x37,
+	0x60, 0x18, 0x13, 0x95, 0xd4, 0x30, 0xdd, 0x68, 0x32, 0x77, 0x9e, 0xd3, 0xf4, 0x9a, 0xa9, 0xcb,
+	0x71, 0x97, 0x02, 0xf6, 0xd0, 0x11, 0x51, 0x18, 0x58, 0xfe, 0x45, 0x80, 0x85, 0x6d, 0x5c, 0x7a,
+	0x54, 0xd5, 0x55, 0x82, 0x1e, 0x1b, 0x26, 0x41, 0x5b, 0x26, 0x26, 0x1f, 0xaa, 0x65, 0x53, 0x57,
+	0x89, 0xed, 0xbc, 0x6e, 0x31, 0xe5, 0x60, 0xf4, 0xd0, 0xf7, 0xc5, 0xea, 0x69, 0xba, 0xd9, 0x48,
+	0x9f, 0xf5, 0x4c, 0xb9, 0x4a, 0x56, 0x5a, 0x30, 0xf1, 0x6d, 0x48, 0xda, 0x55, 0xe4, 0xa8, 0xc4,
+	0xb4, 0xad, 0x82, 0x9b, 0x39, 0xac, 0x5e, 0xe6, 0x9b, 0x8d, 0xf4, 0x8c, 0x67, 0x18, 0xd6, 0xcb,
+	0xca, 0x38, 0x17, 0xec, 0xb9, 0xef, 0x97, 0xe1, 0x62, 0x97, 0x3d, 0xf9, 0x31, 0x95, 0x9f, 0xc0,
+	0x39, 0x0e, 0xdb, 0x40, 0xc4, 0xf0, 0x33, 0x7d, 0x5d, 0xd3, 0x68, 0xc1, 0xf6, 0x74, 0xc6, 0xe4,
+	0x60, 0x86, 0xa6, 0xb2, 0x5f, 0x39, 0x05, 0xd5, 0xb3, 0x66, 0xf9, 0x36, 0xa5, 0x75, 0x3a, 0x96,
+	0xaf, 0xc0, 0xa5, 0x6e, 0x0b, 0x73, 0x82, 0x2f, 0x04, 0x18, 0xdf, 0xc6, 0x25, 0x05, 0x61, 0xad,
+	0x46, 0x81, 0xbd, 0x51, 0x5a, 0x86, 0x09, 0x06, 0xe2, 0x15, 0xef, 0x91, 0x49, 0x7a, 0x62, 0x5e,
+	0xd1, 0x0f, 0xc2, 0x15, 0xed, 0x85, 0x39, 0xd3, 0x5f, 0x45, 0x07, 0x6b, 0x57, 0xfc, 0x5f, 0x6c,
+	0xdf, 0xea, 0xe8, 0x4c, 0xf2, 0x1c, 0xcc, 0x84, 0xf6, 0xc6, 0x77, 0xbd, 0x41, 0x1b, 0xc0, 0x2e,
+	0x22, 0xf9, 0xb2, 0xaa, 0x1d, 0x94, 0x4d, 0x4c, 0x44, 0x11, 0x12, 0x45, 0xc7, 0xae, 0xb0, 0xdd,
+	0xd2, 0x67, 0xf1, 0x1c, 0x8c, 0xaa, 0xba, 0xee, 0x20, 0x8c, 0x91, 0x9b, 0xf3, 0x43, 0x2b, 0xa3,
+	0x4a, 0x4b, 0x20, 0xcf, 0xc3, 0x5c, 0x9b, 0x13, 0xee, 0x1f, 0xd3, 0xa0, 0xee, 0x22, 0xb2, 0x83,
+	0xc8, 0x13, 0xdb, 0xe9, 0x71, 0x96, 0xb8, 0x0b, 0x23, 0x96, 0x87, 0xa7, 0xc1, 0x1c, 0xcb, 0x9d,
+	0x8f, 0xee, 0x03, 0xcc, 0x69, 0x3e, 0xe1, 0x86, 0x51, 0xf1, 0x6d, 0xd8, 0x6e, 0x5b, 0x8b, 0x72,
+	0x36, 0x07, 0x30, 0xe6, 0x29, 0x1e, 0xaa, 0x35, 0x8c, 0x7a, 0xe3, 0x72, 0x13, 0x4e, 0x55, 0x5d,
+	0x34, 0x63, 0xb2, 0x10, 0xcd, 0x84, 0x3a, 0x64, 0x3c, 0x3c, 0xbc, 0x3c, 0x03, 0x53, 0x81, 0xc5,
+	0x38, 0x87, 0x9f, 0x05, 0x98, 0xa7, 0xdf, 0xa2, 0x6a, 0x3b, 0xe4, 0x41, 0x8d, 0xec, 0xdb, 0x35,
+	0x4b, 0xdf, 0x73, 0x54, 0x0b, 0x17, 0x91, 0x23, 0x5e, 0x83, 0x49, 0x5e, 0x9c, 0x05, 0x16, 0x61,
+	0x46, 0xeb, 0x2c, 0x57, 0xac, 0x7b, 0xf2, 0x4e, 0xfe, 0x83, 0x11, 0xfc, 0xe3, 0xfb, 0xf9, 0x50,
+	0x97, 0x7e, 0xbe, 0x02, 0xfc, 0x4c, 0x2a, 0x90, 0xa3, 0x82, 0xa1, 0x62, 0x83, 0xe6, 0xd6, 0xa8,
+	0x92, 0xf4, 0xe5, 0x7b, 0x47, 0xf7, 0x55, 0x6c, 0xc8, 0x9f, 0xc3, 0x85, 0xd8, 0xed, 0x9c, 0x78,
+	0x47, 0xfd, 0xc1, 0x0f, 0x66, 0xb1, 0x66, 0xe9, 0x1d, 0xc1, 0xec, 0xb5, 0x80, 0x09, 0x33, 0x08,
+	0x87, 0x31, 0xe9, 0x8b, 0x19, 0xf0, 0x16, 0xa4, 0xda, 0x80, 0xed, 0xa1, 0x9c, 0x0d, 0x5b, 0xf8,
+	0xc1, 0x94, 0x2f, 0xc2, 0x85, 0x58, 0x92, 0x3c, 0x2f, 0xaa, 0xf4, 0x6c, 0x50, 0x90, 0x6e, 0x3a,
+	0x48, 0x23, 0x8f, 0x2c, 0x7a, 0x7c, 0x21, 0x7d, 0xd3, 0xa2, 0xf0, 0xde, 0xf6, 0x92, 0x84, 0x41,
+	0x76, 0xf8, 0x26, 0x94, 0x41, 0x53, 0x77, 0xcb, 0xd6, 0x41, 0x9a, 0x59, 0x35, 0x91, 0xdf, 0x71,
+	0x94, 0x96, 0x80, 0x75, 0xee, 0xb8, 0x15, 0x39, 0xb1, 0xef, 0x04, 0x98, 0xdc, 0xc6, 0xa5, 0x75,
+	0x5d, 0xdf, 0xb3, 0x5b, 0x5d, 0xa2, 0x27, 0x3e, 0x5d, 0xdb, 0x86, 0x38, 0x0b, 0xc3, 0x0e, 0x52,
+	0xb1, 0x6d, 0x31, 0x6a, 0xec, 0xcd, 0x75, 0x8d, 0x8e, 0xaa, 0xa6, 0x53, 0x2f, 0x18, 0xc8, 0x2c,
+	0x19, 0x84, 0x35, 0xb5, 0x33, 0x9e, 0xf0, 0x3e, 0x95, 0xc9, 0x0b, 0x30, 0xdf, 0x41, 0x2a, 0xd0,
+	0x75, 0x66, 0xe9, 0xce, 0x2a, 0xf6, 0x21, 0x7a, 0xcf, 0xb1, 0x2b, 0xff, 0x04, 0x6d, 0x79, 0x09,
+	0x16, 0xa3, 0x17, 0xe5, 0xb4, 0x7e, 0xf2, 0x22, 0xe9, 0xd5, 0xca, 0x3d, 0x15, 0x3f, 0x74, 0x4c,
+	0x0d, 0xf5, 0x57, 0xf2, 0x91, 0x4d, 0x7f, 0x30, 0xb2, 0xe9, 0x8b, 0x1f, 0xc0, 0x68, 0x49, 0xc5,
+	0x85, 0xaa, 0xbb, 0xca, 0x5f, 0x3c, 0x6f, 0x4e, 0x97, 0x18, 0x4b, 0x16, 0xef, 0x30, 0x75, 0xbe,
+	0xb1, 0xef, 0x05, 0x90, 0x3a, 0x87, 0xaa, 0x9d, 0x22, 0xf1, 0xe6, 0xbb, 0x3a, 0x4c, 0x07, 0xe6,
+	0x32, 0xab, 0x48, 0x42, 0x33, 0xde, 0xf2, 0x31, 0x33, 0x9e, 0xef, 0x26, 0x9f, 0x6e, 0x36, 0xd2,
+	0x0b, 0x1d, 0x63, 0x1e, 0x77, 0x27, 0x2b, 0x93, 0xa8, 0xdd, 0x46, 0x7e, 0x0a, 0x72, 0x3c, 0xb1,
+	0x13, 0x6f, 0x4f, 0xbf, 0x0d, 0x02, 0xb0, 0xf9, 0x7a, 0xa7, 0x48, 0x5e, 0x77, 0xbe, 0xcb, 0xc0,
+	0x69, 0xad, 0xac, 0x62, 0xcc, 0x07, 0xec, 0xfc, 0x54, 0xb3, 0x91, 0x9e, 0x60, 0x96, 0x4c, 0x23,
+	0x2b, 0x23, 0xf4, 0x71, 0x53, 0x77, 0xf1, 0xc4, 0x3e, 0x40, 0x34, 0x45, 0x86, 0xda, 0xf1, 0xbe,
+	0x46, 0x56, 0x46, 0xe8, 0x63, 0xdc, 0xf5, 0x25, 0xf1, 0x77, 0x5c, 0x5f, 0x4e, 0x9d, 0xd0, 0xf5,
+	0xe5, 0x7d, 0x10, 0x5b, 0xe1, 0x7d, 0xbd, 0x1b, 0x4c, 0xee, 0x8f, 0x24, 0x0c, 0x6d, 0xe3, 0x92,
+	0xb8, 0x05, 0x09, 0xfa, 0x4f, 0x8f, 0x98, 0x91, 0x83, 0xdd, 0xb1, 0xa5, 0xcb, 0x5d, 0xd5, 0x9c,
+	0xcb, 0x16, 0x24, 0xe8, 0x3d, 0x39, 0xde, 0x9b, 0xab, 0x96, 0x2e, 0x77, 0x55, 0x73, 0x6f, 0x4f,
+	0x61, 0x3a, 0xf2, 0x02, 0xb5, 0x1a, 0x6b, 0x1e, 0x05, 0x97, 0x6e, 0xf4, 0x05, 0xe7, 0xab, 0x7f,
+	0x2d, 0x40, 0x2a, 0xf6, 0xee, 0xb2, 0x16, 0xeb, 0x33, 0xce, 0x44, 0xba, 0xdd, 0xb7, 0x09, 0xa7,
+	0xf2, 0x8d, 0x00, 0xf3, 0xf1, 0x77, 0x89, 0xdc, 0x31, 0x8e, 0x23, 0x6c, 0xa4, 0x3b, 0xfd, 0xdb,
+	0x70, 0x36, 0x9f, 0x00, 0x04, 0xaf, 0x0d, 0xb1, 0x9e, 0x5a, 0x20, 0xe9, 0x5a, 0x0f, 0x20, 0xee,
+	0x5f, 0x87, 0x33, 0xa1, 0x09, 0x3d, 0x3e, 0x5b, 0x82, 0x30, 0x69, 0xb5, 0x27, 0x58, 0x70, 0x17,
+	0xc1, 0x39, 0xbd, 0x9b, 0x31, 0x03, 0x49, 0xd7, 0x7a, 0x00, 0x71, 0xff, 0x1f, 0xc1, 0x69, 0x3e,
+	0x79, 0x5f, 0xe8, 0x66, 0x48, 0x21, 0xd2, 0xd5, 0x63, 0x21, 0xdc, 0xf3, 0x17, 0x02, 0xcc, 0xc6,
+	0xcc, 0xd3, 0xd9, 0x2e, 0x71, 0x8e, 0x32, 0x90, 0x6e, 0xf6, 0x69, 0xd0, 0x46, 0x22, 0x72, 0x0e,
+	0xed, 0x46, 0x22, 0xca, 0x40, 0xba, 0xd9, 0xa7, 0x41, 0xa8, 0x44, 0x63, 0x47, 0xc8, 0xb5, 0x2e,
+	0x5e, 0xa3, 0x4d, 0xa4, 0xdb, 0x7d, 0x9b, 0x70, 0x2a, 0x9f, 0x42, 0xb2, 0x6d, 0x64, 0x5c, 0x8e,
+	0x75, 0x16, 0x06, 0x4a, 0xd9, 0x1e, 0x81, 0x7c, 0xad, 0x3a, 0x4c, 0x45, 0x0d, 0x7b, 0xff, 0xef,
+	0xc2, 0xbe, 0x03, 0x2d, 0x5d, 0xef, 0x07, 0x1d, 0xdc, 0x66, 0xdb, 0x3c, 0xb7, 0x7c, 0x4c, 0x06,
+	0xf9, 0x40, 0x29, 0xdb, 0x23, 0x90, 0xaf, 0xf5, 0xa5, 0x00, 0x73, 0x71, 0x33, 0xd6, 0x1b, 0xbd,
+	0xf6, 0x74, 0xdf, 0x42, 0xba, 0xd5, 0xaf, 0x05, 0xe7, 0xf1, 0x08, 0x46, 0xfc, 0x91, 0x66, 0xa9,
+	0xeb, 0xc1, 0xb5, 0x53, 0x24, 0xd2, 0xca, 0x71, 0x08, 0xdf, 0x6d, 0xfe, 0xde, 0xb3, 0x97, 0x8b,
+	0xc2, 0xf3, 0x97, 0x8b, 0xc2, 0xef, 0x2f, 0x17, 0x85, 0x6f, 0x5f, 0x2d, 0x0e, 0x3c, 0x7f, 0xb5,
+	0x38, 0xf0, 0xeb, 0xab, 0xc5, 0x81, 0x8f, 0x57, 0x03, 0x13, 0xc3, 0xae, 0x59, 0xa4, 0x83, 0x6f,
+	0xd6, 0xff, 0xfd, 0xe2, 0x28, 0xf0, 0x1b, 0x07, 0x1d, 0x1e, 0xf6, 0x87, 0xe9, 0x4f, 0x18, 0x6f,
+	0xfe, 0x39, 0x00, 0x23, 0xec, 0x72, 0x10, 0x50, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error)
+	UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error)
+	UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error)
+	RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error)
+	SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error)
+	SetNetwork(ctx context.Context, in *MsgSetNetwork, opts ...grpc.CallOption) (*MsgSetNetworkResponse, error)
+	SetPause(ctx context.Context, in *MsgSetPause, opts ...grpc.CallOption) (*MsgSetPauseResponse, error)
+	ReportOutboundTransfer(ctx context.Context, in *MsgReportOutboundTransfer, opts ...grpc.CallOption) (*MsgReportOutboundTransferResponse, error)
+	RefundOutboundTransfer(ctx context.Context, in *MsgRefundOutboundTransfer, opts ...grpc.CallOption) (*MsgRefundOutboundTransferResponse, error)
+	RedirectUnclaimedInbound(ctx context.Context, in *MsgRedirectUnclaimedInbound, opts ...grpc.CallOption) (*MsgRedirectUnclaimedInboundResponse, error)
+	AddToBlacklist(ctx context.Context, in *MsgAddToBlacklist, opts ...grpc.CallOption) (*MsgAddToBlacklistResponse, error)
+	RemoveFromBlacklist(ctx context.Context, in *MsgRemoveFromBlacklist, opts ...grpc.CallOption) (*MsgRemoveFromBlacklistResponse, error)
+	ReportGasPrice(ctx context.Context, in *MsgReportGasPrice, opts ...grpc.CallOption) (*MsgReportGasPriceResponse, error)
+	CreateEthBridgeNftClaim(ctx context.Context, in *MsgCreateEthBridgeNftClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeNftClaimResponse, error)
+	BurnNft(ctx context.Context, in *MsgBurnNft, opts ...grpc.CallOption) (*MsgBurnNftResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
+	out := new(MsgLockResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error) {
+	out := new(MsgCreateEthBridgeClaimResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error) {
+	out := new(MsgUpdateWhiteListValidatorResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error) {
+	out := new(MsgUpdateCethReceiverAccountResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error) {
+	out := new(MsgRescueCethResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/RescueCeth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error) {
+	out := new(MsgSetBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNetwork(ctx context.Context, in *MsgSetNetwork, opts ...grpc.CallOption) (*MsgSetNetworkResponse, error) {
+	out := new(MsgSetNetworkResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPause(ctx context.Context, in *MsgSetPause, opts ...grpc.CallOption) (*MsgSetPauseResponse, error) {
+	out := new(MsgSetPauseResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportOutboundTransfer(ctx context.Context, in *MsgReportOutboundTransfer, opts ...grpc.CallOption) (*MsgReportOutboundTransferResponse, error) {
+	out := new(MsgReportOutboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/ReportOutboundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundOutboundTransfer(ctx context.Context, in *MsgRefundOutboundTransfer, opts ...grpc.CallOption) (*MsgRefundOutboundTransferResponse, error) {
+	out := new(MsgRefundOutboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/RefundOutboundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedirectUnclaimedInbound(ctx context.Context, in *MsgRedirectUnclaimedInbound, opts ...grpc.CallOption) (*MsgRedirectUnclaimedInboundResponse, error) {
+	out := new(MsgRedirectUnclaimedInboundResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/RedirectUnclaimedInbound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToBlacklist(ctx context.Context, in *MsgAddToBlacklist, opts ...grpc.CallOption) (*MsgAddToBlacklistResponse, error) {
+	out := new(MsgAddToBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/AddToBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromBlacklist(ctx context.Context, in *MsgRemoveFromBlacklist, opts ...grpc.CallOption) (*MsgRemoveFromBlacklistResponse, error) {
+	out := new(MsgRemoveFromBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/RemoveFromBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportGasPrice(ctx context.Context, in *MsgReportGasPrice, opts ...grpc.CallOption) (*MsgReportGasPriceResponse, error) {
+	out := new(MsgReportGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/ReportGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEthBridgeNftClaim(ctx context.Context, in *MsgCreateEthBridgeNftClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeNftClaimResponse, error) {
+	out := new(MsgCreateEthBridgeNftClaimResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/CreateEthBridgeNftClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnNft(ctx context.Context, in *MsgBurnNft, opts ...grpc.CallOption) (*MsgBurnNftResponse, error) {
+	out := new(MsgBurnNftResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/BurnNft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	CreateEthBridgeClaim(context.Context, *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error)
+	UpdateWhiteListValidator(context.Context, *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error)
+	UpdateCethReceiverAccount(context.Context, *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error)
+	RescueCeth(context.Context, *MsgRescueCeth) (*MsgRescueCethResponse, error)
+	SetBlacklist(context.Context, *MsgSetBlacklist) (*MsgSetBlacklistResponse, error)
+	SetNetwork(context.Context, *MsgSetNetwork) (*MsgSetNetworkResponse, error)
+	SetPause(context.Context, *MsgSetPause) (*MsgSetPauseResponse, error)
+	ReportOutboundTransfer(context.Context, *MsgReportOutboundTransfer) (*MsgReportOutboundTransferResponse, error)
+	RefundOutboundTransfer(context.Context, *MsgRefundOutboundTransfer) (*MsgRefundOutboundTransferResponse, error)
+	RedirectUnclaimedInbound(context.Context, *MsgRedirectUnclaimedInbound) (*MsgRedirectUnclaimedInboundResponse, error)
+	AddToBlacklist(context.Context, *MsgAddToBlacklist) (*MsgAddToBlacklistResponse, error)
+	RemoveFromBlacklist(context.Context, *MsgRemoveFromBlacklist) (*MsgRemoveFromBlacklistResponse, error)
+	ReportGasPrice(context.Context, *MsgReportGasPrice) (*MsgReportGasPriceResponse, error)
+	CreateEthBridgeNftClaim(context.Context, *MsgCreateEthBridgeNftClaim) (*MsgCreateEthBridgeNftClaimResponse, error)
+	BurnNft(context.Context, *MsgBurnNft) (*MsgBurnNftResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Lock(ctx context.Context, req *MsgLock) (*MsgLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) CreateEthBridgeClaim(ctx context.Context, req *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEthBridgeClaim not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWhiteListValidator(ctx context.Context, req *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWhiteListValidator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCethReceiverAccount(ctx context.Context, req *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCethReceiverAccount not implemented")
+}
+func (*UnimplementedMsgServer) RescueCeth(ctx context.Context, req *MsgRescueCeth) (*MsgRescueCethResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescueCeth not implemented")
+}
+func (*UnimplementedMsgServer) SetBlacklist(ctx context.Context, req *MsgSetBlacklist) (*MsgSetBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlacklist not implemented")
+}
+func (*UnimplementedMsgServer) SetNetwork(ctx context.Context, req *MsgSetNetwork) (*MsgSetNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNetwork not implemented")
+}
+func (*UnimplementedMsgServer) SetPause(ctx context.Context, req *MsgSetPause) (*MsgSetPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPause not implemented")
+}
+func (*UnimplementedMsgServer) ReportOutboundTransfer(ctx context.Context, req *MsgReportOutboundTransfer) (*MsgReportOutboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportOutboundTransfer not implemented")
+}
+func (*UnimplementedMsgServer) RefundOutboundTransfer(ctx context.Context, req *MsgRefundOutboundTransfer) (*MsgRefundOutboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOutboundTransfer not implemented")
+}
+func (*UnimplementedMsgServer) RedirectUnclaimedInbound(ctx context.Context, req *MsgRedirectUnclaimedInbound) (*MsgRedirectUnclaimedInboundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectUnclaimedInbound not implemented")
+}
+func (*UnimplementedMsgServer) AddToBlacklist(ctx context.Context, req *MsgAddToBlacklist) (*MsgAddToBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToBlacklist not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFromBlacklist(ctx context.Context, req *MsgRemoveFromBlacklist) (*MsgRemoveFromBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromBlacklist not implemented")
+}
+func (*UnimplementedMsgServer) ReportGasPrice(ctx context.Context, req *MsgReportGasPrice) (*MsgReportGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportGasPrice not implemented")
+}
+func (*UnimplementedMsgServer) CreateEthBridgeNftClaim(ctx context.Context, req *MsgCreateEthBridgeNftClaim) (*MsgCreateEthBridgeNftClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEthBridgeNftClaim not implemented")
+}
+func (*UnimplementedMsgServer) BurnNft(ctx context.Context, req *MsgBurnNft) (*MsgBurnNftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnNft not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Lock(ctx, req.(*MsgLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEthBridgeClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEthBridgeClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEthBridgeClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEthBridgeClaim(ctx, req.(*MsgCreateEthBridgeClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWhiteListValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWhiteListValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWhiteListValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWhiteListValidator(ctx, req.(*MsgUpdateWhiteListValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCethReceiverAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCethReceiverAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, req.(*MsgUpdateCethReceiverAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RescueCeth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescueCeth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescueCeth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/RescueCeth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescueCeth(ctx, req.(*MsgRescueCeth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlacklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlacklist(ctx, req.(*MsgSetBlacklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNetwork(ctx, req.(*MsgSetNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPause(ctx, req.(*MsgSetPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportOutboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportOutboundTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportOutboundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/ReportOutboundTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportOutboundTransfer(ctx, req.(*MsgReportOutboundTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundOutboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundOutboundTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundOutboundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/RefundOutboundTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundOutboundTransfer(ctx, req.(*MsgRefundOutboundTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedirectUnclaimedInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedirectUnclaimedInbound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedirectUnclaimedInbound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/RedirectUnclaimedInbound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedirectUnclaimedInbound(ctx, req.(*MsgRedirectUnclaimedInbound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToBlacklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/AddToBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToBlacklist(ctx, req.(*MsgAddToBlacklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromBlacklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/RemoveFromBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromBlacklist(ctx, req.(*MsgRemoveFromBlacklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/ReportGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportGasPrice(ctx, req.(*MsgReportGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEthBridgeNftClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEthBridgeNftClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEthBridgeNftClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/CreateEthBridgeNftClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEthBridgeNftClaim(ctx, req.(*MsgCreateEthBridgeNftClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnNft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnNft)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnNft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/BurnNft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnNft(ctx, req.(*MsgBurnNft))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.ethbridge.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lock",
+			Handler:    _Msg_Lock_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "CreateEthBridgeClaim",
+			Handler:    _Msg_CreateEthBridgeClaim_Handler,
+		},
+		{
+			MethodName: "UpdateWhiteListValidator",
+			Handler:    _Msg_UpdateWhiteListValidator_Handler,
+		},
+		{
+			MethodName: "UpdateCethReceiverAccount",
+			Handler:    _Msg_UpdateCethReceiverAccount_Handler,
+		},
+		{
+			MethodName: "RescueCeth",
+			Handler:    _Msg_RescueCeth_Handler,
+		},
+		{
+			MethodName: "SetBlacklist",
+			Handler:    _Msg_SetBlacklist_Handler,
+		},
+		{
+			MethodName: "SetNetwork",
+			Handler:    _Msg_SetNetwork_Handler,
+		},
+		{
+			MethodName: "SetPause",
+			Handler:    _Msg_SetPause_Handler,
+		},
+		{
+			MethodName: "ReportOutboundTransfer",
+			Handler:    _Msg_ReportOutboundTransfer_Handler,
+		},
+		{
+			MethodName: "RefundOutboundTransfer",
+			Handler:    _Msg_RefundOutboundTransfer_Handler,
+		},
+		{
+			MethodName: "RedirectUnclaimedInbound",
+			Handler:    _Msg_RedirectUnclaimedInbound_Handler,
+		},
+		{
+			MethodName: "AddToBlacklist",
+			Handler:    _Msg_AddToBlacklist_Handler,
+		},
+		{
+			MethodName: "RemoveFromBlacklist",
+			Handler:    _Msg_RemoveFromBlacklist_Handler,
+		},
+		{
+			MethodName: "ReportGasPrice",
+			Handler:    _Msg_ReportGasPrice_Handler,
+		},
+		{
+			MethodName: "CreateEthBridgeNftClaim",
+			Handler:    _Msg_CreateEthBridgeNftClaim_Handler,
+		},
+		{
+			MethodName: "BurnNft",
+			Handler:    _Msg_BurnNft_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/ethbridge/v1/tx.proto",
+}
+
+func (m *MsgLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EthereumReceiver) > 0 {
+		i -= len(m.EthereumReceiver)
+		copy(dAtA[i:], m.EthereumReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProphecyId) > 0 {
+		i -= len(m.ProphecyId)
+		copy(dAtA[i:], m.ProphecyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProphecyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CosmosSenderSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CosmosSenderSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EthereumReceiver) > 0 {
+		i -= len(m.EthereumReceiver)
+		copy(dAtA[i:], m.EthereumReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProphecyId) > 0 {
+		i -= len(m.ProphecyId)
+		copy(dAtA[i:], m.ProphecyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProphecyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CosmosSenderSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CosmosSenderSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEthBridgeClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEthBridgeClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEthBridgeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthBridgeClaim != nil {
+		{
+			size, err := m.EthBridgeClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProphecyId) > 0 {
+		i -= len(m.ProphecyId)
+		copy(dAtA[i:], m.ProphecyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProphecyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhiteListValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhiteListValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhiteListValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperationType) > 0 {
+		i -= len(m.OperationType)
+		copy(dAtA[i:], m.OperationType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCethReceiverAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCethReceiverAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCethReceiverAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CethReceiverAccount) > 0 {
+		i -= len(m.CethReceiverAccount)
+		copy(dAtA[i:], m.CethReceiverAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CethReceiverAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescueCeth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescueCeth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescueCeth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescueCethResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescueCethResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescueCethResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlacklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlacklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNetwork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNetwork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetwork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Network.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNetworkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNetworkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNetworkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportOutboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportOutboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportOutboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CosmosSenderSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CosmosSenderSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportOutboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportOutboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportOutboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProphecyId) > 0 {
+		i -= len(m.ProphecyId)
+		copy(dAtA[i:], m.ProphecyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProphecyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundOutboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundOutboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundOutboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransferSenderSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TransferSenderSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TransferSender) > 0 {
+		i -= len(m.TransferSender)
+		copy(dAtA[i:], m.TransferSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundOutboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundOutboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundOutboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedirectUnclaimedInbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedirectUnclaimedInbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedirectUnclaimedInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedirectUnclaimedInboundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedirectUnclaimedInboundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedirectUnclaimedInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToBlacklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToBlacklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromBlacklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromBlacklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEthBridgeNftClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEthBridgeNftClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEthBridgeNftClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthBridgeNftClaim != nil {
+		{
+			size, err := m.EthBridgeNftClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEthBridgeNftClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEthBridgeNftClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEthBridgeNftClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProphecyId) > 0 {
+		i -= len(m.ProphecyId)
+		copy(dAtA[i:], m.ProphecyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProphecyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnNft) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnNft) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnNft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EthereumReceiver) > 0 {
+		i -= len(m.EthereumReceiver)
+		copy(dAtA[i:], m.EthereumReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnNftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnNftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnNftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosSenderSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CosmosSenderSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTx(uint64(m.EthereumChainId))
+	}
+	l = len(m.EthereumReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosSenderSequence != 0 {
+		n += 1 + sovTx(uint64(m.CosmosSenderSequence))
+	}
+	l = len(m.ProphecyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTx(uint64(m.EthereumChainId))
+	}
+	l = len(m.EthereumReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosSenderSequence != 0 {
+		n += 1 + sovTx(uint64(m.CosmosSenderSequence))
+	}
+	l = len(m.ProphecyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEthBridgeClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthBridgeClaim != nil {
+		l = m.EthBridgeClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProphecyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgUpdateWhiteListValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperationType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCethReceiverAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CethReceiverAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRescueCeth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTx(uint64(m.EthereumChainId))
+	}
+	return n
+}
+
+func (m *MsgRescueCethResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBlacklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetNetwork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Network.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetNetworkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportOutboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CosmosSenderSequence != 0 {
+		n += 1 + sovTx(uint64(m.CosmosSenderSequence))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportOutboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProphecyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgRefundOutboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TransferSenderSequence != 0 {
+		n += 1 + sovTx(uint64(m.TransferSenderSequence))
+	}
+	return n
+}
+
+func (m *MsgRefundOutboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedirectUnclaimedInbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedirectUnclaimedInboundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToBlacklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgAddToBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFromBlacklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFromBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTx(uint64(m.EthereumChainId))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReportGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateEthBridgeNftClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthBridgeNftClaim != nil {
+		l = m.EthBridgeNftClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEthBridgeNftClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProphecyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgBurnNft) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1731,59 +4326,2234 @@ func (m *MsgRescueCeth) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.CosmosReceiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthereumReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnNftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosSenderSequence != 0 {
+		n += 1 + sovTx(uint64(m.CosmosSenderSequence))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSenderSequence", wireType)
+			}
+			m.CosmosSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProphecyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSenderSequence", wireType)
+			}
+			m.CosmosSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProphecyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBridgeClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EthBridgeClaim == nil {
+				m.EthBridgeClaim = &EthBridgeClaim{}
+			}
+			if err := m.EthBridgeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProphecyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.StatusText(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiverAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CethReceiverAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescueCeth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescueCeth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescueCethResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescueCethResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescueCethResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBlacklist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBlacklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBlacklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNetwork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNetwork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNetwork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Network.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNetworkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNetworkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNetworkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportOutboundTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportOutboundTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportOutboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSenderSequence", wireType)
+			}
+			m.CosmosSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.CethAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgRescueCethResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSetBlacklist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgReportOutboundTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportOutboundTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportOutboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProphecyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.StatusText(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgSetBlacklistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgLock) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundOutboundTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1806,10 +6576,10 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundOutboundTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundOutboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1846,7 +6616,7 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1871,16 +6641,133 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSenderSequence", wireType)
+			}
+			m.TransferSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundOutboundTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundOutboundTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundOutboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedirectUnclaimedInbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedirectUnclaimedInbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedirectUnclaimedInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1908,32 +6795,13 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
-			}
-			m.EthereumChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EthereumChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1943,27 +6811,14 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1991,9 +6846,7 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2016,7 +6869,7 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedirectUnclaimedInboundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2039,10 +6892,10 @@ func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedirectUnclaimedInboundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedirectUnclaimedInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2066,7 +6919,7 @@ func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToBlacklist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,10 +6942,10 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToBlacklist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2129,7 +6982,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2157,13 +7010,11 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,64 +7042,13 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
-			}
-			m.EthereumChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EthereumChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
-			}
-			var stringLen uint64
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2258,26 +7058,11 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2299,7 +7084,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToBlacklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2322,10 +7107,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToBlacklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2349,7 +7134,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromBlacklist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2372,17 +7157,17 @@ func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromBlacklist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthBridgeClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2392,27 +7177,87 @@ func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EthBridgeClaim == nil {
-				m.EthBridgeClaim = &EthBridgeClaim{}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			if err := m.EthBridgeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2435,7 +7280,7 @@ func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromBlacklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2458,10 +7303,10 @@ func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromBlacklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2485,7 +7330,7 @@ func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgReportGasPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2508,15 +7353,15 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportGasPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2544,13 +7389,13 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
 			}
-			var stringLen uint64
+			m.EthereumChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2560,27 +7405,14 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EthereumChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2608,7 +7440,9 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperationType = string(dAtA[iNdEx:postIndex])
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2631,7 +7465,7 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2654,10 +7488,10 @@ func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2681,7 +7515,7 @@ func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateEthBridgeNftClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2704,17 +7538,17 @@ func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateEthBridgeNftClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateEthBridgeNftClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBridgeNftClaim", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2724,55 +7558,27 @@ func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosSender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiverAccount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if m.EthBridgeNftClaim == nil {
+				m.EthBridgeNftClaim = &EthBridgeNftClaim{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.EthBridgeNftClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CethReceiverAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2795,7 +7601,7 @@ func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateEthBridgeNftClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,12 +7624,63 @@ func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateEthBridgeNftClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateEthBridgeNftClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProphecyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.StatusText(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2845,7 +7702,7 @@ func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnNft) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2868,10 +7725,10 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRescueCeth: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnNft: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRescueCeth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnNft: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2908,7 +7765,7 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2936,11 +7793,11 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2968,113 +7825,11 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRescueCethResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRescueCethResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRescueCethResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBlacklist: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3102,11 +7857,11 @@ func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3134,7 +7889,9 @@ func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3157,7 +7914,7 @@ func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBlacklistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnNftResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3180,12 +7937,31 @@ func (m *MsgSetBlacklistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBlacklistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnNftResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnNftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSenderSequence", wireType)
+			}
+			m.CosmosSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
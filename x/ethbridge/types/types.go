@@ -4,6 +4,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -17,6 +18,22 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateCethReceiverAccount{},
 		&MsgRescueCeth{},
 		&MsgSetBlacklist{},
+		&MsgSetNetwork{},
+		&MsgSetPause{},
+		&MsgReportOutboundTransfer{},
+		&MsgRefundOutboundTransfer{},
+		&MsgRedirectUnclaimedInbound{},
+		&MsgAddToBlacklist{},
+		&MsgRemoveFromBlacklist{},
+		&MsgReportGasPrice{},
+		&MsgCreateEthBridgeNftClaim{},
+		&MsgBurnNft{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetPauseProposal{},
+		&SetAdminRoleProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
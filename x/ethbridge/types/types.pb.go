@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/Sifchain/sifnode/x/oracle/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -24,6 +25,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OutboundStatus is the lifecycle of an outbound transfer
+type OutboundStatus int32
+
+const (
+	OutboundStatus_OUTBOUND_STATUS_UNSPECIFIED OutboundStatus = 0
+	// Pending transfers have not been reported by any relayer
+	OutboundStatus_OUTBOUND_STATUS_PENDING OutboundStatus = 1
+	// Relayed transfers have been reported by relayers without consensus yet
+	OutboundStatus_OUTBOUND_STATUS_RELAYED OutboundStatus = 2
+	// Completed transfers have been reported by a consensus of relayers
+	OutboundStatus_OUTBOUND_STATUS_COMPLETED OutboundStatus = 3
+	// Refunded transfers were returned to the sender by the admin
+	OutboundStatus_OUTBOUND_STATUS_REFUNDED OutboundStatus = 4
+)
+
+var OutboundStatus_name = map[int32]string{
+	0: "OUTBOUND_STATUS_UNSPECIFIED",
+	1: "OUTBOUND_STATUS_PENDING",
+	2: "OUTBOUND_STATUS_RELAYED",
+	3: "OUTBOUND_STATUS_COMPLETED",
+	4: "OUTBOUND_STATUS_REFUNDED",
+}
+
+var OutboundStatus_value = map[string]int32{
+	"OUTBOUND_STATUS_UNSPECIFIED": 0,
+	"OUTBOUND_STATUS_PENDING":     1,
+	"OUTBOUND_STATUS_RELAYED":     2,
+	"OUTBOUND_STATUS_COMPLETED":   3,
+	"OUTBOUND_STATUS_REFUNDED":    4,
+}
+
+func (x OutboundStatus) String() string {
+	return proto.EnumName(OutboundStatus_name, int32(x))
+}
+
+func (OutboundStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{0}
+}
+
 // Claim type enum
 type ClaimType int32
 
@@ -34,18 +74,22 @@ const (
 	ClaimType_CLAIM_TYPE_BURN ClaimType = 1
 	// Lock claim type
 	ClaimType_CLAIM_TYPE_LOCK ClaimType = 2
+	// NFT lock claim type, an ERC-721 token locked on an EVM network
+	ClaimType_CLAIM_TYPE_NFT_LOCK ClaimType = 3
 )
 
 var ClaimType_name = map[int32]string{
 	0: "CLAIM_TYPE_UNSPECIFIED",
 	1: "CLAIM_TYPE_BURN",
 	2: "CLAIM_TYPE_LOCK",
+	3: "CLAIM_TYPE_NFT_LOCK",
 }
 
 var ClaimType_value = map[string]int32{
 	"CLAIM_TYPE_UNSPECIFIED": 0,
 	"CLAIM_TYPE_BURN":        1,
 	"CLAIM_TYPE_LOCK":        2,
+	"CLAIM_TYPE_NFT_LOCK":    3,
 }
 
 func (x ClaimType) String() string {
@@ -53,7 +97,49 @@ func (x ClaimType) String() string {
 }
 
 func (ClaimType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4cb34f678c9ed59f, []int{0}
+	return fileDescriptor_4cb34f678c9ed59f, []int{1}
+}
+
+// AdminRole is a part of the admin powers over the bridge, each role is granted
+// to accounts separately
+type AdminRole int32
+
+const (
+	// Unspecified admin role
+	AdminRole_ADMIN_ROLE_UNSPECIFIED AdminRole = 0
+	// Validator set manager, updates the whitelist of validators
+	AdminRole_ADMIN_ROLE_VALIDATOR_SET AdminRole = 1
+	// Fee manager, updates the ceth receiver account and the networks
+	AdminRole_ADMIN_ROLE_FEE AdminRole = 2
+	// Compliance, manages the blacklist and pauses bridge transfers
+	AdminRole_ADMIN_ROLE_COMPLIANCE AdminRole = 3
+	// Rescuer, rescues ceth, refunds outbound transfers and redirects unclaimed
+	// inbound transfers
+	AdminRole_ADMIN_ROLE_RESCUER AdminRole = 4
+)
+
+var AdminRole_name = map[int32]string{
+	0: "ADMIN_ROLE_UNSPECIFIED",
+	1: "ADMIN_ROLE_VALIDATOR_SET",
+	2: "ADMIN_ROLE_FEE",
+	3: "ADMIN_ROLE_COMPLIANCE",
+	4: "ADMIN_ROLE_RESCUER",
+}
+
+var AdminRole_value = map[string]int32{
+	"ADMIN_ROLE_UNSPECIFIED":   0,
+	"ADMIN_ROLE_VALIDATOR_SET": 1,
+	"ADMIN_ROLE_FEE":           2,
+	"ADMIN_ROLE_COMPLIANCE":    3,
+	"ADMIN_ROLE_RESCUER":       4,
+}
+
+func (x AdminRole) String() string {
+	return proto.EnumName(AdminRole_name, int32(x))
+}
+
+func (AdminRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{2}
 }
 
 // EthBridgeClaim is a structure that contains all the data for a particular
@@ -74,6 +160,9 @@ type EthBridgeClaim struct {
 	ValidatorAddress string                                 `protobuf:"bytes,8,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
 	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
 	ClaimType        ClaimType                              `protobuf:"varint,10,opt,name=claim_type,json=claimType,proto3,enum=sifnode.ethbridge.v1.ClaimType" json:"claim_type,omitempty"`
+	// decimals of the token, reported on lock claims so that the first lock of a
+	// token can register it in the token registry
+	Decimals int64 `protobuf:"varint,11,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
 }
 
 func (m *EthBridgeClaim) Reset()         { *m = EthBridgeClaim{} }
@@ -172,6 +261,13 @@ func (m *EthBridgeClaim) GetClaimType() ClaimType {
 	return ClaimType_CLAIM_TYPE_UNSPECIFIED
 }
 
+func (m *EthBridgeClaim) GetDecimals() int64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 type PeggyTokens struct {
 	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 }
@@ -216,24 +312,38 @@ func (m *PeggyTokens) GetTokens() []string {
 	return nil
 }
 
-// GenesisState for ethbridge
-type GenesisState struct {
-	CethReceiveAccount string   `protobuf:"bytes,1,opt,name=ceth_receive_account,json=cethReceiveAccount,proto3" json:"ceth_receive_account,omitempty"`
-	PeggyTokens        []string `protobuf:"bytes,2,rep,name=peggy_tokens,json=peggyTokens,proto3" json:"peggy_tokens,omitempty"`
+// Network is an EVM network bridged to sifchain, keyed by its chain id
+type Network struct {
+	EthereumChainId int64  `protobuf:"varint,1,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// bridge_contract_address is the EthereumAddress of the bridge contract
+	// claims from the network must come from
+	BridgeContractAddress string `protobuf:"bytes,3,opt,name=bridge_contract_address,json=bridgeContractAddress,proto3" json:"bridge_contract_address,omitempty" yaml:"bridge_contract_address"`
+	// denom_prefix is prepended to the symbol of the tokens locked on the
+	// network to get their pegged denom, it must start with the pegged coin
+	// prefix "c"
+	DenomPrefix string `protobuf:"bytes,4,opt,name=denom_prefix,json=denomPrefix,proto3" json:"denom_prefix,omitempty" yaml:"denom_prefix"`
+	// native_token is the pegged denom of the gas token of the network, the
+	// cross-chain fee of locks and burns to the network is paid in it
+	NativeToken string `protobuf:"bytes,5,opt,name=native_token,json=nativeToken,proto3" json:"native_token,omitempty" yaml:"native_token"`
+	// outbound_gas is the gas relayers spend delivering a lock or burn to the
+	// network. The cross-chain fee of locks and burns must cover it at the median
+	// reported gas price, zero disables the minimum fee.
+	OutboundGas uint64 `protobuf:"varint,6,opt,name=outbound_gas,json=outboundGas,proto3" json:"outbound_gas,omitempty" yaml:"outbound_gas"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
+func (m *Network) Reset()         { *m = Network{} }
+func (m *Network) String() string { return proto.CompactTextString(m) }
+func (*Network) ProtoMessage()    {}
+func (*Network) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4cb34f678c9ed59f, []int{2}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *Network) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Network) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Network.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -243,342 +353,6663 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *Network) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Network.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *Network) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *Network) XXX_DiscardUnknown() {
+	xxx_messageInfo_Network.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_Network proto.InternalMessageInfo
 
-func (m *GenesisState) GetCethReceiveAccount() string {
+func (m *Network) GetEthereumChainId() int64 {
 	if m != nil {
-		return m.CethReceiveAccount
+		return m.EthereumChainId
 	}
-	return ""
+	return 0
 }
 
-func (m *GenesisState) GetPeggyTokens() []string {
+func (m *Network) GetName() string {
 	if m != nil {
-		return m.PeggyTokens
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("sifnode.ethbridge.v1.ClaimType", ClaimType_name, ClaimType_value)
-	proto.RegisterType((*EthBridgeClaim)(nil), "sifnode.ethbridge.v1.EthBridgeClaim")
-	proto.RegisterType((*PeggyTokens)(nil), "sifnode.ethbridge.v1.PeggyTokens")
-	proto.RegisterType((*GenesisState)(nil), "sifnode.ethbridge.v1.GenesisState")
+func (m *Network) GetBridgeContractAddress() string {
+	if m != nil {
+		return m.BridgeContractAddress
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("sifnode/ethbridge/v1/types.proto", fileDescriptor_4cb34f678c9ed59f) }
+func (m *Network) GetDenomPrefix() string {
+	if m != nil {
+		return m.DenomPrefix
+	}
+	return ""
+}
 
-var fileDescriptor_4cb34f678c9ed59f = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xd1, 0x6e, 0xd3, 0x3c,
-	0x14, 0xc7, 0x9b, 0xed, 0x5b, 0x3f, 0xea, 0x8d, 0xae, 0x33, 0xa5, 0x44, 0x13, 0x24, 0x9d, 0x25,
-	0xa6, 0x82, 0xb4, 0x96, 0xc1, 0x1d, 0x17, 0xa0, 0x35, 0x94, 0x51, 0x31, 0xc6, 0x70, 0x37, 0x4d,
-	0xec, 0x26, 0x4a, 0x1d, 0xaf, 0x89, 0xd6, 0xc4, 0x55, 0xec, 0x55, 0xf4, 0x2d, 0x78, 0xac, 0x5d,
-	0xee, 0x12, 0x71, 0x11, 0xa1, 0xed, 0x0d, 0xf2, 0x04, 0x28, 0x76, 0xd2, 0x55, 0xed, 0xb8, 0xaa,
-	0xfb, 0x3f, 0x3f, 0xff, 0x8f, 0x7d, 0xce, 0x89, 0x41, 0x9d, 0xfb, 0xe7, 0x21, 0x73, 0x69, 0x8b,
-	0x0a, 0xaf, 0x1f, 0xf9, 0xee, 0x80, 0xb6, 0xc6, 0xbb, 0x2d, 0x31, 0x19, 0x51, 0xde, 0x1c, 0x45,
-	0x4c, 0x30, 0x58, 0xcd, 0x88, 0xe6, 0x94, 0x68, 0x8e, 0x77, 0x37, 0xab, 0x03, 0x36, 0x60, 0x12,
-	0x68, 0xa5, 0x2b, 0xc5, 0xa2, 0xeb, 0x15, 0x50, 0xee, 0x08, 0xaf, 0x2d, 0x31, 0x6b, 0xe8, 0xf8,
-	0x01, 0xfc, 0x04, 0x36, 0xa8, 0xf0, 0x68, 0x44, 0x2f, 0x03, 0x9b, 0x78, 0x8e, 0x1f, 0xda, 0xbe,
-	0xab, 0x6b, 0x75, 0xad, 0xb1, 0xdc, 0x7e, 0x9a, 0xc4, 0xa6, 0x3e, 0x71, 0x82, 0xe1, 0x5b, 0xb4,
-	0x80, 0x20, 0xbc, 0x9e, 0x6b, 0x56, 0x2a, 0x75, 0x5d, 0x78, 0x06, 0x9e, 0xa8, 0xfc, 0x36, 0x61,
-	0xa1, 0x88, 0x1c, 0x22, 0x6c, 0xc7, 0x75, 0x23, 0xca, 0xb9, 0xbe, 0x54, 0xd7, 0x1a, 0xa5, 0x36,
-	0x4a, 0x62, 0xd3, 0x50, 0x7e, 0xff, 0x00, 0x11, 0x7e, 0xac, 0x22, 0x56, 0x16, 0xd8, 0x53, 0x3a,
-	0xdc, 0x06, 0x2b, 0x21, 0x0b, 0x09, 0xd5, 0x97, 0xe5, 0xc9, 0x2a, 0x49, 0x6c, 0xae, 0x29, 0x27,
-	0x29, 0x23, 0xac, 0xc2, 0xf0, 0x05, 0x28, 0xf2, 0x49, 0xd0, 0x67, 0x43, 0xfd, 0x3f, 0x99, 0x72,
-	0x23, 0x89, 0xcd, 0x87, 0x0a, 0x54, 0x3a, 0xc2, 0x19, 0x00, 0x4f, 0x41, 0x4d, 0xb0, 0x0b, 0x1a,
-	0x2e, 0x9e, 0x76, 0x45, 0x6e, 0xdd, 0x4a, 0x62, 0xf3, 0x99, 0xda, 0x7a, 0x3f, 0x87, 0x70, 0x55,
-	0x06, 0xe6, 0xcf, 0x6a, 0x81, 0x69, 0x69, 0x6c, 0x4e, 0x43, 0x97, 0x46, 0x7a, 0x51, 0x3a, 0x6e,
-	0x26, 0xb1, 0x59, 0x9b, 0xab, 0xa7, 0x02, 0x10, 0x2e, 0xe7, 0x4a, 0x4f, 0x0a, 0xa9, 0x09, 0x61,
-	0x3c, 0x60, 0xdc, 0x8e, 0x28, 0xa1, 0xfe, 0x98, 0x46, 0xfa, 0xff, 0xf3, 0x26, 0x73, 0x00, 0xc2,
-	0x65, 0xa5, 0xe0, 0x4c, 0x80, 0x5d, 0xb0, 0x31, 0x76, 0x86, 0xbe, 0xeb, 0x08, 0x16, 0x4d, 0x6f,
-	0xf7, 0x40, 0xda, 0xcc, 0xf4, 0x76, 0x01, 0x41, 0xb8, 0x32, 0xd5, 0xf2, 0x4b, 0x9d, 0x82, 0xa2,
-	0x13, 0xb0, 0xcb, 0x50, 0xe8, 0x25, 0xb9, 0xff, 0xfd, 0x55, 0x6c, 0x16, 0x7e, 0xc7, 0xe6, 0xf6,
-	0xc0, 0x17, 0xde, 0x65, 0xbf, 0x49, 0x58, 0xd0, 0x52, 0xd9, 0xb3, 0x9f, 0x1d, 0xee, 0x5e, 0x64,
-	0x73, 0xda, 0x0d, 0xc5, 0x5d, 0x1b, 0x94, 0x0b, 0xc2, 0x99, 0x1d, 0x7c, 0x07, 0x00, 0x49, 0x07,
-	0xd1, 0x4e, 0x59, 0x1d, 0xd4, 0xb5, 0x46, 0xf9, 0xb5, 0xd9, 0xbc, 0x6f, 0xa6, 0x9b, 0x72, 0x60,
-	0x8f, 0x27, 0x23, 0x8a, 0x4b, 0x24, 0x5f, 0xa2, 0xe7, 0x60, 0xf5, 0x88, 0x0e, 0x06, 0x93, 0xe3,
-	0xb4, 0x15, 0x1c, 0xd6, 0x40, 0x51, 0x36, 0x85, 0xeb, 0x5a, 0x7d, 0xb9, 0x51, 0xc2, 0xd9, 0x3f,
-	0x44, 0xc0, 0xda, 0x3e, 0x0d, 0x29, 0xf7, 0x79, 0x4f, 0x38, 0x82, 0xc2, 0x57, 0xa0, 0x4a, 0xa8,
-	0xf0, 0xf2, 0xe2, 0xd9, 0x0e, 0x21, 0xf2, 0x76, 0xe9, 0xe4, 0x97, 0x30, 0x4c, 0x63, 0x59, 0x19,
-	0xf7, 0x54, 0x04, 0x6e, 0x81, 0xb5, 0x51, 0x9a, 0xc8, 0xce, 0xfc, 0x97, 0xa4, 0xff, 0xea, 0xe8,
-	0x2e, 0xf9, 0xcb, 0x6f, 0xa0, 0x34, 0x3d, 0x23, 0xdc, 0x04, 0x35, 0xeb, 0x60, 0xaf, 0xfb, 0xc5,
-	0x3e, 0xfe, 0x7e, 0xd4, 0xb1, 0x4f, 0x0e, 0x7b, 0x47, 0x1d, 0xab, 0xfb, 0xb1, 0xdb, 0xf9, 0x50,
-	0x29, 0xc0, 0x47, 0x60, 0x7d, 0x26, 0xd6, 0x3e, 0xc1, 0x87, 0x15, 0x6d, 0x4e, 0x3c, 0xf8, 0x6a,
-	0x7d, 0xae, 0x2c, 0xb5, 0xf7, 0xaf, 0x6e, 0x0c, 0xed, 0xfa, 0xc6, 0xd0, 0xfe, 0xdc, 0x18, 0xda,
-	0xcf, 0x5b, 0xa3, 0x70, 0x7d, 0x6b, 0x14, 0x7e, 0xdd, 0x1a, 0x85, 0xb3, 0x9d, 0x99, 0xca, 0xf7,
-	0xfc, 0x73, 0xf9, 0x61, 0xb6, 0xf2, 0xd7, 0xe2, 0xc7, 0xcc, 0x7b, 0x21, 0x9b, 0xd0, 0x2f, 0xca,
-	0x17, 0xe0, 0xcd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xf4, 0xb5, 0x8c, 0x51, 0x04, 0x00,
-	0x00,
+func (m *Network) GetNativeToken() string {
+	if m != nil {
+		return m.NativeToken
+	}
+	return ""
 }
 
-func (m *EthBridgeClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Network) GetOutboundGas() uint64 {
+	if m != nil {
+		return m.OutboundGas
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EthBridgeClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NetworkPeggyToken is a pegged denom minted for tokens locked on a network
+type NetworkPeggyToken struct {
+	EthereumChainId int64  `protobuf:"varint,1,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty"`
+	Denom           string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *EthBridgeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClaimType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimType))
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *NetworkPeggyToken) Reset()         { *m = NetworkPeggyToken{} }
+func (m *NetworkPeggyToken) String() string { return proto.CompactTextString(m) }
+func (*NetworkPeggyToken) ProtoMessage()    {}
+func (*NetworkPeggyToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{3}
+}
+func (m *NetworkPeggyToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkPeggyToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkPeggyToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.EthereumSender) > 0 {
-		i -= len(m.EthereumSender)
-		copy(dAtA[i:], m.EthereumSender)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TokenContractAddress) > 0 {
-		i -= len(m.TokenContractAddress)
-		copy(dAtA[i:], m.TokenContractAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContractAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BridgeContractAddress) > 0 {
-		i -= len(m.BridgeContractAddress)
-		copy(dAtA[i:], m.BridgeContractAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeContractAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.EthereumChainId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *NetworkPeggyToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkPeggyToken.Merge(m, src)
+}
+func (m *NetworkPeggyToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkPeggyToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkPeggyToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkPeggyToken proto.InternalMessageInfo
+
+func (m *NetworkPeggyToken) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PeggyTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NetworkPeggyToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PeggyTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TransferUsage is the volume of a denom that crossed the bridge in the current
+// and the previous transfer window of its registry entry
+type TransferUsage struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// window_start is the first block height of the current window
+	WindowStart    int64                                  `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty" yaml:"window_start"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	PreviousAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=previous_amount,json=previousAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previous_amount" yaml:"previous_amount"`
 }
 
-func (m *PeggyTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokens[iNdEx])
-			copy(dAtA[i:], m.Tokens[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Tokens[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *TransferUsage) Reset()         { *m = TransferUsage{} }
+func (m *TransferUsage) String() string { return proto.CompactTextString(m) }
+func (*TransferUsage) ProtoMessage()    {}
+func (*TransferUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{4}
+}
+func (m *TransferUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TransferUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUsage.Merge(m, src)
+}
+func (m *TransferUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUsage.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferUsage proto.InternalMessageInfo
+
+func (m *TransferUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransferUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PeggyTokens) > 0 {
-		for iNdEx := len(m.PeggyTokens) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PeggyTokens[iNdEx])
-			copy(dAtA[i:], m.PeggyTokens[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.PeggyTokens[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.CethReceiveAccount) > 0 {
-		i -= len(m.CethReceiveAccount)
-		copy(dAtA[i:], m.CethReceiveAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CethReceiveAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Pause stops bridge transfers of a symbol, or of all symbols when the symbol
+// is empty, in one or both directions
+type Pause struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	// outbound pauses locks and burns sent to EVM networks
+	Outbound bool `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty" yaml:"outbound"`
+	// inbound pauses claims of transfers from EVM networks
+	Inbound bool `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty" yaml:"inbound"`
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Pause) Reset()         { *m = Pause{} }
+func (m *Pause) String() string { return proto.CompactTextString(m) }
+func (*Pause) ProtoMessage()    {}
+func (*Pause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{5}
+}
+func (m *Pause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *EthBridgeClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Pause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pause.Merge(m, src)
+}
+func (m *Pause) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pause) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pause proto.InternalMessageInfo
+
+func (m *Pause) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	var l int
-	_ = l
-	if m.EthereumChainId != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	return ""
+}
+
+func (m *Pause) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
 	}
-	l = len(m.BridgeContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return false
+}
+
+func (m *Pause) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	return false
+}
+
+// OutboundTransfer is the record of a lock or burn sent to an EVM network
+type OutboundTransfer struct {
+	CosmosSender         string                                 `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty" yaml:"cosmos_sender"`
+	CosmosSenderSequence uint64                                 `protobuf:"varint,2,opt,name=cosmos_sender_sequence,json=cosmosSenderSequence,proto3" json:"cosmos_sender_sequence,omitempty" yaml:"cosmos_sender_sequence"`
+	EthereumChainId      int64                                  `protobuf:"varint,3,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	EthereumReceiver     string                                 `protobuf:"bytes,4,opt,name=ethereum_receiver,json=ethereumReceiver,proto3" json:"ethereum_receiver,omitempty" yaml:"ethereum_receiver"`
+	Symbol               string                                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	Amount               github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	CethAmount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=ceth_amount,json=cethAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ceth_amount" yaml:"ceth_amount"`
+	// claim_type is lock for locks and burn for burns
+	ClaimType     ClaimType      `protobuf:"varint,8,opt,name=claim_type,json=claimType,proto3,enum=sifnode.ethbridge.v1.ClaimType" json:"claim_type,omitempty" yaml:"claim_type"`
+	Status        OutboundStatus `protobuf:"varint,9,opt,name=status,proto3,enum=sifnode.ethbridge.v1.OutboundStatus" json:"status,omitempty" yaml:"status"`
+	CreatedHeight int64          `protobuf:"varint,10,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty" yaml:"created_height"`
+	// ethereum_tx_hash is the hash of the ethereum transaction delivering the
+	// transfer, set once the relayers agree on it
+	EthereumTxHash string `protobuf:"bytes,11,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3" json:"ethereum_tx_hash,omitempty" yaml:"ethereum_tx_hash"`
+}
+
+func (m *OutboundTransfer) Reset()         { *m = OutboundTransfer{} }
+func (m *OutboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*OutboundTransfer) ProtoMessage()    {}
+func (*OutboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{6}
+}
+func (m *OutboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *OutboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundTransfer.Merge(m, src)
+}
+func (m *OutboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundTransfer proto.InternalMessageInfo
+
+func (m *OutboundTransfer) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	l = len(m.TokenContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *OutboundTransfer) GetCosmosSenderSequence() uint64 {
+	if m != nil {
+		return m.CosmosSenderSequence
 	}
-	l = len(m.EthereumSender)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *OutboundTransfer) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
 	}
-	l = len(m.CosmosReceiver)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *OutboundTransfer) GetEthereumReceiver() string {
+	if m != nil {
+		return m.EthereumReceiver
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *OutboundTransfer) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.ClaimType != 0 {
-		n += 1 + sovTypes(uint64(m.ClaimType))
+	return ""
+}
+
+func (m *OutboundTransfer) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
 	}
-	return n
+	return ClaimType_CLAIM_TYPE_UNSPECIFIED
 }
 
-func (m *PeggyTokens) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OutboundTransfer) GetStatus() OutboundStatus {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for _, s := range m.Tokens {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	return OutboundStatus_OUTBOUND_STATUS_UNSPECIFIED
+}
+
+func (m *OutboundTransfer) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *OutboundTransfer) GetEthereumTxHash() string {
+	if m != nil {
+		return m.EthereumTxHash
+	}
+	return ""
+}
+
+// UnclaimedInbound escrows the coins of a successful claim that could not be
+// sent to its cosmos receiver, until they are redirected
+type UnclaimedInbound struct {
+	Id              uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	CosmosReceiver  string                                 `protobuf:"bytes,2,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty" yaml:"cosmos_receiver"`
+	EthereumChainId int64                                  `protobuf:"varint,3,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	Symbol          string                                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	Amount          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// reason is why the coins could not be sent to the receiver
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	CreatedHeight int64  `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty" yaml:"created_height"`
+	// held is set when the coins were over the transfer limits of their denom.
+	// Only the rescuer can redirect held coins.
+	Held bool `protobuf:"varint,8,opt,name=held,proto3" json:"held,omitempty" yaml:"held"`
+	// release_height is the height held coins are sent to the receiver at, zero
+	// when they wait for the rescuer
+	ReleaseHeight int64 `protobuf:"varint,9,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty" yaml:"release_height"`
+}
+
+func (m *UnclaimedInbound) Reset()         { *m = UnclaimedInbound{} }
+func (m *UnclaimedInbound) String() string { return proto.CompactTextString(m) }
+func (*UnclaimedInbound) ProtoMessage()    {}
+func (*UnclaimedInbound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{7}
+}
+func (m *UnclaimedInbound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnclaimedInbound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnclaimedInbound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnclaimedInbound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnclaimedInbound.Merge(m, src)
+}
+func (m *UnclaimedInbound) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnclaimedInbound) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnclaimedInbound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnclaimedInbound proto.InternalMessageInfo
+
+func (m *UnclaimedInbound) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UnclaimedInbound) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *UnclaimedInbound) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
+func (m *UnclaimedInbound) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *UnclaimedInbound) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *UnclaimedInbound) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *UnclaimedInbound) GetHeld() bool {
+	if m != nil {
+		return m.Held
+	}
+	return false
+}
+
+func (m *UnclaimedInbound) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+// BlacklistEntry is an address bridge transfers are refused for
+type BlacklistEntry struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	// added_by is the admin account that added the entry
+	AddedBy     string `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty" yaml:"added_by"`
+	AddedHeight int64  `protobuf:"varint,4,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty" yaml:"added_height"`
+	// expiry_height is the height the entry stops applying at, zero for never
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+}
+
+func (m *BlacklistEntry) Reset()         { *m = BlacklistEntry{} }
+func (m *BlacklistEntry) String() string { return proto.CompactTextString(m) }
+func (*BlacklistEntry) ProtoMessage()    {}
+func (*BlacklistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{8}
+}
+func (m *BlacklistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlacklistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistEntry.Merge(m, src)
+}
+func (m *BlacklistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistEntry proto.InternalMessageInfo
+
+func (m *BlacklistEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlacklistEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BlacklistEntry) GetAddedBy() string {
+	if m != nil {
+		return m.AddedBy
+	}
+	return ""
+}
+
+func (m *BlacklistEntry) GetAddedHeight() int64 {
+	if m != nil {
+		return m.AddedHeight
+	}
+	return 0
+}
+
+func (m *BlacklistEntry) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// GasPriceReport is the latest gas price of a network reported by a relayer
+type GasPriceReport struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	EthereumChainId  int64  `protobuf:"varint,2,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	// gas_price is in the smallest unit of the native token of the network
+	GasPrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price" yaml:"gas_price"`
+	Height   int64                                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *GasPriceReport) Reset()         { *m = GasPriceReport{} }
+func (m *GasPriceReport) String() string { return proto.CompactTextString(m) }
+func (*GasPriceReport) ProtoMessage()    {}
+func (*GasPriceReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{9}
+}
+func (m *GasPriceReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceReport.Merge(m, src)
+}
+func (m *GasPriceReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceReport proto.InternalMessageInfo
+
+func (m *GasPriceReport) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *GasPriceReport) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
+func (m *GasPriceReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EthBridgeNftClaim is a structure that contains all the data for a claim of an
+// ERC-721 token locked on an EVM network
+type EthBridgeNftClaim struct {
+	EthereumChainId int64 `protobuf:"varint,1,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	// bridge_contract_address is an EthereumAddress
+	BridgeContractAddress string `protobuf:"bytes,2,opt,name=bridge_contract_address,json=bridgeContractAddress,proto3" json:"bridge_contract_address,omitempty" yaml:"bridge_contract_address"`
+	Nonce                 int64  `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
+	// symbol of the ERC-721 contract
+	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	// token_contract_address is the EthereumAddress of the ERC-721 contract
+	TokenContractAddress string `protobuf:"bytes,5,opt,name=token_contract_address,json=tokenContractAddress,proto3" json:"token_contract_address,omitempty" yaml:"token_contract_address"`
+	// ethereum_sender is an EthereumAddress
+	EthereumSender string `protobuf:"bytes,6,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty" yaml:"ethereum_sender"`
+	// cosmos_receiver is an sdk.AccAddress
+	CosmosReceiver string `protobuf:"bytes,7,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty" yaml:"cosmos_receiver"`
+	// validator_address is an sdk.ValAddress
+	ValidatorAddress string `protobuf:"bytes,8,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// token_id is the uint256 id of the token in its contract, in decimal
+	TokenId string `protobuf:"bytes,9,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty" yaml:"token_id"`
+	// token_uri is the metadata URI of the token
+	TokenUri string `protobuf:"bytes,10,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty" yaml:"token_uri"`
+}
+
+func (m *EthBridgeNftClaim) Reset()         { *m = EthBridgeNftClaim{} }
+func (m *EthBridgeNftClaim) String() string { return proto.CompactTextString(m) }
+func (*EthBridgeNftClaim) ProtoMessage()    {}
+func (*EthBridgeNftClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{10}
+}
+func (m *EthBridgeNftClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthBridgeNftClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthBridgeNftClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthBridgeNftClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthBridgeNftClaim.Merge(m, src)
+}
+func (m *EthBridgeNftClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthBridgeNftClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthBridgeNftClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthBridgeNftClaim proto.InternalMessageInfo
+
+func (m *EthBridgeNftClaim) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
+func (m *EthBridgeNftClaim) GetBridgeContractAddress() string {
+	if m != nil {
+		return m.BridgeContractAddress
+	}
+	return ""
+}
+
+func (m *EthBridgeNftClaim) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EthBridgeNftClaim) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *EthBridgeNftClaim) GetTokenContractAddress() string {
+	if m != nil {
+		return m.TokenContractAddress
+	}
+	return ""
+}
+
+func (m *EthBridgeNftClaim) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *EthBridgeNftClaim) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *EthBridgeNftClaim) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EthBridgeNftClaim) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *EthBridgeNftClaim) GetTokenUri() string {
+	if m != nil {
+		return m.TokenUri
+	}
+	return ""
+}
+
+// NftClass mirrors an ERC-721 contract of an EVM network, created by the first
+// claim of one of its tokens
+type NftClass struct {
+	Id                   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	EthereumChainId      int64  `protobuf:"varint,2,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	TokenContractAddress string `protobuf:"bytes,3,opt,name=token_contract_address,json=tokenContractAddress,proto3" json:"token_contract_address,omitempty" yaml:"token_contract_address"`
+	Symbol               string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+}
+
+func (m *NftClass) Reset()         { *m = NftClass{} }
+func (m *NftClass) String() string { return proto.CompactTextString(m) }
+func (*NftClass) ProtoMessage()    {}
+func (*NftClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{11}
+}
+func (m *NftClass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NftClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NftClass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NftClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftClass.Merge(m, src)
+}
+func (m *NftClass) XXX_Size() int {
+	return m.Size()
+}
+func (m *NftClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftClass proto.InternalMessageInfo
+
+func (m *NftClass) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NftClass) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
+func (m *NftClass) GetTokenContractAddress() string {
+	if m != nil {
+		return m.TokenContractAddress
+	}
+	return ""
+}
+
+func (m *NftClass) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// Nft is an ERC-721 token minted on sifchain while it is locked on its network
+type Nft struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty" yaml:"class_id"`
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty" yaml:"token_id"`
+	// owner is the sdk.AccAddress holding the token
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// uri is the metadata URI of the token
+	Uri string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty" yaml:"uri"`
+}
+
+func (m *Nft) Reset()         { *m = Nft{} }
+func (m *Nft) String() string { return proto.CompactTextString(m) }
+func (*Nft) ProtoMessage()    {}
+func (*Nft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{12}
+}
+func (m *Nft) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Nft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Nft.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Nft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nft.Merge(m, src)
+}
+func (m *Nft) XXX_Size() int {
+	return m.Size()
+}
+func (m *Nft) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nft.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nft proto.InternalMessageInfo
+
+func (m *Nft) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *Nft) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *Nft) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Nft) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+// BridgeSupply is the cumulative amount of a denom minted, burned and locked by
+// the bridge, checked against the bank supply and the outbound transfers by the
+// invariants of the module
+type BridgeSupply struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// minted is minted by successful lock claims and refunds of burns
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted" yaml:"minted"`
+	// burned is burned by burns of pegged coins
+	Burned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=burned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned" yaml:"burned"`
+	// locked is burned by locks of native coins
+	Locked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked" yaml:"locked"`
+}
+
+func (m *BridgeSupply) Reset()         { *m = BridgeSupply{} }
+func (m *BridgeSupply) String() string { return proto.CompactTextString(m) }
+func (*BridgeSupply) ProtoMessage()    {}
+func (*BridgeSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{13}
+}
+func (m *BridgeSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeSupply.Merge(m, src)
+}
+func (m *BridgeSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeSupply proto.InternalMessageInfo
+
+func (m *BridgeSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AdminRoleGrant is an admin role held by an account
+type AdminRoleGrant struct {
+	Role AdminRole `protobuf:"varint,1,opt,name=role,proto3,enum=sifnode.ethbridge.v1.AdminRole" json:"role,omitempty" yaml:"role"`
+	// address is the sdk.AccAddress holding the role
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *AdminRoleGrant) Reset()         { *m = AdminRoleGrant{} }
+func (m *AdminRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*AdminRoleGrant) ProtoMessage()    {}
+func (*AdminRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{14}
+}
+func (m *AdminRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminRoleGrant.Merge(m, src)
+}
+func (m *AdminRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminRoleGrant proto.InternalMessageInfo
+
+func (m *AdminRoleGrant) GetRole() AdminRole {
+	if m != nil {
+		return m.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+func (m *AdminRoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ClaimRecord is the history of the prophecy of the claims of a lock or burn on
+// an EVM network, from the first claim of a validator until the prophecy
+// completes
+type ClaimRecord struct {
+	// id orders the records by their first claim
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	ProphecyId      string `protobuf:"bytes,2,opt,name=prophecy_id,json=prophecyId,proto3" json:"prophecy_id,omitempty" yaml:"prophecy_id"`
+	EthereumChainId int64  `protobuf:"varint,3,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	Nonce           int64  `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
+	// ethereum_sender is an EthereumAddress
+	EthereumSender string `protobuf:"bytes,5,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty" yaml:"ethereum_sender"`
+	// cosmos_receiver is an sdk.AccAddress, the one of the first claim until the
+	// prophecy succeeds and the one of the final claim after
+	CosmosReceiver string `protobuf:"bytes,6,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty" yaml:"cosmos_receiver"`
+	Symbol         string `protobuf:"bytes,7,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	// token_contract_address is an EthereumAddress
+	TokenContractAddress string                                 `protobuf:"bytes,8,opt,name=token_contract_address,json=tokenContractAddress,proto3" json:"token_contract_address,omitempty" yaml:"token_contract_address"`
+	Amount               github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	ClaimType            ClaimType                              `protobuf:"varint,10,opt,name=claim_type,json=claimType,proto3,enum=sifnode.ethbridge.v1.ClaimType" json:"claim_type,omitempty" yaml:"claim_type"`
+	Status               types.StatusText                       `protobuf:"varint,11,opt,name=status,proto3,enum=sifnode.oracle.v1.StatusText" json:"status,omitempty" yaml:"status"`
+	CreatedHeight        int64                                  `protobuf:"varint,12,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty" yaml:"created_height"`
+	// completed_height is the height the prophecy succeeded or failed at, zero
+	// while it is pending
+	CompletedHeight int64 `protobuf:"varint,13,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty" yaml:"completed_height"`
+}
+
+func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
+func (m *ClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*ClaimRecord) ProtoMessage()    {}
+func (*ClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{15}
+}
+func (m *ClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRecord.Merge(m, src)
+}
+func (m *ClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRecord proto.InternalMessageInfo
+
+func (m *ClaimRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetProphecyId() string {
+	if m != nil {
+		return m.ProphecyId
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetTokenContractAddress() string {
+	if m != nil {
+		return m.TokenContractAddress
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ClaimType_CLAIM_TYPE_UNSPECIFIED
+}
+
+func (m *ClaimRecord) GetStatus() types.StatusText {
+	if m != nil {
+		return m.Status
+	}
+	return types.StatusText_STATUS_TEXT_UNSPECIFIED
+}
+
+func (m *ClaimRecord) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
+// GenesisState for ethbridge
+type GenesisState struct {
+	CethReceiveAccount     string              `protobuf:"bytes,1,opt,name=ceth_receive_account,json=cethReceiveAccount,proto3" json:"ceth_receive_account,omitempty"`
+	PeggyTokens            []string            `protobuf:"bytes,2,rep,name=peggy_tokens,json=peggyTokens,proto3" json:"peggy_tokens,omitempty"`
+	Networks               []Network           `protobuf:"bytes,3,rep,name=networks,proto3" json:"networks"`
+	NetworkPeggyTokens     []NetworkPeggyToken `protobuf:"bytes,4,rep,name=network_peggy_tokens,json=networkPeggyTokens,proto3" json:"network_peggy_tokens"`
+	TransferUsages         []TransferUsage     `protobuf:"bytes,5,rep,name=transfer_usages,json=transferUsages,proto3" json:"transfer_usages"`
+	Pauses                 []Pause             `protobuf:"bytes,6,rep,name=pauses,proto3" json:"pauses"`
+	OutboundTransfers      []OutboundTransfer  `protobuf:"bytes,7,rep,name=outbound_transfers,json=outboundTransfers,proto3" json:"outbound_transfers"`
+	UnclaimedInbounds      []UnclaimedInbound  `protobuf:"bytes,8,rep,name=unclaimed_inbounds,json=unclaimedInbounds,proto3" json:"unclaimed_inbounds"`
+	NextUnclaimedInboundId uint64              `protobuf:"varint,9,opt,name=next_unclaimed_inbound_id,json=nextUnclaimedInboundId,proto3" json:"next_unclaimed_inbound_id,omitempty"`
+	Blacklist              []BlacklistEntry    `protobuf:"bytes,10,rep,name=blacklist,proto3" json:"blacklist"`
+	GasPriceReports        []GasPriceReport    `protobuf:"bytes,11,rep,name=gas_price_reports,json=gasPriceReports,proto3" json:"gas_price_reports"`
+	NftClasses             []NftClass          `protobuf:"bytes,12,rep,name=nft_classes,json=nftClasses,proto3" json:"nft_classes"`
+	Nfts                   []Nft               `protobuf:"bytes,13,rep,name=nfts,proto3" json:"nfts"`
+	BridgeSupplies         []BridgeSupply      `protobuf:"bytes,14,rep,name=bridge_supplies,json=bridgeSupplies,proto3" json:"bridge_supplies"`
+	AdminRoleGrants        []AdminRoleGrant    `protobuf:"bytes,15,rep,name=admin_role_grants,json=adminRoleGrants,proto3" json:"admin_role_grants"`
+	ClaimRecords           []ClaimRecord       `protobuf:"bytes,16,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records"`
+	NextClaimRecordId      uint64              `protobuf:"varint,17,opt,name=next_claim_record_id,json=nextClaimRecordId,proto3" json:"next_claim_record_id,omitempty"`
+	// configured_admin_roles are the admin roles which have been granted, they
+	// are no longer held by the admin account
+	ConfiguredAdminRoles []AdminRole `protobuf:"varint,18,rep,packed,name=configured_admin_roles,json=configuredAdminRoles,proto3,enum=sifnode.ethbridge.v1.AdminRole" json:"configured_admin_roles,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{16}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetCethReceiveAccount() string {
+	if m != nil {
+		return m.CethReceiveAccount
+	}
+	return ""
+}
+
+func (m *GenesisState) GetPeggyTokens() []string {
+	if m != nil {
+		return m.PeggyTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNetworks() []Network {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNetworkPeggyTokens() []NetworkPeggyToken {
+	if m != nil {
+		return m.NetworkPeggyTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferUsages() []TransferUsage {
+	if m != nil {
+		return m.TransferUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauses() []Pause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutboundTransfers() []OutboundTransfer {
+	if m != nil {
+		return m.OutboundTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnclaimedInbounds() []UnclaimedInbound {
+	if m != nil {
+		return m.UnclaimedInbounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextUnclaimedInboundId() uint64 {
+	if m != nil {
+		return m.NextUnclaimedInboundId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBlacklist() []BlacklistEntry {
+	if m != nil {
+		return m.Blacklist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGasPriceReports() []GasPriceReport {
+	if m != nil {
+		return m.GasPriceReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNftClasses() []NftClass {
+	if m != nil {
+		return m.NftClasses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNfts() []Nft {
+	if m != nil {
+		return m.Nfts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeSupplies() []BridgeSupply {
+	if m != nil {
+		return m.BridgeSupplies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminRoleGrants() []AdminRoleGrant {
+	if m != nil {
+		return m.AdminRoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimRecords() []ClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextClaimRecordId() uint64 {
+	if m != nil {
+		return m.NextClaimRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConfiguredAdminRoles() []AdminRole {
+	if m != nil {
+		return m.ConfiguredAdminRoles
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("sifnode.ethbridge.v1.OutboundStatus", OutboundStatus_name, OutboundStatus_value)
+	proto.RegisterEnum("sifnode.ethbridge.v1.ClaimType", ClaimType_name, ClaimType_value)
+	proto.RegisterEnum("sifnode.ethbridge.v1.AdminRole", AdminRole_name, AdminRole_value)
+	proto.RegisterType((*EthBridgeClaim)(nil), "sifnode.ethbridge.v1.EthBridgeClaim")
+	proto.RegisterType((*PeggyTokens)(nil), "sifnode.ethbridge.v1.PeggyTokens")
+	proto.RegisterType((*Network)(nil), "sifnode.ethbridge.v1.Network")
+	proto.RegisterType((*NetworkPeggyToken)(nil), "sifnode.ethbridge.v1.NetworkPeggyToken")
+	proto.RegisterType((*TransferUsage)(nil), "sifnode.ethbridge.v1.TransferUsage")
+	proto.RegisterType((*Pause)(nil), "sifnode.ethbridge.v1.Pause")
+	proto.RegisterType((*OutboundTransfer)(nil), "sifnode.ethbridge.v1.OutboundTransfer")
+	proto.RegisterType((*UnclaimedInbound)(nil), "sifnode.ethbridge.v1.UnclaimedInbound")
+	proto.RegisterType((*BlacklistEntry)(nil), "sifnode.ethbridge.v1.BlacklistEntry")
+	proto.RegisterType((*GasPriceReport)(nil), "sifnode.ethbridge.v1.GasPriceReport")
+	proto.RegisterType((*EthBridgeNftClaim)(nil), "sifnode.ethbridge.v1.EthBridgeNftClaim")
+	proto.RegisterType((*NftClass)(nil), "sifnode.ethbridge.v1.NftClass")
+	proto.RegisterType((*Nft)(nil), "sifnode.ethbridge.v1.Nft")
+	proto.RegisterType((*BridgeSupply)(nil), "sifnode.ethbridge.v1.BridgeSupply")
+	proto.RegisterType((*AdminRoleGrant)(nil), "sifnode.ethbridge.v1.AdminRoleGrant")
+	proto.RegisterType((*ClaimRecord)(nil), "sifnode.ethbridge.v1.ClaimRecord")
+	proto.RegisterType((*GenesisState)(nil), "sifnode.ethbridge.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("sifnode/ethbridge/v1/types.proto", fileDescriptor_4cb34f678c9ed59f) }
+
+var fileDescriptor_4cb34f678c9ed59f = []byte{
+	// 2350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xbd, 0x6f, 0x1b, 0xc9,
+	0x15, 0x37, 0x3f, 0x44, 0x91, 0x8f, 0x12, 0x45, 0x8d, 0x65, 0x79, 0xfd, 0x21, 0x51, 0x9e, 0x4b,
+	0x1c, 0x9f, 0x91, 0x93, 0x62, 0x5f, 0x11, 0xe4, 0x80, 0xcb, 0x45, 0x14, 0x69, 0x99, 0x88, 0x4c,
+	0x29, 0x43, 0xf2, 0x8c, 0xbb, 0x14, 0x8b, 0xd5, 0xee, 0x88, 0xdc, 0x98, 0xdc, 0xe5, 0xed, 0x2c,
+	0x65, 0xb1, 0x0c, 0x90, 0x32, 0x45, 0x02, 0xa4, 0x48, 0x93, 0xfe, 0xba, 0x20, 0x7f, 0x41, 0x90,
+	0xee, 0xca, 0x2b, 0x83, 0x04, 0x20, 0x02, 0xbb, 0x4a, 0xcb, 0xe6, 0xda, 0x60, 0x3e, 0x76, 0xb9,
+	0x5c, 0x51, 0xb2, 0x24, 0x2b, 0xdd, 0x55, 0xdc, 0x79, 0x1f, 0xbf, 0x99, 0x79, 0xf3, 0xe6, 0xbd,
+	0x37, 0x8f, 0xb0, 0xc1, 0xec, 0x23, 0xc7, 0xb5, 0xe8, 0x16, 0xf5, 0x3b, 0x87, 0x9e, 0x6d, 0xb5,
+	0xe9, 0xd6, 0xf1, 0x93, 0x2d, 0x7f, 0xd8, 0xa7, 0x6c, 0xb3, 0xef, 0xb9, 0xbe, 0x8b, 0x56, 0x94,
+	0xc4, 0x66, 0x28, 0xb1, 0x79, 0xfc, 0xe4, 0xee, 0x4a, 0xdb, 0x6d, 0xbb, 0x42, 0x60, 0x8b, 0x7f,
+	0x49, 0xd9, 0xbb, 0x6b, 0x01, 0x9a, 0xeb, 0x19, 0x66, 0x37, 0x0e, 0x85, 0x7f, 0x9b, 0x81, 0x42,
+	0xd5, 0xef, 0x94, 0x05, 0xca, 0x4e, 0xd7, 0xb0, 0x7b, 0xe8, 0x39, 0x2c, 0x53, 0xbf, 0x43, 0x3d,
+	0x3a, 0xe8, 0xe9, 0x66, 0xc7, 0xb0, 0x1d, 0xdd, 0xb6, 0xb4, 0xc4, 0x46, 0xe2, 0x51, 0xaa, 0x7c,
+	0x7f, 0x3c, 0x2a, 0x69, 0x43, 0xa3, 0xd7, 0xfd, 0x04, 0x9f, 0x12, 0xc1, 0x64, 0x29, 0xa0, 0xed,
+	0x70, 0x52, 0xcd, 0x42, 0x5f, 0xc2, 0x6d, 0xb9, 0x3c, 0xdd, 0x74, 0x1d, 0xdf, 0x33, 0x4c, 0x5f,
+	0x37, 0x2c, 0xcb, 0xa3, 0x8c, 0x69, 0xc9, 0x8d, 0xc4, 0xa3, 0x5c, 0x19, 0x8f, 0x47, 0xa5, 0x75,
+	0x89, 0x77, 0x86, 0x20, 0x26, 0xb7, 0x24, 0x67, 0x47, 0x31, 0xb6, 0x25, 0x1d, 0x3d, 0x84, 0x39,
+	0xc7, 0x75, 0x4c, 0xaa, 0xa5, 0xc4, 0xca, 0x8a, 0xe3, 0x51, 0x69, 0x41, 0x22, 0x09, 0x32, 0x26,
+	0x92, 0x8d, 0x3e, 0x84, 0x0c, 0x1b, 0xf6, 0x0e, 0xdd, 0xae, 0x96, 0x16, 0x53, 0x2e, 0x8f, 0x47,
+	0xa5, 0x45, 0x29, 0x28, 0xe9, 0x98, 0x28, 0x01, 0xf4, 0x12, 0x56, 0x7d, 0xf7, 0x15, 0x75, 0x4e,
+	0xaf, 0x76, 0x4e, 0xa8, 0x3e, 0x18, 0x8f, 0x4a, 0x6b, 0x52, 0x75, 0xb6, 0x1c, 0x26, 0x2b, 0x82,
+	0x11, 0x5f, 0xeb, 0x0e, 0x84, 0xa6, 0xd1, 0x19, 0x75, 0x2c, 0xea, 0x69, 0x19, 0x81, 0x78, 0x77,
+	0x3c, 0x2a, 0xad, 0xc6, 0xec, 0x29, 0x05, 0x30, 0x29, 0x04, 0x94, 0x86, 0x20, 0x70, 0x10, 0xd3,
+	0x65, 0x3d, 0x97, 0xe9, 0x1e, 0x35, 0xa9, 0x7d, 0x4c, 0x3d, 0x6d, 0x3e, 0x0e, 0x12, 0x13, 0xc0,
+	0xa4, 0x20, 0x29, 0x44, 0x11, 0x50, 0x0d, 0x96, 0x8f, 0x8d, 0xae, 0x6d, 0x19, 0xbe, 0xeb, 0x85,
+	0xbb, 0xcb, 0x0a, 0x98, 0xc8, 0xd9, 0x9e, 0x12, 0xc1, 0xa4, 0x18, 0xd2, 0x82, 0x4d, 0xbd, 0x84,
+	0x8c, 0xd1, 0x73, 0x07, 0x8e, 0xaf, 0xe5, 0x84, 0xfe, 0x67, 0xdf, 0x8c, 0x4a, 0x37, 0xfe, 0x35,
+	0x2a, 0x3d, 0x6c, 0xdb, 0x7e, 0x67, 0x70, 0xb8, 0x69, 0xba, 0xbd, 0x2d, 0x39, 0xbb, 0xfa, 0xf9,
+	0x88, 0x59, 0xaf, 0x94, 0xef, 0xd5, 0x1c, 0x7f, 0x72, 0x0c, 0x12, 0x05, 0x13, 0x05, 0x87, 0x7e,
+	0x0e, 0x60, 0x72, 0x47, 0xd4, 0xb9, 0xac, 0x06, 0x1b, 0x89, 0x47, 0x85, 0xa7, 0xa5, 0xcd, 0x59,
+	0x2e, 0xbf, 0x29, 0x1c, 0xb6, 0x39, 0xec, 0x53, 0x92, 0x33, 0x83, 0x4f, 0xb4, 0x05, 0x59, 0x8b,
+	0x9a, 0x76, 0xcf, 0xe8, 0x32, 0x2d, 0x2f, 0x9c, 0xe3, 0xe6, 0x78, 0x54, 0x5a, 0x92, 0x93, 0x05,
+	0x1c, 0x4c, 0x42, 0x21, 0xfc, 0x43, 0xc8, 0x1f, 0xd0, 0x76, 0x7b, 0xd8, 0xe4, 0x67, 0xc7, 0xd0,
+	0x2a, 0x64, 0xc4, 0x29, 0x32, 0x2d, 0xb1, 0x91, 0x7a, 0x94, 0x23, 0x6a, 0x84, 0xbf, 0x4b, 0xc2,
+	0x7c, 0x9d, 0xfa, 0xaf, 0x5d, 0xef, 0xd5, 0x35, 0xde, 0x11, 0x04, 0x69, 0xc7, 0xe8, 0x51, 0x79,
+	0x21, 0x88, 0xf8, 0x3e, 0xef, 0xde, 0xa4, 0xde, 0xf7, 0xde, 0x7c, 0x02, 0x0b, 0x16, 0x75, 0xdc,
+	0x9e, 0xde, 0xf7, 0xe8, 0x91, 0x7d, 0xa2, 0x6e, 0xc5, 0xed, 0xf1, 0xa8, 0x74, 0x33, 0xb0, 0xd0,
+	0x84, 0x8b, 0x49, 0x5e, 0x0c, 0x0f, 0xc4, 0x88, 0xeb, 0x3a, 0x86, 0x6f, 0x1f, 0x53, 0x5d, 0x98,
+	0x44, 0x9b, 0x8b, 0xeb, 0x46, 0xb9, 0x98, 0xe4, 0xe5, 0x50, 0x98, 0x95, 0xeb, 0xba, 0x03, 0xff,
+	0xd0, 0x1d, 0x38, 0x96, 0xde, 0x36, 0x98, 0xb8, 0x00, 0xe9, 0xa8, 0x6e, 0x94, 0x8b, 0x49, 0x3e,
+	0x18, 0xee, 0x1a, 0x0c, 0xb7, 0x60, 0x59, 0x19, 0x7e, 0x72, 0x4e, 0xe8, 0xf1, 0x99, 0x47, 0x70,
+	0xda, 0xc8, 0x2b, 0x30, 0x27, 0xf6, 0xa1, 0xac, 0x2c, 0x07, 0xf8, 0xef, 0x49, 0x58, 0x6c, 0x7a,
+	0x86, 0xc3, 0x8e, 0xa8, 0xd7, 0x62, 0x46, 0x9b, 0xf2, 0xa0, 0x22, 0xe5, 0x12, 0x62, 0x67, 0x91,
+	0xa0, 0x22, 0x35, 0x94, 0x26, 0xdf, 0xcc, 0x6b, 0xdb, 0xb1, 0xdc, 0xd7, 0x3a, 0xf3, 0x0d, 0xcf,
+	0x17, 0xb0, 0xa9, 0xe8, 0x66, 0xa2, 0x5c, 0x4c, 0xf2, 0x72, 0xd8, 0xe0, 0xa3, 0xc8, 0xbd, 0x49,
+	0x5d, 0xef, 0xbd, 0xf9, 0x0a, 0x96, 0xfa, 0x1e, 0x3d, 0xb6, 0xdd, 0x01, 0xd3, 0xd5, 0x0c, 0xf2,
+	0x70, 0x9f, 0x5f, 0x7a, 0x06, 0x15, 0x4e, 0x62, 0x70, 0x98, 0x14, 0x02, 0xca, 0xb6, 0x24, 0xfc,
+	0x31, 0x01, 0x73, 0x07, 0xc6, 0x80, 0x45, 0xc3, 0x6c, 0xe2, 0x5d, 0x61, 0x76, 0x0b, 0xb2, 0xc1,
+	0xe1, 0x0a, 0xc3, 0x65, 0xa3, 0xf7, 0x33, 0xe0, 0x60, 0x12, 0x0a, 0xa1, 0x1f, 0xc3, 0xbc, 0xed,
+	0x48, 0xf9, 0x94, 0x90, 0x47, 0xe3, 0x51, 0xa9, 0x20, 0xe5, 0x15, 0x03, 0x93, 0x40, 0x04, 0xff,
+	0x3b, 0x03, 0xc5, 0x7d, 0xa5, 0x1a, 0x9c, 0x2e, 0xfa, 0x14, 0x16, 0x55, 0x6c, 0x54, 0xf1, 0x57,
+	0xae, 0x52, 0x1b, 0x8f, 0x4a, 0x2b, 0x53, 0xa1, 0x33, 0x88, 0xbe, 0x0b, 0x72, 0xac, 0x62, 0xef,
+	0x4b, 0x58, 0x9d, 0xe2, 0xeb, 0x8c, 0x7e, 0x35, 0xa0, 0x3c, 0xfb, 0x24, 0x85, 0x1b, 0x47, 0x32,
+	0xc3, 0x6c, 0x39, 0x4c, 0x56, 0xa2, 0x80, 0x0d, 0x45, 0x9e, 0x1d, 0x47, 0x52, 0x57, 0x89, 0x23,
+	0xb5, 0x08, 0x52, 0x98, 0x20, 0xd2, 0xf1, 0xc8, 0x7e, 0x4a, 0x04, 0x93, 0x62, 0x40, 0x0b, 0x93,
+	0xc4, 0xe4, 0x2c, 0xe7, 0xde, 0x9d, 0x32, 0x03, 0x67, 0xce, 0x5c, 0xaf, 0x33, 0x53, 0xc8, 0x9b,
+	0xd4, 0xef, 0x04, 0x8e, 0x2c, 0x33, 0x5d, 0xe5, 0xd2, 0xe8, 0x48, 0x1d, 0xca, 0x04, 0x0a, 0x13,
+	0xe0, 0x23, 0xe9, 0xc0, 0xa8, 0x35, 0x95, 0x6b, 0xb2, 0x17, 0xca, 0x35, 0xe5, 0x5b, 0xe3, 0x51,
+	0x69, 0x59, 0x01, 0x87, 0xca, 0x38, 0x9a, 0x82, 0xf6, 0x21, 0xc3, 0x7c, 0xc3, 0x1f, 0x30, 0x91,
+	0x1b, 0x0b, 0x4f, 0x7f, 0x30, 0x1b, 0x32, 0x70, 0xd3, 0x86, 0x90, 0x9d, 0xb2, 0xb3, 0xa0, 0x70,
+	0x3b, 0x8b, 0x0f, 0xf4, 0x0b, 0x28, 0x98, 0x1e, 0x35, 0x7c, 0x6a, 0xe9, 0x1d, 0x6a, 0xb7, 0x3b,
+	0xbe, 0xc8, 0x8b, 0xa9, 0xf2, 0x9d, 0xf1, 0xa8, 0x74, 0x4b, 0x2d, 0x65, 0x8a, 0x8f, 0xc9, 0xa2,
+	0x22, 0x3c, 0x17, 0x63, 0x54, 0x85, 0xf0, 0xa0, 0x75, 0xff, 0x44, 0xef, 0x18, 0xac, 0x23, 0xb2,
+	0x63, 0xae, 0x7c, 0x6f, 0x3c, 0x2a, 0xdd, 0x8e, 0xb9, 0x87, 0x92, 0x88, 0x54, 0x21, 0xcd, 0x93,
+	0xe7, 0x9c, 0xf0, 0xe7, 0x34, 0x14, 0x5b, 0x8e, 0xd8, 0x29, 0xb5, 0x6a, 0xf2, 0xca, 0xa1, 0x35,
+	0x48, 0xaa, 0xd8, 0x9b, 0x2e, 0x2f, 0x8e, 0x47, 0xa5, 0x9c, 0xba, 0x9b, 0x16, 0x26, 0x49, 0xdb,
+	0x9a, 0x55, 0xb9, 0x24, 0x2f, 0x5d, 0xb9, 0x5c, 0xdf, 0x4d, 0xb9, 0x54, 0x45, 0x18, 0xb8, 0xf7,
+	0xdc, 0xf5, 0xba, 0xf7, 0x87, 0x90, 0xf1, 0xa8, 0xc1, 0x5c, 0x47, 0xcb, 0xc4, 0xd7, 0x20, 0xe9,
+	0x98, 0x28, 0x81, 0x19, 0x47, 0x3f, 0x7f, 0xc9, 0xa3, 0xff, 0x00, 0xd2, 0x1d, 0xda, 0xb5, 0x84,
+	0x7b, 0x67, 0xcb, 0x4b, 0xe3, 0x51, 0x29, 0x2f, 0xf5, 0x38, 0x15, 0x13, 0xc1, 0xe4, 0xd3, 0x78,
+	0xb4, 0x4b, 0x0d, 0x46, 0x83, 0x69, 0x72, 0xf1, 0x69, 0xa6, 0xf9, 0x98, 0x2c, 0x2a, 0x82, 0x9c,
+	0x06, 0xff, 0x29, 0x09, 0x85, 0x72, 0xd7, 0x30, 0x5f, 0x75, 0x6d, 0xe6, 0x57, 0x1d, 0xdf, 0x1b,
+	0xf2, 0xc8, 0x1d, 0x14, 0x2e, 0x32, 0xe0, 0x46, 0x22, 0x77, 0x58, 0xa8, 0x04, 0x22, 0x11, 0xa3,
+	0x24, 0xdf, 0x65, 0x94, 0x4d, 0xc8, 0x1a, 0x96, 0x45, 0x2d, 0xfd, 0x70, 0xa8, 0xd2, 0x68, 0x24,
+	0x87, 0x04, 0x1c, 0x09, 0x4d, 0xad, 0xf2, 0x90, 0x27, 0x6c, 0x49, 0x55, 0x7b, 0x4b, 0xc7, 0x13,
+	0x76, 0x94, 0x8b, 0x49, 0x5e, 0x0c, 0x95, 0xf9, 0x3e, 0x85, 0x45, 0x7a, 0xd2, 0xb7, 0xbd, 0x61,
+	0xa0, 0x3c, 0x27, 0x94, 0x23, 0xb9, 0x63, 0x8a, 0x8d, 0xc9, 0x82, 0x1c, 0x2b, 0xb3, 0xfc, 0x2d,
+	0x09, 0x85, 0x5d, 0x83, 0x1d, 0x78, 0xb6, 0x49, 0x09, 0xed, 0xbb, 0x9e, 0x3f, 0xbb, 0x0a, 0x4f,
+	0x5c, 0xa9, 0x0a, 0x9f, 0x79, 0x2d, 0x92, 0x57, 0xb9, 0x16, 0x3a, 0xe4, 0xda, 0x06, 0xd3, 0xfb,
+	0x7c, 0x9d, 0xca, 0xa6, 0xe5, 0x4b, 0xbb, 0x7b, 0x51, 0xce, 0x17, 0x02, 0x61, 0x92, 0x6d, 0xab,
+	0xbd, 0xf3, 0xe3, 0x9d, 0xb2, 0x7e, 0xe4, 0x78, 0x03, 0xcb, 0x29, 0x01, 0xfc, 0x97, 0x39, 0x58,
+	0x0e, 0x5f, 0xa5, 0xf5, 0x23, 0xff, 0xfb, 0x87, 0xe9, 0xf7, 0x0f, 0xd3, 0x0b, 0x5f, 0x89, 0x4d,
+	0xc8, 0x4a, 0x2b, 0xd8, 0x96, 0x96, 0x8b, 0xc7, 0x86, 0x80, 0x83, 0xc9, 0xbc, 0xf8, 0xac, 0x59,
+	0xe8, 0x09, 0xe4, 0x24, 0x75, 0xe0, 0xd9, 0x22, 0xad, 0xe6, 0xca, 0x2b, 0x13, 0x57, 0x0e, 0x59,
+	0x98, 0x48, 0xd8, 0x96, 0x67, 0xe3, 0xef, 0x12, 0x90, 0x95, 0x6e, 0xc9, 0x58, 0x24, 0xfb, 0xe5,
+	0x66, 0x65, 0xbf, 0xeb, 0xbb, 0xa1, 0x67, 0xbb, 0x41, 0xea, 0xfd, 0xdc, 0xe0, 0xe2, 0xae, 0x88,
+	0xff, 0x9a, 0x80, 0x54, 0xfd, 0xc8, 0xe7, 0x46, 0x36, 0xf9, 0xee, 0xf5, 0x70, 0xeb, 0x11, 0x23,
+	0x07, 0x1c, 0x4c, 0xe6, 0xc5, 0x67, 0xcd, 0x9a, 0x3a, 0x94, 0xe4, 0x05, 0x0e, 0xe5, 0x21, 0xcc,
+	0xb9, 0xaf, 0x1d, 0xea, 0xa9, 0xad, 0x45, 0x6e, 0x91, 0x20, 0x63, 0x22, 0xd9, 0x68, 0x03, 0x52,
+	0xfc, 0xd8, 0xe4, 0xba, 0x0b, 0xe3, 0x51, 0x09, 0xa4, 0x94, 0x38, 0x30, 0xce, 0xc2, 0xff, 0x48,
+	0xc2, 0x82, 0x0c, 0x24, 0x8d, 0x41, 0xbf, 0xdf, 0x1d, 0x5e, 0xf8, 0x91, 0xf7, 0x12, 0x32, 0x3d,
+	0xdb, 0xf1, 0x69, 0xb0, 0xe0, 0x2b, 0x27, 0x7f, 0x89, 0x82, 0x89, 0x82, 0xe3, 0xc0, 0x87, 0x03,
+	0xcf, 0xa1, 0xd6, 0xfb, 0xbe, 0x00, 0x25, 0x0a, 0x26, 0x0a, 0x8e, 0x03, 0x77, 0x5d, 0xf3, 0x15,
+	0xb5, 0xb4, 0xf4, 0xfb, 0x01, 0x4b, 0x14, 0x4c, 0x14, 0x1c, 0xfe, 0x5d, 0x02, 0x0a, 0xdb, 0x56,
+	0xcf, 0x76, 0x88, 0xdb, 0xa5, 0xbb, 0x9e, 0xe1, 0xf8, 0xa8, 0x02, 0x69, 0xcf, 0xed, 0x52, 0x2d,
+	0x71, 0x5e, 0xcd, 0x1c, 0xea, 0x44, 0xab, 0x0e, 0xae, 0x86, 0x89, 0xd0, 0x8e, 0x16, 0x08, 0xc9,
+	0x77, 0x16, 0x08, 0xf8, 0xbf, 0x19, 0xc8, 0x8b, 0x54, 0x40, 0xa8, 0xe9, 0x7a, 0xef, 0xac, 0x3b,
+	0x7f, 0x0a, 0xf9, 0xbe, 0xe7, 0xf6, 0x3b, 0xd4, 0x1c, 0x4e, 0xdc, 0x6e, 0x75, 0xf2, 0x2a, 0x88,
+	0x30, 0x31, 0x81, 0x60, 0x54, 0xb3, 0xae, 0xb1, 0xd6, 0x0c, 0x93, 0x41, 0xfa, 0xfc, 0x64, 0x30,
+	0x23, 0x10, 0xcf, 0x5d, 0x47, 0x20, 0xce, 0x5c, 0x3a, 0x10, 0x4f, 0x62, 0xc1, 0xfc, 0xd5, 0xd3,
+	0x52, 0xf6, 0xfd, 0xe2, 0xd1, 0xff, 0xad, 0xb5, 0xd8, 0xba, 0x42, 0x6b, 0xf1, 0x02, 0xcf, 0xbd,
+	0xe7, 0xe1, 0x73, 0x2f, 0x2f, 0x20, 0xd7, 0x42, 0x48, 0xd9, 0x74, 0xe7, 0x78, 0xf2, 0x8d, 0xd7,
+	0xa4, 0x27, 0xfe, 0xe5, 0xde, 0x79, 0x0b, 0x97, 0x2c, 0xf6, 0x9f, 0x41, 0xd1, 0x74, 0x7b, 0xfd,
+	0x2e, 0x8d, 0x60, 0x2c, 0x0a, 0x8c, 0xc8, 0x3b, 0x2f, 0x2e, 0x81, 0xc9, 0x52, 0x48, 0x92, 0x38,
+	0xf8, 0x6b, 0x80, 0x85, 0x5d, 0xea, 0x50, 0x66, 0x33, 0xbe, 0x74, 0x8a, 0x7e, 0x02, 0x2b, 0xe2,
+	0x19, 0xad, 0x5c, 0x47, 0x37, 0x4c, 0x53, 0x1c, 0x91, 0x88, 0xa2, 0x04, 0x71, 0x9e, 0x72, 0xa2,
+	0x6d, 0xc9, 0x41, 0x0f, 0x60, 0xa1, 0xcf, 0xfb, 0x75, 0xba, 0x6a, 0xa7, 0x26, 0x45, 0x3b, 0x35,
+	0xdf, 0x8f, 0xf4, 0x5a, 0x3f, 0x83, 0xac, 0x23, 0x3b, 0x7b, 0x3c, 0x89, 0xa5, 0x1e, 0xe5, 0x9f,
+	0xae, 0xcd, 0x3e, 0x0e, 0xd5, 0xff, 0x2b, 0xa7, 0xb9, 0x2b, 0x90, 0x50, 0x09, 0xe9, 0xb0, 0xa2,
+	0xbe, 0xf5, 0xa9, 0xb9, 0xd2, 0x02, 0xec, 0x47, 0xe7, 0x82, 0x4d, 0x9a, 0x89, 0x0a, 0x16, 0x39,
+	0x71, 0x06, 0x43, 0x04, 0x96, 0x7c, 0xd5, 0x45, 0xd2, 0x07, 0xbc, 0x49, 0xc8, 0x8b, 0x2e, 0x8e,
+	0xfd, 0xc1, 0x6c, 0xec, 0xa9, 0x86, 0xa2, 0xc2, 0x2d, 0xf8, 0x51, 0x22, 0x43, 0x3f, 0x83, 0x4c,
+	0x9f, 0x77, 0xcd, 0x78, 0x17, 0x94, 0x43, 0xdd, 0x9b, 0x0d, 0x25, 0x3a, 0x6b, 0x0a, 0x42, 0x29,
+	0xa0, 0x5f, 0x03, 0x0a, 0x1b, 0xa5, 0x01, 0x2a, 0xd3, 0xe6, 0x05, 0xcc, 0xc3, 0xf3, 0xbb, 0x0c,
+	0xc1, 0xca, 0x14, 0xe2, 0xb2, 0x1b, 0xa3, 0x0b, 0xf0, 0x41, 0xf0, 0xb6, 0xd7, 0x55, 0x3f, 0x8d,
+	0x5f, 0xe6, 0x73, 0xc0, 0xe3, 0xbd, 0x80, 0x00, 0x7c, 0x10, 0xa3, 0xf3, 0x4d, 0xdf, 0x71, 0xe8,
+	0x89, 0xaf, 0x9f, 0x9a, 0x21, 0xa8, 0xd3, 0xd2, 0x64, 0x95, 0x0b, 0xc4, 0x11, 0x45, 0x3c, 0xce,
+	0x1d, 0x06, 0x0f, 0x4b, 0x0d, 0xc4, 0x72, 0xce, 0xe8, 0xa8, 0x4c, 0xbf, 0x3f, 0xd5, 0x62, 0x26,
+	0xca, 0xe8, 0x73, 0x58, 0x0e, 0xdf, 0x26, 0xba, 0x27, 0x5e, 0x63, 0xfc, 0xd2, 0x9e, 0x83, 0x38,
+	0xfd, 0x74, 0x53, 0x88, 0x4b, 0xed, 0x29, 0x2a, 0x43, 0x55, 0xc8, 0x3b, 0x47, 0xbe, 0x2e, 0xaa,
+	0x1d, 0xca, 0xb4, 0x05, 0x81, 0xb8, 0x7e, 0x86, 0xf7, 0xa9, 0xc2, 0x51, 0x61, 0x81, 0xa3, 0xc6,
+	0x94, 0xa1, 0x8f, 0x21, 0xed, 0x1c, 0xf9, 0x4c, 0x5b, 0x14, 0xfa, 0x77, 0xce, 0xd4, 0x57, 0xaa,
+	0x42, 0x18, 0xfd, 0x0a, 0x96, 0x24, 0x53, 0x67, 0xbc, 0xc0, 0xb1, 0x29, 0xd3, 0x0a, 0x42, 0x1f,
+	0x9f, 0x61, 0xa3, 0x48, 0x31, 0x14, 0x38, 0xe8, 0xe1, 0x84, 0x66, 0x53, 0xc6, 0xcd, 0x64, 0xf0,
+	0xd4, 0xad, 0xf3, 0x24, 0xad, 0xb7, 0x79, 0xc2, 0x67, 0xda, 0xd2, 0x79, 0x66, 0x9a, 0xae, 0x0e,
+	0x02, 0x33, 0x19, 0x53, 0x54, 0x86, 0xf6, 0x60, 0x51, 0x86, 0x50, 0x4f, 0x24, 0x70, 0xa6, 0x15,
+	0x05, 0xe6, 0x83, 0x73, 0x42, 0xb0, 0x4c, 0xf5, 0x0a, 0x70, 0xc1, 0x9c, 0x90, 0x18, 0xda, 0xe2,
+	0x77, 0xff, 0xc4, 0xd7, 0xa3, 0x90, 0xdc, 0x99, 0x96, 0x85, 0x33, 0x2d, 0x73, 0x5e, 0x04, 0xa2,
+	0x66, 0xa1, 0x16, 0x6f, 0xe3, 0x3a, 0x47, 0x76, 0x7b, 0xe0, 0x51, 0x4b, 0x9f, 0xec, 0x90, 0x69,
+	0x68, 0x23, 0x75, 0x81, 0x2a, 0x86, 0xac, 0x4c, 0xd4, 0x43, 0x22, 0x7b, 0xfc, 0x75, 0x02, 0x0a,
+	0xd3, 0xad, 0x3c, 0x54, 0x82, 0x7b, 0xfb, 0xad, 0x66, 0x79, 0xbf, 0x55, 0xaf, 0xe8, 0x8d, 0xe6,
+	0x76, 0xb3, 0xd5, 0xd0, 0x5b, 0xf5, 0xc6, 0x41, 0x75, 0xa7, 0xf6, 0xac, 0x56, 0xad, 0x14, 0x6f,
+	0xa0, 0x7b, 0x70, 0x3b, 0x2e, 0x70, 0x50, 0xad, 0x57, 0x6a, 0xf5, 0xdd, 0x62, 0x62, 0x16, 0x93,
+	0x54, 0xf7, 0xb6, 0xbf, 0xa8, 0x56, 0x8a, 0x49, 0xb4, 0x06, 0x77, 0xe2, 0xcc, 0x9d, 0xfd, 0x17,
+	0x07, 0x7b, 0xd5, 0x66, 0xb5, 0x52, 0x4c, 0xa1, 0xfb, 0xa0, 0x9d, 0xd6, 0x7d, 0xd6, 0xaa, 0x57,
+	0xaa, 0x95, 0x62, 0xfa, 0xf1, 0x6f, 0x20, 0x17, 0x26, 0x36, 0x74, 0x17, 0x56, 0x77, 0xf6, 0xb6,
+	0x6b, 0x2f, 0xf4, 0xe6, 0x17, 0x07, 0xd5, 0xd8, 0xfa, 0x6e, 0xc2, 0x52, 0x84, 0x57, 0x6e, 0x91,
+	0x7a, 0x31, 0x11, 0x23, 0xee, 0xed, 0xef, 0xfc, 0xb2, 0x98, 0x44, 0xb7, 0xe1, 0x66, 0x84, 0x58,
+	0x7f, 0xd6, 0x94, 0x8c, 0xd4, 0xe3, 0xdf, 0x27, 0x20, 0x17, 0x5a, 0x89, 0x4f, 0xb6, 0x5d, 0x79,
+	0x51, 0xab, 0xeb, 0x64, 0x7f, 0x2f, 0x3e, 0xd9, 0x7d, 0xd0, 0x22, 0xbc, 0xcf, 0xb7, 0xf7, 0x6a,
+	0x95, 0xed, 0xe6, 0x3e, 0xd1, 0x1b, 0xd5, 0x66, 0x31, 0x81, 0x10, 0x14, 0x22, 0xdc, 0x67, 0xd5,
+	0x6a, 0x31, 0x89, 0xee, 0xc0, 0xad, 0x08, 0x4d, 0xec, 0xbf, 0xb6, 0x5d, 0xdf, 0xa9, 0x16, 0x53,
+	0x68, 0x15, 0x50, 0x84, 0x45, 0xaa, 0x8d, 0x9d, 0x56, 0x95, 0x14, 0xd3, 0xe5, 0xdd, 0x6f, 0xde,
+	0xac, 0x27, 0xbe, 0x7d, 0xb3, 0x9e, 0xf8, 0xcf, 0x9b, 0xf5, 0xc4, 0x1f, 0xde, 0xae, 0xdf, 0xf8,
+	0xf6, 0xed, 0xfa, 0x8d, 0x7f, 0xbe, 0x5d, 0xbf, 0xf1, 0xe5, 0x47, 0x91, 0xb2, 0xa2, 0x61, 0x1f,
+	0x89, 0x72, 0x6e, 0x2b, 0xf8, 0xdb, 0xfc, 0x24, 0xf2, 0x37, 0xbc, 0xa8, 0x30, 0x0e, 0x33, 0xe2,
+	0x9f, 0xf3, 0x8f, 0xff, 0x37, 0x00, 0x71, 0xc8, 0x58, 0x28, 0xa8, 0x1f, 0x00, 0x00,
+}
+
+func (m *EthBridgeClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthBridgeClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthBridgeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenContractAddress) > 0 {
+		i -= len(m.TokenContractAddress)
+		copy(dAtA[i:], m.TokenContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BridgeContractAddress) > 0 {
+		i -= len(m.BridgeContractAddress)
+		copy(dAtA[i:], m.BridgeContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeggyTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeggyTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeggyTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokens[iNdEx])
+			copy(dAtA[i:], m.Tokens[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Tokens[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Network) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Network) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Network) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutboundGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OutboundGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NativeToken) > 0 {
+		i -= len(m.NativeToken)
+		copy(dAtA[i:], m.NativeToken)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NativeToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DenomPrefix) > 0 {
+		i -= len(m.DenomPrefix)
+		copy(dAtA[i:], m.DenomPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DenomPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BridgeContractAddress) > 0 {
+		i -= len(m.BridgeContractAddress)
+		copy(dAtA[i:], m.BridgeContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkPeggyToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkPeggyToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkPeggyToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PreviousAmount.Size()
+		i -= size
+		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inbound {
+		i--
+		if m.Inbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Outbound {
+		i--
+		if m.Outbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumReceiver) > 0 {
+		i -= len(m.EthereumReceiver)
+		copy(dAtA[i:], m.EthereumReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CosmosSenderSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosSenderSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnclaimedInbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnclaimedInbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnclaimedInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Held {
+		i--
+		if m.Held {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlacklistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AddedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AddedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthBridgeNftClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthBridgeNftClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthBridgeNftClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenUri) > 0 {
+		i -= len(m.TokenUri)
+		copy(dAtA[i:], m.TokenUri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenUri)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenContractAddress) > 0 {
+		i -= len(m.TokenContractAddress)
+		copy(dAtA[i:], m.TokenContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BridgeContractAddress) > 0 {
+		i -= len(m.BridgeContractAddress)
+		copy(dAtA[i:], m.BridgeContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NftClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NftClass) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NftClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenContractAddress) > 0 {
+		i -= len(m.TokenContractAddress)
+		copy(dAtA[i:], m.TokenContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Nft) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Nft) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Nft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.TokenContractAddress) > 0 {
+		i -= len(m.TokenContractAddress)
+		copy(dAtA[i:], m.TokenContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContractAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProphecyId) > 0 {
+		i -= len(m.ProphecyId)
+		copy(dAtA[i:], m.ProphecyId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProphecyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConfiguredAdminRoles) > 0 {
+		dAtA2 := make([]byte, len(m.ConfiguredAdminRoles)*10)
+		var j1 int
+		for _, num := range m.ConfiguredAdminRoles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.NextClaimRecordId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextClaimRecordId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AdminRoleGrants) > 0 {
+		for iNdEx := len(m.AdminRoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminRoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.BridgeSupplies) > 0 {
+		for iNdEx := len(m.BridgeSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.NftClasses) > 0 {
+		for iNdEx := len(m.NftClasses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NftClasses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.GasPriceReports) > 0 {
+		for iNdEx := len(m.GasPriceReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPriceReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for iNdEx := len(m.Blacklist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blacklist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextUnclaimedInboundId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextUnclaimedInboundId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.UnclaimedInbounds) > 0 {
+		for iNdEx := len(m.UnclaimedInbounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnclaimedInbounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OutboundTransfers) > 0 {
+		for iNdEx := len(m.OutboundTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for iNdEx := len(m.TransferUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.NetworkPeggyTokens) > 0 {
+		for iNdEx := len(m.NetworkPeggyTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetworkPeggyTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Networks) > 0 {
+		for iNdEx := len(m.Networks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Networks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeggyTokens) > 0 {
+		for iNdEx := len(m.PeggyTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PeggyTokens[iNdEx])
+			copy(dAtA[i:], m.PeggyTokens[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.PeggyTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CethReceiveAccount) > 0 {
+		i -= len(m.CethReceiveAccount)
+		copy(dAtA[i:], m.CethReceiveAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CethReceiveAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthBridgeClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.BridgeContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ClaimType != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimType))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *PeggyTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, s := range m.Tokens {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Network) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BridgeContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DenomPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NativeToken)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OutboundGas != 0 {
+		n += 1 + sovTypes(uint64(m.OutboundGas))
+	}
+	return n
+}
+
+func (m *NetworkPeggyToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStart))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PreviousAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Pause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Outbound {
+		n += 2
+	}
+	if m.Inbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *OutboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CosmosSenderSequence != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosSenderSequence))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.EthereumReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ClaimType != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimType))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedHeight))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *UnclaimedInbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedHeight))
+	}
+	if m.Held {
+		n += 2
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *BlacklistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AddedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.AddedHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *GasPriceReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EthBridgeNftClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.BridgeContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenUri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *NftClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.TokenContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Nft) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *AdminRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovTypes(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.ProphecyId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ClaimType != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimType))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CreatedHeight))
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CompletedHeight))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CethReceiveAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.PeggyTokens) > 0 {
+		for _, s := range m.PeggyTokens {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.NetworkPeggyTokens) > 0 {
+		for _, e := range m.NetworkPeggyTokens {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for _, e := range m.TransferUsages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.OutboundTransfers) > 0 {
+		for _, e := range m.OutboundTransfers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.UnclaimedInbounds) > 0 {
+		for _, e := range m.UnclaimedInbounds {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.NextUnclaimedInboundId != 0 {
+		n += 1 + sovTypes(uint64(m.NextUnclaimedInboundId))
+	}
+	if len(m.Blacklist) > 0 {
+		for _, e := range m.Blacklist {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.GasPriceReports) > 0 {
+		for _, e := range m.GasPriceReports {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.NftClasses) > 0 {
+		for _, e := range m.NftClasses {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.BridgeSupplies) > 0 {
+		for _, e := range m.BridgeSupplies {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AdminRoleGrants) > 0 {
+		for _, e := range m.AdminRoleGrants {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.NextClaimRecordId != 0 {
+		n += 2 + sovTypes(uint64(m.NextClaimRecordId))
+	}
+	if len(m.ConfiguredAdminRoles) > 0 {
+		l = 0
+		for _, e := range m.ConfiguredAdminRoles {
+			l += sovTypes(uint64(e))
+		}
+		n += 2 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthBridgeClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthBridgeClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeggyTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeggyTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeggyTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Network) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Network: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Network: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundGas", wireType)
+			}
+			m.OutboundGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPeggyToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPeggyToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPeggyToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outbound = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inbound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboundTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSenderSequence", wireType)
+			}
+			m.CosmosSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutboundStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnclaimedInbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnclaimedInbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnclaimedInbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Held = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlacklistEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedHeight", wireType)
+			}
+			m.AddedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthBridgeNftClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthBridgeNftClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthBridgeNftClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NftClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NftClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NftClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Nft) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nft: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nft: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= AdminRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProphecyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.StatusText(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CethReceiveAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.PeggyTokens) > 0 {
-		for _, s := range m.PeggyTokens {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -601,17 +7032,17 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthBridgeClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthBridgeClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiveAccount", wireType)
 			}
-			m.EthereumChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -621,16 +7052,129 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CethReceiveAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeggyTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeggyTokens = append(m.PeggyTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Networks = append(m.Networks, Network{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPeggyTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkPeggyTokens = append(m.NetworkPeggyTokens, NetworkPeggyToken{})
+			if err := m.NetworkPeggyTokens[len(m.NetworkPeggyTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferUsages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -640,29 +7184,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			m.TransferUsages = append(m.TransferUsages, TransferUsage{})
+			if err := m.TransferUsages[len(m.TransferUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -672,16 +7218,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTransfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -691,29 +7252,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.OutboundTransfers = append(m.OutboundTransfers, OutboundTransfer{})
+			if err := m.OutboundTransfers[len(m.OutboundTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedInbounds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -723,29 +7286,50 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			m.UnclaimedInbounds = append(m.UnclaimedInbounds, UnclaimedInbound{})
+			if err := m.UnclaimedInbounds[len(m.UnclaimedInbounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnclaimedInboundId", wireType)
+			}
+			m.NextUnclaimedInboundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUnclaimedInboundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -755,29 +7339,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			m.Blacklist = append(m.Blacklist, BlacklistEntry{})
+			if err := m.Blacklist[len(m.Blacklist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceReports", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -787,29 +7373,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			m.GasPriceReports = append(m.GasPriceReports, GasPriceReport{})
+			if err := m.GasPriceReports[len(m.GasPriceReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftClasses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -819,29 +7407,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.NftClasses = append(m.NftClasses, NftClass{})
+			if err := m.NftClasses[len(m.NftClasses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -851,31 +7441,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nfts = append(m.Nfts, Nft{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSupplies", wireType)
 			}
-			m.ClaimType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -885,66 +7475,31 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClaimType |= ClaimType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PeggyTokens) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BridgeSupplies = append(m.BridgeSupplies, BridgeSupply{})
+			if err := m.BridgeSupplies[len(m.BridgeSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PeggyTokens: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeggyTokens: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminRoleGrants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -954,79 +7509,31 @@ func (m *PeggyTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			m.AdminRoleGrants = append(m.AdminRoleGrants, AdminRoleGrant{})
+			if err := m.AdminRoleGrants[len(m.AdminRoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiveAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1036,29 +7543,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CethReceiveAccount = string(dAtA[iNdEx:postIndex])
+			m.ClaimRecords = append(m.ClaimRecords, ClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeggyTokens", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimRecordId", wireType)
 			}
-			var stringLen uint64
+			m.NextClaimRecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1068,24 +7577,80 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextClaimRecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 18:
+			if wireType == 0 {
+				var v AdminRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= AdminRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConfiguredAdminRoles = append(m.ConfiguredAdminRoles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ConfiguredAdminRoles) == 0 {
+					m.ConfiguredAdminRoles = make([]AdminRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v AdminRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= AdminRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConfiguredAdminRoles = append(m.ConfiguredAdminRoles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfiguredAdminRoles", wireType)
 			}
-			m.PeggyTokens = append(m.PeggyTokens, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
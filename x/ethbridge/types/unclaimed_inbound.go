@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewUnclaimedInbound returns the record escrowing the coins of a claim that could not be sent to its receiver
+func NewUnclaimedInbound(id uint64, claim OracleClaimContent, symbol string, reason string, height int64) UnclaimedInbound {
+	return UnclaimedInbound{
+		Id:              id,
+		CosmosReceiver:  claim.CosmosReceiver.String(),
+		EthereumChainId: claim.EthereumChainID,
+		Symbol:          symbol,
+		Amount:          claim.Amount,
+		Reason:          reason,
+		CreatedHeight:   height,
+	}
+}
+
+// Coins returns the escrowed coins
+func (u UnclaimedInbound) Coins() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(u.Symbol, u.Amount))
+}
+
+// Validate checks the fields of an unclaimed inbound record
+func (u UnclaimedInbound) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, u.CosmosReceiver)
+	}
+	if err := sdk.ValidateDenom(u.Symbol); err != nil {
+		return err
+	}
+	if u.Amount.IsNil() || !u.Amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+	if u.ReleaseHeight < 0 || (!u.Held && u.ReleaseHeight != 0) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "release height %d of unclaimed inbound %d", u.ReleaseHeight, u.Id)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package margin
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/margin/keeper"
+)
+
+// EndBlocker charges interest on a bounded number of open positions and liquidates the unhealthy ones
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.UpdatePositions(ctx)
+}
@@ -0,0 +1,9 @@
+package cli
+
+const (
+	FlagAssetSymbol      = "symbol"
+	FlagPosition         = "position"
+	FlagCollateralAmount = "collateralAmount"
+	FlagLeverage         = "leverage"
+	FlagMTPID            = "id"
+)
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	marginTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	marginTxCmd.AddCommand(
+		GetCmdOpen(),
+		GetCmdClose(),
+	)
+	return marginTxCmd
+}
+
+func GetCmdOpen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open --from [key] --symbol [asset-symbol] --position [long|short] --collateralAmount [amount] --leverage [leverage]",
+		Short: "Open a margin position on the pool of an external asset",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			symbol, err := cmd.Flags().GetString(FlagAssetSymbol)
+			if err != nil {
+				return err
+			}
+			positionStr, err := cmd.Flags().GetString(FlagPosition)
+			if err != nil {
+				return err
+			}
+			position, err := ParsePosition(positionStr)
+			if err != nil {
+				return err
+			}
+			collateralAmount, err := cmd.Flags().GetString(FlagCollateralAmount)
+			if err != nil {
+				return err
+			}
+			leverageStr, err := cmd.Flags().GetString(FlagLeverage)
+			if err != nil {
+				return err
+			}
+			leverage, err := sdk.NewDecFromStr(leverageStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgOpen(clientCtx.GetFromAddress(), symbol, position, sdk.NewUintFromString(collateralAmount), leverage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(FlagAssetSymbol, "", "Symbol of the external asset of the pool")
+	cmd.Flags().String(FlagPosition, "", "Side of the position, long or short")
+	cmd.Flags().String(FlagCollateralAmount, "", "Collateral amount, in rowan for long and in the external asset for short positions")
+	cmd.Flags().String(FlagLeverage, "", "Leverage of the position")
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(FlagPosition); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(FlagCollateralAmount); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(FlagLeverage); err != nil {
+		panic(err)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdClose() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close --from [key] --id [position-id]",
+		Short: "Close a margin position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := cmd.Flags().GetUint64(FlagMTPID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgClose(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(FlagMTPID, 0, "Id of the position")
+	if err := cmd.MarkFlagRequired(FlagMTPID); err != nil {
+		panic(err)
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParsePosition parses long or short, case insensitive
+func ParsePosition(position string) (types.Position, error) {
+	switch strings.ToLower(strings.TrimSpace(position)) {
+	case "long":
+		return types.Position_POSITION_LONG, nil
+	case "short":
+		return types.Position_POSITION_SHORT, nil
+	default:
+		return types.Position_POSITION_UNSPECIFIED, types.ErrInvalidPosition
+	}
+}
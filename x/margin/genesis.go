@@ -0,0 +1,39 @@
+package margin
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Sifchain/sifnode/x/margin/keeper"
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []abci.ValidatorUpdate {
+	k.SetParams(ctx, data.Params)
+	for i := range data.Mtps {
+		k.SetMTP(ctx, &data.Mtps[i])
+	}
+	k.SetNextMTPID(ctx, data.NextMtpId)
+	k.SetMTPCursor(ctx, data.MtpCursor)
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.GenesisState{
+		Params:    k.GetParams(ctx),
+		Mtps:      k.GetMTPs(ctx),
+		NextMtpId: k.GetNextMTPID(ctx),
+		MtpCursor: k.GetMTPCursor(ctx),
+	}
+}
+
+// ValidateGenesis validates the margin genesis parameters and positions
+func ValidateGenesis(data types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("margin: genesis is invalid : %s", err.Error()))
+	}
+	return nil
+}
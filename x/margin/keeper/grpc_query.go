@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+const MaxPageLimit = 200
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (k Querier) GetMTP(c context.Context, req *types.MTPReq) (*types.MTPRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	mtp, err := k.Keeper.GetMTP(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "margin position %d not found", req.Id)
+	}
+	return &types.MTPRes{
+		Mtp:    mtp,
+		Height: ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetPositionsForAddress(c context.Context, req *types.PositionsForAddressReq) (*types.PositionsForAddressRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	mtps, pageRes, err := k.Keeper.GetMTPsForAddressPaginated(ctx, address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PositionsForAddressRes{
+		Mtps:       mtps,
+		Height:     ctx.BlockHeight(),
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) GetParams(c context.Context, req *types.ParamsReq) (*types.ParamsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.ParamsRes{Params: k.Keeper.GetParams(ctx)}, nil
+}
@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	marginkeeper "github.com/Sifchain/sifnode/x/margin/keeper"
+	"github.com/Sifchain/sifnode/x/margin/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+var (
+	initialBalance = sdk.NewUintFromString("1000000000000000000000")
+	poolBalance    = sdk.NewUintFromString("100000000000000000000")
+	collateral     = sdk.NewUintFromString("1000000000000000000")
+)
+
+// setupMarginPool creates an eth pool enabled for margin and funds the trader
+func setupMarginPool(t *testing.T) (sdk.Context, *sifapp.SifchainApp, sdk.AccAddress) {
+	ctx, app := test.CreateTestAppClp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: clptypes.NativeSymbol, Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+	signer := test.GenerateAddress(test.AddressKey1)
+	coins := sdk.NewCoins(sdk.NewCoin("eth", sdk.Int(initialBalance)), sdk.NewCoin(clptypes.NativeSymbol, sdk.Int(initialBalance)))
+	err := sifapp.AddCoinsToAccount(clptypes.ModuleName, app.BankKeeper, ctx, signer, coins)
+	require.NoError(t, err)
+	msgCreatePool := clptypes.NewMsgCreatePool(signer, clptypes.NewAsset("eth"), poolBalance, poolBalance)
+	_, err = clpkeeper.NewMsgServerImpl(app.ClpKeeper).CreatePool(sdk.WrapSDKContext(ctx), &msgCreatePool)
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.Pools = []string{"eth"}
+	app.MarginKeeper.SetParams(ctx, params)
+	return ctx, app, signer
+}
+
+// assertPoolCovered checks the pool records the principal lent to positions as liabilities, and that the clp module
+// account holds the balances of every pool net of their liabilities
+func assertPoolCovered(t *testing.T, ctx sdk.Context, app *sifapp.SifchainApp, native, external sdk.Uint) {
+	pool, err := app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+	assert.Equal(t, native.String(), pool.GetNativeLiabilities().String())
+	assert.Equal(t, external.String(), pool.GetExternalLiabilities().String())
+	owed := sdk.NewCoins()
+	for _, pool := range app.ClpKeeper.GetPools(ctx) {
+		assert.True(t, pool.CoversLiabilities())
+		owed = owed.Add(sdk.NewCoins(
+			sdk.NewCoin(clptypes.NativeSymbol, sdk.Int(pool.NativeAssetBalance.Sub(pool.GetNativeLiabilities()))),
+			sdk.NewCoin(pool.ExternalAsset.Symbol, sdk.Int(pool.ExternalAssetBalance.Sub(pool.GetExternalLiabilities()))))...)
+	}
+	held := app.BankKeeper.GetAllBalances(ctx, clptypes.GetCLPModuleAddress())
+	assert.True(t, held.IsAllGTE(owed), "clp module account holds %s, pools need %s", held, owed)
+}
+
+func TestKeeper_OpenClose(t *testing.T) {
+	for _, position := range []types.Position{types.Position_POSITION_LONG, types.Position_POSITION_SHORT} {
+		t.Run(position.String(), func(t *testing.T) {
+			ctx, app, signer := setupMarginPool(t)
+			msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			msgOpen := types.NewMsgOpen(signer, "eth", position, collateral, sdk.NewDec(2))
+			openRes, err := msgServer.Open(goCtx, &msgOpen)
+			require.NoError(t, err)
+			mtp := openRes.Mtp
+			collateralAsset, custodyAsset, err := types.GetCollateralAndCustodyAssets(position, "eth")
+			require.NoError(t, err)
+			assert.Equal(t, collateralAsset, mtp.CollateralAsset)
+			assert.Equal(t, custodyAsset, mtp.CustodyAsset)
+			assert.Equal(t, collateral.String(), mtp.LiabilitiesPrincipal.String())
+			assert.True(t, mtp.Health.GT(types.DefaultHealthThreshold))
+			custody := app.BankKeeper.GetBalance(ctx, types.GetMTPModuleAddress(), custodyAsset).Amount
+			assert.Equal(t, mtp.CustodyAmount.String(), custody.String())
+			if position == types.Position_POSITION_LONG {
+				assertPoolCovered(t, ctx, app, mtp.LiabilitiesPrincipal, sdk.ZeroUint())
+			} else {
+				assertPoolCovered(t, ctx, app, sdk.ZeroUint(), mtp.LiabilitiesPrincipal)
+			}
+
+			balanceBefore := app.BankKeeper.GetBalance(ctx, signer, collateralAsset).Amount
+			msgClose := types.NewMsgClose(signer, mtp.Id)
+			closeRes, err := msgServer.Close(goCtx, &msgClose)
+			require.NoError(t, err)
+			assert.Equal(t, mtp.LiabilitiesPrincipal.String(), closeRes.RepaidAmount.String())
+			// swap fees both ways keep the returned amount below the collateral
+			assert.True(t, closeRes.ReturnedAmount.GT(sdk.ZeroUint()))
+			assert.True(t, closeRes.ReturnedAmount.LT(collateral))
+			balanceAfter := app.BankKeeper.GetBalance(ctx, signer, collateralAsset).Amount
+			assert.Equal(t, balanceBefore.Add(sdk.Int(closeRes.ReturnedAmount)).String(), balanceAfter.String())
+			_, err = app.MarginKeeper.GetMTP(ctx, mtp.Id)
+			assert.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+			assert.True(t, app.BankKeeper.GetBalance(ctx, types.GetMTPModuleAddress(), custodyAsset).IsZero())
+			assertPoolCovered(t, ctx, app, sdk.ZeroUint(), sdk.ZeroUint())
+		})
+	}
+}
+
+func TestKeeper_OpenErrors(t *testing.T) {
+	ctx, app, signer := setupMarginPool(t)
+	msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	msgOpen := types.NewMsgOpen(signer, "cdash", types.Position_POSITION_LONG, collateral, sdk.NewDec(2))
+	_, err := msgServer.Open(goCtx, &msgOpen)
+	assert.ErrorIs(t, err, types.ErrPoolNotEnabled)
+
+	msgOpen = types.NewMsgOpen(signer, "eth", types.Position_POSITION_LONG, collateral, sdk.NewDec(3))
+	_, err = msgServer.Open(goCtx, &msgOpen)
+	assert.ErrorIs(t, err, types.ErrInvalidLeverage)
+
+	msgOpen = types.NewMsgOpen(signer, "eth", types.Position_POSITION_LONG, initialBalance.Add(sdk.OneUint()), sdk.NewDec(2))
+	_, err = msgServer.Open(goCtx, &msgOpen)
+	assert.ErrorIs(t, err, types.ErrBalanceNotAvailable)
+
+	msgOpen = types.NewMsgOpen(signer, "eth", types.Position_POSITION_LONG, collateral, sdk.NewDec(2))
+	openRes, err := msgServer.Open(goCtx, &msgOpen)
+	require.NoError(t, err)
+	other := test.GenerateAddress(test.AddressKey2)
+	msgClose := types.NewMsgClose(other, openRes.Mtp.Id)
+	_, err = msgServer.Close(goCtx, &msgClose)
+	assert.ErrorIs(t, err, types.ErrNotMTPOwner)
+}
+
+func TestKeeper_DecommissionPoolWithPosition(t *testing.T) {
+	ctx, app, signer := setupMarginPool(t)
+	app.ClpKeeper.SetClpWhiteList(ctx, []sdk.AccAddress{signer})
+	msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+	clpMsgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgOpen := types.NewMsgOpen(signer, "eth", types.Position_POSITION_LONG, collateral, sdk.NewDec(2))
+	openRes, err := msgServer.Open(goCtx, &msgOpen)
+	require.NoError(t, err)
+
+	msgDecommission := clptypes.NewMsgDecommissionPool(signer, "eth")
+	_, err = clpMsgServer.DecommissionPool(goCtx, &msgDecommission)
+	assert.ErrorIs(t, err, clptypes.ErrPoolHasLiabilities)
+	_, err = app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+	_, err = app.MarginKeeper.GetMTP(ctx, openRes.Mtp.Id)
+	require.NoError(t, err)
+
+	// the open position is enough to keep the pool, even if it records no liabilities
+	pool, err := app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+	pool.NativeLiabilities = sdk.ZeroUint()
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	_, err = clpMsgServer.DecommissionPool(goCtx, &msgDecommission)
+	assert.ErrorIs(t, err, clptypes.ErrPoolHasLiabilities)
+}
+
+func TestKeeper_UpdatePositions(t *testing.T) {
+	ctx, app, signer := setupMarginPool(t)
+	params := app.MarginKeeper.GetParams(ctx)
+	params.InterestRatePerBlock = sdk.NewDecWithPrec(1, 2)
+	app.MarginKeeper.SetParams(ctx, params)
+	msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+	msgOpen := types.NewMsgOpen(signer, "eth", types.Position_POSITION_LONG, collateral, sdk.NewDec(2))
+	openRes, err := msgServer.Open(sdk.WrapSDKContext(ctx), &msgOpen)
+	require.NoError(t, err)
+	poolBefore, err := app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+
+	// interest is charged for the blocks since the position was last updated and credited to the pool
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+	app.MarginKeeper.UpdatePositions(ctx)
+	mtp, err := app.MarginKeeper.GetMTP(ctx, openRes.Mtp.Id)
+	require.NoError(t, err)
+	interest := collateral.QuoUint64(100).MulUint64(2)
+	assert.Equal(t, interest.String(), mtp.LiabilitiesInterest.String())
+	assert.Equal(t, ctx.BlockHeight(), mtp.InterestHeight)
+	assert.True(t, mtp.Health.LT(openRes.Mtp.Health))
+	pool, err := app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+	assert.Equal(t, poolBefore.NativeAssetBalance.Add(interest).String(), pool.NativeAssetBalance.String())
+	assertPoolCovered(t, ctx, app, mtp.Liabilities(), sdk.ZeroUint())
+
+	// updating again in the same block charges nothing
+	app.MarginKeeper.UpdatePositions(ctx)
+	mtp, err = app.MarginKeeper.GetMTP(ctx, openRes.Mtp.Id)
+	require.NoError(t, err)
+	assert.Equal(t, interest.String(), mtp.LiabilitiesInterest.String())
+
+	// a threshold above the health of the position liquidates it
+	params.HealthThreshold = sdk.NewDec(10)
+	app.MarginKeeper.SetParams(ctx, params)
+	app.MarginKeeper.UpdatePositions(ctx)
+	_, err = app.MarginKeeper.GetMTP(ctx, mtp.Id)
+	assert.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+	assertPoolCovered(t, ctx, app, sdk.ZeroUint(), sdk.ZeroUint())
+}
+
+func TestKeeper_LiquidateUnderwater(t *testing.T) {
+	ctx, app, signer := setupMarginPool(t)
+	msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgOpen := types.NewMsgOpen(signer, "eth", types.Position_POSITION_LONG, collateral, sdk.NewDec(2))
+	openRes, err := msgServer.Open(goCtx, &msgOpen)
+	require.NoError(t, err)
+
+	// selling most of the eth of the trader into the pool leaves the custody worth less than the liabilities
+	msgSwap := clptypes.NewMsgSwap(signer, clptypes.NewAsset("eth"), clptypes.NewAsset(clptypes.NativeSymbol),
+		sdk.NewUintFromString("800000000000000000000"), sdk.ZeroUint())
+	_, err = clpkeeper.NewMsgServerImpl(app.ClpKeeper).Swap(goCtx, &msgSwap)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+	normalizationFactor, adjustExternalToken, err := app.MarginKeeper.GetNormalizationFactor(ctx, "eth")
+	require.NoError(t, err)
+	value, _, _, swappedPool, err := clpkeeper.SwapOne(clptypes.NewAsset("eth"), openRes.Mtp.CustodyAmount,
+		clptypes.NewAsset(clptypes.NativeSymbol), pool, normalizationFactor, adjustExternalToken)
+	require.NoError(t, err)
+	require.True(t, value.LT(openRes.Mtp.Liabilities()))
+
+	// the pool takes the custody and writes off the shortfall
+	app.MarginKeeper.UpdatePositions(ctx)
+	_, err = app.MarginKeeper.GetMTP(ctx, openRes.Mtp.Id)
+	assert.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+	pool, err = app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+	shortfall := openRes.Mtp.Liabilities().Sub(value)
+	assert.Equal(t, swappedPool.NativeAssetBalance.Sub(shortfall).String(), pool.NativeAssetBalance.String())
+	assert.True(t, app.BankKeeper.GetBalance(ctx, types.GetMTPModuleAddress(), "eth").IsZero())
+	assertPoolCovered(t, ctx, app, sdk.ZeroUint(), sdk.ZeroUint())
+}
+
+func TestKeeper_UpdatePositions_Bounded(t *testing.T) {
+	ctx, app, signer := setupMarginPool(t)
+	params := app.MarginKeeper.GetParams(ctx)
+	params.InterestRatePerBlock = sdk.NewDecWithPrec(1, 2)
+	params.PositionsPerBlock = 2
+	app.MarginKeeper.SetParams(ctx, params)
+	msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		msgOpen := types.NewMsgOpen(signer, "eth", types.Position_POSITION_LONG, collateral, sdk.NewDec(2))
+		openRes, err := msgServer.Open(sdk.WrapSDKContext(ctx), &msgOpen)
+		require.NoError(t, err)
+		ids = append(ids, openRes.Mtp.Id)
+	}
+	interestHeights := func() []int64 {
+		var heights []int64
+		for _, id := range ids {
+			mtp, err := app.MarginKeeper.GetMTP(ctx, id)
+			require.NoError(t, err)
+			heights = append(heights, mtp.InterestHeight)
+		}
+		return heights
+	}
+	height := ctx.BlockHeight()
+
+	ctx = ctx.WithBlockHeight(height + 1)
+	app.MarginKeeper.UpdatePositions(ctx)
+	assert.Equal(t, []int64{height + 1, height + 1, height}, interestHeights())
+
+	ctx = ctx.WithBlockHeight(height + 2)
+	app.MarginKeeper.UpdatePositions(ctx)
+	assert.Equal(t, []int64{height + 1, height + 1, height + 2}, interestHeights())
+
+	// the next block starts over from the first position
+	ctx = ctx.WithBlockHeight(height + 3)
+	app.MarginKeeper.UpdatePositions(ctx)
+	assert.Equal(t, []int64{height + 3, height + 3, height + 2}, interestHeights())
+	assertPoolCovered(t, ctx, app, app.ClpKeeper.GetPools(ctx)[0].GetNativeLiabilities(), sdk.ZeroUint())
+
+	// closing a position charges the interest it owes since it was last updated
+	mtp, err := app.MarginKeeper.GetMTP(ctx, ids[2])
+	require.NoError(t, err)
+	msgClose := types.NewMsgClose(signer, mtp.Id)
+	closeRes, err := msgServer.Close(sdk.WrapSDKContext(ctx), &msgClose)
+	require.NoError(t, err)
+	owed := mtp.Liabilities().Add(marginkeeper.CalculateInterest(mtp.LiabilitiesPrincipal, params.InterestRatePerBlock, 1))
+	assert.Equal(t, owed.String(), closeRes.RepaidAmount.String())
+	assertPoolCovered(t, ctx, app, app.ClpKeeper.GetPools(ctx)[0].GetNativeLiabilities(), sdk.ZeroUint())
+}
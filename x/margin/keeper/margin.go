@@ -0,0 +1,331 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	"github.com/Sifchain/sifnode/x/margin/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+// GetNormalizationFactor returns the normalization factor of the pool of the external asset, which must be enabled
+// for clp in the token registry
+func (k Keeper) GetNormalizationFactor(ctx sdk.Context, externalAsset string) (sdk.Dec, bool, error) {
+	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
+	entry, err := k.tokenRegistryKeeper.GetEntry(registry, externalAsset)
+	if err != nil {
+		return sdk.Dec{}, false, clptypes.ErrTokenNotSupported
+	}
+	if !k.tokenRegistryKeeper.CheckEntryPermissions(entry, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+		return sdk.Dec{}, false, tokenregistrytypes.ErrPermissionDenied
+	}
+	normalizationFactor, adjustExternalToken := k.clpKeeper.GetNormalizationFactor(entry.Decimals)
+	return normalizationFactor, adjustExternalToken, nil
+}
+
+// CalculateBorrowed returns the amount lent by the pool for a position of collateral opened with leverage
+func CalculateBorrowed(collateral sdk.Uint, leverage sdk.Dec) sdk.Uint {
+	borrowed := sdk.NewDecFromBigInt(collateral.BigInt()).Mul(leverage.Sub(sdk.OneDec())).TruncateInt()
+	return sdk.NewUintFromBigInt(borrowed.BigInt())
+}
+
+// CalculateInterest returns the interest charged on the principal over a number of blocks
+func CalculateInterest(principal sdk.Uint, interestRatePerBlock sdk.Dec, blocks int64) sdk.Uint {
+	if blocks <= 0 {
+		return sdk.ZeroUint()
+	}
+	interest := sdk.NewDecFromBigInt(principal.BigInt()).Mul(interestRatePerBlock).MulInt64(blocks).TruncateInt()
+	return sdk.NewUintFromBigInt(interest.BigInt())
+}
+
+// CalculateHealth returns the value of the custody of the position, swapped back into the collateral asset against
+// the pool, divided by its liabilities
+func CalculateHealth(mtp types.MTP, pool clptypes.Pool, normalizationFactor sdk.Dec, adjustExternalToken bool) (sdk.Dec, error) {
+	value, _, _, _, err := clpkeeper.SwapOne(clptypes.NewAsset(mtp.CustodyAsset), mtp.CustodyAmount,
+		clptypes.NewAsset(mtp.CollateralAsset), pool, normalizationFactor, adjustExternalToken)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	liabilities := mtp.Liabilities()
+	if liabilities.IsZero() {
+		return sdk.ZeroDec(), types.ErrInvalid
+	}
+	return sdk.NewDecFromBigInt(value.BigInt()).Quo(sdk.NewDecFromBigInt(liabilities.BigInt())), nil
+}
+
+// OpenPosition takes the collateral of the signer into the clp module account and swaps it, together with the amount
+// borrowed from the pool, for the custody asset. The pool records the borrowed amount as a liability until it is
+// repaid, and the custody is held by the margin module account.
+func (k Keeper) OpenPosition(ctx sdk.Context, msg *types.MsgOpen) (types.MTP, error) {
+	params := k.GetParams(ctx)
+	if !params.IsPoolEnabled(msg.ExternalAsset) {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrPoolNotEnabled, msg.ExternalAsset)
+	}
+	if msg.Leverage.LTE(sdk.OneDec()) || msg.Leverage.GT(params.LeverageMax) {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrInvalidLeverage, msg.Leverage.String())
+	}
+	collateralAsset, custodyAsset, err := types.GetCollateralAndCustodyAssets(msg.Position, msg.ExternalAsset)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	collateral := sdk.NewCoin(collateralAsset, sdk.NewIntFromBigInt(msg.CollateralAmount.BigInt()))
+	if !k.bankKeeper.HasBalance(ctx, signer, collateral) {
+		return types.MTP{}, types.ErrBalanceNotAvailable
+	}
+	pool, err := k.clpKeeper.GetPool(ctx, msg.ExternalAsset)
+	if err != nil {
+		return types.MTP{}, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, msg.ExternalAsset)
+	}
+	normalizationFactor, adjustExternalToken, err := k.GetNormalizationFactor(ctx, msg.ExternalAsset)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	borrowed := CalculateBorrowed(msg.CollateralAmount, msg.Leverage)
+	if borrowed.IsZero() {
+		return types.MTP{}, types.ErrBorrowTooSmall
+	}
+	custodyAmount, _, _, newPool, err := clpkeeper.SwapOne(clptypes.NewAsset(collateralAsset),
+		msg.CollateralAmount.Add(borrowed), clptypes.NewAsset(custodyAsset), pool, normalizationFactor, adjustExternalToken)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	// Only the collateral is paid into the clp module account, the borrowed amount swapped along with it is owed
+	// to the pool until the position is closed
+	lendToPosition(&newPool, collateralAsset, borrowed)
+	mtp := types.MTP{
+		Address:              msg.Signer,
+		ExternalAsset:        msg.ExternalAsset,
+		Position:             msg.Position,
+		CollateralAsset:      collateralAsset,
+		CollateralAmount:     msg.CollateralAmount,
+		LiabilitiesPrincipal: borrowed,
+		LiabilitiesInterest:  sdk.ZeroUint(),
+		CustodyAsset:         custodyAsset,
+		CustodyAmount:        custodyAmount,
+		Leverage:             msg.Leverage,
+		OpenedHeight:         ctx.BlockHeight(),
+		InterestHeight:       ctx.BlockHeight(),
+	}
+	mtp.Health, err = CalculateHealth(mtp, newPool, normalizationFactor, adjustExternalToken)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	if mtp.Health.LT(params.HealthThreshold) {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrMTPUnhealthy, mtp.Health.String())
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, clptypes.ModuleName, sdk.NewCoins(collateral))
+	if err != nil {
+		return types.MTP{}, err
+	}
+	custody := sdk.NewCoin(custodyAsset, sdk.NewIntFromBigInt(custodyAmount.BigInt()))
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, clptypes.ModuleName, types.ModuleName, sdk.NewCoins(custody))
+	if err != nil {
+		return types.MTP{}, err
+	}
+	err = k.clpKeeper.SetPool(ctx, &newPool)
+	if err != nil {
+		return types.MTP{}, sdkerrors.Wrap(clptypes.ErrUnableToSetPool, err.Error())
+	}
+	mtp.Id = k.GetNextMTPID(ctx)
+	k.SetNextMTPID(ctx, mtp.Id+1)
+	k.SetMTP(ctx, &mtp)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOpen,
+			sdk.NewAttribute(types.AttributeKeyMTPID, strconv.FormatUint(mtp.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPosition, mtp.Position.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventOpen{
+		Mtp:    mtp,
+		Height: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return types.MTP{}, err
+	}
+	return mtp, nil
+}
+
+// ClosePosition swaps the custody of the position back into the collateral asset against its pool, repays the
+// liabilities to the pool and sends what is left to the owner. Liabilities the proceeds can not cover are a loss of
+// the pool. It returns the amount repaid and the amount returned to the owner.
+func (k Keeper) ClosePosition(ctx sdk.Context, mtp types.MTP, liquidated bool) (sdk.Uint, sdk.Uint, error) {
+	owner, err := sdk.AccAddressFromBech32(mtp.Address)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	pool, err := k.clpKeeper.GetPool(ctx, mtp.ExternalAsset)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtp.ExternalAsset)
+	}
+	normalizationFactor, adjustExternalToken, err := k.GetNormalizationFactor(ctx, mtp.ExternalAsset)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	chargeInterest(ctx, &mtp, &pool, k.GetParams(ctx).InterestRatePerBlock)
+	proceeds, _, _, newPool, err := clpkeeper.SwapOne(clptypes.NewAsset(mtp.CustodyAsset), mtp.CustodyAmount,
+		clptypes.NewAsset(mtp.CollateralAsset), pool, normalizationFactor, adjustExternalToken)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	repaid := sdk.MinUint(proceeds, mtp.Liabilities())
+	returned := proceeds.Sub(repaid)
+	settleLiabilities(&newPool, mtp.CollateralAsset, repaid, mtp.Liabilities())
+	custody := sdk.NewCoin(mtp.CustodyAsset, sdk.NewIntFromBigInt(mtp.CustodyAmount.BigInt()))
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, clptypes.ModuleName, sdk.NewCoins(custody))
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	if !returned.IsZero() {
+		coin := sdk.NewCoin(mtp.CollateralAsset, sdk.NewIntFromBigInt(returned.BigInt()))
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, clptypes.ModuleName, owner, sdk.NewCoins(coin))
+		if err != nil {
+			return sdk.Uint{}, sdk.Uint{}, err
+		}
+	}
+	err = k.clpKeeper.SetPool(ctx, &newPool)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, sdkerrors.Wrap(clptypes.ErrUnableToSetPool, err.Error())
+	}
+	k.DestroyMTP(ctx, mtp.Id)
+	eventType := types.EventTypeClose
+	if liquidated {
+		eventType = types.EventTypeLiquidate
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyMTPID, strconv.FormatUint(mtp.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPosition, mtp.Position.String()),
+			sdk.NewAttribute(types.AttributeKeyRepaidAmount, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	})
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClose{
+		Mtp:            mtp,
+		RepaidAmount:   repaid,
+		ReturnedAmount: returned,
+		Liquidated:     liquidated,
+		Height:         ctx.BlockHeight(),
+	})
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	return repaid, returned, nil
+}
+
+// chargeInterest adds the interest due since it was last charged to the liabilities of the position. The interest is
+// earned by the pool, whose balance and liabilities grow by it until the position repays it.
+func chargeInterest(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, interestRatePerBlock sdk.Dec) {
+	interest := CalculateInterest(mtp.LiabilitiesPrincipal, interestRatePerBlock, ctx.BlockHeight()-mtp.InterestHeight)
+	if ctx.BlockHeight() > mtp.InterestHeight {
+		mtp.InterestHeight = ctx.BlockHeight()
+	}
+	if interest.IsZero() {
+		return
+	}
+	mtp.LiabilitiesInterest = mtp.LiabilitiesInterest.Add(interest)
+	if mtp.CollateralAsset == clptypes.NativeSymbol {
+		pool.NativeAssetBalance = pool.NativeAssetBalance.Add(interest)
+	} else {
+		pool.ExternalAssetBalance = pool.ExternalAssetBalance.Add(interest)
+	}
+	lendToPosition(pool, mtp.CollateralAsset, interest)
+}
+
+// lendToPosition records the amount lent by the pool to a position as a liability of the pool in the asset
+func lendToPosition(pool *clptypes.Pool, asset string, amount sdk.Uint) {
+	if asset == clptypes.NativeSymbol {
+		pool.NativeLiabilities = pool.GetNativeLiabilities().Add(amount)
+	} else {
+		pool.ExternalLiabilities = pool.GetExternalLiabilities().Add(amount)
+	}
+}
+
+// settleLiabilities clears the amount owed to the pool by a position. The owed amount was counted in the balance
+// while it was lent, the repayment replaces it and what is not repaid is written off as a loss of the pool, so that
+// underwater positions can always be closed.
+func settleLiabilities(pool *clptypes.Pool, asset string, repaid, owed sdk.Uint) {
+	if asset == clptypes.NativeSymbol {
+		owed = sdk.MinUint(owed, pool.GetNativeLiabilities())
+		balance := pool.NativeAssetBalance.Add(repaid)
+		pool.NativeAssetBalance = balance.Sub(sdk.MinUint(owed, balance))
+		pool.NativeLiabilities = pool.GetNativeLiabilities().Sub(owed)
+	} else {
+		owed = sdk.MinUint(owed, pool.GetExternalLiabilities())
+		balance := pool.ExternalAssetBalance.Add(repaid)
+		pool.ExternalAssetBalance = balance.Sub(sdk.MinUint(owed, balance))
+		pool.ExternalLiabilities = pool.GetExternalLiabilities().Sub(owed)
+	}
+}
+
+// UpdatePositions charges the interest due on a bounded number of positions and refreshes their health, liquidating
+// the positions whose health fell below the threshold. Positions which can not be valued or liquidated are left open.
+// The positions are visited in id order, each block continuing where the previous one stopped.
+func (k Keeper) UpdatePositions(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	mtps := k.GetMTPsFrom(ctx, k.GetMTPCursor(ctx), params.PositionsPerBlock)
+	if uint64(len(mtps)) < params.PositionsPerBlock {
+		k.SetMTPCursor(ctx, 0)
+	} else {
+		k.SetMTPCursor(ctx, mtps[len(mtps)-1].Id+1)
+	}
+	for _, mtp := range mtps {
+		pool, err := k.clpKeeper.GetPool(ctx, mtp.ExternalAsset)
+		if err != nil {
+			k.Logger(ctx).Error("unable to find pool of margin position", "id", mtp.Id, "error", err)
+			continue
+		}
+		chargeInterest(ctx, &mtp, &pool, params.InterestRatePerBlock)
+		err = k.clpKeeper.SetPool(ctx, &pool)
+		if err != nil {
+			k.Logger(ctx).Error("unable to charge interest on margin position", "id", mtp.Id, "error", err)
+			continue
+		}
+		k.SetMTP(ctx, &mtp)
+		health, err := k.calculateCurrentHealth(ctx, mtp)
+		if err != nil {
+			k.Logger(ctx).Error("unable to value margin position", "id", mtp.Id, "error", err)
+			continue
+		}
+		mtp.Health = health
+		k.SetMTP(ctx, &mtp)
+		if mtp.Health.GTE(params.HealthThreshold) {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		_, _, err = k.ClosePosition(cacheCtx, mtp, true)
+		if err != nil {
+			k.Logger(ctx).Error("unable to liquidate margin position", "id", mtp.Id, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+func (k Keeper) calculateCurrentHealth(ctx sdk.Context, mtp types.MTP) (sdk.Dec, error) {
+	pool, err := k.clpKeeper.GetPool(ctx, mtp.ExternalAsset)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	normalizationFactor, adjustExternalToken, err := k.GetNormalizationFactor(ctx, mtp.ExternalAsset)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return CalculateHealth(mtp, pool, normalizationFactor, adjustExternalToken)
+}
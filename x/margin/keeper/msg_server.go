@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the margin MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) Open(goCtx context.Context, msg *types.MsgOpen) (*types.MsgOpenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mtp, err := k.Keeper.OpenPosition(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgOpenResponse{Mtp: mtp}, nil
+}
+
+func (k msgServer) Close(goCtx context.Context, msg *types.MsgClose) (*types.MsgCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mtp, err := k.Keeper.GetMTP(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if mtp.Address != msg.Signer {
+		return nil, sdkerrors.Wrap(types.ErrNotMTPOwner, msg.Signer)
+	}
+	repaid, returned, err := k.Keeper.ClosePosition(ctx, mtp, false)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+	))
+	return &types.MsgCloseResponse{RepaidAmount: repaid, ReturnedAmount: returned}, nil
+}
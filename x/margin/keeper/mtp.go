@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+func (k Keeper) SetMTP(ctx sdk.Context, mtp *types.MTP) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMTPKey(mtp.Id), k.cdc.MustMarshal(mtp))
+}
+
+func (k Keeper) GetMTP(ctx sdk.Context, id uint64) (types.MTP, error) {
+	var mtp types.MTP
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMTPKey(id))
+	if bz == nil {
+		return mtp, types.ErrMTPDoesNotExist
+	}
+	k.cdc.MustUnmarshal(bz, &mtp)
+	return mtp, nil
+}
+
+func (k Keeper) DestroyMTP(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMTPKey(id))
+}
+
+func (k Keeper) GetMTPIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.MTPPrefix)
+}
+
+// GetMTPs returns all open positions ordered by id
+func (k Keeper) GetMTPs(ctx sdk.Context) []types.MTP {
+	var mtps []types.MTP
+	iterator := k.GetMTPIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var mtp types.MTP
+		k.cdc.MustUnmarshal(iterator.Value(), &mtp)
+		mtps = append(mtps, mtp)
+	}
+	return mtps
+}
+
+// HasPositions returns true if any open position borrowed from the pool of the external asset
+func (k Keeper) HasPositions(ctx sdk.Context, externalAsset string) bool {
+	iterator := k.GetMTPIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var mtp types.MTP
+		k.cdc.MustUnmarshal(iterator.Value(), &mtp)
+		if mtp.ExternalAsset == externalAsset {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Keeper) GetMTPsForAddressPaginated(ctx sdk.Context, address sdk.AccAddress,
+	pagination *query.PageRequest) ([]types.MTP, *query.PageResponse, error) {
+	var mtps []types.MTP
+	store := ctx.KVStore(k.storeKey)
+	mtpStore := prefix.NewStore(store, types.MTPPrefix)
+	pageRes, err := query.FilteredPaginate(mtpStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var mtp types.MTP
+		err := k.cdc.Unmarshal(value, &mtp)
+		if err != nil {
+			return false, err
+		}
+		if mtp.Address != address.String() {
+			return false, nil
+		}
+		if accumulate {
+			mtps = append(mtps, mtp)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return mtps, pageRes, nil
+}
+
+// GetNextMTPID returns the id the next opened position is assigned
+func (k Keeper) GetNextMTPID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MTPCountPrefix)
+	if bz == nil {
+		return 0
+	}
+	return types.MTPIDFromBytes(bz)
+}
+
+func (k Keeper) SetNextMTPID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MTPCountPrefix, types.MTPIDToBytes(id))
+}
+
+// GetMTPCursor returns the id from which positions are updated at the end of the next block
+func (k Keeper) GetMTPCursor(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MTPCursorKey)
+	if bz == nil {
+		return 0
+	}
+	return types.MTPIDFromBytes(bz)
+}
+
+func (k Keeper) SetMTPCursor(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MTPCursorKey, types.MTPIDToBytes(id))
+}
+
+// GetMTPsFrom returns at most limit open positions in id order, starting from the position with the given id
+func (k Keeper) GetMTPsFrom(ctx sdk.Context, id uint64, limit uint64) []types.MTP {
+	var mtps []types.MTP
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetMTPKey(id), sdk.PrefixEndBytes(types.MTPPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid() && uint64(len(mtps)) < limit; iterator.Next() {
+		var mtp types.MTP
+		k.cdc.MustUnmarshal(iterator.Value(), &mtp)
+		mtps = append(mtps, mtp)
+	}
+	return mtps
+}
@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding margin type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.MTPPrefix):
+			var mtpA, mtpB types.MTP
+			cdc.MustUnmarshal(kvA.Value, &mtpA)
+			cdc.MustUnmarshal(kvB.Value, &mtpB)
+			return fmt.Sprintf("%v\n%v", mtpA, mtpB)
+		case bytes.Equal(kvA.Key[:1], types.MTPCountPrefix), bytes.Equal(kvA.Key[:1], types.MTPCursorKey):
+			return fmt.Sprintf("%d\n%d", types.MTPIDFromBytes(kvA.Value), types.MTPIDFromBytes(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid margin key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	clpsimulation "github.com/Sifchain/sifnode/x/clp/simulation"
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+// Simulation parameter constants
+const (
+	LeverageMax          = "leverage_max"
+	InterestRatePerBlock = "interest_rate_per_block"
+	HealthThreshold      = "health_threshold"
+	Pools                = "pools"
+	PositionsPerBlock    = "positions_per_block"
+)
+
+// GenLeverageMax randomized LeverageMax between 1.5 and 10
+func GenLeverageMax(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 15, 101)), 1)
+}
+
+// GenInterestRatePerBlock randomized InterestRatePerBlock, up to 0.01% a block
+func GenInterestRatePerBlock(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 10001)), 8)
+}
+
+// GenHealthThreshold randomized HealthThreshold between 1 and 1.5
+func GenHealthThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 100, 151)), 2)
+}
+
+// GenPositionsPerBlock randomized PositionsPerBlock between 1 and 100
+func GenPositionsPerBlock(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 101))
+}
+
+// GenPools enables margin on a random subset of the simulated clp assets
+func GenPools(r *rand.Rand) []string {
+	var pools []string
+	for _, symbol := range clpsimulation.ExternalAssets {
+		if r.Intn(3) != 0 {
+			pools = append(pools, symbol)
+		}
+	}
+	return pools
+}
+
+// RandomizedGenState generates a random GenesisState for margin
+func RandomizedGenState(simState *module.SimulationState) {
+	var leverageMax sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, LeverageMax, &leverageMax, simState.Rand,
+		func(r *rand.Rand) { leverageMax = GenLeverageMax(r) },
+	)
+	var interestRatePerBlock sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InterestRatePerBlock, &interestRatePerBlock, simState.Rand,
+		func(r *rand.Rand) { interestRatePerBlock = GenInterestRatePerBlock(r) },
+	)
+	var healthThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HealthThreshold, &healthThreshold, simState.Rand,
+		func(r *rand.Rand) { healthThreshold = GenHealthThreshold(r) },
+	)
+	var pools []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Pools, &pools, simState.Rand,
+		func(r *rand.Rand) { pools = GenPools(r) },
+	)
+	var positionsPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PositionsPerBlock, &positionsPerBlock, simState.Rand,
+		func(r *rand.Rand) { positionsPerBlock = GenPositionsPerBlock(r) },
+	)
+	marginGenesis := types.GenesisState{
+		Params: types.NewParams(leverageMax, interestRatePerBlock, healthThreshold, pools, positionsPerBlock),
+	}
+	bz, err := json.MarshalIndent(&marginGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated margin parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&marginGenesis)
+}
@@ -0,0 +1,156 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	"github.com/Sifchain/sifnode/x/margin/keeper"
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgOpen  = "op_weight_msg_open"
+	OpWeightMsgClose = "op_weight_msg_close"
+
+	DefaultWeightMsgOpen  = 40
+	DefaultWeightMsgClose = 20
+)
+
+// TransactionFee is paid in rowan by every simulated margin transaction.
+var TransactionFee = sdk.NewCoins(sdk.NewCoin(clptypes.NativeSymbol, sdk.NewIntWithDecimal(1, 17)))
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgOpen  int
+		weightMsgClose int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgOpen, &weightMsgOpen, nil,
+		func(_ *rand.Rand) { weightMsgOpen = DefaultWeightMsgOpen },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgClose, &weightMsgClose, nil,
+		func(_ *rand.Rand) { weightMsgClose = DefaultWeightMsgClose },
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgOpen, SimulateMsgOpen(ak, k)),
+		simulation.NewWeightedOperation(weightMsgClose, SimulateMsgClose(ak, k)),
+	}
+}
+
+// SimulateMsgOpen generates a MsgOpen of a random side on a random enabled pool,
+// with a random share of the collateral asset held by a random account.
+func SimulateMsgOpen(ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgOpen{}.Type()
+		params := k.GetParams(ctx)
+		if len(params.Pools) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "margin is not enabled on any pool"), nil, nil
+		}
+		externalAsset := params.Pools[r.Intn(len(params.Pools))]
+		position := types.Position_POSITION_LONG
+		if r.Intn(2) == 0 {
+			position = types.Position_POSITION_SHORT
+		}
+		collateralAsset, _, err := types.GetCollateralAndCustodyAssets(position, externalAsset)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, simAccount.Address)
+		available := spendable.AmountOf(collateralAsset)
+		if collateralAsset == clptypes.NativeSymbol {
+			available = available.Sub(TransactionFee.AmountOf(clptypes.NativeSymbol))
+		}
+		// keep positions small next to the pools so most of them can be opened
+		available = available.QuoRaw(10)
+		if !available.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no collateral"), nil, nil
+		}
+		collateralAmount := simtypes.RandomAmount(r, available)
+		if collateralAmount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "collateral amount is zero"), nil, nil
+		}
+		// leverage between 1.1 and the maximum leverage
+		leverage := sdk.NewDecWithPrec(11, 1)
+		spread := params.LeverageMax.Sub(leverage).MulInt64(100).TruncateInt()
+		if spread.IsPositive() {
+			leverage = leverage.Add(sdk.NewDecFromInt(simtypes.RandomAmount(r, spread)).QuoInt64(100))
+		}
+		msg := types.NewMsgOpen(simAccount.Address, externalAsset, position,
+			sdk.NewUintFromBigInt(collateralAmount.BigInt()), leverage)
+		return deliver(r, app, ctx, ak, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.Open(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgClose generates a MsgClose for a random open position, signed by
+// its owner.
+func SimulateMsgClose(ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgClose{}.Type()
+		mtps := k.GetMTPs(ctx)
+		if len(mtps) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open positions"), nil, nil
+		}
+		mtp := mtps[r.Intn(len(mtps))]
+		owner, err := sdk.AccAddressFromBech32(mtp.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid owner address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, owner)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "owner is not a simulation account"), nil, nil
+		}
+		msg := types.NewMsgClose(simAccount.Address, mtp.Id)
+		return deliver(r, app, ctx, ak, k, simAccount, &msg, func(msgServer types.MsgServer, ctx sdk.Context) error {
+			_, err := msgServer.Close(sdk.WrapSDKContext(ctx), &msg)
+			return err
+		})
+	}
+}
+
+// deliver runs the msg against a cached copy of the state first, after taking
+// the fee, and only delivers transactions the msg server accepts. Rejected msgs
+// are reported as no-ops.
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, k keeper.Keeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, check func(types.MsgServer, sdk.Context) error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	err := k.GetBankKeeper().SendCoinsFromAccountToModule(cacheCtx, simAccount.Address, authtypes.FeeCollectorName, TransactionFee)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "not enough rowan to pay fees"), nil, nil
+	}
+	err = check(keeper.NewMsgServerImpl(k), cacheCtx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+	}
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:           nil,
+		Msg:           msg,
+		MsgType:       msg.Type(),
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    k.GetBankKeeper(),
+		ModuleName:    types.ModuleName,
+	}
+	return simulation.GenAndDeliverTx(txCtx, TransactionFee)
+}
@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyInterestRatePerBlock),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInterestRatePerBlock(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyHealthThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenHealthThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPositionsPerBlock),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenPositionsPerBlock(r))
+			},
+		),
+	}
+}
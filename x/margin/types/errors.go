@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrInvalid             = sdkerrors.Register(ModuleName, 1, "invalid")
+	ErrMTPDoesNotExist     = sdkerrors.Register(ModuleName, 2, "margin position does not exist")
+	ErrInvalidPosition     = sdkerrors.Register(ModuleName, 3, "position must be long or short")
+	ErrInvalidLeverage     = sdkerrors.Register(ModuleName, 4, "leverage must be greater than one and at most the maximum leverage")
+	ErrPoolNotEnabled      = sdkerrors.Register(ModuleName, 5, "margin is not enabled on the pool")
+	ErrBorrowTooSmall      = sdkerrors.Register(ModuleName, 6, "borrowed amount rounds to zero")
+	ErrMTPUnhealthy        = sdkerrors.Register(ModuleName, 7, "position would be opened below the health threshold")
+	ErrNotMTPOwner         = sdkerrors.Register(ModuleName, 8, "signer does not own the margin position")
+	ErrBalanceNotAvailable = sdkerrors.Register(ModuleName, 9, "user does not have enough balance of the required coin")
+)
@@ -0,0 +1,14 @@
+package types
+
+// margin module event types
+
+const (
+	EventTypeOpen            = "margin_open"
+	EventTypeClose           = "margin_close"
+	EventTypeLiquidate       = "margin_liquidate"
+	AttributeKeyMTPID        = "id"
+	AttributeKeyPosition     = "position"
+	AttributeKeyRepaidAmount = "repaid_amount"
+	AttributeKeyHeight       = "height"
+	AttributeValueCategory   = ModuleName
+)
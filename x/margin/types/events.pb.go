@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/margin/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventOpen is emitted when a position is opened.
+type EventOpen struct {
+	Mtp    MTP   `protobuf:"bytes,1,opt,name=mtp,proto3" json:"mtp"`
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventOpen) Reset()         { *m = EventOpen{} }
+func (m *EventOpen) String() string { return proto.CompactTextString(m) }
+func (*EventOpen) ProtoMessage()    {}
+func (*EventOpen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfffe8176d80204b, []int{0}
+}
+func (m *EventOpen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOpen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOpen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOpen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOpen.Merge(m, src)
+}
+func (m *EventOpen) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOpen) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOpen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOpen proto.InternalMessageInfo
+
+func (m *EventOpen) GetMtp() MTP {
+	if m != nil {
+		return m.Mtp
+	}
+	return MTP{}
+}
+
+func (m *EventOpen) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EventClose is emitted when a position is closed by its owner, or
+// liquidated by the end blocker when liquidated is true.
+type EventClose struct {
+	Mtp            MTP                                     `protobuf:"bytes,1,opt,name=mtp,proto3" json:"mtp"`
+	RepaidAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=repaid_amount,json=repaidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"repaid_amount"`
+	ReturnedAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=returned_amount,json=returnedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"returned_amount"`
+	Liquidated     bool                                    `protobuf:"varint,4,opt,name=liquidated,proto3" json:"liquidated,omitempty"`
+	Height         int64                                   `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventClose) Reset()         { *m = EventClose{} }
+func (m *EventClose) String() string { return proto.CompactTextString(m) }
+func (*EventClose) ProtoMessage()    {}
+func (*EventClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfffe8176d80204b, []int{1}
+}
+func (m *EventClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClose.Merge(m, src)
+}
+func (m *EventClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClose proto.InternalMessageInfo
+
+func (m *EventClose) GetMtp() MTP {
+	if m != nil {
+		return m.Mtp
+	}
+	return MTP{}
+}
+
+func (m *EventClose) GetLiquidated() bool {
+	if m != nil {
+		return m.Liquidated
+	}
+	return false
+}
+
+func (m *EventClose) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventOpen)(nil), "sifnode.margin.v1.EventOpen")
+	proto.RegisterType((*EventClose)(nil), "sifnode.margin.v1.EventClose")
+}
+
+func init() { proto.RegisterFile("sifnode/margin/v1/events.proto", fileDescriptor_dfffe8176d80204b) }
+
+var fileDescriptor_dfffe8176d80204b = []byte{
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd1, 0xdd, 0x4a, 0xfb, 0x30,
+	0x14, 0x00, 0xf0, 0x66, 0xdb, 0x7f, 0xfc, 0x17, 0xbf, 0xb0, 0xc8, 0x18, 0x03, 0xb3, 0xb1, 0x1b,
+	0x87, 0x60, 0xc2, 0xf4, 0x09, 0x9c, 0x7a, 0x29, 0x4a, 0x37, 0x41, 0xbc, 0x91, 0x6e, 0xcd, 0xda,
+	0xe0, 0x9a, 0xd4, 0x26, 0x1d, 0xfa, 0x16, 0x3e, 0x84, 0x0f, 0xb3, 0xcb, 0x5d, 0x8a, 0x17, 0x43,
+	0xda, 0x17, 0x91, 0xb6, 0xa9, 0x14, 0x76, 0xa5, 0x57, 0xf9, 0x38, 0x87, 0x5f, 0x4e, 0xce, 0x81,
+	0x48, 0xb2, 0x19, 0x17, 0x0e, 0x25, 0xbe, 0x1d, 0xba, 0x8c, 0x93, 0xc5, 0x80, 0xd0, 0x05, 0xe5,
+	0x4a, 0xe2, 0x20, 0x14, 0x4a, 0x98, 0xfb, 0x3a, 0x8e, 0xf3, 0x38, 0x5e, 0x0c, 0xda, 0x07, 0xae,
+	0x70, 0x45, 0x16, 0x25, 0xe9, 0x2e, 0x4f, 0x6c, 0x1f, 0x6e, 0x42, 0xea, 0x35, 0xa0, 0xda, 0xe9,
+	0x8d, 0x60, 0xe3, 0x2a, 0x75, 0x6f, 0x02, 0xca, 0x4d, 0x0c, 0xab, 0xbe, 0x0a, 0x5a, 0xa0, 0x0b,
+	0xfa, 0x5b, 0xa7, 0x4d, 0xbc, 0xf1, 0x04, 0xbe, 0x1e, 0xdf, 0x0e, 0x6b, 0xcb, 0x75, 0xc7, 0xb0,
+	0xd2, 0x44, 0xb3, 0x09, 0xeb, 0x1e, 0x65, 0xae, 0xa7, 0x5a, 0x95, 0x2e, 0xe8, 0x57, 0x2d, 0x7d,
+	0xea, 0xbd, 0x57, 0x20, 0xcc, 0xd4, 0x8b, 0xb9, 0x90, 0xf4, 0xd7, 0xec, 0x18, 0xee, 0x84, 0x34,
+	0xb0, 0x99, 0xf3, 0x68, 0xfb, 0x22, 0xe2, 0xb9, 0xde, 0x18, 0x92, 0x34, 0xe3, 0x73, 0xdd, 0x39,
+	0x72, 0x99, 0xf2, 0xa2, 0x09, 0x9e, 0x0a, 0x9f, 0x4c, 0x85, 0xf4, 0x85, 0xd4, 0xcb, 0x89, 0x74,
+	0x9e, 0xf4, 0xe7, 0xee, 0x18, 0x57, 0xd6, 0x76, 0xae, 0x9c, 0x67, 0x88, 0x79, 0x0f, 0xf7, 0x42,
+	0xaa, 0xa2, 0x90, 0xd3, 0x1f, 0xb7, 0xfa, 0x37, 0x77, 0xb7, 0x70, 0xb4, 0x8c, 0x20, 0x9c, 0xb3,
+	0xe7, 0x88, 0x39, 0xb6, 0xa2, 0x4e, 0xab, 0xd6, 0x05, 0xfd, 0xff, 0x56, 0xe9, 0xa6, 0xd4, 0xa6,
+	0x7f, 0xe5, 0x36, 0x0d, 0x2f, 0x97, 0x31, 0x02, 0xab, 0x18, 0x81, 0xaf, 0x18, 0x81, 0xb7, 0x04,
+	0x19, 0xab, 0x04, 0x19, 0x1f, 0x09, 0x32, 0x1e, 0x8e, 0x4b, 0xa5, 0x8c, 0xd8, 0x6c, 0xea, 0xd9,
+	0x8c, 0x93, 0x62, 0x90, 0x2f, 0xc5, 0x28, 0xb3, 0x92, 0x26, 0xf5, 0x6c, 0x90, 0x67, 0xdf, 0x03,
+	0x00, 0x94, 0x44, 0x43, 0xcf, 0x32, 0x02, 0x00, 0x00,
+}
+
+func (m *EventOpen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOpen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Mtp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Liquidated {
+		i--
+		if m.Liquidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ReturnedAmount.Size()
+		i -= size
+		if _, err := m.ReturnedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RepaidAmount.Size()
+		i -= size
+		if _, err := m.RepaidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Mtp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventOpen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mtp.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mtp.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.RepaidAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ReturnedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Liquidated {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventOpen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOpen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOpen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mtp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mtp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepaidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Liquidated = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
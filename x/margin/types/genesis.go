@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState instance
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState gets the raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate checks the params and that every position is valid and has an id below the next id
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[uint64]bool, len(gs.Mtps))
+	for _, mtp := range gs.Mtps {
+		if err := mtp.Validate(); err != nil {
+			return err
+		}
+		if mtp.Id >= gs.NextMtpId {
+			return fmt.Errorf("position id %d is not below the next id %d", mtp.Id, gs.NextMtpId)
+		}
+		if seen[mtp.Id] {
+			return fmt.Errorf("duplicate position id: %d", mtp.Id)
+		}
+		seen[mtp.Id] = true
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "margin"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+)
+
+var (
+	MTPPrefix      = []byte{0x01} // key for storing margin trading positions
+	MTPCountPrefix = []byte{0x02} // key for storing the next position id
+	MTPOwnerPrefix = []byte{0x03} // key for indexing positions by owner
+	MTPCursorKey   = []byte{0x04} // key for storing the id of the next position updated at the end of a block
+)
+
+// GetMTPKey returns the key of the position with the given id
+func GetMTPKey(id uint64) []byte {
+	return append(MTPPrefix, MTPIDToBytes(id)...)
+}
+
+// MTPIDFromBytes returns the position id encoded at the end of a key
+func MTPIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz[len(bz)-8:])
+}
+
+// MTPIDToBytes encodes a position id in big endian so keys iterate in id order
+func MTPIDToBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
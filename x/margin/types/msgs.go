@@ -0,0 +1,96 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+)
+
+var (
+	_ sdk.Msg = &MsgOpen{}
+	_ sdk.Msg = &MsgClose{}
+)
+
+func NewMsgOpen(signer sdk.AccAddress, externalAsset string, position Position, collateralAmount sdk.Uint, leverage sdk.Dec) MsgOpen {
+	return MsgOpen{
+		Signer:           signer.String(),
+		ExternalAsset:    externalAsset,
+		Position:         position,
+		CollateralAmount: collateralAmount,
+		Leverage:         leverage,
+	}
+}
+
+func (m MsgOpen) Route() string {
+	return RouterKey
+}
+
+func (m MsgOpen) Type() string {
+	return "open"
+}
+
+func (m MsgOpen) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	symbol := strings.TrimSpace(m.ExternalAsset)
+	if len(symbol) == 0 || len(symbol) > clptypes.MaxSymbolLength || symbol == clptypes.NativeSymbol {
+		return sdkerrors.Wrap(clptypes.ErrInValidAsset, m.ExternalAsset)
+	}
+	if m.Position != Position_POSITION_LONG && m.Position != Position_POSITION_SHORT {
+		return sdkerrors.Wrap(ErrInvalidPosition, m.Position.String())
+	}
+	if m.CollateralAmount.IsZero() {
+		return sdkerrors.Wrap(clptypes.ErrInValidAmount, m.CollateralAmount.String())
+	}
+	if m.Leverage.IsNil() || m.Leverage.LTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidLeverage, m.Leverage.String())
+	}
+	return nil
+}
+
+func (m MsgOpen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgOpen) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgClose(signer sdk.AccAddress, id uint64) MsgClose {
+	return MsgClose{Signer: signer.String(), Id: id}
+}
+
+func (m MsgClose) Route() string {
+	return RouterKey
+}
+
+func (m MsgClose) Type() string {
+	return "close"
+}
+
+func (m MsgClose) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	return nil
+}
+
+func (m MsgClose) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClose) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+// Default parameter values. Margin starts disabled on every pool.
+var (
+	DefaultLeverageMax          = sdk.NewDec(2)
+	DefaultInterestRatePerBlock = sdk.NewDecWithPrec(1, 8)
+	DefaultHealthThreshold      = sdk.NewDecWithPrec(11, 1)
+	DefaultPositionsPerBlock    = uint64(100)
+)
+
+// Parameter store keys
+var (
+	KeyLeverageMax          = []byte("LeverageMax")
+	KeyInterestRatePerBlock = []byte("InterestRatePerBlock")
+	KeyHealthThreshold      = []byte("HealthThreshold")
+	KeyPools                = []byte("Pools")
+	KeyPositionsPerBlock    = []byte("PositionsPerBlock")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for margin module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(leverageMax, interestRatePerBlock, healthThreshold sdk.Dec, pools []string, positionsPerBlock uint64) Params {
+	return Params{
+		LeverageMax:          leverageMax,
+		InterestRatePerBlock: interestRatePerBlock,
+		HealthThreshold:      healthThreshold,
+		Pools:                pools,
+		PositionsPerBlock:    positionsPerBlock,
+	}
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyLeverageMax, &p.LeverageMax, validateLeverageMax),
+		paramtypes.NewParamSetPair(KeyInterestRatePerBlock, &p.InterestRatePerBlock, validateInterestRatePerBlock),
+		paramtypes.NewParamSetPair(KeyHealthThreshold, &p.HealthThreshold, validateHealthThreshold),
+		paramtypes.NewParamSetPair(KeyPools, &p.Pools, validatePools),
+		paramtypes.NewParamSetPair(KeyPositionsPerBlock, &p.PositionsPerBlock, validatePositionsPerBlock),
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultLeverageMax, DefaultInterestRatePerBlock, DefaultHealthThreshold, []string{}, DefaultPositionsPerBlock)
+}
+
+func (p Params) Validate() error {
+	if err := validateLeverageMax(p.LeverageMax); err != nil {
+		return err
+	}
+	if err := validateInterestRatePerBlock(p.InterestRatePerBlock); err != nil {
+		return err
+	}
+	if err := validateHealthThreshold(p.HealthThreshold); err != nil {
+		return err
+	}
+	if err := validatePools(p.Pools); err != nil {
+		return err
+	}
+	return validatePositionsPerBlock(p.PositionsPerBlock)
+}
+
+// IsPoolEnabled returns true if positions can be opened on the pool of the external asset
+func (p Params) IsPoolEnabled(externalAsset string) bool {
+	for _, pool := range p.Pools {
+		if pool == externalAsset {
+			return true
+		}
+	}
+	return false
+}
+
+func validateLeverageMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LTE(sdk.OneDec()) {
+		return fmt.Errorf("leverage max must be greater than one: %s", v)
+	}
+	return nil
+}
+
+func validateInterestRatePerBlock(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("interest rate per block must be between zero and one: %s", v)
+	}
+	return nil
+}
+
+func validateHealthThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("health threshold must be at least one: %s", v)
+	}
+	return nil
+}
+
+func validatePools(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, pool := range v {
+		if strings.TrimSpace(pool) == "" {
+			return fmt.Errorf("pool symbol cannot be empty")
+		}
+		if seen[pool] {
+			return fmt.Errorf("duplicate pool: %s", pool)
+		}
+		seen[pool] = true
+	}
+	return nil
+}
+
+func validatePositionsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("positions per block must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,514 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/margin/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params - used for initializing default parameter for margin at genesis
+type Params struct {
+	// leverage_max is the highest leverage a position can be opened with.
+	LeverageMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=leverage_max,json=leverageMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage_max"`
+	// interest_rate_per_block is charged on the liabilities principal for every
+	// block, whenever the position is updated or closed.
+	InterestRatePerBlock github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=interest_rate_per_block,json=interestRatePerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_rate_per_block"`
+	// health_threshold is the health below which positions are liquidated.
+	HealthThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=health_threshold,json=healthThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"health_threshold"`
+	// pools are the external asset symbols of the pools margin is enabled on.
+	Pools []string `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+	// positions_per_block is the number of positions updated at the end of a
+	// block, going through the open positions in id order across blocks.
+	PositionsPerBlock uint64 `protobuf:"varint,5,opt,name=positions_per_block,json=positionsPerBlock,proto3" json:"positions_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530135b2c1d7983d, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetPools() []string {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *Params) GetPositionsPerBlock() uint64 {
+	if m != nil {
+		return m.PositionsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "sifnode.margin.v1.Params")
+}
+
+func init() { proto.RegisterFile("sifnode/margin/v1/params.proto", fileDescriptor_530135b2c1d7983d) }
+
+var fileDescriptor_530135b2c1d7983d = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0xdb, 0xfd, 0x83, 0x45, 0x41, 0x57, 0x07, 0x16, 0x0f, 0xd9, 0xf0, 0x20, 0x43, 0x30,
+	0x61, 0xf8, 0x0d, 0xc6, 0xae, 0xc2, 0xac, 0x5e, 0xf4, 0x52, 0xb2, 0xee, 0x5d, 0x1b, 0xd6, 0xf6,
+	0x2d, 0x49, 0x1c, 0xf3, 0x5b, 0xf8, 0xb1, 0x76, 0xdc, 0x51, 0x3c, 0x0c, 0x59, 0xbf, 0x88, 0xac,
+	0x5d, 0x65, 0xe7, 0x9d, 0x92, 0xf0, 0xcb, 0xfb, 0xe3, 0x79, 0x79, 0x08, 0xd5, 0x72, 0x9e, 0xe2,
+	0x0c, 0x78, 0x22, 0x54, 0x28, 0x53, 0xbe, 0x1c, 0xf2, 0x4c, 0x28, 0x91, 0x68, 0x96, 0x29, 0x34,
+	0xe8, 0x74, 0x0e, 0x9c, 0x95, 0x9c, 0x2d, 0x87, 0x37, 0xdd, 0x10, 0x43, 0x2c, 0x28, 0xdf, 0xdf,
+	0xca, 0x8f, 0xb7, 0x79, 0x8d, 0xb4, 0x26, 0xc5, 0xa4, 0xf3, 0x4c, 0xce, 0x63, 0x58, 0x82, 0x12,
+	0x21, 0xf8, 0x89, 0x58, 0xb9, 0x76, 0xdf, 0x1e, 0xb4, 0x47, 0x6c, 0xbd, 0xed, 0x59, 0x3f, 0xdb,
+	0xde, 0x5d, 0x28, 0x4d, 0xf4, 0x31, 0x65, 0x01, 0x26, 0x3c, 0x40, 0x9d, 0xa0, 0x3e, 0x1c, 0x0f,
+	0x7a, 0xb6, 0xe0, 0xe6, 0x33, 0x03, 0xcd, 0xc6, 0x10, 0x78, 0x67, 0x95, 0xe3, 0x49, 0xac, 0x1c,
+	0x20, 0xd7, 0x32, 0x35, 0xa0, 0x40, 0x1b, 0x5f, 0x09, 0x03, 0x7e, 0x06, 0xca, 0x9f, 0xc6, 0x18,
+	0x2c, 0xdc, 0xda, 0x49, 0xf6, 0x6e, 0xa5, 0xf3, 0x84, 0x81, 0x09, 0xa8, 0xd1, 0xde, 0xe5, 0xbc,
+	0x91, 0xcb, 0x08, 0x44, 0x6c, 0x22, 0xdf, 0x44, 0x0a, 0x74, 0x84, 0xf1, 0xcc, 0xad, 0x9f, 0xe4,
+	0xbf, 0x28, 0x3d, 0xaf, 0x95, 0xc6, 0xe9, 0x92, 0x66, 0x86, 0x18, 0x6b, 0xb7, 0xd1, 0xaf, 0x0f,
+	0xda, 0x5e, 0xf9, 0x70, 0x18, 0xb9, 0xca, 0x50, 0x4b, 0x23, 0x31, 0xd5, 0x47, 0x3b, 0x35, 0xfb,
+	0xf6, 0xa0, 0xe1, 0x75, 0xfe, 0x51, 0x15, 0x70, 0x34, 0x5e, 0xef, 0xa8, 0xbd, 0xd9, 0x51, 0xfb,
+	0x77, 0x47, 0xed, 0xaf, 0x9c, 0x5a, 0x9b, 0x9c, 0x5a, 0xdf, 0x39, 0xb5, 0xde, 0xef, 0x8f, 0x82,
+	0xbd, 0xc8, 0x79, 0x10, 0x09, 0x99, 0xf2, 0xaa, 0xdc, 0x55, 0x55, 0x6f, 0x11, 0x70, 0xda, 0x2a,
+	0x2a, 0x7b, 0xfc, 0x1b, 0x00, 0x16, 0xc9, 0x04, 0x56, 0xfd, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PositionsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pools[iNdEx])
+			copy(dAtA[i:], m.Pools[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Pools[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.HealthThreshold.Size()
+		i -= size
+		if _, err := m.HealthThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InterestRatePerBlock.Size()
+		i -= size
+		if _, err := m.InterestRatePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LeverageMax.Size()
+		i -= size
+		if _, err := m.LeverageMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LeverageMax.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InterestRatePerBlock.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.HealthThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PositionsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.PositionsPerBlock))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeverageMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LeverageMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRatePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestRatePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HealthThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsPerBlock", wireType)
+			}
+			m.PositionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
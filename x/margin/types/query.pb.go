@@ -0,0 +1,1486 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/margin/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MTPReq struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MTPReq) Reset()         { *m = MTPReq{} }
+func (m *MTPReq) String() string { return proto.CompactTextString(m) }
+func (*MTPReq) ProtoMessage()    {}
+func (*MTPReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{0}
+}
+func (m *MTPReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MTPReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MTPReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MTPReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MTPReq.Merge(m, src)
+}
+func (m *MTPReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *MTPReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MTPReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MTPReq proto.InternalMessageInfo
+
+func (m *MTPReq) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MTPRes struct {
+	Mtp    MTP   `protobuf:"bytes,1,opt,name=mtp,proto3" json:"mtp"`
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MTPRes) Reset()         { *m = MTPRes{} }
+func (m *MTPRes) String() string { return proto.CompactTextString(m) }
+func (*MTPRes) ProtoMessage()    {}
+func (*MTPRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{1}
+}
+func (m *MTPRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MTPRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MTPRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MTPRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MTPRes.Merge(m, src)
+}
+func (m *MTPRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MTPRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MTPRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MTPRes proto.InternalMessageInfo
+
+func (m *MTPRes) GetMtp() MTP {
+	if m != nil {
+		return m.Mtp
+	}
+	return MTP{}
+}
+
+func (m *MTPRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type PositionsForAddressReq struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PositionsForAddressReq) Reset()         { *m = PositionsForAddressReq{} }
+func (m *PositionsForAddressReq) String() string { return proto.CompactTextString(m) }
+func (*PositionsForAddressReq) ProtoMessage()    {}
+func (*PositionsForAddressReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{2}
+}
+func (m *PositionsForAddressReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionsForAddressReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionsForAddressReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionsForAddressReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionsForAddressReq.Merge(m, src)
+}
+func (m *PositionsForAddressReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionsForAddressReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionsForAddressReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionsForAddressReq proto.InternalMessageInfo
+
+func (m *PositionsForAddressReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PositionsForAddressReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type PositionsForAddressRes struct {
+	Mtps       []MTP               `protobuf:"bytes,1,rep,name=mtps,proto3" json:"mtps"`
+	Height     int64               `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PositionsForAddressRes) Reset()         { *m = PositionsForAddressRes{} }
+func (m *PositionsForAddressRes) String() string { return proto.CompactTextString(m) }
+func (*PositionsForAddressRes) ProtoMessage()    {}
+func (*PositionsForAddressRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{3}
+}
+func (m *PositionsForAddressRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionsForAddressRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionsForAddressRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionsForAddressRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionsForAddressRes.Merge(m, src)
+}
+func (m *PositionsForAddressRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionsForAddressRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionsForAddressRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionsForAddressRes proto.InternalMessageInfo
+
+func (m *PositionsForAddressRes) GetMtps() []MTP {
+	if m != nil {
+		return m.Mtps
+	}
+	return nil
+}
+
+func (m *PositionsForAddressRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PositionsForAddressRes) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type ParamsReq struct {
+}
+
+func (m *ParamsReq) Reset()         { *m = ParamsReq{} }
+func (m *ParamsReq) String() string { return proto.CompactTextString(m) }
+func (*ParamsReq) ProtoMessage()    {}
+func (*ParamsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{4}
+}
+func (m *ParamsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsReq.Merge(m, src)
+}
+func (m *ParamsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsReq proto.InternalMessageInfo
+
+type ParamsRes struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsRes) Reset()         { *m = ParamsRes{} }
+func (m *ParamsRes) String() string { return proto.CompactTextString(m) }
+func (*ParamsRes) ProtoMessage()    {}
+func (*ParamsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{5}
+}
+func (m *ParamsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRes.Merge(m, src)
+}
+func (m *ParamsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRes proto.InternalMessageInfo
+
+func (m *ParamsRes) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*MTPReq)(nil), "sifnode.margin.v1.MTPReq")
+	proto.RegisterType((*MTPRes)(nil), "sifnode.margin.v1.MTPRes")
+	proto.RegisterType((*PositionsForAddressReq)(nil), "sifnode.margin.v1.PositionsForAddressReq")
+	proto.RegisterType((*PositionsForAddressRes)(nil), "sifnode.margin.v1.PositionsForAddressRes")
+	proto.RegisterType((*ParamsReq)(nil), "sifnode.margin.v1.ParamsReq")
+	proto.RegisterType((*ParamsRes)(nil), "sifnode.margin.v1.ParamsRes")
+}
+
+func init() { proto.RegisterFile("sifnode/margin/v1/query.proto", fileDescriptor_73c14070fed1f663) }
+
+var fileDescriptor_73c14070fed1f663 = []byte{
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x9d, 0x60, 0x94, 0x89, 0x84, 0xc4, 0x08, 0x45, 0xa9, 0x15, 0x4c, 0x65, 0x21, 0xfa,
+	0x58, 0xcc, 0x90, 0xb0, 0x60, 0x0d, 0xaa, 0x9a, 0x55, 0x24, 0x63, 0xb2, 0x62, 0x37, 0x89, 0xa7,
+	0xce, 0x08, 0xec, 0xb1, 0x3d, 0x93, 0x88, 0x52, 0x75, 0xc3, 0x17, 0x20, 0xf1, 0x01, 0x7c, 0x00,
+	0x3f, 0xd2, 0x65, 0x25, 0x36, 0xac, 0x10, 0x4a, 0xf8, 0x0c, 0x16, 0xc8, 0x33, 0x63, 0xb5, 0x55,
+	0x1d, 0xd2, 0x9d, 0xaf, 0xce, 0x99, 0x7b, 0xce, 0xb9, 0xbe, 0x17, 0x3c, 0x16, 0xec, 0x24, 0xe5,
+	0x11, 0xc5, 0x09, 0x29, 0x62, 0x96, 0xe2, 0xe5, 0x00, 0xe7, 0x0b, 0x5a, 0x9c, 0xa2, 0xac, 0xe0,
+	0x92, 0xc3, 0x87, 0x06, 0x46, 0x1a, 0x46, 0xcb, 0x81, 0xfb, 0x28, 0xe6, 0x31, 0x57, 0x28, 0x2e,
+	0xbf, 0x34, 0xd1, 0xf5, 0x6e, 0xf7, 0xc9, 0x48, 0x41, 0x12, 0x61, 0xf0, 0x1a, 0x1d, 0x79, 0x9a,
+	0xd1, 0x0a, 0x3e, 0x9c, 0x71, 0x91, 0x70, 0x81, 0xa7, 0x44, 0x50, 0x6d, 0x00, 0x2f, 0x07, 0x53,
+	0x2a, 0x49, 0xd9, 0x26, 0x66, 0x29, 0x91, 0x8c, 0xa7, 0x86, 0xdb, 0x8f, 0x39, 0x8f, 0x3f, 0x50,
+	0x4c, 0x32, 0x86, 0x49, 0x9a, 0x72, 0xa9, 0x40, 0xd3, 0xc9, 0xef, 0x01, 0x67, 0x3c, 0x09, 0x42,
+	0x9a, 0xc3, 0x07, 0xc0, 0x66, 0x51, 0xcf, 0xda, 0xb5, 0xf6, 0x5b, 0xa1, 0xcd, 0x22, 0x3f, 0x30,
+	0x88, 0x80, 0x08, 0x34, 0x13, 0x99, 0x29, 0xa8, 0x33, 0xec, 0xa2, 0x5b, 0x19, 0xd1, 0x78, 0x12,
+	0xbc, 0x6e, 0x5d, 0xfc, 0x7a, 0xd2, 0x08, 0x4b, 0x22, 0xec, 0x02, 0x67, 0x4e, 0x59, 0x3c, 0x97,
+	0x3d, 0x7b, 0xd7, 0xda, 0x6f, 0x86, 0xa6, 0xf2, 0x3f, 0x81, 0x6e, 0xc0, 0x05, 0x53, 0xf2, 0xc7,
+	0xbc, 0x78, 0x15, 0x45, 0x05, 0x15, 0xa2, 0xd4, 0xee, 0x81, 0xfb, 0x44, 0x57, 0x4a, 0xa5, 0x1d,
+	0x56, 0x25, 0x3c, 0x06, 0xe0, 0x2a, 0x91, 0xea, 0xd7, 0x19, 0x3e, 0x43, 0x3a, 0x3e, 0x2a, 0xe3,
+	0x23, 0x3d, 0x7f, 0x13, 0x1f, 0x05, 0x24, 0xa6, 0x21, 0xcd, 0x17, 0x54, 0xc8, 0xf0, 0xda, 0x4b,
+	0xff, 0xbb, 0xb5, 0x41, 0x5c, 0xc0, 0xe7, 0xa0, 0x95, 0xc8, 0xac, 0x54, 0x6e, 0x6e, 0xcd, 0xa7,
+	0x98, 0x9b, 0x02, 0xc2, 0xd1, 0x0d, 0xb3, 0x4d, 0x65, 0x76, 0x6f, 0xab, 0x59, 0x91, 0xf1, 0x54,
+	0xd0, 0x1b, 0x6e, 0x3b, 0xa0, 0x1d, 0xa8, 0x75, 0x08, 0x69, 0xee, 0x1f, 0x5d, 0x15, 0x02, 0xbe,
+	0x04, 0x8e, 0x5e, 0x14, 0xf3, 0x3b, 0x76, 0x6a, 0xec, 0x6a, 0xb6, 0x71, 0x6c, 0xe8, 0xc3, 0xbf,
+	0x36, 0xb8, 0xf7, 0xa6, 0x54, 0x87, 0x11, 0x70, 0x46, 0x54, 0x8e, 0x27, 0x01, 0xdc, 0xa9, 0xcf,
+	0x1a, 0xd2, 0xdc, 0xdd, 0x08, 0x09, 0xff, 0xe9, 0xe7, 0x1f, 0x7f, 0xbe, 0xda, 0x1e, 0xec, 0x63,
+	0xc1, 0x4e, 0x66, 0x73, 0xc2, 0xd2, 0x6b, 0x5b, 0x9a, 0xc8, 0x0c, 0x9f, 0xb1, 0xe8, 0x1c, 0x7e,
+	0xb3, 0x40, 0x77, 0x44, 0x65, 0xcd, 0xcc, 0xe1, 0x41, 0x9d, 0xe7, 0xda, 0xc5, 0x70, 0xef, 0x4c,
+	0x15, 0x3e, 0x56, 0xb6, 0x0e, 0xe0, 0x5e, 0x9d, 0xad, 0xac, 0x7a, 0x83, 0xcf, 0xcc, 0x66, 0x9d,
+	0xc3, 0xf7, 0xa0, 0x5d, 0x1a, 0x54, 0xe3, 0x81, 0xfd, 0x8d, 0x73, 0x2c, 0x6d, 0xfc, 0x0f, 0x15,
+	0xbe, 0xaf, 0x94, 0xfb, 0xd0, 0xad, 0x55, 0xd6, 0x3f, 0xe3, 0xe8, 0x62, 0xe5, 0x59, 0x97, 0x2b,
+	0xcf, 0xfa, 0xbd, 0xf2, 0xac, 0x2f, 0x6b, 0xaf, 0x71, 0xb9, 0xf6, 0x1a, 0x3f, 0xd7, 0x5e, 0xe3,
+	0xdd, 0x61, 0xcc, 0xe4, 0x7c, 0x31, 0x45, 0x33, 0x9e, 0xe0, 0xb7, 0xd5, 0xfb, 0xea, 0xfc, 0x3f,
+	0x56, 0x9d, 0xd4, 0xf5, 0x4f, 0x1d, 0x75, 0xb4, 0x2f, 0xfe, 0x0d, 0x00, 0x00, 0x1b, 0xdd, 0x86,
+	0x87, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	GetMTP(ctx context.Context, in *MTPReq, opts ...grpc.CallOption) (*MTPRes, error)
+	GetPositionsForAddress(ctx context.Context, in *PositionsForAddressReq, opts ...grpc.CallOption) (*PositionsForAddressRes, error)
+	GetParams(ctx context.Context, in *ParamsReq, opts ...grpc.CallOption) (*ParamsRes, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetMTP(ctx context.Context, in *MTPReq, opts ...grpc.CallOption) (*MTPRes, error) {
+	out := new(MTPRes)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Query/GetMTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPositionsForAddress(ctx context.Context, in *PositionsForAddressReq, opts ...grpc.CallOption) (*PositionsForAddressRes, error) {
+	out := new(PositionsForAddressRes)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Query/GetPositionsForAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetParams(ctx context.Context, in *ParamsReq, opts ...grpc.CallOption) (*ParamsRes, error) {
+	out := new(ParamsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Query/GetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	GetMTP(context.Context, *MTPReq) (*MTPRes, error)
+	GetPositionsForAddress(context.Context, *PositionsForAddressReq) (*PositionsForAddressRes, error)
+	GetParams(context.Context, *ParamsReq) (*ParamsRes, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GetMTP(ctx context.Context, req *MTPReq) (*MTPRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMTP not implemented")
+}
+func (*UnimplementedQueryServer) GetPositionsForAddress(ctx context.Context, req *PositionsForAddressReq) (*PositionsForAddressRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositionsForAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetParams(ctx context.Context, req *ParamsReq) (*ParamsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetMTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Query/GetMTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMTP(ctx, req.(*MTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPositionsForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionsForAddressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPositionsForAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Query/GetPositionsForAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPositionsForAddress(ctx, req.(*PositionsForAddressReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Query/GetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*ParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.margin.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMTP",
+			Handler:    _Query_GetMTP_Handler,
+		},
+		{
+			MethodName: "GetPositionsForAddress",
+			Handler:    _Query_GetPositionsForAddress_Handler,
+		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/margin/v1/query.proto",
+}
+
+func (m *MTPReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MTPReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MTPReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MTPRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MTPRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MTPRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Mtp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionsForAddressReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionsForAddressReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionsForAddressReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionsForAddressRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionsForAddressRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionsForAddressRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Mtps) > 0 {
+		for iNdEx := len(m.Mtps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mtps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MTPReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MTPRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mtp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PositionsForAddressReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionsForAddressRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mtps) > 0 {
+		for _, e := range m.Mtps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MTPReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MTPReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MTPReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MTPRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MTPRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MTPRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mtp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionsForAddressReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionsForAddressReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionsForAddressReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionsForAddressRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionsForAddressRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionsForAddressRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mtps = append(m.Mtps, MTP{})
+			if err := m.Mtps[len(m.Mtps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// GetCollateralAndCustodyAssets returns the asset a position on the pool of externalAsset is collateralised and
+// borrowed in, and the asset it holds in custody
+func GetCollateralAndCustodyAssets(position Position, externalAsset string) (string, string, error) {
+	switch position {
+	case Position_POSITION_LONG:
+		return clptypes.NativeSymbol, externalAsset, nil
+	case Position_POSITION_SHORT:
+		return externalAsset, clptypes.NativeSymbol, nil
+	default:
+		return "", "", ErrInvalidPosition
+	}
+}
+
+// GetMTPModuleAddress returns the address of the module account holding the custody of all positions
+func GetMTPModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
+}
+
+// Liabilities returns the principal and interest owed to the pool
+func (m MTP) Liabilities() sdk.Uint {
+	return m.LiabilitiesPrincipal.Add(m.LiabilitiesInterest)
+}
+
+// Validate checks the position is well formed
+func (m MTP) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return err
+	}
+	collateralAsset, custodyAsset, err := GetCollateralAndCustodyAssets(m.Position, m.ExternalAsset)
+	if err != nil {
+		return err
+	}
+	if m.ExternalAsset == clptypes.NativeSymbol || m.CollateralAsset != collateralAsset || m.CustodyAsset != custodyAsset {
+		return fmt.Errorf("invalid assets for position %d", m.Id)
+	}
+	if m.CollateralAmount.IsZero() || m.LiabilitiesPrincipal.IsZero() || m.CustodyAmount.IsZero() {
+		return fmt.Errorf("position %d must have collateral, liabilities and custody", m.Id)
+	}
+	if m.Leverage.IsNil() || m.Health.IsNil() {
+		return fmt.Errorf("position %d must have leverage and health", m.Id)
+	}
+	return nil
+}
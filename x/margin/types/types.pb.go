@@ -0,0 +1,955 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/margin/v1/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Position is the side of a margin trade. A long position borrows rowan to
+// hold the external asset of a pool, a short position borrows the external
+// asset to hold rowan.
+type Position int32
+
+const (
+	Position_POSITION_UNSPECIFIED Position = 0
+	Position_POSITION_LONG        Position = 1
+	Position_POSITION_SHORT       Position = 2
+)
+
+var Position_name = map[int32]string{
+	0: "POSITION_UNSPECIFIED",
+	1: "POSITION_LONG",
+	2: "POSITION_SHORT",
+}
+
+var Position_value = map[string]int32{
+	"POSITION_UNSPECIFIED": 0,
+	"POSITION_LONG":        1,
+	"POSITION_SHORT":       2,
+}
+
+func (x Position) String() string {
+	return proto.EnumName(Position_name, int32(x))
+}
+
+func (Position) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b3994728d56e8650, []int{0}
+}
+
+// MTP is an open margin trading position. Collateral and liabilities are
+// denominated in collateral_asset, custody is held in custody_asset by the
+// margin module account.
+type MTP struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// external_asset is the symbol of the clp pool the position trades against.
+	ExternalAsset        string                                  `protobuf:"bytes,3,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty"`
+	Position             Position                                `protobuf:"varint,4,opt,name=position,proto3,enum=sifnode.margin.v1.Position" json:"position,omitempty"`
+	CollateralAsset      string                                  `protobuf:"bytes,5,opt,name=collateral_asset,json=collateralAsset,proto3" json:"collateral_asset,omitempty"`
+	CollateralAmount     github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=collateral_amount,json=collateralAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"collateral_amount"`
+	LiabilitiesPrincipal github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,7,opt,name=liabilities_principal,json=liabilitiesPrincipal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liabilities_principal"`
+	LiabilitiesInterest  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,8,opt,name=liabilities_interest,json=liabilitiesInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liabilities_interest"`
+	CustodyAsset         string                                  `protobuf:"bytes,9,opt,name=custody_asset,json=custodyAsset,proto3" json:"custody_asset,omitempty"`
+	CustodyAmount        github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,10,opt,name=custody_amount,json=custodyAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"custody_amount"`
+	Leverage             github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,11,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	// health is the value of the custody swapped back into the collateral
+	// asset divided by the liabilities, refreshed when the position is updated
+	// at the end of a block.
+	Health       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=health,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"health"`
+	OpenedHeight int64                                  `protobuf:"varint,13,opt,name=opened_height,json=openedHeight,proto3" json:"opened_height,omitempty"`
+	// interest_height is the block height up to which interest has been charged
+	// on the liabilities principal.
+	InterestHeight int64 `protobuf:"varint,14,opt,name=interest_height,json=interestHeight,proto3" json:"interest_height,omitempty"`
+}
+
+func (m *MTP) Reset()         { *m = MTP{} }
+func (m *MTP) String() string { return proto.CompactTextString(m) }
+func (*MTP) ProtoMessage()    {}
+func (*MTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3994728d56e8650, []int{0}
+}
+func (m *MTP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MTP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MTP.Merge(m, src)
+}
+func (m *MTP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MTP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MTP proto.InternalMessageInfo
+
+func (m *MTP) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MTP) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MTP) GetExternalAsset() string {
+	if m != nil {
+		return m.ExternalAsset
+	}
+	return ""
+}
+
+func (m *MTP) GetPosition() Position {
+	if m != nil {
+		return m.Position
+	}
+	return Position_POSITION_UNSPECIFIED
+}
+
+func (m *MTP) GetCollateralAsset() string {
+	if m != nil {
+		return m.CollateralAsset
+	}
+	return ""
+}
+
+func (m *MTP) GetCustodyAsset() string {
+	if m != nil {
+		return m.CustodyAsset
+	}
+	return ""
+}
+
+func (m *MTP) GetOpenedHeight() int64 {
+	if m != nil {
+		return m.OpenedHeight
+	}
+	return 0
+}
+
+func (m *MTP) GetInterestHeight() int64 {
+	if m != nil {
+		return m.InterestHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("sifnode.margin.v1.Position", Position_name, Position_value)
+	proto.RegisterType((*MTP)(nil), "sifnode.margin.v1.MTP")
+}
+
+func init() { proto.RegisterFile("sifnode/margin/v1/types.proto", fileDescriptor_b3994728d56e8650) }
+
+var fileDescriptor_b3994728d56e8650 = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0x76, 0x74, 0xdd, 0x4b, 0x9b, 0xb5, 0xa6, 0x48, 0x16, 0x88, 0xac, 0x02, 0xc1,
+	0xca, 0x24, 0x12, 0x0d, 0x0e, 0x9c, 0x37, 0xba, 0xb1, 0xf2, 0xa7, 0xad, 0xd2, 0x8e, 0x03, 0x42,
+	0x8a, 0xd2, 0xc4, 0x4b, 0x2c, 0xd2, 0x38, 0x8a, 0xdd, 0x6a, 0xfb, 0x16, 0x7c, 0x25, 0x6e, 0x3b,
+	0xee, 0x88, 0x38, 0x4c, 0xa8, 0xfd, 0x22, 0xa8, 0x49, 0x1c, 0x22, 0x71, 0x99, 0x7a, 0x6a, 0xfb,
+	0xbc, 0x3f, 0x3f, 0x8f, 0xfd, 0x34, 0x31, 0x3c, 0xe1, 0xf4, 0x22, 0x64, 0x2e, 0x31, 0x66, 0x76,
+	0xec, 0xd1, 0xd0, 0x58, 0x1c, 0x1a, 0xe2, 0x2a, 0x22, 0x5c, 0x8f, 0x62, 0x26, 0x18, 0x6a, 0x65,
+	0x63, 0x3d, 0x1d, 0xeb, 0x8b, 0xc3, 0x47, 0x6d, 0x8f, 0x79, 0x2c, 0x99, 0x1a, 0xeb, 0x6f, 0x29,
+	0xf8, 0xf4, 0x67, 0x15, 0x2a, 0x9f, 0x27, 0x23, 0xa4, 0x42, 0x99, 0xba, 0x58, 0xe9, 0x28, 0xdd,
+	0x2d, 0xb3, 0x4c, 0x5d, 0x84, 0x61, 0xdb, 0x76, 0xdd, 0x98, 0x70, 0x8e, 0xcb, 0x1d, 0xa5, 0xbb,
+	0x63, 0xca, 0x9f, 0xe8, 0x39, 0xa8, 0xe4, 0x52, 0x90, 0x38, 0xb4, 0x03, 0xcb, 0xe6, 0x9c, 0x08,
+	0x5c, 0x49, 0x80, 0x86, 0x54, 0x8f, 0xd6, 0x22, 0x7a, 0x0b, 0xb5, 0x88, 0x71, 0x2a, 0x28, 0x0b,
+	0xf1, 0x56, 0x47, 0xe9, 0xaa, 0xaf, 0x1f, 0xeb, 0xff, 0x6d, 0x4a, 0x1f, 0x65, 0x88, 0x99, 0xc3,
+	0xe8, 0x25, 0x34, 0x1d, 0x16, 0x04, 0xb6, 0x20, 0x71, 0x9e, 0x70, 0x2f, 0x49, 0xd8, 0xfd, 0xa7,
+	0xa7, 0x19, 0xdf, 0xa0, 0x55, 0x44, 0x67, 0x6c, 0x1e, 0x0a, 0x5c, 0x5d, 0xb3, 0xc7, 0xc6, 0xf5,
+	0xed, 0x5e, 0xe9, 0xf7, 0xed, 0xde, 0xbe, 0x47, 0x85, 0x3f, 0x9f, 0xea, 0x0e, 0x9b, 0x19, 0x0e,
+	0xe3, 0x33, 0xc6, 0xb3, 0x8f, 0x57, 0xdc, 0xfd, 0x9e, 0x55, 0x76, 0x4e, 0x43, 0x61, 0x16, 0x42,
+	0x8f, 0x12, 0x23, 0xe4, 0xc2, 0xc3, 0x80, 0xda, 0x53, 0x1a, 0x50, 0x41, 0x09, 0xb7, 0xa2, 0x98,
+	0x86, 0x0e, 0x8d, 0xec, 0x00, 0x6f, 0x6f, 0x96, 0xd0, 0x2e, 0xb8, 0x8d, 0xa4, 0x19, 0x9a, 0x42,
+	0x51, 0xb7, 0x68, 0x28, 0x48, 0x4c, 0xb8, 0xc0, 0xb5, 0xcd, 0x42, 0x1e, 0x14, 0xcc, 0xfa, 0x99,
+	0x17, 0x7a, 0x06, 0x0d, 0x67, 0xce, 0x05, 0x73, 0xaf, 0xb2, 0x3e, 0x77, 0x92, 0x3e, 0xeb, 0x99,
+	0x98, 0x96, 0xf9, 0x05, 0xd4, 0x1c, 0x4a, 0x9b, 0x84, 0xcd, 0xb6, 0x20, 0xb3, 0xb2, 0x1a, 0x3f,
+	0x40, 0x2d, 0x20, 0x0b, 0x12, 0xdb, 0x1e, 0xc1, 0xf7, 0x13, 0x47, 0x3d, 0x73, 0x7c, 0x71, 0x07,
+	0xc7, 0x1e, 0x71, 0xcc, 0x7c, 0x3d, 0x3a, 0x85, 0xaa, 0x4f, 0xec, 0x40, 0xf8, 0xb8, 0xbe, 0x91,
+	0x53, 0xb6, 0x7a, 0x5d, 0x08, 0x8b, 0x48, 0x48, 0x5c, 0xcb, 0x27, 0xd4, 0xf3, 0x05, 0x6e, 0x74,
+	0x94, 0x6e, 0xc5, 0xac, 0xa7, 0xe2, 0x59, 0xa2, 0xa1, 0x7d, 0xd8, 0x95, 0xff, 0x86, 0xc4, 0xd4,
+	0x04, 0x53, 0xa5, 0x9c, 0x82, 0x07, 0x1f, 0xa1, 0x26, 0x9f, 0x63, 0x84, 0xa1, 0x3d, 0x1a, 0x8e,
+	0xfb, 0x93, 0xfe, 0x70, 0x60, 0x9d, 0x0f, 0xc6, 0xa3, 0x93, 0x77, 0xfd, 0xd3, 0xfe, 0x49, 0xaf,
+	0x59, 0x42, 0x2d, 0x68, 0xe4, 0x93, 0x4f, 0xc3, 0xc1, 0xfb, 0xa6, 0x82, 0x10, 0xa8, 0xb9, 0x34,
+	0x3e, 0x1b, 0x9a, 0x93, 0x66, 0xf9, 0xb8, 0x77, 0xbd, 0xd4, 0x94, 0x9b, 0xa5, 0xa6, 0xfc, 0x59,
+	0x6a, 0xca, 0x8f, 0x95, 0x56, 0xba, 0x59, 0x69, 0xa5, 0x5f, 0x2b, 0xad, 0xf4, 0xf5, 0xa0, 0x70,
+	0xc8, 0x31, 0xbd, 0x70, 0x7c, 0x9b, 0x86, 0x86, 0xbc, 0x06, 0x2e, 0xe5, 0x45, 0x90, 0x1c, 0x76,
+	0x5a, 0x4d, 0xde, 0xee, 0x37, 0x7f, 0x07, 0x00, 0xda, 0x76, 0x00, 0xb1, 0x27, 0x04, 0x00, 0x00,
+}
+
+func (m *MTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InterestHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InterestHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.OpenedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OpenedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.Health.Size()
+		i -= size
+		if _, err := m.Health.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.CustodyAmount.Size()
+		i -= size
+		if _, err := m.CustodyAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.CustodyAsset) > 0 {
+		i -= len(m.CustodyAsset)
+		copy(dAtA[i:], m.CustodyAsset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CustodyAsset)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.LiabilitiesInterest.Size()
+		i -= size
+		if _, err := m.LiabilitiesInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.LiabilitiesPrincipal.Size()
+		i -= size
+		if _, err := m.LiabilitiesPrincipal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CollateralAmount.Size()
+		i -= size
+		if _, err := m.CollateralAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.CollateralAsset) > 0 {
+		i -= len(m.CollateralAsset)
+		copy(dAtA[i:], m.CollateralAsset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CollateralAsset)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Position != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExternalAsset) > 0 {
+		i -= len(m.ExternalAsset)
+		copy(dAtA[i:], m.ExternalAsset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalAsset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTypes(uint64(m.Position))
+	}
+	l = len(m.CollateralAsset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.CollateralAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LiabilitiesPrincipal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LiabilitiesInterest.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.CustodyAsset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.CustodyAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Health.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.OpenedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.OpenedHeight))
+	}
+	if m.InterestHeight != 0 {
+		n += 1 + sovTypes(uint64(m.InterestHeight))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MTP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= Position(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiabilitiesPrincipal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiabilitiesPrincipal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiabilitiesInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiabilitiesInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustodyAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustodyAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustodyAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CustodyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
+			}
+			m.OpenedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestHeight", wireType)
+			}
+			m.InterestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
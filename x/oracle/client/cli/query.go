@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	oracleQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	oracleQueryCmd.AddCommand(
+		GetCmdWhiteList(),
+		GetCmdAdminAccount(),
+		GetCmdProphecies(),
+		GetCmdProphecy(),
+		GetCmdConsensusNeeded(),
+	)
+	return oracleQueryCmd
+}
+
+func GetCmdWhiteList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist",
+		Short: "Get the validators allowed to make oracle claims",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetOracleWhiteList(context.Background(), &types.QueryOracleWhiteListRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAdminAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Get the account allowed to update the oracle whitelist",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetAdminAccount(context.Background(), &types.QueryAdminAccountRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdProphecies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prophecies",
+		Short: "Get the prophecies, optionally only those with a status",
+		Long: `Get the prophecies with the claims of their validators, optionally only those
+with a status (pending, success or failed):
+
+$ sifnoded q oracle prophecies --status=pending`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryPropheciesRequest{Pagination: pageReq}
+			status, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			if status != "" {
+				req.Status, err = types.ParseStatusText(status)
+				if err != nil {
+					return err
+				}
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetProphecies(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagStatus, "", "Only the prophecies with a status: pending, success or failed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prophecies")
+	return cmd
+}
+
+func GetCmdProphecy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prophecy [id]",
+		Short: "Get a prophecy with the claims of its validators",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetProphecy(context.Background(), &types.QueryProphecyRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdConsensusNeeded() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-needed",
+		Short: "Get the share of the whitelisted validator power needed for a prophecy to succeed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetConsensusNeeded(context.Background(), &types.QueryConsensusNeededRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
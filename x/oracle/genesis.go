@@ -40,6 +40,8 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 		keeper.SetDBProphecy(ctx, *dbProphecy)
 	}
 
+	keeper.SetParams(ctx, data.Params)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -63,10 +65,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		AddressWhitelist: wl,
 		AdminAddress:     adminAcc.String(),
 		Prophecies:       dbProphecies,
+		Params:           keeper.GetParams(ctx),
 	}
 }
 
 // ValidateGenesis validates the oracle genesis parameters
-func ValidateGenesis(_ *types.GenesisState) error {
-	return nil
+func ValidateGenesis(data *types.GenesisState) error {
+	return data.Params.Validate()
 }
@@ -33,6 +33,8 @@ func TestInitGenesis(t *testing.T) {
 				require.Equal(t, addr, wl[i].String())
 			}
 
+			require.Equal(t, tc.genesis.Params, keeper.GetParams(ctx))
+
 			prophecies := keeper.GetProphecies(ctx)
 			require.Equal(t, len(tc.genesis.Prophecies), len(prophecies))
 			for i, p := range tc.genesis.Prophecies {
@@ -61,6 +63,8 @@ func TestExportGenesis(t *testing.T) {
 				require.Equal(t, addr, wl[i])
 			}
 
+			require.Equal(t, tc.genesis.Params, genesis.Params)
+
 			prophecies := genesis.Prophecies
 			require.Equal(t, len(tc.genesis.Prophecies), len(prophecies))
 			for i, p := range tc.genesis.Prophecies {
@@ -108,6 +112,19 @@ func TestGenesisMarshalling(t *testing.T) {
 	}
 }
 
+func TestValidateGenesis(t *testing.T) {
+	genesis := types.DefaultGenesisState()
+	require.NoError(t, oracle.ValidateGenesis(genesis))
+
+	genesis.Params = types.NewParams(sdk.OneDec())
+	require.NoError(t, oracle.ValidateGenesis(genesis))
+
+	for _, consensusNeeded := range []sdk.Dec{{}, sdk.ZeroDec(), sdk.NewDecWithPrec(-5, 1), sdk.NewDecWithPrec(11, 1)} {
+		genesis.Params = types.NewParams(consensusNeeded)
+		require.ErrorIs(t, oracle.ValidateGenesis(genesis), types.ErrMinimumConsensusNeededInvalid)
+	}
+}
+
 type testCase struct {
 	name    string
 	genesis types.GenesisState
@@ -151,6 +168,7 @@ func testGenesisData(t *testing.T) ([]testCase, []types.Prophecy) {
 				Prophecies: []*types.DBProphecy{
 					&dbProphecy,
 				},
+				Params: types.NewParams(sdk.NewDecWithPrec(6, 1)),
 			},
 		},
 	}, []types.Prophecy{prophecy}
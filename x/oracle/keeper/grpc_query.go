@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// MaxPageLimit is the largest page size of the paginated oracle queries
+const MaxPageLimit = 200
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (k Querier) GetOracleWhiteList(c context.Context, _ *types.QueryOracleWhiteListRequest) (*types.QueryOracleWhiteListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	whiteList := k.Keeper.GetOracleWhiteList(ctx)
+	validators := make([]string, len(whiteList))
+	for i, validator := range whiteList {
+		validators[i] = validator.String()
+	}
+	return &types.QueryOracleWhiteListResponse{Validators: validators}, nil
+}
+
+func (k Querier) GetAdminAccount(c context.Context, _ *types.QueryAdminAccountRequest) (*types.QueryAdminAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAdminAccountResponse{AdminAccount: k.Keeper.GetAdminAccount(ctx).String()}, nil
+}
+
+func (k Querier) GetProphecies(c context.Context, req *types.QueryPropheciesRequest) (*types.QueryPropheciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, ok := types.StatusText_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid status %d", req.Status))
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{Limit: MaxPageLimit}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	prophecies, pageRes, err := k.Keeper.GetPropheciesPaginated(sdk.UnwrapSDKContext(c), req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]types.ProphecyInfo, len(prophecies))
+	for i, prophecy := range prophecies {
+		infos[i] = types.NewProphecyInfo(prophecy)
+	}
+	return &types.QueryPropheciesResponse{Prophecies: infos, Pagination: pageRes}, nil
+}
+
+func (k Querier) GetProphecy(c context.Context, req *types.QueryProphecyRequest) (*types.QueryProphecyResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	prophecy, found := k.Keeper.GetProphecy(sdk.UnwrapSDKContext(c), req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "prophecy %s not found", req.Id)
+	}
+	return &types.QueryProphecyResponse{Prophecy: types.NewProphecyInfo(prophecy)}, nil
+}
+
+func (k Querier) GetConsensusNeeded(c context.Context, _ *types.QueryConsensusNeededRequest) (*types.QueryConsensusNeededResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryConsensusNeededResponse{ConsensusNeeded: k.Keeper.GetConsensusNeeded(ctx)}, nil
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/oracle/keeper"
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func TestQueryServer(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	querier := keeper.Querier{Keeper: oracleKeeper}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	whiteList, err := querier.GetOracleWhiteList(goCtx, &types.QueryOracleWhiteListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{validatorAddresses[0].String(), validatorAddresses[1].String()}, whiteList.Validators)
+
+	admin := sdk.AccAddress(validatorAddresses[0])
+	oracleKeeper.SetAdminAccount(ctx, admin)
+	adminRes, err := querier.GetAdminAccount(goCtx, &types.QueryAdminAccountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, admin.String(), adminRes.AdminAccount)
+
+	consensus, err := querier.GetConsensusNeeded(goCtx, &types.QueryConsensusNeededRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.7"), consensus.ConsensusNeeded)
+
+	// the 3 power validator leaves the first prophecy pending, the 7 power validator completes the second
+	_, err = oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[0].String(), TestString))
+	require.NoError(t, err)
+	_, err = oracleKeeper.ProcessClaim(ctx, types.NewClaim(AlternateTestID, validatorAddresses[1].String(), AlternateTestString))
+	require.NoError(t, err)
+
+	prophecy, err := querier.GetProphecy(goCtx, &types.QueryProphecyRequest{Id: AlternateTestID})
+	require.NoError(t, err)
+	require.Equal(t, types.StatusText_STATUS_TEXT_SUCCESS, prophecy.Prophecy.Status.Text)
+	require.Equal(t, []types.ValidatorClaim{{
+		ValidatorAddress: validatorAddresses[1].String(),
+		Content:          AlternateTestString,
+	}}, prophecy.Prophecy.Claims)
+	_, err = querier.GetProphecy(goCtx, &types.QueryProphecyRequest{Id: "missing"})
+	require.Error(t, err)
+
+	all, err := querier.GetProphecies(goCtx, &types.QueryPropheciesRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Prophecies, 2)
+
+	pending, err := querier.GetProphecies(goCtx, &types.QueryPropheciesRequest{Status: types.StatusText_STATUS_TEXT_PENDING})
+	require.NoError(t, err)
+	require.Len(t, pending.Prophecies, 1)
+	require.Equal(t, TestID, pending.Prophecies[0].Id)
+
+	page, err := querier.GetProphecies(goCtx, &types.QueryPropheciesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.Prophecies, 1)
+	require.Equal(t, uint64(2), page.Pagination.Total)
+
+	_, err = querier.GetProphecies(goCtx, &types.QueryPropheciesRequest{Pagination: &query.PageRequest{Limit: keeper.MaxPageLimit + 1}})
+	require.Error(t, err)
+}
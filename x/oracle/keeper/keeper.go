@@ -4,7 +4,10 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/Sifchain/sifnode/x/oracle/types"
@@ -16,22 +19,22 @@ type Keeper struct {
 	cdc         codec.BinaryCodec // The wire codec for binary encoding/decoding.
 	storeKey    sdk.StoreKey      // Unexposed key to access store from sdk.Context
 	stakeKeeper types.StakingKeeper
-	// TODO: use this as param instead
-	consensusNeeded float64 // The minimum % of stake needed to sign claims in order for consensus to occur
+	paramstore  paramtypes.Subspace // Holds the minimum share of stake needed to sign claims in order for consensus to occur
 }
 
 // NewKeeper creates new instances of the oracle Keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, storeKey sdk.StoreKey, stakeKeeper types.StakingKeeper, consensusNeeded float64,
+	cdc codec.BinaryCodec, storeKey sdk.StoreKey, stakeKeeper types.StakingKeeper, ps paramtypes.Subspace,
 ) Keeper {
-	if consensusNeeded <= 0 || consensusNeeded > 1 {
-		panic(types.ErrMinimumConsensusNeededInvalid.Error())
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 	return Keeper{
-		cdc:             cdc,
-		storeKey:        storeKey,
-		stakeKeeper:     stakeKeeper,
-		consensusNeeded: consensusNeeded,
+		cdc:         cdc,
+		storeKey:    storeKey,
+		stakeKeeper: stakeKeeper,
+		paramstore:  ps,
 	}
 }
 
@@ -40,6 +43,10 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+func (k Keeper) Codec() codec.BinaryCodec {
+	return k.cdc
+}
+
 func (k Keeper) GetProphecies(ctx sdk.Context) []types.Prophecy {
 	var prophecies []types.Prophecy
 	store := ctx.KVStore(k.storeKey)
@@ -56,6 +63,33 @@ func (k Keeper) GetProphecies(ctx sdk.Context) []types.Prophecy {
 	return prophecies
 }
 
+// GetPropheciesPaginated returns a page of the prophecies with a status, or of all prophecies if the status is unspecified
+func (k Keeper) GetPropheciesPaginated(ctx sdk.Context, status types.StatusText, pagination *query.PageRequest) ([]types.Prophecy, *query.PageResponse, error) {
+	var prophecies []types.Prophecy
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProphecyPrefix)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var dbProphecy types.DBProphecy
+		if err := k.cdc.Unmarshal(value, &dbProphecy); err != nil {
+			return false, err
+		}
+		if status != types.StatusText_STATUS_TEXT_UNSPECIFIED && dbProphecy.Status.Text != status {
+			return false, nil
+		}
+		if accumulate {
+			prophecy, err := dbProphecy.DeserializeFromDB()
+			if err != nil {
+				return false, err
+			}
+			prophecies = append(prophecies, prophecy)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return prophecies, pageRes, nil
+}
+
 // GetProphecy gets the entire prophecy data struct for a given id
 func (k Keeper) GetProphecy(ctx sdk.Context, id string) (types.Prophecy, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -180,14 +214,19 @@ func (k Keeper) ProcessUpdateWhiteListValidator(ctx sdk.Context, cosmosSender sd
 // left to push it over the threshold required for consensus.
 func (k Keeper) processCompletion(ctx sdk.Context, prophecy types.Prophecy) types.Prophecy {
 	highestClaim, highestClaimPower, totalClaimsPower, totalPower := prophecy.FindHighestClaim(ctx, k.stakeKeeper, k.GetOracleWhiteList(ctx))
-	highestConsensusRatio := float64(highestClaimPower) / float64(totalPower)
+	if totalPower <= 0 {
+		// No whitelisted power is bonded, so the prophecy stays pending
+		return prophecy
+	}
+	consensusNeeded := k.GetConsensusNeeded(ctx)
+	highestConsensusRatio := sdk.NewDec(highestClaimPower).QuoInt64(totalPower)
 	remainingPossibleClaimPower := totalPower - totalClaimsPower
 	highestPossibleClaimPower := highestClaimPower + remainingPossibleClaimPower
-	highestPossibleConsensusRatio := float64(highestPossibleClaimPower) / float64(totalPower)
-	if highestConsensusRatio >= k.consensusNeeded {
+	highestPossibleConsensusRatio := sdk.NewDec(highestPossibleClaimPower).QuoInt64(totalPower)
+	if highestConsensusRatio.GTE(consensusNeeded) {
 		prophecy.Status.Text = types.StatusText_STATUS_TEXT_SUCCESS
 		prophecy.Status.FinalClaim = highestClaim
-	} else if highestPossibleConsensusRatio < k.consensusNeeded {
+	} else if highestPossibleConsensusRatio.LT(consensusNeeded) {
 		prophecy.Status.Text = types.StatusText_STATUS_TEXT_FAILED
 	}
 	return prophecy
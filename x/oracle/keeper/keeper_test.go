@@ -4,10 +4,12 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Sifchain/sifnode/app"
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/oracle/keeper"
 	"github.com/Sifchain/sifnode/x/oracle/types"
 )
 
@@ -274,3 +276,24 @@ func TestNonValidator(t *testing.T) {
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "validator must be in whitelist"))
 }
+
+func TestConsensusNeededParam(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.6, []int64{3, 7}, "")
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), oracleKeeper.GetConsensusNeeded(ctx))
+
+	// the migration sets the default that was hard-wired before
+	require.NoError(t, keeper.NewMigrator(oracleKeeper).MigrateToVer2(ctx))
+	require.Equal(t, types.DefaultParams(), oracleKeeper.GetParams(ctx))
+
+	// 7 of the 10 power is exactly the default consensus needed
+	status, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[1].String(), TestString))
+	require.NoError(t, err)
+	require.Equal(t, types.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+
+	oracleKeeper.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(8, 1)))
+	status, err = oracleKeeper.ProcessClaim(ctx, types.NewClaim(AlternateTestID, validatorAddresses[1].String(), TestString))
+	require.NoError(t, err)
+	require.Equal(t, types.StatusText_STATUS_TEXT_PENDING, status.Text)
+
+	require.Panics(t, func() { oracleKeeper.SetParams(ctx, types.NewParams(sdk.ZeroDec())) })
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// Migrator migrates the oracle store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a migrator of the oracle store
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateToVer2 moves the consensus needed, which was hard-wired at the default, into the params
+func (m Migrator) MigrateToVer2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// GetConsensusNeeded returns the minimum share of the whitelisted validator power needed to sign a claim, the default
+// until the params are set by genesis or the store migration
+func (k Keeper) GetConsensusNeeded(ctx sdk.Context) (res sdk.Dec) {
+	if !k.paramstore.Has(ctx, types.KeyConsensusNeeded) {
+		return types.DefaultConsensusNeeded
+	}
+	k.paramstore.Get(ctx, types.KeyConsensusNeeded, &res)
+	return res
+}
+
+// GetParams returns the params, the defaults until they are set by genesis or the store migration
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	if !k.paramstore.Has(ctx, types.KeyConsensusNeeded) {
+		return types.DefaultParams()
+	}
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the params, panicking if they are invalid
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -37,5 +37,6 @@ func Migrate(genesis v039oracle.GenesisState) *types.GenesisState {
 		AddressWhitelist: addressWhiteList,
 		AdminAddress:     genesis.AdminAddress.String(),
 		Prophecies:       prophecies,
+		Params:           types.DefaultParams(),
 	}
 }
@@ -0,0 +1,40 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding oracle type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.WhiteListValidatorPrefix):
+			var whitelistA, whitelistB stakingtypes.ValAddresses
+			cdc.MustUnmarshal(kvA.Value, &whitelistA)
+			cdc.MustUnmarshal(kvB.Value, &whitelistB)
+			return fmt.Sprintf("%v\n%v", whitelistA, whitelistB)
+		case bytes.Equal(kvA.Key[:1], types.AdminAccountPrefix):
+			var adminA, adminB gogotypes.BytesValue
+			cdc.MustUnmarshal(kvA.Value, &adminA)
+			cdc.MustUnmarshal(kvB.Value, &adminB)
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(adminA.Value), sdk.AccAddress(adminB.Value))
+		case bytes.Equal(kvA.Key[:1], types.ProphecyPrefix):
+			var prophecyA, prophecyB types.DBProphecy
+			cdc.MustUnmarshal(kvA.Value, &prophecyA)
+			cdc.MustUnmarshal(kvB.Value, &prophecyB)
+			return fmt.Sprintf("%v\n%v", prophecyA, prophecyB)
+		default:
+			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
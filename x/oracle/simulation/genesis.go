@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// Simulation parameter constants
+const (
+	AddressWhitelist = "address_whitelist"
+	AdminAddress     = "admin_address"
+	ConsensusNeeded  = "consensus_needed"
+)
+
+// GenAddressWhitelist whitelists every validator bonded at genesis, so that
+// they are all able to sign bridge claims.
+func GenAddressWhitelist(accs []simtypes.Account, numBonded int64) []string {
+	if numBonded > int64(len(accs)) {
+		numBonded = int64(len(accs))
+	}
+	whitelist := make([]string, numBonded)
+	for i := range whitelist {
+		whitelist[i] = sdk.ValAddress(accs[i].Address).String()
+	}
+	return whitelist
+}
+
+// GenAdminAddress randomized AdminAddress
+func GenAdminAddress(r *rand.Rand, accs []simtypes.Account) string {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
+
+// GenConsensusNeeded randomized ConsensusNeeded, a majority between 51% and 100%
+func GenConsensusNeeded(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 51, 101)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for oracle
+func RandomizedGenState(simState *module.SimulationState) {
+	var addressWhitelist []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AddressWhitelist, &addressWhitelist, simState.Rand,
+		func(r *rand.Rand) { addressWhitelist = GenAddressWhitelist(simState.Accounts, simState.NumBonded) },
+	)
+	var adminAddress string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AdminAddress, &adminAddress, simState.Rand,
+		func(r *rand.Rand) { adminAddress = GenAdminAddress(r, simState.Accounts) },
+	)
+	var consensusNeeded sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ConsensusNeeded, &consensusNeeded, simState.Rand,
+		func(r *rand.Rand) { consensusNeeded = GenConsensusNeeded(r) },
+	)
+
+	oracleGenesis := types.GenesisState{
+		AddressWhitelist: addressWhitelist,
+		AdminAddress:     adminAddress,
+		Params:           types.NewParams(consensusNeeded),
+	}
+	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated oracle genesis:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&oracleGenesis)
+}
@@ -17,6 +17,7 @@ func DefaultGenesisState() *GenesisState {
 		AddressWhitelist: []string{},
 		AdminAddress:     "",
 		Prophecies:       []*DBProphecy{},
+		Params:           DefaultParams(),
 	}
 }
 
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultParamspace is the oracle parameter namespace
+const DefaultParamspace = ModuleName
+
+// DefaultConsensusNeeded defines the default consensus value required for a
+// prophecy to be finalized
+var DefaultConsensusNeeded = sdk.NewDecWithPrec(7, 1)
+
+// Parameter store keys
+var (
+	KeyConsensusNeeded = []byte("ConsensusNeeded")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for oracle module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(consensusNeeded sdk.Dec) Params {
+	return Params{
+		ConsensusNeeded: consensusNeeded,
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultConsensusNeeded)
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyConsensusNeeded, &p.ConsensusNeeded, validateConsensusNeeded),
+	}
+}
+
+func (p Params) Validate() error {
+	return validateConsensusNeeded(p.ConsensusNeeded)
+}
+
+func validateConsensusNeeded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return ErrMinimumConsensusNeededInvalid
+	}
+	return nil
+}
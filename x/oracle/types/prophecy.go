@@ -4,15 +4,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// DefaultConsensusNeeded defines the default consensus value required for a
-// prophecy to be finalized
-const DefaultConsensusNeeded float64 = 0.7
-
 // Prophecy is a struct that contains all the metadata of an oracle ritual.
 // Claims are indexed by the claim's validator bech32 address and by the claim's json value to allow
 // for constant lookup times for any validation/verifiation checks of duplicate claims
@@ -149,3 +148,33 @@ func NewStatus(text StatusText, finalClaim string) Status {
 		FinalClaim: finalClaim,
 	}
 }
+
+// NewProphecyInfo returns the query view of a prophecy, with its validator claims sorted by validator address
+func NewProphecyInfo(prophecy Prophecy) ProphecyInfo {
+	claims := make([]ValidatorClaim, 0, len(prophecy.ValidatorClaims))
+	for validator, content := range prophecy.ValidatorClaims {
+		claims = append(claims, ValidatorClaim{ValidatorAddress: validator, Content: content})
+	}
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].ValidatorAddress < claims[j].ValidatorAddress
+	})
+	return ProphecyInfo{
+		Id:     prophecy.ID,
+		Status: prophecy.Status,
+		Claims: claims,
+	}
+}
+
+// ParseStatusText returns the prophecy status of a name, either its enum name or its short form like "pending"
+func ParseStatusText(name string) (StatusText, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "STATUS_TEXT_") {
+		name = "STATUS_TEXT_" + name
+	}
+	status, ok := StatusText_value[name]
+	if !ok || status == int32(StatusText_STATUS_TEXT_UNSPECIFIED) {
+		return StatusText_STATUS_TEXT_UNSPECIFIED, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"invalid status %s", name)
+	}
+	return StatusText(status), nil
+}
@@ -0,0 +1,2603 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/oracle/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorClaim is the claim content a validator made on a prophecy
+type ValidatorClaim struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Content          string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *ValidatorClaim) Reset()         { *m = ValidatorClaim{} }
+func (m *ValidatorClaim) String() string { return proto.CompactTextString(m) }
+func (*ValidatorClaim) ProtoMessage()    {}
+func (*ValidatorClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{0}
+}
+func (m *ValidatorClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorClaim.Merge(m, src)
+}
+func (m *ValidatorClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorClaim proto.InternalMessageInfo
+
+func (m *ValidatorClaim) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorClaim) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+// ProphecyInfo is a prophecy with the claims of its validators, ordered by
+// validator address
+type ProphecyInfo struct {
+	Id     string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status Status           `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	Claims []ValidatorClaim `protobuf:"bytes,3,rep,name=claims,proto3" json:"claims"`
+}
+
+func (m *ProphecyInfo) Reset()         { *m = ProphecyInfo{} }
+func (m *ProphecyInfo) String() string { return proto.CompactTextString(m) }
+func (*ProphecyInfo) ProtoMessage()    {}
+func (*ProphecyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{1}
+}
+func (m *ProphecyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProphecyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProphecyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProphecyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProphecyInfo.Merge(m, src)
+}
+func (m *ProphecyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProphecyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProphecyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProphecyInfo proto.InternalMessageInfo
+
+func (m *ProphecyInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProphecyInfo) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status{}
+}
+
+func (m *ProphecyInfo) GetClaims() []ValidatorClaim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+type QueryOracleWhiteListRequest struct {
+}
+
+func (m *QueryOracleWhiteListRequest) Reset()         { *m = QueryOracleWhiteListRequest{} }
+func (m *QueryOracleWhiteListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleWhiteListRequest) ProtoMessage()    {}
+func (*QueryOracleWhiteListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{2}
+}
+func (m *QueryOracleWhiteListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleWhiteListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleWhiteListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleWhiteListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleWhiteListRequest.Merge(m, src)
+}
+func (m *QueryOracleWhiteListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleWhiteListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleWhiteListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleWhiteListRequest proto.InternalMessageInfo
+
+type QueryOracleWhiteListResponse struct {
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryOracleWhiteListResponse) Reset()         { *m = QueryOracleWhiteListResponse{} }
+func (m *QueryOracleWhiteListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleWhiteListResponse) ProtoMessage()    {}
+func (*QueryOracleWhiteListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{3}
+}
+func (m *QueryOracleWhiteListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleWhiteListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleWhiteListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleWhiteListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleWhiteListResponse.Merge(m, src)
+}
+func (m *QueryOracleWhiteListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleWhiteListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleWhiteListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleWhiteListResponse proto.InternalMessageInfo
+
+func (m *QueryOracleWhiteListResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type QueryAdminAccountRequest struct {
+}
+
+func (m *QueryAdminAccountRequest) Reset()         { *m = QueryAdminAccountRequest{} }
+func (m *QueryAdminAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminAccountRequest) ProtoMessage()    {}
+func (*QueryAdminAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{4}
+}
+func (m *QueryAdminAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminAccountRequest.Merge(m, src)
+}
+func (m *QueryAdminAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminAccountRequest proto.InternalMessageInfo
+
+type QueryAdminAccountResponse struct {
+	AdminAccount string `protobuf:"bytes,1,opt,name=admin_account,json=adminAccount,proto3" json:"admin_account,omitempty"`
+}
+
+func (m *QueryAdminAccountResponse) Reset()         { *m = QueryAdminAccountResponse{} }
+func (m *QueryAdminAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminAccountResponse) ProtoMessage()    {}
+func (*QueryAdminAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{5}
+}
+func (m *QueryAdminAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminAccountResponse.Merge(m, src)
+}
+func (m *QueryAdminAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminAccountResponse proto.InternalMessageInfo
+
+func (m *QueryAdminAccountResponse) GetAdminAccount() string {
+	if m != nil {
+		return m.AdminAccount
+	}
+	return ""
+}
+
+type QueryPropheciesRequest struct {
+	// status filters the prophecies, unspecified returns all of them
+	Status     StatusText         `protobuf:"varint,1,opt,name=status,proto3,enum=sifnode.oracle.v1.StatusText" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPropheciesRequest) Reset()         { *m = QueryPropheciesRequest{} }
+func (m *QueryPropheciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPropheciesRequest) ProtoMessage()    {}
+func (*QueryPropheciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{6}
+}
+func (m *QueryPropheciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPropheciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPropheciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPropheciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPropheciesRequest.Merge(m, src)
+}
+func (m *QueryPropheciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPropheciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPropheciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPropheciesRequest proto.InternalMessageInfo
+
+func (m *QueryPropheciesRequest) GetStatus() StatusText {
+	if m != nil {
+		return m.Status
+	}
+	return StatusText_STATUS_TEXT_UNSPECIFIED
+}
+
+func (m *QueryPropheciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPropheciesResponse struct {
+	Prophecies []ProphecyInfo      `protobuf:"bytes,1,rep,name=prophecies,proto3" json:"prophecies"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPropheciesResponse) Reset()         { *m = QueryPropheciesResponse{} }
+func (m *QueryPropheciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPropheciesResponse) ProtoMessage()    {}
+func (*QueryPropheciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{7}
+}
+func (m *QueryPropheciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPropheciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPropheciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPropheciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPropheciesResponse.Merge(m, src)
+}
+func (m *QueryPropheciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPropheciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPropheciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPropheciesResponse proto.InternalMessageInfo
+
+func (m *QueryPropheciesResponse) GetProphecies() []ProphecyInfo {
+	if m != nil {
+		return m.Prophecies
+	}
+	return nil
+}
+
+func (m *QueryPropheciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryProphecyRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryProphecyRequest) Reset()         { *m = QueryProphecyRequest{} }
+func (m *QueryProphecyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProphecyRequest) ProtoMessage()    {}
+func (*QueryProphecyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{8}
+}
+func (m *QueryProphecyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProphecyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProphecyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProphecyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProphecyRequest.Merge(m, src)
+}
+func (m *QueryProphecyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProphecyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProphecyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProphecyRequest proto.InternalMessageInfo
+
+func (m *QueryProphecyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryProphecyResponse struct {
+	Prophecy ProphecyInfo `protobuf:"bytes,1,opt,name=prophecy,proto3" json:"prophecy"`
+}
+
+func (m *QueryProphecyResponse) Reset()         { *m = QueryProphecyResponse{} }
+func (m *QueryProphecyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProphecyResponse) ProtoMessage()    {}
+func (*QueryProphecyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{9}
+}
+func (m *QueryProphecyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProphecyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProphecyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProphecyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProphecyResponse.Merge(m, src)
+}
+func (m *QueryProphecyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProphecyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProphecyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProphecyResponse proto.InternalMessageInfo
+
+func (m *QueryProphecyResponse) GetProphecy() ProphecyInfo {
+	if m != nil {
+		return m.Prophecy
+	}
+	return ProphecyInfo{}
+}
+
+type QueryConsensusNeededRequest struct {
+}
+
+func (m *QueryConsensusNeededRequest) Reset()         { *m = QueryConsensusNeededRequest{} }
+func (m *QueryConsensusNeededRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusNeededRequest) ProtoMessage()    {}
+func (*QueryConsensusNeededRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{10}
+}
+func (m *QueryConsensusNeededRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusNeededRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusNeededRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusNeededRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusNeededRequest.Merge(m, src)
+}
+func (m *QueryConsensusNeededRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusNeededRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusNeededRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusNeededRequest proto.InternalMessageInfo
+
+type QueryConsensusNeededResponse struct {
+	ConsensusNeeded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=consensus_needed,json=consensusNeeded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"consensus_needed"`
+}
+
+func (m *QueryConsensusNeededResponse) Reset()         { *m = QueryConsensusNeededResponse{} }
+func (m *QueryConsensusNeededResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusNeededResponse) ProtoMessage()    {}
+func (*QueryConsensusNeededResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{11}
+}
+func (m *QueryConsensusNeededResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusNeededResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusNeededResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusNeededResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusNeededResponse.Merge(m, src)
+}
+func (m *QueryConsensusNeededResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusNeededResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusNeededResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusNeededResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ValidatorClaim)(nil), "sifnode.oracle.v1.ValidatorClaim")
+	proto.RegisterType((*ProphecyInfo)(nil), "sifnode.oracle.v1.ProphecyInfo")
+	proto.RegisterType((*QueryOracleWhiteListRequest)(nil), "sifnode.oracle.v1.QueryOracleWhiteListRequest")
+	proto.RegisterType((*QueryOracleWhiteListResponse)(nil), "sifnode.oracle.v1.QueryOracleWhiteListResponse")
+	proto.RegisterType((*QueryAdminAccountRequest)(nil), "sifnode.oracle.v1.QueryAdminAccountRequest")
+	proto.RegisterType((*QueryAdminAccountResponse)(nil), "sifnode.oracle.v1.QueryAdminAccountResponse")
+	proto.RegisterType((*QueryPropheciesRequest)(nil), "sifnode.oracle.v1.QueryPropheciesRequest")
+	proto.RegisterType((*QueryPropheciesResponse)(nil), "sifnode.oracle.v1.QueryPropheciesResponse")
+	proto.RegisterType((*QueryProphecyRequest)(nil), "sifnode.oracle.v1.QueryProphecyRequest")
+	proto.RegisterType((*QueryProphecyResponse)(nil), "sifnode.oracle.v1.QueryProphecyResponse")
+	proto.RegisterType((*QueryConsensusNeededRequest)(nil), "sifnode.oracle.v1.QueryConsensusNeededRequest")
+	proto.RegisterType((*QueryConsensusNeededResponse)(nil), "sifnode.oracle.v1.QueryConsensusNeededResponse")
+}
+
+func init() { proto.RegisterFile("sifnode/oracle/v1/query.proto", fileDescriptor_05283fd272c042b0) }
+
+var fileDescriptor_05283fd272c042b0 = []byte{
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0xe3, 0xb0, 0xb0, 0xcb, 0x84, 0x9f, 0x23, 0x76, 0x37, 0x18, 0xe2, 0x80, 0x59, 0x42,
+	0x16, 0x58, 0x5b, 0xc9, 0xaa, 0xea, 0xad, 0x6d, 0x80, 0x36, 0xaa, 0x54, 0xb5, 0x34, 0x54, 0x45,
+	0xe5, 0x82, 0x26, 0xf6, 0x90, 0x8c, 0x9a, 0x78, 0x42, 0x66, 0x92, 0x12, 0x55, 0xbd, 0xf4, 0xde,
+	0x1f, 0x52, 0x0f, 0xed, 0xa1, 0xb7, 0x9e, 0xfa, 0x9f, 0x70, 0x44, 0xea, 0xa5, 0xea, 0x01, 0x55,
+	0xd0, 0xff, 0xa3, 0x95, 0xc7, 0x63, 0x63, 0x82, 0x03, 0x39, 0x41, 0xe6, 0x7d, 0xdf, 0x7b, 0x9f,
+	0x79, 0x7e, 0x5f, 0x1b, 0xa4, 0x18, 0xd9, 0x73, 0xa8, 0x8d, 0x4d, 0xda, 0x44, 0x56, 0x0d, 0x9b,
+	0xed, 0x9c, 0xb9, 0xdf, 0xc2, 0xcd, 0x8e, 0xd1, 0x68, 0x52, 0x4e, 0xe1, 0xa4, 0x0c, 0x1b, 0x5e,
+	0xd8, 0x68, 0xe7, 0xd4, 0xa9, 0x0a, 0xad, 0x50, 0x11, 0x35, 0xdd, 0xff, 0x3c, 0xa1, 0xba, 0x6c,
+	0x51, 0x56, 0xa7, 0xcc, 0x2c, 0x23, 0x86, 0xbd, 0x0a, 0x66, 0x3b, 0x57, 0xc6, 0x1c, 0xe5, 0xcc,
+	0x06, 0xaa, 0x10, 0x07, 0x71, 0x42, 0x1d, 0xa9, 0x9d, 0xad, 0x50, 0x5a, 0xa9, 0x61, 0x13, 0x35,
+	0x88, 0x89, 0x1c, 0x87, 0x72, 0x11, 0x64, 0x32, 0x1a, 0x41, 0xc4, 0x3b, 0x0d, 0x2c, 0xc3, 0xfa,
+	0x36, 0x18, 0x7b, 0x8c, 0x6a, 0xc4, 0x46, 0x9c, 0x36, 0xd7, 0x6b, 0x88, 0xd4, 0xe1, 0x0a, 0x98,
+	0x6c, 0xfb, 0x27, 0xbb, 0xc8, 0xb6, 0x9b, 0x98, 0xb1, 0xa4, 0x32, 0xa7, 0x64, 0x87, 0x4b, 0x13,
+	0x41, 0xa0, 0xe0, 0x9d, 0xc3, 0x24, 0xf8, 0xdd, 0xa2, 0x0e, 0xc7, 0x0e, 0x4f, 0xc6, 0x85, 0xc4,
+	0xff, 0xa9, 0x7f, 0x50, 0xc0, 0xc8, 0x66, 0x93, 0x36, 0xaa, 0xd8, 0xea, 0xdc, 0x75, 0xf6, 0x28,
+	0x1c, 0x03, 0x71, 0x62, 0xcb, 0x42, 0x71, 0x62, 0xc3, 0xeb, 0x60, 0x88, 0x71, 0xc4, 0x5b, 0x4c,
+	0x64, 0x26, 0xf2, 0xd3, 0xc6, 0x85, 0xe1, 0x18, 0x5b, 0x42, 0xb0, 0xf6, 0xdb, 0xe1, 0x71, 0x3a,
+	0x56, 0x92, 0x72, 0x78, 0x13, 0x0c, 0x59, 0x2e, 0x29, 0x4b, 0x0e, 0xcc, 0x0d, 0x64, 0x13, 0xf9,
+	0xf9, 0x88, 0xc4, 0xf3, 0x77, 0xf2, 0x0b, 0x78, 0x69, 0x7a, 0x0a, 0xcc, 0x3c, 0x74, 0x47, 0xfa,
+	0x40, 0xc8, 0xb7, 0xab, 0x84, 0xe3, 0x7b, 0x84, 0xf1, 0x12, 0xde, 0x6f, 0x61, 0xc6, 0xf5, 0x1b,
+	0x60, 0x36, 0x3a, 0xcc, 0x1a, 0xd4, 0x61, 0x18, 0x6a, 0x00, 0x04, 0x73, 0x70, 0x27, 0x33, 0x90,
+	0x1d, 0x2e, 0x85, 0x4e, 0x74, 0x15, 0x24, 0x45, 0x7e, 0xc1, 0xae, 0x13, 0xa7, 0x60, 0x59, 0xb4,
+	0xe5, 0x04, 0xb5, 0x6f, 0x81, 0xe9, 0x88, 0x98, 0x2c, 0xbc, 0x00, 0x46, 0x91, 0x7b, 0xbe, 0x8b,
+	0xbc, 0x80, 0x1c, 0xd6, 0x08, 0x0a, 0x89, 0xf5, 0xf7, 0x0a, 0xf8, 0x4b, 0x94, 0x90, 0xc3, 0x25,
+	0x98, 0xc9, 0xe2, 0xf0, 0x5a, 0x30, 0x51, 0x37, 0x71, 0x2c, 0x9f, 0xea, 0x39, 0xd1, 0x47, 0xf8,
+	0x80, 0x07, 0xf3, 0xbc, 0x03, 0xc0, 0xd9, 0x4e, 0xc9, 0x87, 0x91, 0x31, 0xbc, 0x05, 0x34, 0xdc,
+	0x05, 0x34, 0xbc, 0x15, 0x96, 0x0b, 0x68, 0x6c, 0xa2, 0x0a, 0x96, 0x2d, 0x4b, 0xa1, 0x4c, 0xfd,
+	0xb3, 0x02, 0xfe, 0xbe, 0x40, 0x26, 0xaf, 0x76, 0x1b, 0x80, 0x46, 0x70, 0x2a, 0x66, 0x96, 0xc8,
+	0xa7, 0x23, 0xf0, 0xc2, 0x1b, 0x23, 0x9f, 0x5a, 0x28, 0x11, 0x16, 0x23, 0x50, 0x97, 0xae, 0x44,
+	0xf5, 0x18, 0xce, 0xb1, 0x66, 0xc0, 0x54, 0x18, 0xb5, 0xe3, 0x8f, 0xb0, 0x6b, 0x49, 0xf5, 0x1d,
+	0xf0, 0x67, 0x97, 0x4e, 0x5e, 0xa8, 0x00, 0xfe, 0x90, 0x5c, 0x1d, 0x21, 0xef, 0xfb, 0x3a, 0x41,
+	0x5a, 0xb0, 0x86, 0xeb, 0x6e, 0x41, 0x87, 0xb5, 0xd8, 0x7d, 0x8c, 0x6d, 0x6c, 0xfb, 0xab, 0xd2,
+	0x01, 0xb3, 0xd1, 0x61, 0x49, 0xf0, 0x04, 0x4c, 0x58, 0x7e, 0x68, 0xd7, 0x11, 0x31, 0x0f, 0x7c,
+	0xcd, 0x70, 0x1b, 0x7d, 0x3b, 0x4e, 0x67, 0x2a, 0x84, 0x57, 0x5b, 0x65, 0xc3, 0xa2, 0x75, 0x53,
+	0xbe, 0x4f, 0xbc, 0x3f, 0xff, 0x31, 0xfb, 0xa9, 0x7c, 0x0b, 0x6c, 0x60, 0xab, 0x34, 0x6e, 0x9d,
+	0x6f, 0x91, 0xff, 0x39, 0x08, 0x06, 0x45, 0x6f, 0xf8, 0x51, 0x01, 0xb0, 0x88, 0x79, 0x97, 0x15,
+	0xa0, 0x11, 0x71, 0xd7, 0x4b, 0x2c, 0xa5, 0x9a, 0x7d, 0xeb, 0xbd, 0xcb, 0xe9, 0x8b, 0x2f, 0xbf,
+	0xfc, 0x78, 0x17, 0x4f, 0xc3, 0x94, 0xc9, 0xc8, 0x9e, 0x55, 0x45, 0xc4, 0x09, 0xbd, 0xbf, 0x9e,
+	0xb9, 0xf2, 0x9a, 0xcb, 0xf1, 0x46, 0x01, 0xe3, 0x45, 0xcc, 0xc3, 0x6e, 0x82, 0x2b, 0xbd, 0x7a,
+	0x45, 0xf8, 0x51, 0x5d, 0xed, 0x4f, 0x2c, 0xa9, 0xe6, 0x05, 0xd5, 0x0c, 0x9c, 0x8e, 0xa2, 0x12,
+	0x2e, 0x85, 0xaf, 0x15, 0x30, 0x5a, 0xc4, 0xfc, 0xcc, 0x02, 0xf0, 0xdf, 0x5e, 0x2d, 0x2e, 0x18,
+	0x58, 0x5d, 0xee, 0x47, 0x2a, 0x59, 0x32, 0x82, 0x65, 0x0e, 0x6a, 0x51, 0x2c, 0x21, 0xcb, 0xbc,
+	0x52, 0x40, 0xe2, 0x0c, 0xa8, 0x03, 0x97, 0xae, 0xe8, 0xe1, 0x5b, 0x41, 0xcd, 0x5e, 0x2d, 0x94,
+	0x28, 0x2b, 0x02, 0x65, 0x11, 0x2e, 0x5c, 0x8e, 0x62, 0x3e, 0x27, 0xf6, 0x0b, 0xf8, 0xc9, 0xdb,
+	0xa8, 0xae, 0xad, 0xee, 0xbd, 0x51, 0xd1, 0xee, 0x50, 0xcd, 0xbe, 0xf5, 0x12, 0x72, 0x55, 0x40,
+	0x66, 0xe0, 0x3f, 0x51, 0x90, 0xdd, 0x46, 0x5a, 0xdb, 0x38, 0x3c, 0xd1, 0x94, 0xa3, 0x13, 0x4d,
+	0xf9, 0x7e, 0xa2, 0x29, 0x6f, 0x4f, 0xb5, 0xd8, 0xd1, 0xa9, 0x16, 0xfb, 0x7a, 0xaa, 0xc5, 0x76,
+	0x96, 0x43, 0xa6, 0xda, 0xf2, 0x2b, 0xf9, 0xdf, 0xd8, 0x03, 0xbf, 0xa6, 0x30, 0x57, 0x79, 0x48,
+	0x7c, 0x63, 0xff, 0xff, 0x35, 0x00, 0x57, 0x8a, 0x2a, 0x48, 0x16, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetOracleWhiteList queries the validators allowed to make claims
+	GetOracleWhiteList(ctx context.Context, in *QueryOracleWhiteListRequest, opts ...grpc.CallOption) (*QueryOracleWhiteListResponse, error)
+	// GetAdminAccount queries the account allowed to update the whitelist
+	GetAdminAccount(ctx context.Context, in *QueryAdminAccountRequest, opts ...grpc.CallOption) (*QueryAdminAccountResponse, error)
+	// GetProphecies queries the prophecies, optionally filtered by status
+	GetProphecies(ctx context.Context, in *QueryPropheciesRequest, opts ...grpc.CallOption) (*QueryPropheciesResponse, error)
+	// GetProphecy queries a prophecy with the claims of its validators
+	GetProphecy(ctx context.Context, in *QueryProphecyRequest, opts ...grpc.CallOption) (*QueryProphecyResponse, error)
+	// GetConsensusNeeded queries the share of the whitelisted validator power
+	// that must agree on a claim for its prophecy to succeed
+	GetConsensusNeeded(ctx context.Context, in *QueryConsensusNeededRequest, opts ...grpc.CallOption) (*QueryConsensusNeededResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetOracleWhiteList(ctx context.Context, in *QueryOracleWhiteListRequest, opts ...grpc.CallOption) (*QueryOracleWhiteListResponse, error) {
+	out := new(QueryOracleWhiteListResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/GetOracleWhiteList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAdminAccount(ctx context.Context, in *QueryAdminAccountRequest, opts ...grpc.CallOption) (*QueryAdminAccountResponse, error) {
+	out := new(QueryAdminAccountResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/GetAdminAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProphecies(ctx context.Context, in *QueryPropheciesRequest, opts ...grpc.CallOption) (*QueryPropheciesResponse, error) {
+	out := new(QueryPropheciesResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/GetProphecies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProphecy(ctx context.Context, in *QueryProphecyRequest, opts ...grpc.CallOption) (*QueryProphecyResponse, error) {
+	out := new(QueryProphecyResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/GetProphecy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetConsensusNeeded(ctx context.Context, in *QueryConsensusNeededRequest, opts ...grpc.CallOption) (*QueryConsensusNeededResponse, error) {
+	out := new(QueryConsensusNeededResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/GetConsensusNeeded", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GetOracleWhiteList queries the validators allowed to make claims
+	GetOracleWhiteList(context.Context, *QueryOracleWhiteListRequest) (*QueryOracleWhiteListResponse, error)
+	// GetAdminAccount queries the account allowed to update the whitelist
+	GetAdminAccount(context.Context, *QueryAdminAccountRequest) (*QueryAdminAccountResponse, error)
+	// GetProphecies queries the prophecies, optionally filtered by status
+	GetProphecies(context.Context, *QueryPropheciesRequest) (*QueryPropheciesResponse, error)
+	// GetProphecy queries a prophecy with the claims of its validators
+	GetProphecy(context.Context, *QueryProphecyRequest) (*QueryProphecyResponse, error)
+	// GetConsensusNeeded queries the share of the whitelisted validator power
+	// that must agree on a claim for its prophecy to succeed
+	GetConsensusNeeded(context.Context, *QueryConsensusNeededRequest) (*QueryConsensusNeededResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GetOracleWhiteList(ctx context.Context, req *QueryOracleWhiteListRequest) (*QueryOracleWhiteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOracleWhiteList not implemented")
+}
+func (*UnimplementedQueryServer) GetAdminAccount(ctx context.Context, req *QueryAdminAccountRequest) (*QueryAdminAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminAccount not implemented")
+}
+func (*UnimplementedQueryServer) GetProphecies(ctx context.Context, req *QueryPropheciesRequest) (*QueryPropheciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProphecies not implemented")
+}
+func (*UnimplementedQueryServer) GetProphecy(ctx context.Context, req *QueryProphecyRequest) (*QueryProphecyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProphecy not implemented")
+}
+func (*UnimplementedQueryServer) GetConsensusNeeded(ctx context.Context, req *QueryConsensusNeededRequest) (*QueryConsensusNeededResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusNeeded not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetOracleWhiteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleWhiteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOracleWhiteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/GetOracleWhiteList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOracleWhiteList(ctx, req.(*QueryOracleWhiteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/GetAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAdminAccount(ctx, req.(*QueryAdminAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProphecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPropheciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProphecies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/GetProphecies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProphecies(ctx, req.(*QueryPropheciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProphecy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProphecyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProphecy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/GetProphecy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProphecy(ctx, req.(*QueryProphecyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetConsensusNeeded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusNeededRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetConsensusNeeded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/GetConsensusNeeded",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetConsensusNeeded(ctx, req.(*QueryConsensusNeededRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOracleWhiteList",
+			Handler:    _Query_GetOracleWhiteList_Handler,
+		},
+		{
+			MethodName: "GetAdminAccount",
+			Handler:    _Query_GetAdminAccount_Handler,
+		},
+		{
+			MethodName: "GetProphecies",
+			Handler:    _Query_GetProphecies_Handler,
+		},
+		{
+			MethodName: "GetProphecy",
+			Handler:    _Query_GetProphecy_Handler,
+		},
+		{
+			MethodName: "GetConsensusNeeded",
+			Handler:    _Query_GetConsensusNeeded_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/oracle/v1/query.proto",
+}
+
+func (m *ValidatorClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProphecyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProphecyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProphecyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleWhiteListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleWhiteListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleWhiteListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleWhiteListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleWhiteListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleWhiteListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdminAccount) > 0 {
+		i -= len(m.AdminAccount)
+		copy(dAtA[i:], m.AdminAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPropheciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPropheciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPropheciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPropheciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPropheciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPropheciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prophecies) > 0 {
+		for iNdEx := len(m.Prophecies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prophecies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProphecyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProphecyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProphecyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProphecyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProphecyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProphecyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Prophecy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusNeededRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusNeededRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusNeededRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusNeededResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusNeededResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusNeededResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConsensusNeeded.Size()
+		i -= size
+		if _, err := m.ConsensusNeeded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProphecyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleWhiteListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOracleWhiteListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAdminAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAdminAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPropheciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPropheciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prophecies) > 0 {
+		for _, e := range m.Prophecies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProphecyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProphecyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Prophecy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsensusNeededRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsensusNeededResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsensusNeeded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProphecyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProphecyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProphecyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ValidatorClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleWhiteListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleWhiteListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleWhiteListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleWhiteListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleWhiteListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleWhiteListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPropheciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPropheciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPropheciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StatusText(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPropheciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPropheciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPropheciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prophecies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prophecies = append(m.Prophecies, ProphecyInfo{})
+			if err := m.Prophecies[len(m.Prophecies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProphecyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProphecyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProphecyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProphecyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProphecyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProphecyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prophecy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Prophecy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusNeededRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusNeededRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusNeededRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusNeededResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusNeededResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusNeededResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusNeeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusNeeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -63,6 +64,7 @@ type GenesisState struct {
 	AddressWhitelist []string      `protobuf:"bytes,1,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
 	AdminAddress     string        `protobuf:"bytes,2,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
 	Prophecies       []*DBProphecy `protobuf:"bytes,3,rep,name=prophecies,proto3" json:"prophecies,omitempty"`
+	Params           Params        `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +121,53 @@ func (m *GenesisState) GetProphecies() []*DBProphecy {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params are the oracle parameters tunable by governance
+type Params struct {
+	// consensus_needed is the minimum share of the whitelisted validator power
+	// that must make the same claim for its prophecy to succeed
+	ConsensusNeeded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=consensus_needed,json=consensusNeeded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"consensus_needed"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1b931484f4203, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 // Claim contains an arbitrary claim with arbitrary content made by a given
 // validator
 type Claim struct {
@@ -131,7 +180,7 @@ func (m *Claim) Reset()         { *m = Claim{} }
 func (m *Claim) String() string { return proto.CompactTextString(m) }
 func (*Claim) ProtoMessage()    {}
 func (*Claim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dac1b931484f4203, []int{1}
+	return fileDescriptor_dac1b931484f4203, []int{2}
 }
 func (m *Claim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +244,7 @@ func (m *DBProphecy) Reset()         { *m = DBProphecy{} }
 func (m *DBProphecy) String() string { return proto.CompactTextString(m) }
 func (*DBProphecy) ProtoMessage()    {}
 func (*DBProphecy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dac1b931484f4203, []int{2}
+	return fileDescriptor_dac1b931484f4203, []int{3}
 }
 func (m *DBProphecy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,7 +311,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dac1b931484f4203, []int{3}
+	return fileDescriptor_dac1b931484f4203, []int{4}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,6 +357,7 @@ func (m *Status) GetFinalClaim() string {
 func init() {
 	proto.RegisterEnum("sifnode.oracle.v1.StatusText", StatusText_name, StatusText_value)
 	proto.RegisterType((*GenesisState)(nil), "sifnode.oracle.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "sifnode.oracle.v1.Params")
 	proto.RegisterType((*Claim)(nil), "sifnode.oracle.v1.Claim")
 	proto.RegisterType((*DBProphecy)(nil), "sifnode.oracle.v1.DBProphecy")
 	proto.RegisterType((*Status)(nil), "sifnode.oracle.v1.Status")
@@ -316,38 +366,42 @@ func init() {
 func init() { proto.RegisterFile("sifnode/oracle/v1/types.proto", fileDescriptor_dac1b931484f4203) }
 
 var fileDescriptor_dac1b931484f4203 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6e, 0xda, 0x40,
-	0x18, 0x85, 0x31, 0x50, 0xaa, 0xfc, 0xd0, 0xd4, 0x99, 0x56, 0x8d, 0xdb, 0x2a, 0x0e, 0xa2, 0x1b,
-	0x9a, 0x4a, 0xb6, 0x48, 0x17, 0x5d, 0x75, 0x01, 0xd8, 0x89, 0x90, 0x2a, 0x84, 0x6c, 0xd3, 0x56,
-	0x55, 0x55, 0x6b, 0x62, 0x0f, 0x30, 0x92, 0xf1, 0x20, 0xcf, 0x84, 0x92, 0x5b, 0xf4, 0x06, 0x3d,
-	0x40, 0x2f, 0x92, 0x65, 0x96, 0x5d, 0x55, 0x15, 0x5c, 0x24, 0x62, 0x6c, 0x03, 0x4a, 0xb2, 0xb3,
-	0xdf, 0xf7, 0x66, 0xde, 0x9b, 0x5f, 0x3f, 0x1c, 0x71, 0x3a, 0x8a, 0x59, 0x48, 0x4c, 0x96, 0xe0,
-	0x20, 0x22, 0xe6, 0xbc, 0x65, 0x8a, 0xab, 0x19, 0xe1, 0xc6, 0x2c, 0x61, 0x82, 0xa1, 0x83, 0x0c,
-	0x1b, 0x29, 0x36, 0xe6, 0xad, 0x57, 0xcf, 0xc7, 0x6c, 0xcc, 0x24, 0x35, 0xd7, 0x5f, 0xa9, 0xb1,
-	0xf1, 0x5b, 0x81, 0xda, 0x39, 0x89, 0x09, 0xa7, 0xdc, 0x15, 0x58, 0x10, 0xf4, 0x0e, 0x0e, 0x70,
-	0x18, 0x26, 0x84, 0x73, 0xff, 0xe7, 0x84, 0x0a, 0x12, 0x51, 0x2e, 0x34, 0xa5, 0x5e, 0x6a, 0xee,
-	0x39, 0x6a, 0x06, 0xbe, 0xe4, 0x3a, 0x7a, 0x03, 0x4f, 0x70, 0x38, 0xa5, 0xb1, 0x9f, 0x11, 0xad,
-	0x58, 0x57, 0x9a, 0x7b, 0x4e, 0x4d, 0x8a, 0xed, 0x54, 0x43, 0x1f, 0x01, 0x66, 0x09, 0x9b, 0x4d,
-	0x48, 0x40, 0x09, 0xd7, 0x4a, 0xf5, 0x52, 0xb3, 0x7a, 0x7a, 0x64, 0xdc, 0x2b, 0x68, 0x58, 0x9d,
-	0x41, 0x6a, 0xbb, 0x72, 0x76, 0x0e, 0x34, 0x7e, 0xc0, 0xa3, 0x6e, 0x84, 0xe9, 0x14, 0xed, 0x43,
-	0x91, 0x86, 0x9a, 0x22, 0x13, 0x8a, 0x34, 0x5c, 0x37, 0x9d, 0xe3, 0x88, 0x86, 0x58, 0xb0, 0xe4,
-	0x4e, 0x01, 0x75, 0x03, 0xf2, 0x12, 0x1a, 0x3c, 0x0e, 0x58, 0x2c, 0x48, 0x2c, 0xb4, 0x92, 0xb4,
-	0xe4, 0xbf, 0x8d, 0x3f, 0x0a, 0xc0, 0x36, 0xfa, 0x5e, 0xca, 0x07, 0xa8, 0x70, 0x81, 0xc5, 0x65,
-	0x7a, 0x75, 0xf5, 0xf4, 0xe5, 0x03, 0xcd, 0x5d, 0x69, 0xe8, 0x94, 0xaf, 0xff, 0x1d, 0x17, 0x9c,
-	0xcc, 0x8e, 0xde, 0x82, 0x1a, 0xac, 0x7b, 0xfb, 0x9b, 0x2e, 0x5c, 0x46, 0xd7, 0x9c, 0xa7, 0x52,
-	0xff, 0xbc, 0x91, 0xd7, 0xd6, 0xed, 0x4b, 0x24, 0xe4, 0x5a, 0x39, 0xb5, 0x6e, 0x74, 0x39, 0x03,
-	0xde, 0xf8, 0x0e, 0x95, 0x34, 0x0d, 0xb5, 0xa0, 0x2c, 0xc8, 0x42, 0xc8, 0xaa, 0xfb, 0x0f, 0x0e,
-	0x34, 0x35, 0x7a, 0x64, 0x21, 0x1c, 0x69, 0x45, 0xc7, 0x50, 0x1d, 0xd1, 0x18, 0x47, 0x69, 0x46,
-	0x36, 0x2b, 0x90, 0x92, 0xbc, 0xfe, 0x84, 0x03, 0x6c, 0x0f, 0xa1, 0xd7, 0x70, 0xe8, 0x7a, 0x6d,
-	0x6f, 0xe8, 0xfa, 0x9e, 0xfd, 0xd5, 0xf3, 0x87, 0x7d, 0x77, 0x60, 0x77, 0x7b, 0x67, 0x3d, 0xdb,
-	0x52, 0x0b, 0xe8, 0x10, 0x9e, 0xed, 0xc2, 0x81, 0xdd, 0xb7, 0x7a, 0xfd, 0x73, 0x55, 0xb9, 0x0b,
-	0xdc, 0x61, 0xb7, 0x6b, 0xbb, 0xae, 0x5a, 0x44, 0x2f, 0x00, 0xed, 0x82, 0xb3, 0x76, 0xef, 0x93,
-	0x6d, 0xa9, 0xa5, 0x8e, 0x75, 0xbd, 0xd4, 0x95, 0x9b, 0xa5, 0xae, 0xfc, 0x5f, 0xea, 0xca, 0xaf,
-	0x95, 0x5e, 0xb8, 0x59, 0xe9, 0x85, 0xbf, 0x2b, 0xbd, 0xf0, 0xed, 0x64, 0x4c, 0xc5, 0xe4, 0xf2,
-	0xc2, 0x08, 0xd8, 0xd4, 0x74, 0xe9, 0x28, 0x98, 0x60, 0x1a, 0x9b, 0xf9, 0xe2, 0x2f, 0xf2, 0xd5,
-	0x97, 0x7b, 0x7f, 0x51, 0x91, 0xfb, 0xfc, 0xfe, 0x36, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x8f, 0x95,
-	0x2e, 0x19, 0x03, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xc5, 0x90, 0x8f, 0x4f, 0xb9, 0xa1, 0x89, 0x33, 0xad, 0x1a, 0xb7, 0x55, 0x1c, 0x44, 0xa5,
+	0x8a, 0xa6, 0xaa, 0xad, 0xa4, 0x8b, 0xac, 0xba, 0x00, 0xec, 0x44, 0x48, 0x15, 0x42, 0x36, 0xf4,
+	0x4f, 0x55, 0xad, 0x89, 0x3d, 0xc0, 0xa8, 0xe0, 0x41, 0x9e, 0x81, 0x92, 0xb7, 0xe8, 0x7b, 0xf4,
+	0x45, 0xb2, 0xcc, 0x32, 0xea, 0x22, 0xaa, 0xe0, 0x45, 0x2a, 0x8f, 0x6d, 0x40, 0x09, 0x2b, 0xdb,
+	0xe7, 0x9c, 0x7b, 0xef, 0xf1, 0x99, 0xb9, 0x70, 0xc8, 0x69, 0x2f, 0x64, 0x01, 0x31, 0x59, 0x84,
+	0xfd, 0x21, 0x31, 0xa7, 0x27, 0xa6, 0xb8, 0x1a, 0x13, 0x6e, 0x8c, 0x23, 0x26, 0x18, 0xda, 0x4f,
+	0x69, 0x23, 0xa1, 0x8d, 0xe9, 0xc9, 0xf3, 0x27, 0x7d, 0xd6, 0x67, 0x92, 0x35, 0xe3, 0xb7, 0x44,
+	0x58, 0xb9, 0x55, 0xa0, 0x74, 0x41, 0x42, 0xc2, 0x29, 0x77, 0x05, 0x16, 0x04, 0xbd, 0x81, 0x7d,
+	0x1c, 0x04, 0x11, 0xe1, 0xdc, 0xfb, 0x39, 0xa0, 0x82, 0x0c, 0x29, 0x17, 0x9a, 0x52, 0x2e, 0x54,
+	0xb7, 0x1d, 0x35, 0x25, 0x3e, 0x65, 0x38, 0x7a, 0x09, 0x8f, 0x70, 0x30, 0xa2, 0xa1, 0x97, 0x32,
+	0x5a, 0xbe, 0xac, 0x54, 0xb7, 0x9d, 0x92, 0x04, 0x6b, 0x09, 0x86, 0xde, 0x03, 0x8c, 0x23, 0x36,
+	0x1e, 0x10, 0x9f, 0x12, 0xae, 0x15, 0xca, 0x85, 0xea, 0xce, 0xe9, 0xa1, 0xf1, 0xc0, 0xa0, 0x61,
+	0xd5, 0xdb, 0x89, 0xec, 0xca, 0x59, 0x2b, 0x40, 0x67, 0x50, 0x1c, 0xe3, 0x08, 0x8f, 0xb8, 0xb6,
+	0x55, 0x56, 0xaa, 0x3b, 0xa7, 0xcf, 0x36, 0x94, 0xb6, 0xa5, 0xa0, 0xbe, 0x75, 0x7d, 0x77, 0x94,
+	0x73, 0x52, 0x79, 0xc5, 0x87, 0x62, 0x82, 0xa3, 0x2f, 0xa0, 0xfa, 0x2c, 0xe4, 0x24, 0xe4, 0x13,
+	0xee, 0x85, 0x84, 0x04, 0x24, 0xd0, 0x94, 0xd8, 0x69, 0xdd, 0x88, 0x2b, 0xfe, 0xdc, 0x1d, 0xbd,
+	0xea, 0x53, 0x31, 0x98, 0x5c, 0x1a, 0x3e, 0x1b, 0x99, 0x3e, 0xe3, 0x23, 0xc6, 0xd3, 0xc7, 0x5b,
+	0x1e, 0xfc, 0x48, 0x93, 0xb5, 0x88, 0xef, 0xec, 0x2d, 0xfb, 0xb4, 0x64, 0x9b, 0xca, 0x77, 0xf8,
+	0xaf, 0x31, 0xc4, 0x74, 0x84, 0x76, 0x21, 0x4f, 0xd3, 0xae, 0x4e, 0x9e, 0x06, 0x71, 0x8e, 0x53,
+	0x3c, 0xa4, 0x01, 0x16, 0x2c, 0xba, 0x17, 0x8f, 0xba, 0x24, 0xb2, 0x88, 0x34, 0xf8, 0xdf, 0x67,
+	0xa1, 0x20, 0xa1, 0xd0, 0x0a, 0x52, 0x92, 0x7d, 0x56, 0x7e, 0x2b, 0x00, 0xab, 0x60, 0x1e, 0x4c,
+	0x39, 0x83, 0x22, 0x17, 0x58, 0x4c, 0x92, 0xd6, 0x9b, 0xc3, 0x71, 0xa5, 0x20, 0x0b, 0x27, 0x91,
+	0xa3, 0xd7, 0xa0, 0xfa, 0xb1, 0x6f, 0x6f, 0xe9, 0x85, 0xcb, 0xd1, 0x25, 0x67, 0x4f, 0xe2, 0x1f,
+	0x97, 0x70, 0x2c, 0x5d, 0xfd, 0x89, 0x24, 0x93, 0xa3, 0x28, 0x39, 0x7b, 0x4b, 0x5c, 0x66, 0xc0,
+	0x2b, 0xdf, 0xa0, 0x98, 0x4c, 0x43, 0x27, 0xb0, 0x25, 0xc8, 0x4c, 0x48, 0xab, 0xbb, 0x1b, 0x8f,
+	0x3b, 0x11, 0x76, 0xc8, 0x4c, 0x38, 0x52, 0x8a, 0x8e, 0x60, 0xa7, 0x47, 0x43, 0x3c, 0x4c, 0x66,
+	0xa4, 0x59, 0x81, 0x84, 0x64, 0xfb, 0x63, 0x0e, 0xb0, 0x2a, 0x42, 0x2f, 0xe0, 0xc0, 0xed, 0xd4,
+	0x3a, 0x5d, 0xd7, 0xeb, 0xd8, 0x9f, 0x3b, 0x5e, 0xb7, 0xe5, 0xb6, 0xed, 0x46, 0xf3, 0xbc, 0x69,
+	0x5b, 0x6a, 0x0e, 0x1d, 0xc0, 0xe3, 0x75, 0xb2, 0x6d, 0xb7, 0xac, 0x66, 0xeb, 0x42, 0x55, 0xee,
+	0x13, 0x6e, 0xb7, 0xd1, 0xb0, 0x5d, 0x57, 0xcd, 0xa3, 0xa7, 0x80, 0xd6, 0x89, 0xf3, 0x5a, 0xf3,
+	0x83, 0x6d, 0xa9, 0x85, 0xba, 0x75, 0x3d, 0xd7, 0x95, 0x9b, 0xb9, 0xae, 0xfc, 0x9d, 0xeb, 0xca,
+	0xaf, 0x85, 0x9e, 0xbb, 0x59, 0xe8, 0xb9, 0xdb, 0x85, 0x9e, 0xfb, 0x7a, 0xbc, 0x76, 0x67, 0x5c,
+	0xda, 0xf3, 0x07, 0x98, 0x86, 0x66, 0xb6, 0x96, 0xb3, 0x6c, 0x31, 0xe5, 0xdd, 0xb9, 0x2c, 0xca,
+	0x6d, 0x7b, 0xf7, 0x6f, 0x00, 0xe2, 0x79, 0xe7, 0x39, 0xb7, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -370,6 +424,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Prophecies) > 0 {
 		for iNdEx := len(m.Prophecies) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -403,6 +467,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConsensusNeeded.Size()
+		i -= size
+		if _, err := m.ConsensusNeeded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Claim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -569,6 +666,19 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsensusNeeded.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -765,6 +875,123 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusNeeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusNeeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
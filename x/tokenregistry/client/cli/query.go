@@ -66,6 +66,8 @@ func GetCmdGenerateEntry() *cobra.Command {
 	var flagDisplaySymbol = "token_display_symbol"
 	var flagExternalSymbol = "token_external_symbol"
 	var flagTransferLimit = "token_transfer_limit"
+	var flagWindowTransferLimit = "token_window_transfer_limit"
+	var flagTransferWindow = "token_transfer_window"
 	var flagNetwork = "token_network"
 	var flagAddress = "token_address"
 	var flagsPermission = []string{"token_permission_clp", "token_permission_ibc_export", "token_permission_ibc_import"}
@@ -127,6 +129,14 @@ func GetCmdGenerateEntry() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			windowTransferLimit, err := flags.GetString(flagWindowTransferLimit)
+			if err != nil {
+				return err
+			}
+			transferWindow, err := flags.GetInt64(flagTransferWindow)
+			if err != nil {
+				return err
+			}
 			network, err := flags.GetString(flagNetwork)
 			if err != nil {
 				return err
@@ -191,6 +201,8 @@ func GetCmdGenerateEntry() *cobra.Command {
 				Address:                  address,
 				ExternalSymbol:           externalSymbol,
 				TransferLimit:            transferLimit,
+				WindowTransferLimit:      windowTransferLimit,
+				TransferWindow:           transferWindow,
 				Permissions:              permissions,
 			}
 			return clientCtx.PrintProto(&types.Registry{Entries: []*types.RegistryEntry{&entry}})
@@ -219,7 +231,11 @@ func GetCmdGenerateEntry() *cobra.Command {
 	cmd.Flags().String(flagExternalSymbol, "",
 		"The original symbol as seen on external network")
 	cmd.Flags().String(flagTransferLimit, "",
-		"Used by UI")
+		"The maximum amount of a single transfer across the ethereum bridge, empty for no limit")
+	cmd.Flags().String(flagWindowTransferLimit, "",
+		"The maximum volume transferred across the ethereum bridge within the transfer window, empty for no limit")
+	cmd.Flags().Int64(flagTransferWindow, 0,
+		"The length in blocks of the rolling window used by the window transfer limit")
 	cmd.Flags().String(flagNetwork, "",
 		"Original network of token i.e ethereum")
 	cmd.Flags().String(flagAddress, "",
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/Sifchain/sifnode/x/tokenregistry/types"
 	whitelistutils "github.com/Sifchain/sifnode/x/tokenregistry/utils"
@@ -23,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 		GetCmdRegisterAll(),
 		GetCmdDeregisterAll(),
 		GetCmdSetRegistry(),
+		GetCmdApprove(),
 	)
 	return cmd
 }
@@ -198,3 +201,39 @@ func GetCmdDeregisterAll() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [denom] [permission]...",
+		Short: "Lift the quarantine of a token registered by the bridge and grant it permissions, e.g. approve ctoken CLP",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			err = cobra.MinimumNArgs(1)(cmd, args)
+			if err != nil {
+				return err
+			}
+			var permissions []types.Permission
+			for _, arg := range args[1:] {
+				permission, ok := types.Permission_value[strings.ToUpper(arg)]
+				if !ok {
+					return fmt.Errorf("unknown permission %s", arg)
+				}
+				permissions = append(permissions, types.Permission(permission))
+			}
+			msg := types.MsgApprove{
+				From:        clientCtx.GetFromAddress().String(),
+				Denom:       args[0],
+				Permissions: permissions,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -24,6 +24,9 @@ func NewHandler(k types.Keeper) sdk.Handler {
 		case *types.MsgDeregister:
 			res, err := msgServer.Deregister(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgApprove:
+			res, err := msgServer.Approve(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized message type: %v", sdk.MsgTypeURL(msg))
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -302,3 +302,88 @@ func TestHandleDeregister(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleApprove(t *testing.T) {
+	app, ctx, admin := test.CreateTestApp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{
+		Denom:       "cquarantined",
+		Decimals:    6,
+		Quarantined: true,
+	})
+	app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{
+		Denom:       "cnodecimals",
+		Quarantined: true,
+	})
+	app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{
+		Denom:    "capproved",
+		Decimals: 18,
+	})
+	h := handler.NewHandler(app.TokenRegistryKeeper)
+	tests := []struct {
+		name           string
+		msg            types.MsgApprove
+		errorAssertion assert.ErrorAssertionFunc
+		valueAssertion require.ValueAssertionFunc
+	}{
+		{
+			name: "Non Admin Account",
+			msg: types.MsgApprove{
+				From:        sdk.AccAddress("addr2_______________").String(),
+				Denom:       "cquarantined",
+				Permissions: []types.Permission{types.Permission_CLP},
+			},
+			errorAssertion: assert.Error,
+			valueAssertion: require.Nil,
+		},
+		{
+			name: "Successful Approval",
+			msg: types.MsgApprove{
+				From:        admin,
+				Denom:       "cquarantined",
+				Permissions: []types.Permission{types.Permission_CLP},
+			},
+			errorAssertion: assert.NoError,
+			valueAssertion: func(t require.TestingT, res interface{}, i ...interface{}) {
+				entry, err := app.TokenRegistryKeeper.GetEntry(app.TokenRegistryKeeper.GetRegistry(ctx), "cquarantined")
+				require.NoError(t, err)
+				require.False(t, entry.Quarantined)
+				require.True(t, app.TokenRegistryKeeper.CheckEntryPermissions(entry, []types.Permission{types.Permission_CLP}))
+			},
+		},
+		{
+			name: "Entry Not Quarantined",
+			msg: types.MsgApprove{
+				From:  admin,
+				Denom: "capproved",
+			},
+			errorAssertion: assert.Error,
+			valueAssertion: require.Nil,
+		},
+		{
+			name: "Entry Without Decimals",
+			msg: types.MsgApprove{
+				From:  admin,
+				Denom: "cnodecimals",
+			},
+			errorAssertion: assert.Error,
+			valueAssertion: require.Nil,
+		},
+		{
+			name: "Entry Not Found",
+			msg: types.MsgApprove{
+				From:  admin,
+				Denom: "cmissing",
+			},
+			errorAssertion: assert.Error,
+			valueAssertion: require.Nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := h(ctx, &tt.msg)
+			tt.errorAssertion(t, err)
+			tt.valueAssertion(t, res)
+		})
+	}
+}
@@ -49,6 +49,32 @@ func (m msgServer) Deregister(ctx context.Context, req *types.MsgDeregister) (*t
 	return &types.MsgDeregisterResponse{}, nil
 }
 
+func (m msgServer) Approve(ctx context.Context, req *types.MsgApprove) (*types.MsgApproveResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	addr, err := sdk.AccAddressFromBech32(req.From)
+	if err != nil {
+		return nil, err
+	}
+	if !m.keeper.IsAdminAccount(sdkCtx, addr) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "unauthorised signer")
+	}
+	entry, err := m.keeper.GetEntry(m.keeper.GetRegistry(sdkCtx), req.Denom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, req.Denom)
+	}
+	if !entry.Quarantined {
+		return nil, sdkerrors.Wrap(types.ErrNotQuarantined, req.Denom)
+	}
+	// The decimals of the token may not have been known to the bridge, such entries have to be registered instead
+	if entry.Decimals <= 0 {
+		return nil, sdkerrors.Wrapf(types.ErrPermissionDenied, "%s has no decimals, register it instead", req.Denom)
+	}
+	entry.Quarantined = false
+	entry.Permissions = req.Permissions
+	m.keeper.SetToken(sdkCtx, entry)
+	return &types.MsgApproveResponse{}, nil
+}
+
 // NewMsgServerImpl returns an implementation of MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper types.Keeper) types.MsgServer {
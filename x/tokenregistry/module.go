@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 
 	"github.com/Sifchain/sifnode/x/tokenregistry/client/cli"
 	"github.com/Sifchain/sifnode/x/tokenregistry/client/rest"
@@ -13,6 +14,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -20,13 +22,15 @@ import (
 
 	"github.com/Sifchain/sifnode/x/tokenregistry/handler"
 	"github.com/Sifchain/sifnode/x/tokenregistry/keeper"
+	"github.com/Sifchain/sifnode/x/tokenregistry/simulation"
 	"github.com/Sifchain/sifnode/x/tokenregistry/types"
 )
 
 var (
-	ModuleName                       = types.ModuleName
-	_          module.AppModule      = AppModule{}
-	_          module.AppModuleBasic = AppModuleBasic{}
+	ModuleName                            = types.ModuleName
+	_          module.AppModule           = AppModule{}
+	_          module.AppModuleBasic      = AppModuleBasic{}
+	_          module.AppModuleSimulation = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module.
@@ -89,13 +93,9 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 //____________________________________________________________________________
 
-// AppModuleSimulation defines the module simulation functions.
-type AppModuleSimulation struct{}
-
 // AppModule implements an application module.
 type AppModule struct {
 	AppModuleBasic
-	AppModuleSimulation
 	Keeper types.Keeper
 	Codec  *codec.Codec
 }
@@ -113,10 +113,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // NewAppModule creates a new AppModule object
 func NewAppModule(keeper types.Keeper, cdc *codec.Codec) AppModule {
 	return AppModule{
-		AppModuleBasic:      AppModuleBasic{},
-		AppModuleSimulation: AppModuleSimulation{},
-		Keeper:              keeper,
-		Codec:               cdc,
+		AppModuleBasic: AppModuleBasic{},
+		Keeper:         keeper,
+		Codec:          cdc,
 	}
 }
 
@@ -171,3 +170,31 @@ func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Valid
 }
 
 func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+//____________________________________________________________________________
+
+// GenerateGenesisState creates a randomized GenState of the module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalContents doesn't return any content functions for governance proposals.
+func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams returns nil, the module has no params.
+func (AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder registers a decoder for the module's types.
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(*am.Codec)
+}
+
+// WeightedOperations returns nil, registry changes are reserved to the admin
+// account and would only get in the way of the other modules' operations.
+func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
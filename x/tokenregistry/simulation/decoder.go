@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding tokenregistry type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.WhitelistStorePrefix):
+			var registryA, registryB types.Registry
+			cdc.MustUnmarshal(kvA.Value, &registryA)
+			cdc.MustUnmarshal(kvB.Value, &registryB)
+			return fmt.Sprintf("%v\n%v", registryA, registryB)
+		case bytes.Equal(kvA.Key[:1], types.AdminAccountStorePrefix):
+			var adminA, adminB gogotypes.BytesValue
+			cdc.MustUnmarshal(kvA.Value, &adminA)
+			cdc.MustUnmarshal(kvB.Value, &adminB)
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(adminA.Value), sdk.AccAddress(adminB.Value))
+		default:
+			panic(fmt.Sprintf("invalid tokenregistry key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
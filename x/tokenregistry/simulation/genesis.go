@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+// Simulation parameter constants
+const (
+	AdminAccount = "admin_account"
+)
+
+// GenAdminAccount randomized AdminAccount
+func GenAdminAccount(r *rand.Rand, accs []simtypes.Account) string {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
+
+// GenRegistryEntry randomized RegistryEntry for the given denom. rowan always
+// has 18 decimals, the other denoms get a random precision to exercise the
+// normalization of the pool math.
+func GenRegistryEntry(r *rand.Rand, denom string) *types.RegistryEntry {
+	decimals := int64(simtypes.RandIntBetween(r, 6, 19))
+	if denom == "rowan" {
+		decimals = 18
+	}
+	permissions := []types.Permission{types.Permission_CLP}
+	if r.Intn(2) == 0 {
+		permissions = append(permissions, types.Permission_IBCEXPORT, types.Permission_IBCIMPORT)
+	}
+	return &types.RegistryEntry{
+		Denom:       denom,
+		Decimals:    decimals,
+		DisplayName: simtypes.RandStringOfLength(r, 10),
+		Permissions: permissions,
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for tokenregistry with
+// an entry for every denom of the bank genesis supply, so it must run after
+// every module funding the simulation accounts.
+func RandomizedGenState(simState *module.SimulationState) {
+	var adminAccount string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AdminAccount, &adminAccount, simState.Rand,
+		func(r *rand.Rand) { adminAccount = GenAdminAccount(r, simState.Accounts) },
+	)
+	bankGenesis := banktypes.GetGenesisStateFromAppState(simState.Cdc, simState.GenState)
+	registry := types.Registry{}
+	for _, coin := range bankGenesis.Supply {
+		registry.Entries = append(registry.Entries, GenRegistryEntry(simState.Rand, coin.Denom))
+	}
+	tokenregistryGenesis := types.GenesisState{
+		AdminAccount: adminAccount,
+		Registry:     &registry,
+	}
+	fmt.Printf("Selected randomly generated tokenregistry admin account: %s\n", adminAccount)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&tokenregistryGenesis)
+}
@@ -19,6 +19,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint
 	cdc.RegisterConcrete(&MsgRegisterResponse{}, "MsgRegisterResponse", nil)
 	cdc.RegisterConcrete(&MsgDeregister{}, "MsgDeregister", nil)
 	cdc.RegisterConcrete(&MsgDeregisterResponse{}, "MsgDeregisterResponse", nil)
+	cdc.RegisterConcrete(&MsgApprove{}, "MsgApprove", nil)
+	cdc.RegisterConcrete(&MsgApproveResponse{}, "MsgApproveResponse", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +28,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgRegister{},
 		&MsgDeregister{},
+		&MsgApprove{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
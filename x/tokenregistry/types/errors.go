@@ -5,4 +5,5 @@ import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 var (
 	ErrNotFound         = sdkerrors.Register(ModuleName, 1, "denom not found in registry")
 	ErrPermissionDenied = sdkerrors.Register(ModuleName, 2, "permission denied for denom")
+	ErrNotQuarantined   = sdkerrors.Register(ModuleName, 3, "registry entry is not quarantined")
 )
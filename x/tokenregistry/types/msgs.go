@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/pkg/errors"
@@ -9,6 +11,7 @@ import (
 var _ sdk.Msg = &MsgRegister{}
 var _ sdk.Msg = &MsgDeregister{}
 var _ sdk.Msg = &MsgSetRegistry{}
+var _ sdk.Msg = &MsgApprove{}
 
 // MsgRegister
 
@@ -145,3 +148,48 @@ func (m *MsgDeregister) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// MsgApprove
+
+func (m *MsgApprove) Route() string {
+	return RouterKey
+}
+
+func (m *MsgApprove) Type() string {
+	return "approve"
+}
+
+func (m *MsgApprove) ValidateBasic() error {
+	if m.Denom == "" {
+		return errors.New("no denom specified")
+	}
+	coin := sdk.Coin{
+		Denom:  m.Denom,
+		Amount: sdk.OneInt(),
+	}
+	if !coin.IsValid() {
+		return errors.New("Denom is not valid")
+	}
+	for _, permission := range m.Permissions {
+		if _, ok := Permission_name[int32(permission)]; !ok || permission == Permission_UNSPECIFIED {
+			return fmt.Errorf("invalid permission %d", permission)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid from address")
+	}
+	return nil
+}
+
+func (m *MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgApprove) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -292,6 +292,103 @@ func (m *MsgDeregisterResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeregisterResponse proto.InternalMessageInfo
 
+// MsgApprove lifts the quarantine of a registry entry and grants it permissions
+type MsgApprove struct {
+	From        string       `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Denom       string       `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Permissions []Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=sifnode.tokenregistry.v1.Permission" json:"permissions,omitempty"`
+}
+
+func (m *MsgApprove) Reset()         { *m = MsgApprove{} }
+func (m *MsgApprove) String() string { return proto.CompactTextString(m) }
+func (*MsgApprove) ProtoMessage()    {}
+func (*MsgApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d09312f3deb69cfe, []int{6}
+}
+func (m *MsgApprove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApprove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApprove.Merge(m, src)
+}
+func (m *MsgApprove) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApprove proto.InternalMessageInfo
+
+func (m *MsgApprove) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgApprove) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgApprove) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type MsgApproveResponse struct {
+}
+
+func (m *MsgApproveResponse) Reset()         { *m = MsgApproveResponse{} }
+func (m *MsgApproveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveResponse) ProtoMessage()    {}
+func (*MsgApproveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d09312f3deb69cfe, []int{7}
+}
+func (m *MsgApproveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveResponse.Merge(m, src)
+}
+func (m *MsgApproveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegister)(nil), "sifnode.tokenregistry.v1.MsgRegister")
 	proto.RegisterType((*MsgRegisterResponse)(nil), "sifnode.tokenregistry.v1.MsgRegisterResponse")
@@ -299,35 +396,41 @@ func init() {
 	proto.RegisterType((*MsgSetRegistryResponse)(nil), "sifnode.tokenregistry.v1.MsgSetRegistryResponse")
 	proto.RegisterType((*MsgDeregister)(nil), "sifnode.tokenregistry.v1.MsgDeregister")
 	proto.RegisterType((*MsgDeregisterResponse)(nil), "sifnode.tokenregistry.v1.MsgDeregisterResponse")
+	proto.RegisterType((*MsgApprove)(nil), "sifnode.tokenregistry.v1.MsgApprove")
+	proto.RegisterType((*MsgApproveResponse)(nil), "sifnode.tokenregistry.v1.MsgApproveResponse")
 }
 
 func init() { proto.RegisterFile("sifnode/tokenregistry/v1/tx.proto", fileDescriptor_d09312f3deb69cfe) }
 
 var fileDescriptor_d09312f3deb69cfe = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x5b, 0x14, 0x03, 0x8f, 0xe8, 0x50, 0x41, 0x9b, 0x0e, 0x0d, 0x36, 0x1a, 0x58, 0xec,
-	0x09, 0x4e, 0x0e, 0x3a, 0x18, 0x9d, 0x4c, 0x97, 0x63, 0x73, 0x52, 0xe0, 0x38, 0x1a, 0x42, 0x8f,
-	0xdc, 0x9d, 0x04, 0xbe, 0x85, 0x1f, 0xc9, 0xd1, 0x91, 0xd1, 0xd1, 0xc0, 0x17, 0x31, 0x5c, 0x69,
-	0x39, 0x8c, 0x60, 0xb7, 0xd7, 0xf4, 0xf7, 0x7f, 0xbf, 0xd7, 0xd7, 0x07, 0x67, 0x22, 0xec, 0x45,
-	0xac, 0x4b, 0x90, 0x64, 0x03, 0x12, 0x71, 0x42, 0x43, 0x21, 0xf9, 0x14, 0x8d, 0x1b, 0x48, 0x4e,
-	0xfc, 0x11, 0x67, 0x92, 0x59, 0xf6, 0x0a, 0xf1, 0x37, 0x10, 0x7f, 0xdc, 0x70, 0xca, 0x94, 0x51,
-	0xa6, 0x20, 0xb4, 0xac, 0x62, 0xde, 0x39, 0xdf, 0xde, 0x72, 0x3a, 0x22, 0x22, 0xa6, 0xbc, 0x17,
-	0x28, 0x05, 0x82, 0x62, 0xf5, 0x96, 0x70, 0xcb, 0x82, 0xfd, 0x1e, 0x67, 0x43, 0xdb, 0xac, 0x9a,
-	0xf5, 0x22, 0x56, 0xb5, 0x75, 0x0b, 0x79, 0x12, 0x49, 0x3e, 0xb5, 0x73, 0x55, 0xb3, 0x5e, 0x6a,
-	0xd6, 0xfc, 0x6d, 0x83, 0xf8, 0x78, 0x55, 0x3f, 0x2e, 0x71, 0x1c, 0xa7, 0xbc, 0x0a, 0x1c, 0x6b,
-	0x06, 0x4c, 0xc4, 0x88, 0x45, 0x82, 0x78, 0x5d, 0x38, 0x0a, 0x04, 0x6d, 0x11, 0x99, 0x84, 0xfe,
-	0x74, 0xdf, 0x41, 0x21, 0x11, 0xac, 0xf4, 0xde, 0xff, 0x7a, 0x9c, 0x66, 0x3c, 0x1b, 0x4e, 0x36,
-	0x2d, 0xa9, 0xff, 0x06, 0x0e, 0x03, 0x41, 0x1f, 0x08, 0xdf, 0xf5, 0xe9, 0x65, 0xc8, 0x77, 0x49,
-	0xc4, 0x86, 0xca, 0x5d, 0xc4, 0xf1, 0x83, 0x77, 0x0a, 0x95, 0x8d, 0x68, 0xd2, 0xb3, 0xf9, 0x91,
-	0x83, 0xbd, 0x40, 0x50, 0xab, 0x0d, 0x85, 0x74, 0xa3, 0x17, 0xdb, 0xe7, 0xd5, 0xd6, 0xe2, 0x5c,
-	0x66, 0xc2, 0xd2, 0xe9, 0x0d, 0xab, 0x0f, 0xa0, 0x0d, 0x5f, 0xdb, 0x19, 0x5f, 0x83, 0x0e, 0xca,
-	0x08, 0x6a, 0xa6, 0x01, 0x94, 0xf4, 0xdf, 0x54, 0xdf, 0xd9, 0x41, 0x23, 0x9d, 0xab, 0xac, 0xe4,
-	0x5a, 0x76, 0xff, 0xf4, 0x39, 0x77, 0xcd, 0xd9, 0xdc, 0x35, 0xbf, 0xe7, 0xae, 0xf9, 0xbe, 0x70,
-	0x8d, 0xd9, 0xc2, 0x35, 0xbe, 0x16, 0xae, 0xf1, 0xdc, 0xa0, 0xa1, 0xec, 0xbf, 0xb5, 0xfd, 0x0e,
-	0x1b, 0xa2, 0x56, 0xd8, 0xeb, 0xf4, 0x5f, 0xc3, 0x08, 0x25, 0x37, 0x3e, 0xf9, 0x75, 0xe5, 0xea,
-	0xc4, 0xdb, 0x07, 0xea, 0xc6, 0xaf, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x0b, 0x39, 0x49,
-	0x5e, 0x03, 0x00, 0x00,
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0x87, 0x5b, 0x2b, 0x0a, 0xa7, 0x91, 0xc5, 0x08, 0xda, 0x74, 0xd1, 0x60, 0x83, 0xa1, 0x0b,
+	0x6d, 0x05, 0x57, 0x2e, 0x34, 0xd1, 0xa8, 0x1b, 0xd3, 0xc4, 0x0c, 0x3b, 0x37, 0x2a, 0x30, 0x0c,
+	0x0d, 0x69, 0xa7, 0x99, 0xa9, 0x04, 0x36, 0x3e, 0x83, 0xaf, 0xe0, 0xdb, 0xb8, 0x64, 0xe9, 0xf2,
+	0x06, 0x5e, 0xe4, 0x86, 0xfe, 0x63, 0xb8, 0xb9, 0xed, 0xed, 0x6e, 0x9a, 0x7e, 0xbf, 0xf3, 0xcd,
+	0xe9, 0xe9, 0x81, 0x67, 0x22, 0x58, 0x46, 0x6c, 0x41, 0xbc, 0x84, 0xad, 0x49, 0xc4, 0x09, 0x0d,
+	0x44, 0xc2, 0x77, 0xde, 0x66, 0xec, 0x25, 0x5b, 0x37, 0xe6, 0x2c, 0x61, 0xc8, 0xc8, 0x11, 0xf7,
+	0x02, 0x71, 0x37, 0x63, 0xb3, 0x47, 0x19, 0x65, 0x29, 0xe4, 0x9d, 0x4e, 0x19, 0x6f, 0x0e, 0xab,
+	0x4b, 0xee, 0x62, 0x22, 0x32, 0xca, 0xfe, 0x01, 0xba, 0x2f, 0x28, 0x4e, 0xdf, 0x12, 0x8e, 0x10,
+	0xdc, 0x5f, 0x72, 0x16, 0x1a, 0xea, 0x40, 0x75, 0x3a, 0x38, 0x3d, 0xa3, 0xb7, 0xd0, 0x22, 0x51,
+	0xc2, 0x77, 0xc6, 0xbd, 0x81, 0xea, 0xe8, 0x93, 0x91, 0x5b, 0x75, 0x11, 0x17, 0xe7, 0xe7, 0x4f,
+	0x27, 0x1c, 0x67, 0x29, 0xbb, 0x0f, 0x8f, 0x25, 0x03, 0x26, 0x22, 0x66, 0x91, 0x20, 0xf6, 0x02,
+	0xba, 0xbe, 0xa0, 0x53, 0x92, 0x14, 0xa1, 0x5b, 0xdd, 0xef, 0xa0, 0x5d, 0x08, 0x72, 0xbd, 0x7d,
+	0xb7, 0x1e, 0x97, 0x19, 0xdb, 0x80, 0x27, 0x97, 0x96, 0xd2, 0xff, 0x06, 0x1e, 0xf9, 0x82, 0x7e,
+	0x24, 0xbc, 0xae, 0xf5, 0x1e, 0xb4, 0x16, 0x24, 0x62, 0x61, 0xea, 0xee, 0xe0, 0xec, 0xc1, 0x7e,
+	0x0a, 0xfd, 0x8b, 0x68, 0x59, 0xf3, 0x37, 0x80, 0x2f, 0xe8, 0xfb, 0x38, 0xe6, 0x6c, 0x43, 0x9a,
+	0x17, 0x44, 0x9f, 0x41, 0x8f, 0x09, 0x0f, 0x03, 0x21, 0x02, 0x16, 0x09, 0x43, 0x1b, 0x68, 0x4e,
+	0x77, 0x32, 0xac, 0x6e, 0xf4, 0x6b, 0x09, 0x63, 0x39, 0x68, 0xf7, 0x00, 0x9d, 0xfd, 0xc5, 0xad,
+	0x26, 0x7f, 0x35, 0xd0, 0x7c, 0x41, 0xd1, 0x0c, 0xda, 0xe5, 0x9c, 0x9f, 0x57, 0x17, 0x97, 0x86,
+	0x65, 0xbe, 0x6c, 0x84, 0x95, 0xfd, 0x2b, 0x68, 0x05, 0x20, 0x7d, 0xd2, 0x51, 0x6d, 0xfc, 0x0c,
+	0x9a, 0x5e, 0x43, 0x50, 0x32, 0xad, 0x41, 0x97, 0x7f, 0x1e, 0xa7, 0xb6, 0x82, 0x44, 0x9a, 0xaf,
+	0x9a, 0x92, 0x92, 0xec, 0x3b, 0x3c, 0x2c, 0xa6, 0x3a, 0xac, 0x8d, 0xe7, 0x94, 0xf9, 0xa2, 0x09,
+	0x75, 0x16, 0x7c, 0xf8, 0xf2, 0xef, 0x60, 0xa9, 0xfb, 0x83, 0xa5, 0x5e, 0x1d, 0x2c, 0xf5, 0xcf,
+	0xd1, 0x52, 0xf6, 0x47, 0x4b, 0xf9, 0x7f, 0xb4, 0x94, 0x6f, 0x63, 0x1a, 0x24, 0xab, 0x5f, 0x33,
+	0x77, 0xce, 0x42, 0x6f, 0x1a, 0x2c, 0xe7, 0xab, 0x9f, 0x41, 0xe4, 0x15, 0xab, 0xbd, 0xbd, 0xb1,
+	0xdc, 0xe9, 0x66, 0xcf, 0x1e, 0xa4, 0xab, 0xfd, 0xfa, 0x7a, 0x00, 0xf2, 0x4e, 0x33, 0xcd, 0x55,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -345,6 +448,7 @@ type MsgClient interface {
 	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
 	Deregister(ctx context.Context, in *MsgDeregister, opts ...grpc.CallOption) (*MsgDeregisterResponse, error)
 	SetRegistry(ctx context.Context, in *MsgSetRegistry, opts ...grpc.CallOption) (*MsgSetRegistryResponse, error)
+	Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error)
 }
 
 type msgClient struct {
@@ -382,11 +486,21 @@ func (c *msgClient) SetRegistry(ctx context.Context, in *MsgSetRegistry, opts ..
 	return out, nil
 }
 
+func (c *msgClient) Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error) {
+	out := new(MsgApproveResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.tokenregistry.v1.Msg/Approve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
 	Deregister(context.Context, *MsgDeregister) (*MsgDeregisterResponse, error)
 	SetRegistry(context.Context, *MsgSetRegistry) (*MsgSetRegistryResponse, error)
+	Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -402,6 +516,9 @@ func (*UnimplementedMsgServer) Deregister(ctx context.Context, req *MsgDeregiste
 func (*UnimplementedMsgServer) SetRegistry(ctx context.Context, req *MsgSetRegistry) (*MsgSetRegistryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRegistry not implemented")
 }
+func (*UnimplementedMsgServer) Approve(ctx context.Context, req *MsgApprove) (*MsgApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -461,6 +578,24 @@ func _Msg_SetRegistry_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.tokenregistry.v1.Msg/Approve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Approve(ctx, req.(*MsgApprove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sifnode.tokenregistry.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -477,6 +612,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRegistry",
 			Handler:    _Msg_SetRegistry_Handler,
 		},
+		{
+			MethodName: "Approve",
+			Handler:    _Msg_Approve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sifnode/tokenregistry/v1/tx.proto",
@@ -672,6 +811,84 @@ func (m *MsgDeregisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgApprove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApprove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApprove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA4 := make([]byte, len(m.Permissions)*10)
+		var j3 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -761,6 +978,39 @@ func (m *MsgDeregisterResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgApprove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgApproveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1267,6 +1517,239 @@ func (m *MsgDeregisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgApprove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApprove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApprove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
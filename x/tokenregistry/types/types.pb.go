@@ -151,19 +151,21 @@ func (m *Registry) GetEntries() []*RegistryEntry {
 }
 
 type RegistryEntry struct {
-	Decimals                 int64        `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	Denom                    string       `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
-	BaseDenom                string       `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	Path                     string       `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
-	IbcChannelId             string       `protobuf:"bytes,6,opt,name=ibc_channel_id,json=ibcChannelId,proto3" json:"ibc_channel_id,omitempty"`
-	IbcCounterpartyChannelId string       `protobuf:"bytes,7,opt,name=ibc_counterparty_channel_id,json=ibcCounterpartyChannelId,proto3" json:"ibc_counterparty_channel_id,omitempty"`
-	DisplayName              string       `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	DisplaySymbol            string       `protobuf:"bytes,9,opt,name=display_symbol,json=displaySymbol,proto3" json:"display_symbol,omitempty"`
-	Network                  string       `protobuf:"bytes,10,opt,name=network,proto3" json:"network,omitempty"`
-	Address                  string       `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
-	ExternalSymbol           string       `protobuf:"bytes,12,opt,name=external_symbol,json=externalSymbol,proto3" json:"external_symbol,omitempty"`
-	TransferLimit            string       `protobuf:"bytes,13,opt,name=transfer_limit,json=transferLimit,proto3" json:"transfer_limit,omitempty"`
-	Permissions              []Permission `protobuf:"varint,15,rep,packed,name=permissions,proto3,enum=sifnode.tokenregistry.v1.Permission" json:"permissions,omitempty"`
+	Decimals                 int64  `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Denom                    string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	BaseDenom                string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	Path                     string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	IbcChannelId             string `protobuf:"bytes,6,opt,name=ibc_channel_id,json=ibcChannelId,proto3" json:"ibc_channel_id,omitempty"`
+	IbcCounterpartyChannelId string `protobuf:"bytes,7,opt,name=ibc_counterparty_channel_id,json=ibcCounterpartyChannelId,proto3" json:"ibc_counterparty_channel_id,omitempty"`
+	DisplayName              string `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	DisplaySymbol            string `protobuf:"bytes,9,opt,name=display_symbol,json=displaySymbol,proto3" json:"display_symbol,omitempty"`
+	Network                  string `protobuf:"bytes,10,opt,name=network,proto3" json:"network,omitempty"`
+	Address                  string `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	ExternalSymbol           string `protobuf:"bytes,12,opt,name=external_symbol,json=externalSymbol,proto3" json:"external_symbol,omitempty"`
+	// The maximum amount of this token that may cross the ethereum bridge in a
+	// single transfer. Empty means no limit.
+	TransferLimit string       `protobuf:"bytes,13,opt,name=transfer_limit,json=transferLimit,proto3" json:"transfer_limit,omitempty"`
+	Permissions   []Permission `protobuf:"varint,15,rep,packed,name=permissions,proto3,enum=sifnode.tokenregistry.v1.Permission" json:"permissions,omitempty"`
 	// The name of denomination unit of this token that is the smallest unit
 	// stored. IBC imports of this RegistryEntry convert and store funds as
 	// unit_denom. Several different denom units of a token may be imported into
@@ -182,6 +184,14 @@ type RegistryEntry struct {
 	// the packet level. i.e rowan -> microrowan i.e microrowan -> microrowan
 	IbcCounterpartyDenom   string `protobuf:"bytes,17,opt,name=ibc_counterparty_denom,json=ibcCounterpartyDenom,proto3" json:"ibc_counterparty_denom,omitempty"`
 	IbcCounterpartyChainId string `protobuf:"bytes,18,opt,name=ibc_counterparty_chain_id,json=ibcCounterpartyChainId,proto3" json:"ibc_counterparty_chain_id,omitempty"`
+	// The maximum volume of this token that may cross the ethereum bridge within
+	// a rolling window of transfer_window blocks. Empty means no limit.
+	WindowTransferLimit string `protobuf:"bytes,19,opt,name=window_transfer_limit,json=windowTransferLimit,proto3" json:"window_transfer_limit,omitempty"`
+	// The length in blocks of the rolling window used by window_transfer_limit.
+	TransferWindow int64 `protobuf:"varint,20,opt,name=transfer_window,json=transferWindow,proto3" json:"transfer_window,omitempty"`
+	// Quarantined entries were created by the ethereum bridge for a newly bridged
+	// token. They have no permissions until the admin approves them.
+	Quarantined bool `protobuf:"varint,21,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
 }
 
 func (m *RegistryEntry) Reset()         { *m = RegistryEntry{} }
@@ -329,6 +339,27 @@ func (m *RegistryEntry) GetIbcCounterpartyChainId() string {
 	return ""
 }
 
+func (m *RegistryEntry) GetWindowTransferLimit() string {
+	if m != nil {
+		return m.WindowTransferLimit
+	}
+	return ""
+}
+
+func (m *RegistryEntry) GetTransferWindow() int64 {
+	if m != nil {
+		return m.TransferWindow
+	}
+	return 0
+}
+
+func (m *RegistryEntry) GetQuarantined() bool {
+	if m != nil {
+		return m.Quarantined
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("sifnode.tokenregistry.v1.Permission", Permission_name, Permission_value)
 	proto.RegisterType((*GenesisState)(nil), "sifnode.tokenregistry.v1.GenesisState")
@@ -341,45 +372,48 @@ func init() {
 }
 
 var fileDescriptor_d08afdaf425e66ea = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x4f, 0xdb, 0x4c,
-	0x10, 0x8e, 0x09, 0x90, 0x64, 0xf2, 0x41, 0xde, 0x15, 0x42, 0xfb, 0x52, 0x35, 0x4a, 0x53, 0x10,
-	0x51, 0x0f, 0x89, 0xa0, 0xbd, 0xf4, 0xd0, 0x4a, 0x10, 0x42, 0x95, 0x16, 0x68, 0xe4, 0xb4, 0x52,
-	0xd5, 0x8b, 0xb5, 0xb6, 0x97, 0xb0, 0xc2, 0xde, 0xb5, 0x76, 0x17, 0x4a, 0x7e, 0x40, 0xef, 0xfd,
-	0x59, 0x3d, 0x72, 0xec, 0xb1, 0x82, 0x3f, 0x52, 0x79, 0xd7, 0xe6, 0xb3, 0xa8, 0xb7, 0x9d, 0xe7,
-	0x63, 0x32, 0x99, 0x47, 0x1e, 0x58, 0x53, 0xec, 0x88, 0x8b, 0x90, 0xf6, 0xb5, 0x38, 0xa1, 0x5c,
-	0xd2, 0x29, 0x53, 0x5a, 0xce, 0xfa, 0x67, 0x9b, 0x7d, 0x3d, 0x4b, 0xa8, 0xea, 0x25, 0x52, 0x68,
-	0x81, 0x70, 0xa6, 0xea, 0xdd, 0x51, 0xf5, 0xce, 0x36, 0x57, 0x97, 0xa7, 0x62, 0x2a, 0x8c, 0xa8,
-	0x9f, 0xbe, 0xac, 0xbe, 0xa3, 0xa0, 0xf6, 0x8e, 0x72, 0xaa, 0x98, 0x9a, 0x68, 0xa2, 0x29, 0x7a,
-	0x0e, 0x75, 0x12, 0xc6, 0x8c, 0x7b, 0x24, 0x08, 0xc4, 0x29, 0xd7, 0xd8, 0x69, 0x3b, 0xdd, 0x8a,
-	0x5b, 0x33, 0xe0, 0xb6, 0xc5, 0xd0, 0x5b, 0x28, 0xe7, 0x9d, 0xf1, 0x5c, 0xdb, 0xe9, 0x56, 0xb7,
-	0x3a, 0xbd, 0xc7, 0x7e, 0xb7, 0xe7, 0x66, 0x6f, 0xf7, 0xda, 0xd3, 0x39, 0x80, 0x72, 0x8e, 0xa2,
-	0x6d, 0x28, 0x51, 0xae, 0x25, 0xa3, 0x0a, 0x3b, 0xed, 0x62, 0xb7, 0xba, 0xb5, 0xf1, 0xef, 0x56,
-	0x43, 0x9e, 0xf6, 0xcb, 0x7d, 0x9d, 0xef, 0x0b, 0x50, 0xbf, 0x43, 0xa1, 0x55, 0x28, 0x87, 0x34,
-	0x60, 0x31, 0x89, 0x94, 0x19, 0xb0, 0xe8, 0x5e, 0xd7, 0x68, 0x19, 0x16, 0x42, 0xca, 0x45, 0x8c,
-	0x8b, 0xe6, 0x9f, 0xd9, 0x02, 0x3d, 0x05, 0xf0, 0x89, 0xa2, 0x9e, 0xa5, 0xe6, 0x0d, 0x55, 0x49,
-	0x91, 0x5d, 0x43, 0x23, 0x98, 0x4f, 0x88, 0x3e, 0xc6, 0x0b, 0x86, 0x30, 0x6f, 0xb4, 0x06, 0x0d,
-	0xe6, 0x07, 0x5e, 0x70, 0x4c, 0x38, 0xa7, 0x91, 0xc7, 0x42, 0xbc, 0x68, 0x77, 0xc5, 0xfc, 0x60,
-	0x60, 0xc1, 0x51, 0x88, 0xde, 0xc0, 0x13, 0xa3, 0x4a, 0x17, 0x47, 0x65, 0x42, 0xa4, 0x9e, 0xdd,
-	0xb6, 0x94, 0x8c, 0x05, 0xa7, 0x96, 0x5b, 0x8a, 0x1b, 0xfb, 0x33, 0xa8, 0x85, 0x4c, 0x25, 0x11,
-	0x99, 0x79, 0x9c, 0xc4, 0x14, 0x97, 0x8d, 0xbe, 0x9a, 0x61, 0x87, 0x24, 0xa6, 0x68, 0x1d, 0x1a,
-	0xb9, 0x44, 0xcd, 0x62, 0x5f, 0x44, 0xb8, 0x62, 0x44, 0xf5, 0x0c, 0x9d, 0x18, 0x10, 0x61, 0x28,
-	0x71, 0xaa, 0xbf, 0x09, 0x79, 0x82, 0xc1, 0xf0, 0x79, 0x99, 0x32, 0x24, 0x0c, 0x25, 0x55, 0x0a,
-	0x57, 0x2d, 0x93, 0x95, 0x68, 0x03, 0x96, 0xe8, 0xb9, 0xa6, 0x92, 0x93, 0x28, 0xef, 0x5d, 0x33,
-	0x8a, 0x46, 0x0e, 0x67, 0xcd, 0xd7, 0xa1, 0xa1, 0x25, 0xe1, 0xea, 0x88, 0x4a, 0x2f, 0x62, 0x31,
-	0xd3, 0xb8, 0x6e, 0x67, 0xc8, 0xd1, 0xfd, 0x14, 0x44, 0x7b, 0x50, 0x4d, 0xa8, 0x8c, 0x99, 0x52,
-	0x4c, 0x70, 0x85, 0x97, 0xda, 0xc5, 0x6e, 0x63, 0x6b, 0xed, 0xf1, 0xc0, 0xc7, 0xd7, 0x62, 0xf7,
-	0xb6, 0x31, 0x4d, 0xeb, 0x94, 0x33, 0x9d, 0xa5, 0xd5, 0xb4, 0x69, 0xa5, 0x88, 0x4d, 0xeb, 0x15,
-	0xac, 0x3c, 0xd8, 0xb9, 0x95, 0xfe, 0x67, 0xa4, 0xcb, 0xf7, 0xd6, 0x6d, 0x5d, 0xaf, 0xe1, 0xff,
-	0xbf, 0x25, 0xc5, 0x78, 0x9a, 0x13, 0x32, 0xc6, 0x95, 0x87, 0x39, 0x31, 0x3e, 0x0a, 0xdf, 0xcf,
-	0x97, 0x9d, 0xe6, 0xdc, 0x8b, 0x5d, 0x80, 0x9b, 0x81, 0xd1, 0x12, 0x54, 0x3f, 0x1f, 0x4e, 0xc6,
-	0xc3, 0xc1, 0x68, 0x6f, 0x34, 0xdc, 0x6d, 0x16, 0x50, 0x09, 0x8a, 0x83, 0xfd, 0x71, 0xd3, 0x41,
-	0x75, 0xa8, 0x8c, 0x76, 0x06, 0xc3, 0x2f, 0xe3, 0x8f, 0xee, 0xa7, 0xe6, 0x5c, 0x56, 0x8e, 0x0e,
-	0x4c, 0x59, 0xdc, 0xf9, 0xf0, 0xf3, 0xb2, 0xe5, 0x5c, 0x5c, 0xb6, 0x9c, 0xdf, 0x97, 0x2d, 0xe7,
-	0xc7, 0x55, 0xab, 0x70, 0x71, 0xd5, 0x2a, 0xfc, 0xba, 0x6a, 0x15, 0xbe, 0x6e, 0x4e, 0x99, 0x3e,
-	0x3e, 0xf5, 0x7b, 0x81, 0x88, 0xfb, 0x13, 0x76, 0x64, 0x66, 0xeb, 0xe7, 0x57, 0xe1, 0xfc, 0xde,
-	0x5d, 0x30, 0x47, 0xc1, 0x5f, 0x34, 0x5f, 0xf9, 0xcb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e,
-	0x0e, 0xd3, 0xd6, 0x3d, 0x04, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x4f, 0x1a, 0x4f,
+	0x18, 0x66, 0x45, 0x05, 0x5e, 0xfe, 0xfe, 0x46, 0x34, 0xf3, 0xb3, 0x29, 0xa1, 0x54, 0x23, 0xe9,
+	0x01, 0x22, 0xed, 0xa5, 0x87, 0x36, 0x51, 0xc4, 0x86, 0x56, 0x2d, 0x59, 0x6c, 0xda, 0xf4, 0xb2,
+	0x19, 0x76, 0x47, 0x9c, 0xc8, 0xce, 0xd2, 0x99, 0x41, 0xe5, 0xde, 0x0f, 0xd0, 0x8f, 0xd5, 0xa3,
+	0xc7, 0x1e, 0x1b, 0xfd, 0x22, 0xcd, 0xce, 0xec, 0x22, 0x6a, 0x4d, 0x6f, 0xf3, 0x3e, 0x7f, 0x5e,
+	0x86, 0xe7, 0xd9, 0x0c, 0x6c, 0x48, 0x76, 0xc2, 0x03, 0x8f, 0x36, 0x55, 0x70, 0x46, 0xb9, 0xa0,
+	0x43, 0x26, 0x95, 0x98, 0x36, 0xcf, 0xb7, 0x9b, 0x6a, 0x3a, 0xa6, 0xb2, 0x31, 0x16, 0x81, 0x0a,
+	0x10, 0x8e, 0x54, 0x8d, 0x3b, 0xaa, 0xc6, 0xf9, 0xf6, 0x7a, 0x79, 0x18, 0x0c, 0x03, 0x2d, 0x6a,
+	0x86, 0x27, 0xa3, 0xaf, 0x49, 0xc8, 0xbd, 0xa3, 0x9c, 0x4a, 0x26, 0xfb, 0x8a, 0x28, 0x8a, 0x9e,
+	0x43, 0x9e, 0x78, 0x3e, 0xe3, 0x0e, 0x71, 0xdd, 0x60, 0xc2, 0x15, 0xb6, 0xaa, 0x56, 0x3d, 0x63,
+	0xe7, 0x34, 0xb8, 0x63, 0x30, 0xf4, 0x16, 0xd2, 0xf1, 0x66, 0xbc, 0x50, 0xb5, 0xea, 0xd9, 0x56,
+	0xad, 0xf1, 0xd8, 0xef, 0x36, 0xec, 0xe8, 0x6c, 0xcf, 0x3c, 0xb5, 0x43, 0x48, 0xc7, 0x28, 0xda,
+	0x81, 0x14, 0xe5, 0x4a, 0x30, 0x2a, 0xb1, 0x55, 0x4d, 0xd6, 0xb3, 0xad, 0xad, 0x7f, 0xaf, 0xea,
+	0xf0, 0x70, 0x5f, 0xec, 0xab, 0x7d, 0x5f, 0x86, 0xfc, 0x1d, 0x0a, 0xad, 0x43, 0xda, 0xa3, 0x2e,
+	0xf3, 0xc9, 0x48, 0xea, 0x0b, 0x26, 0xed, 0xd9, 0x8c, 0xca, 0xb0, 0xe4, 0x51, 0x1e, 0xf8, 0x38,
+	0xa9, 0xff, 0x99, 0x19, 0xd0, 0x53, 0x80, 0x01, 0x91, 0xd4, 0x31, 0xd4, 0xa2, 0xa6, 0x32, 0x21,
+	0xb2, 0xa7, 0x69, 0x04, 0x8b, 0x63, 0xa2, 0x4e, 0xf1, 0x92, 0x26, 0xf4, 0x19, 0x6d, 0x40, 0x81,
+	0x0d, 0x5c, 0xc7, 0x3d, 0x25, 0x9c, 0xd3, 0x91, 0xc3, 0x3c, 0xbc, 0x6c, 0xb2, 0x62, 0x03, 0xb7,
+	0x6d, 0xc0, 0xae, 0x87, 0xde, 0xc0, 0x13, 0xad, 0x0a, 0x83, 0xa3, 0x62, 0x4c, 0x84, 0x9a, 0xce,
+	0x5b, 0x52, 0xda, 0x82, 0x43, 0xcb, 0x9c, 0xe2, 0xd6, 0xfe, 0x0c, 0x72, 0x1e, 0x93, 0xe3, 0x11,
+	0x99, 0x3a, 0x9c, 0xf8, 0x14, 0xa7, 0xb5, 0x3e, 0x1b, 0x61, 0x47, 0xc4, 0xa7, 0x68, 0x13, 0x0a,
+	0xb1, 0x44, 0x4e, 0xfd, 0x41, 0x30, 0xc2, 0x19, 0x2d, 0xca, 0x47, 0x68, 0x5f, 0x83, 0x08, 0x43,
+	0x8a, 0x53, 0x75, 0x11, 0x88, 0x33, 0x0c, 0x9a, 0x8f, 0xc7, 0x90, 0x21, 0x9e, 0x27, 0xa8, 0x94,
+	0x38, 0x6b, 0x98, 0x68, 0x44, 0x5b, 0x50, 0xa4, 0x97, 0x8a, 0x0a, 0x4e, 0x46, 0xf1, 0xee, 0x9c,
+	0x56, 0x14, 0x62, 0x38, 0x5a, 0xbe, 0x09, 0x05, 0x25, 0x08, 0x97, 0x27, 0x54, 0x38, 0x23, 0xe6,
+	0x33, 0x85, 0xf3, 0xe6, 0x0e, 0x31, 0x7a, 0x10, 0x82, 0x68, 0x1f, 0xb2, 0x63, 0x2a, 0x7c, 0x26,
+	0x25, 0x0b, 0xb8, 0xc4, 0xc5, 0x6a, 0xb2, 0x5e, 0x68, 0x6d, 0x3c, 0x5e, 0x78, 0x6f, 0x26, 0xb6,
+	0xe7, 0x8d, 0x61, 0x5b, 0x13, 0xce, 0x54, 0xd4, 0x56, 0xc9, 0xb4, 0x15, 0x22, 0xa6, 0xad, 0x57,
+	0xb0, 0xf6, 0x20, 0x73, 0x23, 0xfd, 0x4f, 0x4b, 0xcb, 0xf7, 0xe2, 0x36, 0xae, 0xd7, 0xf0, 0xff,
+	0xdf, 0x9a, 0x62, 0x3c, 0xec, 0x09, 0x69, 0xe3, 0xda, 0xc3, 0x9e, 0x18, 0xef, 0x7a, 0xa8, 0x05,
+	0xab, 0x17, 0x8c, 0x7b, 0xc1, 0x85, 0x73, 0x2f, 0x85, 0x15, 0x6d, 0x5b, 0x31, 0xe4, 0xf1, 0x9d,
+	0x2c, 0xb6, 0xa0, 0x38, 0x13, 0x1b, 0x1e, 0x97, 0xf5, 0xa7, 0x3a, 0x4b, 0xf2, 0xb3, 0x46, 0x51,
+	0x15, 0xb2, 0xdf, 0x26, 0x44, 0x10, 0xae, 0x18, 0xa7, 0x1e, 0x5e, 0xad, 0x5a, 0xf5, 0xb4, 0x3d,
+	0x0f, 0xbd, 0x5f, 0x4c, 0x5b, 0xa5, 0x85, 0x17, 0x7b, 0x00, 0xb7, 0x79, 0xa1, 0x22, 0x64, 0x3f,
+	0x1d, 0xf5, 0x7b, 0x9d, 0x76, 0x77, 0xbf, 0xdb, 0xd9, 0x2b, 0x25, 0x50, 0x0a, 0x92, 0xed, 0x83,
+	0x5e, 0xc9, 0x42, 0x79, 0xc8, 0x74, 0x77, 0xdb, 0x9d, 0x2f, 0xbd, 0x8f, 0xf6, 0x71, 0x69, 0x21,
+	0x1a, 0xbb, 0x87, 0x7a, 0x4c, 0xee, 0x7e, 0xf8, 0x79, 0x5d, 0xb1, 0xae, 0xae, 0x2b, 0xd6, 0xef,
+	0xeb, 0x8a, 0xf5, 0xe3, 0xa6, 0x92, 0xb8, 0xba, 0xa9, 0x24, 0x7e, 0xdd, 0x54, 0x12, 0x5f, 0xb7,
+	0x87, 0x4c, 0x9d, 0x4e, 0x06, 0x0d, 0x37, 0xf0, 0x9b, 0x7d, 0x76, 0xa2, 0xa3, 0x69, 0xc6, 0x8f,
+	0xd2, 0xe5, 0xbd, 0x67, 0x49, 0xbf, 0x49, 0x83, 0x65, 0xfd, 0xc8, 0xbc, 0xfc, 0x33, 0x00, 0xe8,
+	0x7c, 0x86, 0x44, 0xbc, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -481,6 +515,34 @@ func (m *RegistryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Quarantined {
+		i--
+		if m.Quarantined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.TransferWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TransferWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.WindowTransferLimit) > 0 {
+		i -= len(m.WindowTransferLimit)
+		copy(dAtA[i:], m.WindowTransferLimit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WindowTransferLimit)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.IbcCounterpartyChainId) > 0 {
 		i -= len(m.IbcCounterpartyChainId)
 		copy(dAtA[i:], m.IbcCounterpartyChainId)
@@ -726,6 +788,16 @@ func (m *RegistryEntry) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovTypes(uint64(l))
 	}
+	l = len(m.WindowTransferLimit)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.TransferWindow != 0 {
+		n += 2 + sovTypes(uint64(m.TransferWindow))
+	}
+	if m.Quarantined {
+		n += 3
+	}
 	return n
 }
 
@@ -1502,6 +1574,77 @@ func (m *RegistryEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.IbcCounterpartyChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowTransferLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowTransferLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferWindow", wireType)
+			}
+			m.TransferWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quarantined = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])